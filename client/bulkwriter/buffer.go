@@ -0,0 +1,516 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bulkwriter
+
+import (
+	"bufio"
+	"encoding/json"
+	"io"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strconv"
+	"unicode/utf8"
+
+	"github.com/apache/arrow/go/v17/arrow"
+	"github.com/apache/arrow/go/v17/arrow/array"
+	"github.com/apache/arrow/go/v17/arrow/memory"
+	"github.com/apache/arrow/go/v17/parquet"
+	"github.com/apache/arrow/go/v17/parquet/compress"
+	"github.com/apache/arrow/go/v17/parquet/pqarrow"
+	"github.com/cockroachdb/errors"
+	"github.com/samber/lo"
+
+	"github.com/milvus-io/milvus/client/v2/column"
+	"github.com/milvus-io/milvus/client/v2/entity"
+)
+
+// buffer holds the rows appended to the bulk writer before they are persisted into files.
+// The values are kept per field, nil represents the null value of a nullable field.
+type buffer struct {
+	fields []*entity.Field
+	values map[string][]any
+
+	rowCount int
+	size     int64
+}
+
+func newBuffer(fields []*entity.Field) *buffer {
+	return &buffer{
+		fields: fields,
+		values: make(map[string][]any, len(fields)),
+	}
+}
+
+// appendColumns validates the columns and appends them into the buffer.
+// The columns must have the same length and cover all the fields except the nullable and dynamic ones.
+func (b *buffer) appendColumns(columns []column.Column) error {
+	nameColumns := make(map[string]column.Column, len(columns))
+	rowCount := -1
+	for _, col := range columns {
+		if _, ok := nameColumns[col.Name()]; ok {
+			return errors.Newf("duplicated column %s", col.Name())
+		}
+		if rowCount >= 0 && col.Len() != rowCount {
+			return errors.Newf("column %s has %d rows, but other columns have %d rows", col.Name(), col.Len(), rowCount)
+		}
+		rowCount = col.Len()
+		nameColumns[col.Name()] = col
+	}
+	if rowCount <= 0 {
+		return nil
+	}
+
+	values := make(map[string][]any, len(b.fields))
+	var size int64
+	for _, field := range b.fields {
+		col, ok := nameColumns[field.Name]
+		if !ok && field.IsDynamic {
+			// the dynamic column converted from rows has empty name
+			col, ok = nameColumns[""]
+		}
+		if !ok && field.IsDynamic {
+			// no dynamic values provided
+			values[field.Name] = lo.RepeatBy(rowCount, func(int) any { return []byte("{}") })
+			continue
+		}
+		if !ok {
+			if !field.Nullable && field.DefaultValue == nil {
+				return errors.Newf("column of field %s is not provided", field.Name)
+			}
+			values[field.Name] = make([]any, rowCount)
+			continue
+		}
+		delete(nameColumns, col.Name())
+		if !isColumnTypeMatch(field, col) {
+			return errors.Newf("column %s type %s does not match the field type %s", col.Name(), col.Type().String(), field.DataType.String())
+		}
+
+		fieldValues, fieldSize, err := readColumn(field, col)
+		if err != nil {
+			return err
+		}
+		values[field.Name] = fieldValues
+		size += fieldSize
+	}
+	if len(nameColumns) > 0 {
+		return errors.Newf("columns %v are not in the schema", lo.Keys(nameColumns))
+	}
+
+	for _, field := range b.fields {
+		b.values[field.Name] = append(b.values[field.Name], values[field.Name]...)
+	}
+	b.rowCount += rowCount
+	b.size += size
+	return nil
+}
+
+func isColumnTypeMatch(field *entity.Field, col column.Column) bool {
+	switch field.DataType {
+	case entity.FieldTypeVarChar, entity.FieldTypeString:
+		return col.Type() == entity.FieldTypeVarChar || col.Type() == entity.FieldTypeString
+	default:
+		return col.Type() == field.DataType
+	}
+}
+
+// readColumn reads all the values of the column and validates them against the field schema.
+func readColumn(field *entity.Field, col column.Column) ([]any, int64, error) {
+	values := make([]any, 0, col.Len())
+	var size int64
+	// the values of nullable column are compact, the null rows are skipped
+	valueIdx := 0
+	for i := 0; i < col.Len(); i++ {
+		isNull, err := col.IsNull(i)
+		if err != nil {
+			return nil, 0, err
+		}
+		if isNull {
+			if !field.Nullable {
+				return nil, 0, errors.Newf("field %s is not nullable, but row %d is null", field.Name, i)
+			}
+			values = append(values, nil)
+			continue
+		}
+
+		idx := i
+		if col.Nullable() {
+			idx = valueIdx
+			valueIdx++
+		}
+		value, err := col.Get(idx)
+		if err != nil {
+			return nil, 0, err
+		}
+		valueSize, err := checkValue(field, value)
+		if err != nil {
+			return nil, 0, errors.Wrapf(err, "invalid value of field %s at row %d", field.Name, i)
+		}
+		values = append(values, value)
+		size += valueSize
+	}
+	return values, size, nil
+}
+
+// checkValue validates the value against the field schema and returns the estimated memory size.
+func checkValue(field *entity.Field, value any) (int64, error) {
+	switch v := value.(type) {
+	case bool, int8:
+		return 1, nil
+	case int16:
+		return 2, nil
+	case int32, float32:
+		return 4, nil
+	case int64, float64:
+		return 8, nil
+	case string:
+		if maxLength, ok := getTypeParam(field, entity.TypeParamMaxLength); ok && int64(utf8.RuneCountInString(v)) > maxLength {
+			return 0, errors.Newf("length %d exceeds the max length %d", utf8.RuneCountInString(v), maxLength)
+		}
+		return int64(len(v)), nil
+	case []byte:
+		// json field or dynamic field
+		if !json.Valid(v) {
+			return 0, errors.Newf("invalid json %s", string(v))
+		}
+		if field.IsDynamic {
+			var m map[string]any
+			if err := json.Unmarshal(v, &m); err != nil {
+				return 0, errors.Newf("dynamic field value must be a json object, got %s", string(v))
+			}
+		}
+		return int64(len(v)), nil
+	case entity.SparseEmbedding:
+		return int64(v.Len() * 8), nil
+	case entity.Vector:
+		dim, err := field.GetDim()
+		if err != nil {
+			return 0, err
+		}
+		if int64(v.Dim()) != dim {
+			return 0, errors.Newf("vector dim %d does not match the field dim %d", v.Dim(), dim)
+		}
+		rv := reflect.ValueOf(v)
+		return int64(rv.Len()) * int64(rv.Type().Elem().Size()), nil
+	}
+
+	// array field
+	rv := reflect.ValueOf(value)
+	if rv.Kind() != reflect.Slice {
+		return 0, errors.Newf("unsupported value type %T", value)
+	}
+	if maxCapacity, ok := getTypeParam(field, entity.TypeParamMaxCapacity); ok && int64(rv.Len()) > maxCapacity {
+		return 0, errors.Newf("array length %d exceeds the max capacity %d", rv.Len(), maxCapacity)
+	}
+	if strs, ok := value.([]string); ok {
+		var size int64
+		for _, str := range strs {
+			size += int64(len(str))
+		}
+		return size, nil
+	}
+	return int64(rv.Len()) * int64(rv.Type().Elem().Size()), nil
+}
+
+func getTypeParam(field *entity.Field, key string) (int64, bool) {
+	str, ok := field.TypeParams[key]
+	if !ok {
+		return 0, false
+	}
+	v, err := strconv.ParseInt(str, 10, 64)
+	if err != nil {
+		return 0, false
+	}
+	return v, true
+}
+
+// persist writes the buffered rows into files under the directory, and returns the file paths.
+func (b *buffer) persist(dir string, name string, fileType BulkFileType) ([]string, error) {
+	if err := os.MkdirAll(dir, os.ModePerm); err != nil {
+		return nil, err
+	}
+	switch fileType {
+	case ParquetFileType:
+		path := filepath.Join(dir, name+".parquet")
+		return []string{path}, writeFile(path, b.writeParquet)
+	case JSONFileType:
+		path := filepath.Join(dir, name+".json")
+		return []string{path}, writeFile(path, b.writeJSON)
+	case NumpyFileType:
+		// one numpy file per field under the sub-directory
+		subDir := filepath.Join(dir, name)
+		if err := os.MkdirAll(subDir, os.ModePerm); err != nil {
+			return nil, err
+		}
+		paths := make([]string, 0, len(b.fields))
+		for _, field := range b.fields {
+			field := field
+			path := filepath.Join(subDir, field.Name+".npy")
+			err := writeFile(path, func(w io.Writer) error {
+				return writeNumpy(w, field, b.values[field.Name])
+			})
+			if err != nil {
+				return nil, err
+			}
+			paths = append(paths, path)
+		}
+		return paths, nil
+	default:
+		return nil, errors.Newf("unsupported file type %s", fileType)
+	}
+}
+
+func writeFile(path string, write func(w io.Writer) error) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	if err := write(f); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+// writeJSON writes the rows in the row-based json format: {"rows": [{...}, {...}]}
+func (b *buffer) writeJSON(w io.Writer) error {
+	bw := bufio.NewWriter(w)
+	if _, err := bw.WriteString(`{"rows":[`); err != nil {
+		return err
+	}
+	for i := 0; i < b.rowCount; i++ {
+		row := make(map[string]any, len(b.fields))
+		for _, field := range b.fields {
+			row[field.Name] = toJSONValue(b.values[field.Name][i])
+		}
+		bs, err := json.Marshal(row)
+		if err != nil {
+			return errors.Wrapf(err, "failed to marshal row %d", i)
+		}
+		if i > 0 {
+			bw.WriteByte(',')
+		}
+		bw.Write(bs)
+	}
+	if _, err := bw.WriteString("]}\n"); err != nil {
+		return err
+	}
+	return bw.Flush()
+}
+
+// toJSONValue converts the value into the form which the json import reader accepts.
+func toJSONValue(value any) any {
+	switch v := value.(type) {
+	case []byte:
+		return json.RawMessage(v)
+	case entity.BinaryVector:
+		return lo.Map(v, func(b byte, _ int) int { return int(b) })
+	case entity.Float16Vector:
+		return v.ToFloat32Vector()
+	case entity.BFloat16Vector:
+		return v.ToFloat32Vector()
+	case entity.SparseEmbedding:
+		return sparseToMap(v)
+	default:
+		return v
+	}
+}
+
+func sparseToMap(v entity.SparseEmbedding) map[string]float32 {
+	m := make(map[string]float32, v.Len())
+	for i := 0; i < v.Len(); i++ {
+		pos, value, _ := v.Get(i)
+		m[strconv.FormatUint(uint64(pos), 10)] = value
+	}
+	return m
+}
+
+// writeParquet writes the rows into a parquet file with one column per field.
+func (b *buffer) writeParquet(w io.Writer) error {
+	arrFields := make([]arrow.Field, 0, len(b.fields))
+	for _, field := range b.fields {
+		dataType, err := toArrowDataType(field.DataType, field.ElementType)
+		if err != nil {
+			return err
+		}
+		arrFields = append(arrFields, arrow.Field{
+			Name:     field.Name,
+			Type:     dataType,
+			Nullable: field.Nullable,
+			Metadata: arrow.Metadata{},
+		})
+	}
+	arrSchema := arrow.NewSchema(arrFields, nil)
+
+	builder := array.NewRecordBuilder(memory.DefaultAllocator, arrSchema)
+	defer builder.Release()
+	for i, field := range b.fields {
+		for _, value := range b.values[field.Name] {
+			if err := appendArrowValue(builder.Field(i), value); err != nil {
+				return errors.Wrapf(err, "failed to build parquet column %s", field.Name)
+			}
+		}
+	}
+	record := builder.NewRecord()
+	defer record.Release()
+
+	// hide the Close of w, the parquet writer closes the sink on closing while the file is closed by the caller
+	fw, err := pqarrow.NewFileWriter(arrSchema, struct{ io.Writer }{w},
+		parquet.NewWriterProperties(parquet.WithCompression(compress.Codecs.Zstd)),
+		pqarrow.DefaultWriterProps())
+	if err != nil {
+		return err
+	}
+	if err := fw.Write(record); err != nil {
+		fw.Close()
+		return err
+	}
+	return fw.Close()
+}
+
+// toArrowDataType returns the arrow type of the field, which must be the same as the import reader expects.
+func toArrowDataType(dataType entity.FieldType, elementType entity.FieldType) (arrow.DataType, error) {
+	listOf := func(elem arrow.DataType) arrow.DataType {
+		return arrow.ListOfField(arrow.Field{
+			Name:     "item",
+			Type:     elem,
+			Nullable: true,
+			Metadata: arrow.Metadata{},
+		})
+	}
+	switch dataType {
+	case entity.FieldTypeBool:
+		return &arrow.BooleanType{}, nil
+	case entity.FieldTypeInt8:
+		return &arrow.Int8Type{}, nil
+	case entity.FieldTypeInt16:
+		return &arrow.Int16Type{}, nil
+	case entity.FieldTypeInt32:
+		return &arrow.Int32Type{}, nil
+	case entity.FieldTypeInt64:
+		return &arrow.Int64Type{}, nil
+	case entity.FieldTypeFloat:
+		return &arrow.Float32Type{}, nil
+	case entity.FieldTypeDouble:
+		return &arrow.Float64Type{}, nil
+	case entity.FieldTypeVarChar, entity.FieldTypeString, entity.FieldTypeJSON, entity.FieldTypeSparseVector:
+		// json and sparse vector are stored as json string
+		return &arrow.StringType{}, nil
+	case entity.FieldTypeArray:
+		elem, err := toArrowDataType(elementType, entity.FieldTypeNone)
+		if err != nil {
+			return nil, err
+		}
+		return listOf(elem), nil
+	case entity.FieldTypeFloatVector:
+		return listOf(&arrow.Float32Type{}), nil
+	case entity.FieldTypeBinaryVector, entity.FieldTypeFloat16Vector, entity.FieldTypeBFloat16Vector:
+		return listOf(&arrow.Uint8Type{}), nil
+	case entity.FieldTypeInt8Vector:
+		return listOf(&arrow.Int8Type{}), nil
+	default:
+		return nil, errors.Newf("parquet file does not support data type %s", dataType.String())
+	}
+}
+
+func appendArrowValue(builder array.Builder, value any) error {
+	if value == nil {
+		builder.AppendNull()
+		return nil
+	}
+	switch b := builder.(type) {
+	case *array.BooleanBuilder:
+		return appendArrowScalar(b.Append, value)
+	case *array.Int8Builder:
+		return appendArrowScalar(b.Append, value)
+	case *array.Int16Builder:
+		return appendArrowScalar(b.Append, value)
+	case *array.Int32Builder:
+		return appendArrowScalar(b.Append, value)
+	case *array.Int64Builder:
+		return appendArrowScalar(b.Append, value)
+	case *array.Float32Builder:
+		return appendArrowScalar(b.Append, value)
+	case *array.Float64Builder:
+		return appendArrowScalar(b.Append, value)
+	case *array.Uint8Builder:
+		return appendArrowScalar(b.Append, value)
+	case *array.StringBuilder:
+		switch v := value.(type) {
+		case string:
+			b.Append(v)
+		case []byte:
+			b.Append(string(v))
+		case entity.SparseEmbedding:
+			bs, err := json.Marshal(sparseToMap(v))
+			if err != nil {
+				return err
+			}
+			b.Append(string(bs))
+		default:
+			return errors.Newf("unexpected value type %T for string column", value)
+		}
+		return nil
+	case *array.ListBuilder:
+		// fast path for the vectors
+		switch v := value.(type) {
+		case entity.FloatVector:
+			b.Append(true)
+			b.ValueBuilder().(*array.Float32Builder).AppendValues(v, nil)
+			return nil
+		case entity.BinaryVector:
+			b.Append(true)
+			b.ValueBuilder().(*array.Uint8Builder).AppendValues(v, nil)
+			return nil
+		case entity.Float16Vector:
+			b.Append(true)
+			b.ValueBuilder().(*array.Uint8Builder).AppendValues(v, nil)
+			return nil
+		case entity.BFloat16Vector:
+			b.Append(true)
+			b.ValueBuilder().(*array.Uint8Builder).AppendValues(v, nil)
+			return nil
+		case entity.Int8Vector:
+			b.Append(true)
+			b.ValueBuilder().(*array.Int8Builder).AppendValues(v, nil)
+			return nil
+		}
+		rv := reflect.ValueOf(value)
+		if rv.Kind() != reflect.Slice {
+			return errors.Newf("unexpected value type %T for list column", value)
+		}
+		b.Append(true)
+		for i := 0; i < rv.Len(); i++ {
+			if err := appendArrowValue(b.ValueBuilder(), rv.Index(i).Interface()); err != nil {
+				return err
+			}
+		}
+		return nil
+	default:
+		return errors.Newf("unexpected arrow builder %T", builder)
+	}
+}
+
+func appendArrowScalar[T any](appendFn func(T), value any) error {
+	v, ok := value.(T)
+	if !ok {
+		var t T
+		return errors.Newf("unexpected value type %T, expect %T", value, t)
+	}
+	appendFn(v)
+	return nil
+}
@@ -0,0 +1,361 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bulkwriter
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/apache/arrow/go/v17/arrow"
+	"github.com/apache/arrow/go/v17/arrow/memory"
+	"github.com/apache/arrow/go/v17/parquet/file"
+	"github.com/apache/arrow/go/v17/parquet/pqarrow"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/milvus-io/milvus/client/v2/column"
+	"github.com/milvus-io/milvus/client/v2/entity"
+)
+
+func testSchema() *entity.Schema {
+	return entity.NewSchema().WithName("test").WithDynamicFieldEnabled(true).
+		WithField(entity.NewField().WithName("id").WithDataType(entity.FieldTypeInt64).WithIsPrimaryKey(true).WithIsAutoID(true)).
+		WithField(entity.NewField().WithName("name").WithDataType(entity.FieldTypeVarChar).WithMaxLength(16)).
+		WithField(entity.NewField().WithName("score").WithDataType(entity.FieldTypeFloat)).
+		WithField(entity.NewField().WithName("vector").WithDataType(entity.FieldTypeFloatVector).WithDim(4)).
+		WithField(entity.NewField().WithName("binary").WithDataType(entity.FieldTypeBinaryVector).WithDim(16))
+}
+
+func testRow(i int) map[string]any {
+	return map[string]any{
+		"name":   fmt.Sprintf("name_%d", i),
+		"score":  float32(i),
+		"vector": []float32{float32(i), 1, 2, 3},
+		"binary": []byte{byte(i), 0xff},
+		"extra":  i,
+	}
+}
+
+type LocalBulkWriterSuite struct {
+	suite.Suite
+	dir string
+}
+
+func (s *LocalBulkWriterSuite) SetupTest() {
+	s.dir = s.T().TempDir()
+}
+
+func (s *LocalBulkWriterSuite) TestJSON() {
+	ctx := context.Background()
+	w, err := NewLocalBulkWriter(NewLocalBulkWriterOption(testSchema(), s.dir).WithFileType(JSONFileType))
+	s.Require().NoError(err)
+
+	for i := 0; i < 3; i++ {
+		s.NoError(w.AppendRow(ctx, testRow(i)))
+	}
+	s.NoError(w.AppendColumns(ctx,
+		column.NewColumnVarChar("name", []string{"name_3"}),
+		column.NewColumnFloat("score", []float32{3}),
+		column.NewColumnFloatVector("vector", 4, [][]float32{{3, 1, 2, 3}}),
+		column.NewColumnBinaryVector("binary", 16, [][]byte{{3, 0xff}}),
+	))
+	s.Equal(4, w.BufferRowCount())
+	s.NoError(w.Close(ctx))
+	s.EqualValues(4, w.TotalRowCount())
+
+	batches := w.BatchFiles()
+	s.Require().Len(batches, 1)
+	s.Require().Len(batches[0], 1)
+	s.Equal(filepath.Join(s.dir, w.UUID(), "1.json"), batches[0][0])
+
+	bs, err := os.ReadFile(batches[0][0])
+	s.Require().NoError(err)
+	var content struct {
+		Rows []map[string]any `json:"rows"`
+	}
+	s.Require().NoError(json.Unmarshal(bs, &content))
+	s.Require().Len(content.Rows, 4)
+	s.NotContains(content.Rows[0], "id")
+	s.Equal("name_1", content.Rows[1]["name"])
+	s.Equal([]any{float64(1), float64(1), float64(2), float64(3)}, content.Rows[1]["vector"])
+	s.Equal([]any{float64(1), float64(255)}, content.Rows[1]["binary"])
+	s.Equal(map[string]any{"extra": float64(1)}, content.Rows[1][dynamicFieldName])
+	s.Equal(map[string]any{}, content.Rows[3][dynamicFieldName])
+}
+
+func (s *LocalBulkWriterSuite) TestNumpy() {
+	ctx := context.Background()
+	w, err := NewLocalBulkWriter(NewLocalBulkWriterOption(testSchema(), s.dir).WithFileType(NumpyFileType))
+	s.Require().NoError(err)
+
+	for i := 0; i < 3; i++ {
+		s.NoError(w.AppendRow(ctx, testRow(i)))
+	}
+	s.NoError(w.Commit(ctx))
+
+	batches := w.BatchFiles()
+	s.Require().Len(batches, 1)
+	for _, path := range batches[0] {
+		s.Equal(filepath.Join(s.dir, w.UUID(), "1"), filepath.Dir(path))
+	}
+	s.ElementsMatch([]string{"name.npy", "score.npy", "vector.npy", "binary.npy", dynamicFieldName + ".npy"},
+		lo.Map(batches[0], func(path string, _ int) string { return filepath.Base(path) }))
+
+	descr, shape, data := readNumpy(s.T(), filepath.Join(w.DataPath(), "1", "vector.npy"))
+	s.Equal("<f4", descr)
+	s.Equal("(3, 4)", shape)
+	vectors := make([]float32, 12)
+	s.NoError(binary.Read(bytes.NewReader(data), binary.LittleEndian, vectors))
+	s.Equal([]float32{0, 1, 2, 3, 1, 1, 2, 3, 2, 1, 2, 3}, vectors)
+
+	descr, shape, data = readNumpy(s.T(), filepath.Join(w.DataPath(), "1", "binary.npy"))
+	s.Equal("|u1", descr)
+	s.Equal("(3, 2)", shape)
+	s.Equal([]byte{0, 0xff, 1, 0xff, 2, 0xff}, data)
+
+	descr, shape, data = readNumpy(s.T(), filepath.Join(w.DataPath(), "1", "name.npy"))
+	s.Equal("<U6", descr)
+	s.Equal("(3,)", shape)
+	s.Len(data, 3*6*4)
+	s.Equal([]byte{'n', 0, 0, 0}, data[:4])
+}
+
+// readNumpy parses the numpy file, returns the dtype, shape and the raw data.
+func readNumpy(t *testing.T, path string) (string, string, []byte) {
+	bs, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.HasPrefix(bs, []byte(npyMagic)) {
+		t.Fatalf("invalid numpy magic of %s", path)
+	}
+	headerLen := int(binary.LittleEndian.Uint16(bs[8:10]))
+	if (10+headerLen)%npyAlignment != 0 {
+		t.Fatalf("numpy header of %s is not aligned", path)
+	}
+	header := string(bs[10 : 10+headerLen])
+	descr := regexp.MustCompile(`'descr': '([^']*)'`).FindStringSubmatch(header)
+	shape := regexp.MustCompile(`'shape': (\([^)]*\))`).FindStringSubmatch(header)
+	if descr == nil || shape == nil {
+		t.Fatalf("invalid numpy header %s", header)
+	}
+	return descr[1], shape[1], bs[10+headerLen:]
+}
+
+func (s *LocalBulkWriterSuite) TestParquet() {
+	ctx := context.Background()
+	w, err := NewLocalBulkWriter(NewLocalBulkWriterOption(testSchema(), s.dir))
+	s.Require().NoError(err)
+
+	for i := 0; i < 5; i++ {
+		s.NoError(w.AppendRow(ctx, testRow(i)))
+	}
+	s.NoError(w.Commit(ctx))
+
+	batches := w.BatchFiles()
+	s.Require().Len(batches, 1)
+	s.Equal(filepath.Join(s.dir, w.UUID(), "1.parquet"), batches[0][0])
+
+	reader, err := file.OpenParquetFile(batches[0][0], false)
+	s.Require().NoError(err)
+	defer reader.Close()
+	s.EqualValues(5, reader.NumRows())
+	fileReader, err := pqarrow.NewFileReader(reader, pqarrow.ArrowReadProperties{}, memory.DefaultAllocator)
+	s.Require().NoError(err)
+	schema, err := fileReader.Schema()
+	s.Require().NoError(err)
+	names := make([]string, 0)
+	for _, field := range schema.Fields() {
+		names = append(names, field.Name)
+	}
+	s.Equal([]string{"name", "score", "vector", "binary", dynamicFieldName}, names)
+	// the name of list elements read back depends on the parquet list layout, only the types are checked
+	s.Equal(arrow.LIST, schema.Field(2).Type.ID())
+	s.Equal(arrow.FLOAT32, schema.Field(2).Type.(*arrow.ListType).Elem().ID())
+	s.Equal(arrow.LIST, schema.Field(3).Type.ID())
+	s.Equal(arrow.UINT8, schema.Field(3).Type.(*arrow.ListType).Elem().ID())
+}
+
+func (s *LocalBulkWriterSuite) TestRollBySize() {
+	ctx := context.Background()
+	// each row is about 40 bytes
+	w, err := NewLocalBulkWriter(NewLocalBulkWriterOption(testSchema(), s.dir).WithFileType(JSONFileType).WithChunkSize(70))
+	s.Require().NoError(err)
+
+	for i := 0; i < 5; i++ {
+		s.NoError(w.AppendRow(ctx, testRow(i)))
+	}
+	s.Len(w.BatchFiles(), 2)
+	s.Equal(1, w.BufferRowCount())
+	s.NoError(w.Commit(ctx))
+	s.Len(w.BatchFiles(), 3)
+	s.EqualValues(5, w.TotalRowCount())
+}
+
+func (s *LocalBulkWriterSuite) TestInvalid() {
+	ctx := context.Background()
+
+	s.Run("bad_option", func() {
+		_, err := NewLocalBulkWriter(NewLocalBulkWriterOption(nil, s.dir))
+		s.Error(err)
+		_, err = NewLocalBulkWriter(NewLocalBulkWriterOption(testSchema(), ""))
+		s.Error(err)
+		_, err = NewLocalBulkWriter(NewLocalBulkWriterOption(testSchema(), s.dir).WithChunkSize(0))
+		s.Error(err)
+		_, err = NewLocalBulkWriter(NewLocalBulkWriterOption(testSchema(), s.dir).WithFileType("csv"))
+		s.Error(err)
+
+		schema := testSchema().WithField(entity.NewField().WithName("tags").WithDataType(entity.FieldTypeArray).WithElementType(entity.FieldTypeInt64).WithMaxCapacity(4))
+		_, err = NewLocalBulkWriter(NewLocalBulkWriterOption(schema, s.dir).WithFileType(NumpyFileType))
+		s.Error(err)
+	})
+
+	w, err := NewLocalBulkWriter(NewLocalBulkWriterOption(testSchema(), s.dir).WithFileType(JSONFileType))
+	s.Require().NoError(err)
+
+	s.Run("dim_mismatch", func() {
+		row := testRow(0)
+		row["vector"] = []float32{1, 2}
+		s.Error(w.AppendRow(ctx, row))
+	})
+
+	s.Run("exceed_max_length", func() {
+		row := testRow(0)
+		row["name"] = strings.Repeat("a", 17)
+		s.Error(w.AppendRow(ctx, row))
+	})
+
+	s.Run("missing_column", func() {
+		s.Error(w.AppendColumns(ctx,
+			column.NewColumnVarChar("name", []string{"name_0"}),
+			column.NewColumnFloat("score", []float32{0}),
+		))
+	})
+
+	s.Run("length_mismatch", func() {
+		s.Error(w.AppendColumns(ctx,
+			column.NewColumnVarChar("name", []string{"name_0", "name_1"}),
+			column.NewColumnFloat("score", []float32{0}),
+			column.NewColumnFloatVector("vector", 4, [][]float32{{0, 1, 2, 3}}),
+			column.NewColumnBinaryVector("binary", 16, [][]byte{{0, 0xff}}),
+		))
+	})
+
+	s.Run("unknown_column", func() {
+		s.Error(w.AppendColumns(ctx,
+			column.NewColumnVarChar("name", []string{"name_0"}),
+			column.NewColumnFloat("score", []float32{0}),
+			column.NewColumnFloatVector("vector", 4, [][]float32{{0, 1, 2, 3}}),
+			column.NewColumnBinaryVector("binary", 16, [][]byte{{0, 0xff}}),
+			column.NewColumnInt64("unknown", []int64{0}),
+		))
+	})
+
+	s.Run("type_mismatch", func() {
+		s.Error(w.AppendColumns(ctx,
+			column.NewColumnVarChar("name", []string{"name_0"}),
+			column.NewColumnDouble("score", []float64{0}),
+			column.NewColumnFloatVector("vector", 4, [][]float32{{0, 1, 2, 3}}),
+			column.NewColumnBinaryVector("binary", 16, [][]byte{{0, 0xff}}),
+		))
+	})
+
+	s.Equal(0, w.BufferRowCount())
+}
+
+func TestLocalBulkWriter(t *testing.T) {
+	suite.Run(t, new(LocalBulkWriterSuite))
+}
+
+type memoryStorage struct {
+	mut     sync.Mutex
+	objects map[string][]byte
+}
+
+func (m *memoryStorage) PutObject(ctx context.Context, objectName string, reader io.Reader, objectSize int64) error {
+	bs, err := io.ReadAll(reader)
+	if err != nil {
+		return err
+	}
+	if int64(len(bs)) != objectSize {
+		return fmt.Errorf("object size mismatch, expect %d, got %d", objectSize, len(bs))
+	}
+	m.mut.Lock()
+	defer m.mut.Unlock()
+	m.objects[objectName] = bs
+	return nil
+}
+
+type RemoteBulkWriterSuite struct {
+	suite.Suite
+}
+
+func (s *RemoteBulkWriterSuite) TestUpload() {
+	ctx := context.Background()
+	for _, fileType := range []BulkFileType{ParquetFileType, JSONFileType, NumpyFileType} {
+		s.Run(string(fileType), func() {
+			storage := &memoryStorage{objects: make(map[string][]byte)}
+			w, err := NewRemoteBulkWriter(ctx, NewRemoteBulkWriterOption(testSchema(), "bulk_data", nil).
+				WithStorage(storage).
+				WithLocalPath(s.T().TempDir()).
+				WithFileType(fileType))
+			s.Require().NoError(err)
+
+			for i := 0; i < 3; i++ {
+				s.NoError(w.AppendRow(ctx, testRow(i)))
+			}
+			s.NoError(w.Commit(ctx))
+			for i := 3; i < 6; i++ {
+				s.NoError(w.AppendRow(ctx, testRow(i)))
+			}
+			s.NoError(w.Close(ctx))
+
+			batches := w.BatchFiles()
+			s.Require().Len(batches, 2)
+			for _, files := range batches {
+				for _, name := range files {
+					s.True(strings.HasPrefix(name, "bulk_data/"+w.UUID()+"/"), name)
+					s.Contains(storage.objects, name)
+				}
+			}
+			s.EqualValues(6, w.TotalRowCount())
+
+			// local files are removed after uploading
+			_, err = os.Stat(w.DataPath())
+			s.True(os.IsNotExist(err))
+		})
+	}
+}
+
+func (s *RemoteBulkWriterSuite) TestNoStorage() {
+	_, err := NewRemoteBulkWriter(context.Background(), NewRemoteBulkWriterOption(testSchema(), "bulk_data", nil))
+	s.Error(err)
+}
+
+func TestRemoteBulkWriter(t *testing.T) {
+	suite.Run(t, new(RemoteBulkWriterSuite))
+}
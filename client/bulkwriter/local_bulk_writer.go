@@ -0,0 +1,274 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bulkwriter
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync"
+
+	"github.com/cockroachdb/errors"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+
+	"github.com/milvus-io/milvus/client/v2/column"
+	"github.com/milvus-io/milvus/client/v2/entity"
+	"github.com/milvus-io/milvus/client/v2/row"
+)
+
+// BulkFileType is the format of the files written by bulk writer.
+type BulkFileType string
+
+const (
+	// ParquetFileType writes one parquet file per batch.
+	ParquetFileType BulkFileType = "parquet"
+	// JSONFileType writes one row-based json file per batch.
+	JSONFileType BulkFileType = "json"
+	// NumpyFileType writes one numpy file per field for each batch.
+	NumpyFileType BulkFileType = "numpy"
+)
+
+const (
+	MB = 1024 * 1024
+
+	defaultChunkSize = 128 * MB
+	// the name of the dynamic field created by server
+	dynamicFieldName = "$meta"
+)
+
+type LocalBulkWriterOption struct {
+	Schema *entity.Schema
+	// the files are written under `LocalPath/<uuid>/`
+	LocalPath string
+	// the estimated size of rows in memory for each batch
+	ChunkSize int64
+	FileType  BulkFileType
+}
+
+func (opt *LocalBulkWriterOption) WithChunkSize(chunkSize int64) *LocalBulkWriterOption {
+	opt.ChunkSize = chunkSize
+	return opt
+}
+
+func (opt *LocalBulkWriterOption) WithFileType(fileType BulkFileType) *LocalBulkWriterOption {
+	opt.FileType = fileType
+	return opt
+}
+
+// NewLocalBulkWriterOption returns the option of local bulk writer, which writes parquet files in 128MB batches by default.
+func NewLocalBulkWriterOption(schema *entity.Schema, localPath string) *LocalBulkWriterOption {
+	return &LocalBulkWriterOption{
+		Schema:    schema,
+		LocalPath: localPath,
+		ChunkSize: defaultChunkSize,
+		FileType:  ParquetFileType,
+	}
+}
+
+// LocalBulkWriter buffers the rows and writes them into local files in the layouts accepted by bulk import.
+// Once the buffered rows reach the chunk size, they are flushed as a batch of files.
+type LocalBulkWriter struct {
+	mut sync.Mutex
+
+	// the schema used to convert rows, function output fields are excluded
+	rowSchema *entity.Schema
+	// the fields to write, auto id primary key and function output fields are excluded
+	fields    []*entity.Field
+	chunkSize int64
+	fileType  BulkFileType
+	uuid      string
+	localPath string
+	dataPath  string
+
+	buffer     *buffer
+	flushCount int
+	totalRows  int64
+	batchFiles [][]string
+
+	// onFlush is called with the files of each batch, returns the paths recorded as the batch files
+	onFlush func(ctx context.Context, files []string) ([]string, error)
+}
+
+func NewLocalBulkWriter(opt *LocalBulkWriterOption) (*LocalBulkWriter, error) {
+	if opt.Schema == nil {
+		return nil, errors.New("schema is not provided")
+	}
+	if opt.LocalPath == "" {
+		return nil, errors.New("local path is not provided")
+	}
+	if opt.ChunkSize <= 0 {
+		return nil, errors.Newf("chunk size must be positive, got %d", opt.ChunkSize)
+	}
+
+	outputFields := make(map[string]struct{})
+	for _, function := range opt.Schema.Functions {
+		for _, name := range function.OutputFieldNames {
+			outputFields[name] = struct{}{}
+		}
+	}
+	rowFields := lo.Filter(opt.Schema.Fields, func(field *entity.Field, _ int) bool {
+		_, ok := outputFields[field.Name]
+		return !ok
+	})
+	fields := lo.Filter(rowFields, func(field *entity.Field, _ int) bool {
+		return !(field.PrimaryKey && field.AutoID)
+	})
+	if opt.Schema.EnableDynamicField && !lo.ContainsBy(fields, func(field *entity.Field) bool { return field.IsDynamic }) {
+		fields = append(fields, entity.NewField().WithName(dynamicFieldName).WithDataType(entity.FieldTypeJSON).WithIsDynamic(true))
+	}
+	if err := checkFileType(opt.FileType, fields); err != nil {
+		return nil, err
+	}
+
+	rowSchema := *opt.Schema
+	rowSchema.Fields = rowFields
+	id := uuid.NewString()
+	return &LocalBulkWriter{
+		rowSchema: &rowSchema,
+		fields:    fields,
+		chunkSize: opt.ChunkSize,
+		fileType:  opt.FileType,
+		uuid:      id,
+		localPath: opt.LocalPath,
+		dataPath:  filepath.Join(opt.LocalPath, id),
+		buffer:    newBuffer(fields),
+	}, nil
+}
+
+func checkFileType(fileType BulkFileType, fields []*entity.Field) error {
+	switch fileType {
+	case ParquetFileType, JSONFileType:
+		return nil
+	case NumpyFileType:
+		for _, field := range fields {
+			if field.Nullable {
+				return errors.Newf("numpy file does not support nullable field %s", field.Name)
+			}
+			if field.DataType == entity.FieldTypeArray || field.DataType == entity.FieldTypeSparseVector {
+				return errors.Newf("numpy file does not support field %s of type %s", field.Name, field.DataType.String())
+			}
+		}
+		return nil
+	default:
+		return errors.Newf("unsupported file type %s", fileType)
+	}
+}
+
+// AppendRow appends rows into the writer, the row could be a struct or a map[string]any,
+// the keys not in the schema are put into the dynamic field if it's enabled.
+func (w *LocalBulkWriter) AppendRow(ctx context.Context, rows ...any) error {
+	if len(rows) == 0 {
+		return nil
+	}
+	columns, err := row.AnyToColumns(rows, w.rowSchema)
+	if err != nil {
+		return err
+	}
+	return w.AppendColumns(ctx, columns...)
+}
+
+// AppendColumns appends the column-based data into the writer,
+// all the columns must have the same length.
+func (w *LocalBulkWriter) AppendColumns(ctx context.Context, columns ...column.Column) error {
+	w.mut.Lock()
+	defer w.mut.Unlock()
+
+	if err := w.buffer.appendColumns(columns); err != nil {
+		return err
+	}
+	if w.buffer.size >= w.chunkSize {
+		return w.flush(ctx)
+	}
+	return nil
+}
+
+// Commit flushes the buffered rows into files.
+func (w *LocalBulkWriter) Commit(ctx context.Context) error {
+	w.mut.Lock()
+	defer w.mut.Unlock()
+	return w.flush(ctx)
+}
+
+func (w *LocalBulkWriter) flush(ctx context.Context) error {
+	if w.buffer.rowCount == 0 {
+		return nil
+	}
+
+	w.flushCount++
+	files, err := w.buffer.persist(w.dataPath, strconv.Itoa(w.flushCount), w.fileType)
+	if err != nil {
+		return err
+	}
+	if w.onFlush != nil {
+		files, err = w.onFlush(ctx, files)
+		if err != nil {
+			return err
+		}
+	}
+
+	w.batchFiles = append(w.batchFiles, files)
+	w.totalRows += int64(w.buffer.rowCount)
+	w.buffer = newBuffer(w.fields)
+	return nil
+}
+
+// BatchFiles returns the files of the flushed batches, each batch could be imported by one bulk import request.
+func (w *LocalBulkWriter) BatchFiles() [][]string {
+	w.mut.Lock()
+	defer w.mut.Unlock()
+	return lo.Map(w.batchFiles, func(files []string, _ int) []string {
+		return append([]string{}, files...)
+	})
+}
+
+// UUID returns the unique id of the writer, which is the directory name of its files.
+func (w *LocalBulkWriter) UUID() string {
+	return w.uuid
+}
+
+// DataPath returns the local directory of the files.
+func (w *LocalBulkWriter) DataPath() string {
+	return w.dataPath
+}
+
+// BufferRowCount returns the number of rows not flushed yet.
+func (w *LocalBulkWriter) BufferRowCount() int {
+	w.mut.Lock()
+	defer w.mut.Unlock()
+	return w.buffer.rowCount
+}
+
+// TotalRowCount returns the number of flushed rows.
+func (w *LocalBulkWriter) TotalRowCount() int64 {
+	w.mut.Lock()
+	defer w.mut.Unlock()
+	return w.totalRows
+}
+
+// Close flushes the buffered rows and removes the data directory if nothing is left in it.
+func (w *LocalBulkWriter) Close(ctx context.Context) error {
+	if err := w.Commit(ctx); err != nil {
+		return err
+	}
+	entries, err := os.ReadDir(w.dataPath)
+	if err == nil && len(entries) == 0 {
+		return os.Remove(w.dataPath)
+	}
+	return nil
+}
@@ -0,0 +1,188 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bulkwriter
+
+import (
+	"bufio"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/cockroachdb/errors"
+
+	"github.com/milvus-io/milvus/client/v2/entity"
+)
+
+const (
+	npyMagic     = "\x93NUMPY"
+	npyAlignment = 64
+)
+
+// writeNumpy writes the values of a field in the numpy v1.0 format,
+// the layout is the same as the one `numpy.save` produces and the import reader accepts.
+func writeNumpy(w io.Writer, field *entity.Field, values []any) error {
+	bw := bufio.NewWriter(w)
+	rows := len(values)
+	var err error
+	switch field.DataType {
+	case entity.FieldTypeBool:
+		err = writeNumpyScalars[bool](bw, "|b1", values)
+	case entity.FieldTypeInt8:
+		err = writeNumpyScalars[int8](bw, "|i1", values)
+	case entity.FieldTypeInt16:
+		err = writeNumpyScalars[int16](bw, "<i2", values)
+	case entity.FieldTypeInt32:
+		err = writeNumpyScalars[int32](bw, "<i4", values)
+	case entity.FieldTypeInt64:
+		err = writeNumpyScalars[int64](bw, "<i8", values)
+	case entity.FieldTypeFloat:
+		err = writeNumpyScalars[float32](bw, "<f4", values)
+	case entity.FieldTypeDouble:
+		err = writeNumpyScalars[float64](bw, "<f8", values)
+	case entity.FieldTypeVarChar, entity.FieldTypeString, entity.FieldTypeJSON:
+		err = writeNumpyStrings(bw, values)
+	case entity.FieldTypeFloatVector:
+		err = writeNumpyVectors[entity.FloatVector](bw, "<f4", field, values)
+	case entity.FieldTypeBinaryVector:
+		err = writeNumpyVectors[entity.BinaryVector](bw, "|u1", field, values)
+	case entity.FieldTypeFloat16Vector:
+		err = writeNumpyVectors[entity.Float16Vector](bw, "|u1", field, values)
+	case entity.FieldTypeBFloat16Vector:
+		err = writeNumpyVectors[entity.BFloat16Vector](bw, "|u1", field, values)
+	case entity.FieldTypeInt8Vector:
+		err = writeNumpyVectors[entity.Int8Vector](bw, "|i1", field, values)
+	default:
+		return errors.Newf("numpy file does not support field %s of type %s", field.Name, field.DataType.String())
+	}
+	if err != nil {
+		return errors.Wrapf(err, "failed to write numpy file of field %s with %d rows", field.Name, rows)
+	}
+	return bw.Flush()
+}
+
+func writeNumpyHeader(w io.Writer, descr string, shape ...int) error {
+	dims := make([]string, 0, len(shape))
+	for _, d := range shape {
+		dims = append(dims, fmt.Sprint(d))
+	}
+	shapeStr := strings.Join(dims, ", ")
+	if len(shape) == 1 {
+		shapeStr += ","
+	}
+	header := fmt.Sprintf("{'descr': '%s', 'fortran_order': False, 'shape': (%s), }", descr, shapeStr)
+	// magic(6) + version(2) + header length(2) + header + '\n' is aligned
+	prefix := len(npyMagic) + 4
+	padding := npyAlignment - (prefix+len(header)+1)%npyAlignment
+	if padding == npyAlignment {
+		padding = 0
+	}
+	header += strings.Repeat(" ", padding) + "\n"
+
+	buf := make([]byte, 0, prefix+len(header))
+	buf = append(buf, npyMagic...)
+	buf = append(buf, 1, 0)
+	buf = binary.LittleEndian.AppendUint16(buf, uint16(len(header)))
+	buf = append(buf, header...)
+	_, err := w.Write(buf)
+	return err
+}
+
+func writeNumpyScalars[T bool | int8 | int16 | int32 | int64 | float32 | float64](w io.Writer, descr string, values []any) error {
+	data := make([]T, 0, len(values))
+	for _, value := range values {
+		v, ok := value.(T)
+		if !ok {
+			return errors.Newf("unexpected value type %T", value)
+		}
+		data = append(data, v)
+	}
+	if err := writeNumpyHeader(w, descr, len(data)); err != nil {
+		return err
+	}
+	return binary.Write(w, binary.LittleEndian, data)
+}
+
+// writeNumpyStrings writes the strings as utf32 unicode array, each string occupies the max length characters
+func writeNumpyStrings(w io.Writer, values []any) error {
+	strs := make([]string, 0, len(values))
+	maxLen := 1
+	for _, value := range values {
+		var str string
+		switch v := value.(type) {
+		case string:
+			str = v
+		case []byte:
+			str = string(v)
+		default:
+			return errors.Newf("unexpected value type %T", value)
+		}
+		maxLen = max(maxLen, utf8.RuneCountInString(str))
+		strs = append(strs, str)
+	}
+	if err := writeNumpyHeader(w, fmt.Sprintf("<U%d", maxLen), len(strs)); err != nil {
+		return err
+	}
+
+	buf := make([]byte, 0, maxLen*utf8.UTFMax)
+	for _, str := range strs {
+		buf = buf[:0]
+		for _, r := range str {
+			buf = binary.LittleEndian.AppendUint32(buf, uint32(r))
+		}
+		for len(buf) < maxLen*utf8.UTFMax {
+			buf = append(buf, 0, 0, 0, 0)
+		}
+		if _, err := w.Write(buf); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func writeNumpyVectors[T entity.FloatVector | entity.BinaryVector | entity.Float16Vector | entity.BFloat16Vector | entity.Int8Vector](w io.Writer, descr string, field *entity.Field, values []any) error {
+	dim, err := field.GetDim()
+	if err != nil {
+		return err
+	}
+	// the binary vectors are packed into bytes, and each float16 element occupies two bytes
+	width := int(dim)
+	switch field.DataType {
+	case entity.FieldTypeBinaryVector:
+		width = int(dim) / 8
+	case entity.FieldTypeFloat16Vector, entity.FieldTypeBFloat16Vector:
+		width = int(dim) * 2
+	}
+
+	if err := writeNumpyHeader(w, descr, len(values), width); err != nil {
+		return err
+	}
+	for _, value := range values {
+		v, ok := value.(T)
+		if !ok {
+			return errors.Newf("unexpected value type %T", value)
+		}
+		if len(v) != width {
+			return errors.Newf("vector length %d does not match the dim %d", len(v), dim)
+		}
+		if err := binary.Write(w, binary.LittleEndian, v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,208 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bulkwriter
+
+import (
+	"context"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+
+	"github.com/cockroachdb/errors"
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/credentials"
+
+	"github.com/milvus-io/milvus/client/v2/entity"
+)
+
+// ObjectStorage is the remote storage which the files are uploaded to.
+type ObjectStorage interface {
+	PutObject(ctx context.Context, objectName string, reader io.Reader, objectSize int64) error
+}
+
+// S3ConnectParam is the connection params of S3 compatible storage, such as MinIO.
+type S3ConnectParam struct {
+	Endpoint        string
+	AccessKeyID     string
+	SecretAccessKey string
+	BucketName      string
+	Region          string
+	UseSSL          bool
+	// use the IAM role of the environment instead of the access key
+	UseIAM bool
+}
+
+type minioStorage struct {
+	client     *minio.Client
+	bucketName string
+}
+
+// NewMinioStorage connects to the S3 compatible storage, the bucket must exist.
+func NewMinioStorage(ctx context.Context, param *S3ConnectParam) (ObjectStorage, error) {
+	creds := credentials.NewStaticV4(param.AccessKeyID, param.SecretAccessKey, "")
+	if param.UseIAM {
+		creds = credentials.NewIAM("")
+	}
+	client, err := minio.New(param.Endpoint, &minio.Options{
+		Creds:  creds,
+		Secure: param.UseSSL,
+		Region: param.Region,
+	})
+	if err != nil {
+		return nil, err
+	}
+	exists, err := client.BucketExists(ctx, param.BucketName)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.Newf("bucket %s does not exist", param.BucketName)
+	}
+	return &minioStorage{
+		client:     client,
+		bucketName: param.BucketName,
+	}, nil
+}
+
+func (s *minioStorage) PutObject(ctx context.Context, objectName string, reader io.Reader, objectSize int64) error {
+	_, err := s.client.PutObject(ctx, s.bucketName, objectName, reader, objectSize, minio.PutObjectOptions{})
+	return err
+}
+
+type RemoteBulkWriterOption struct {
+	Schema *entity.Schema
+	// the files are uploaded under `RemotePath/<uuid>/`
+	RemotePath   string
+	ConnectParam *S3ConnectParam
+	// the storage to upload files, overrides the ConnectParam if provided
+	Storage ObjectStorage
+	// the local directory to hold the files before uploading
+	LocalPath string
+	ChunkSize int64
+	FileType  BulkFileType
+}
+
+func (opt *RemoteBulkWriterOption) WithStorage(storage ObjectStorage) *RemoteBulkWriterOption {
+	opt.Storage = storage
+	return opt
+}
+
+func (opt *RemoteBulkWriterOption) WithLocalPath(localPath string) *RemoteBulkWriterOption {
+	opt.LocalPath = localPath
+	return opt
+}
+
+func (opt *RemoteBulkWriterOption) WithChunkSize(chunkSize int64) *RemoteBulkWriterOption {
+	opt.ChunkSize = chunkSize
+	return opt
+}
+
+func (opt *RemoteBulkWriterOption) WithFileType(fileType BulkFileType) *RemoteBulkWriterOption {
+	opt.FileType = fileType
+	return opt
+}
+
+// NewRemoteBulkWriterOption returns the option of remote bulk writer, which uploads parquet files in 128MB batches by default.
+func NewRemoteBulkWriterOption(schema *entity.Schema, remotePath string, connectParam *S3ConnectParam) *RemoteBulkWriterOption {
+	return &RemoteBulkWriterOption{
+		Schema:       schema,
+		RemotePath:   remotePath,
+		ConnectParam: connectParam,
+		LocalPath:    filepath.Join(os.TempDir(), "bulk_writer"),
+		ChunkSize:    defaultChunkSize,
+		FileType:     ParquetFileType,
+	}
+}
+
+// RemoteBulkWriter writes the files like LocalBulkWriter, and uploads each batch to the object storage
+// once it's flushed. The local files are removed after uploading, and the batch files are the object names.
+type RemoteBulkWriter struct {
+	*LocalBulkWriter
+	storage    ObjectStorage
+	remotePath string
+}
+
+func NewRemoteBulkWriter(ctx context.Context, opt *RemoteBulkWriterOption) (*RemoteBulkWriter, error) {
+	storage := opt.Storage
+	if storage == nil {
+		if opt.ConnectParam == nil {
+			return nil, errors.New("neither storage nor connect param is provided")
+		}
+		var err error
+		storage, err = NewMinioStorage(ctx, opt.ConnectParam)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	localWriter, err := NewLocalBulkWriter(&LocalBulkWriterOption{
+		Schema:    opt.Schema,
+		LocalPath: opt.LocalPath,
+		ChunkSize: opt.ChunkSize,
+		FileType:  opt.FileType,
+	})
+	if err != nil {
+		return nil, err
+	}
+	w := &RemoteBulkWriter{
+		LocalBulkWriter: localWriter,
+		storage:         storage,
+		remotePath:      opt.RemotePath,
+	}
+	localWriter.onFlush = w.upload
+	return w, nil
+}
+
+func (w *RemoteBulkWriter) upload(ctx context.Context, files []string) ([]string, error) {
+	objectNames := make([]string, 0, len(files))
+	for _, file := range files {
+		rel, err := filepath.Rel(w.localPath, file)
+		if err != nil {
+			return nil, err
+		}
+		objectName := path.Join(w.remotePath, filepath.ToSlash(rel))
+		if err := w.uploadFile(ctx, file, objectName); err != nil {
+			return nil, errors.Wrapf(err, "failed to upload file %s", file)
+		}
+		objectNames = append(objectNames, objectName)
+	}
+
+	for _, file := range files {
+		if err := os.Remove(file); err != nil {
+			return nil, err
+		}
+	}
+	// remove the sub-directory of numpy files
+	if w.fileType == NumpyFileType && len(files) > 0 {
+		os.Remove(filepath.Dir(files[0]))
+	}
+	return objectNames, nil
+}
+
+func (w *RemoteBulkWriter) uploadFile(ctx context.Context, file string, objectName string) error {
+	f, err := os.Open(file)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	info, err := f.Stat()
+	if err != nil {
+		return err
+	}
+	return w.storage.PutObject(ctx, objectName, f, info.Size())
+}
@@ -29,6 +29,9 @@ const (
 	FunctionTypeUnknown       = schemapb.FunctionType_Unknown
 	FunctionTypeBM25          = schemapb.FunctionType_BM25
 	FunctionTypeTextEmbedding = schemapb.FunctionType_TextEmbedding
+	// FunctionTypeRerank reranks the hybrid search results by a rerank model service,
+	// it has one VarChar input field and no output field.
+	FunctionTypeRerank FunctionType = 3
 )
 
 type Function struct {
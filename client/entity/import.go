@@ -0,0 +1,72 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package entity
+
+import "github.com/milvus-io/milvus/pkg/v2/proto/internalpb"
+
+// ImportState is the state of an import job or one of its tasks.
+type ImportState internalpb.ImportJobState
+
+const (
+	ImportStatePending       ImportState = ImportState(internalpb.ImportJobState_Pending)
+	ImportStatePreImporting  ImportState = ImportState(internalpb.ImportJobState_PreImporting)
+	ImportStateImporting     ImportState = ImportState(internalpb.ImportJobState_Importing)
+	ImportStateFailed        ImportState = ImportState(internalpb.ImportJobState_Failed)
+	ImportStateCompleted     ImportState = ImportState(internalpb.ImportJobState_Completed)
+	ImportStateIndexBuilding ImportState = ImportState(internalpb.ImportJobState_IndexBuilding)
+	ImportStateStats         ImportState = ImportState(internalpb.ImportJobState_Stats)
+)
+
+func (s ImportState) String() string {
+	return internalpb.ImportJobState(s).String()
+}
+
+// ImportProgress is the progress of an import job.
+type ImportProgress struct {
+	JobID          string
+	CollectionName string
+	State          ImportState
+	Reason         string
+	// the progress in percentage, from 0 to 100
+	Progress     int64
+	ImportedRows int64
+	TotalRows    int64
+	StartTime    string
+	CompleteTime string
+	Tasks        []*ImportTaskProgress
+}
+
+// ImportTaskProgress is the progress of importing one group of files.
+type ImportTaskProgress struct {
+	FileName     string
+	FileSize     int64
+	State        string
+	Reason       string
+	Progress     int64
+	ImportedRows int64
+	TotalRows    int64
+	CompleteTime string
+}
+
+// ImportJob is the brief information of an import job.
+type ImportJob struct {
+	JobID          string
+	CollectionName string
+	State          ImportState
+	Reason         string
+	Progress       int64
+}
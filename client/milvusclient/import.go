@@ -0,0 +1,176 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package milvusclient
+
+import (
+	"context"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/samber/lo"
+	"google.golang.org/grpc"
+
+	"github.com/milvus-io/milvus/client/v2/entity"
+	"github.com/milvus-io/milvus/pkg/v2/proto/internalpb"
+	"github.com/milvus-io/milvus/pkg/v2/proto/proxypb"
+	"github.com/milvus-io/milvus/pkg/v2/util/merr"
+)
+
+// ImportTask is the handle of an import job, which could be awaited until the job is completed.
+type ImportTask struct {
+	client   *Client
+	dbName   string
+	jobID    string
+	interval time.Duration
+}
+
+func (t *ImportTask) JobID() string {
+	return t.jobID
+}
+
+// Await waits until the import job is completed, returns error if the job failed.
+func (t *ImportTask) Await(ctx context.Context) error {
+	timer := time.NewTimer(t.interval)
+	defer timer.Stop()
+	for {
+		select {
+		case <-timer.C:
+			progress, err := t.client.GetImportProgress(ctx, NewGetImportProgressOption(t.jobID).WithDBName(t.dbName))
+			if err != nil {
+				return err
+			}
+			switch progress.State {
+			case entity.ImportStateCompleted:
+				return nil
+			case entity.ImportStateFailed:
+				return merr.WrapErrImportFailed(progress.Reason)
+			}
+			if !timer.Stop() {
+				select {
+				case <-timer.C:
+				default:
+				}
+			}
+			timer.Reset(t.interval)
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// callProxyService calls the import RPCs of proxy service, which share the connection with milvus service.
+func (c *Client) callProxyService(fn func(proxyService proxypb.ProxyClient) error) error {
+	conn := c.conn
+	if conn == nil {
+		return merr.WrapErrServiceNotReady("SDK", 0, "not connected")
+	}
+
+	return fn(proxypb.NewProxyClient(conn))
+}
+
+// Import creates an import job of the files, the returned task could be used to wait for the job.
+func (c *Client) Import(ctx context.Context, option ImportOption, callOptions ...grpc.CallOption) (*ImportTask, error) {
+	req := option.Request()
+
+	var task *ImportTask
+
+	err := c.callProxyService(func(proxyService proxypb.ProxyClient) error {
+		resp, err := proxyService.ImportV2(ctx, req, callOptions...)
+		if err = merr.CheckRPCCall(resp, err); err != nil {
+			return err
+		}
+
+		task = &ImportTask{
+			client:   c,
+			dbName:   req.GetDbName(),
+			jobID:    resp.GetJobID(),
+			interval: option.CheckInterval(),
+		}
+		return nil
+	})
+	return task, err
+}
+
+func (c *Client) GetImportProgress(ctx context.Context, option GetImportProgressOption, callOptions ...grpc.CallOption) (*entity.ImportProgress, error) {
+	req := option.Request()
+
+	var progress *entity.ImportProgress
+
+	err := c.callProxyService(func(proxyService proxypb.ProxyClient) error {
+		resp, err := proxyService.GetImportProgress(ctx, req, callOptions...)
+		if err = merr.CheckRPCCall(resp, err); err != nil {
+			return err
+		}
+
+		progress = &entity.ImportProgress{
+			JobID:          req.GetJobID(),
+			CollectionName: resp.GetCollectionName(),
+			State:          entity.ImportState(resp.GetState()),
+			Reason:         resp.GetReason(),
+			Progress:       resp.GetProgress(),
+			ImportedRows:   resp.GetImportedRows(),
+			TotalRows:      resp.GetTotalRows(),
+			StartTime:      resp.GetStartTime(),
+			CompleteTime:   resp.GetCompleteTime(),
+			Tasks: lo.Map(resp.GetTaskProgresses(), func(task *internalpb.ImportTaskProgress, _ int) *entity.ImportTaskProgress {
+				return &entity.ImportTaskProgress{
+					FileName:     task.GetFileName(),
+					FileSize:     task.GetFileSize(),
+					State:        task.GetState(),
+					Reason:       task.GetReason(),
+					Progress:     task.GetProgress(),
+					ImportedRows: task.GetImportedRows(),
+					TotalRows:    task.GetTotalRows(),
+					CompleteTime: task.GetCompleteTime(),
+				}
+			}),
+		}
+		return nil
+	})
+	return progress, err
+}
+
+func (c *Client) ListImports(ctx context.Context, option ListImportsOption, callOptions ...grpc.CallOption) ([]*entity.ImportJob, error) {
+	req := option.Request()
+
+	var jobs []*entity.ImportJob
+
+	err := c.callProxyService(func(proxyService proxypb.ProxyClient) error {
+		resp, err := proxyService.ListImports(ctx, req, callOptions...)
+		if err = merr.CheckRPCCall(resp, err); err != nil {
+			return err
+		}
+
+		jobIDs := resp.GetJobIDs()
+		if len(resp.GetStates()) != len(jobIDs) || len(resp.GetReasons()) != len(jobIDs) ||
+			len(resp.GetProgresses()) != len(jobIDs) || len(resp.GetCollectionNames()) != len(jobIDs) {
+			return errors.New("the lengths of list imports response fields do not match")
+		}
+		jobs = make([]*entity.ImportJob, 0, len(jobIDs))
+		for i, jobID := range jobIDs {
+			jobs = append(jobs, &entity.ImportJob{
+				JobID:          jobID,
+				CollectionName: resp.GetCollectionNames()[i],
+				State:          entity.ImportState(resp.GetStates()[i]),
+				Reason:         resp.GetReasons()[i],
+				Progress:       resp.GetProgresses()[i],
+			})
+		}
+		return nil
+	})
+	return jobs, err
+}
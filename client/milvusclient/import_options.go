@@ -0,0 +1,216 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package milvusclient
+
+import (
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/samber/lo"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus/pkg/v2/proto/internalpb"
+)
+
+// the option keys of import, the same as the ones defined in server side importutilv2
+const (
+	importTimeoutKey    = "timeout"
+	importSkipDQCKey    = "skip_disk_quota_check"
+	importCSVSepKey     = "sep"
+	importNullKeyKey    = "nullkey"
+	importBackupKey     = "backup"
+	importL0Key         = "l0_import"
+	importStartTsKey    = "start_ts"
+	importEndTsKey      = "end_ts"
+	importDryRunKey     = "dry_run"
+	importMaxBadRowsKey = "max_bad_rows"
+)
+
+type ImportOption interface {
+	Request() *internalpb.ImportRequest
+	CheckInterval() time.Duration
+}
+
+type importOption struct {
+	dbName         string
+	collectionName string
+	partitionName  string
+	files          [][]string
+	options        map[string]string
+	interval       time.Duration
+}
+
+func (opt *importOption) Request() *internalpb.ImportRequest {
+	keys := lo.Keys(opt.options)
+	sort.Strings(keys)
+	return &internalpb.ImportRequest{
+		DbName:         opt.dbName,
+		CollectionName: opt.collectionName,
+		PartitionName:  opt.partitionName,
+		Files: lo.Map(opt.files, func(paths []string, _ int) *internalpb.ImportFile {
+			return &internalpb.ImportFile{Paths: paths}
+		}),
+		Options: lo.Map(keys, func(key string, _ int) *commonpb.KeyValuePair {
+			return &commonpb.KeyValuePair{Key: key, Value: opt.options[key]}
+		}),
+	}
+}
+
+func (opt *importOption) CheckInterval() time.Duration {
+	return opt.interval
+}
+
+func (opt *importOption) WithDBName(dbName string) *importOption {
+	opt.dbName = dbName
+	return opt
+}
+
+func (opt *importOption) WithPartition(partitionName string) *importOption {
+	opt.partitionName = partitionName
+	return opt
+}
+
+// WithOption sets an import option by key, which is passed to server as it is.
+func (opt *importOption) WithOption(key, value string) *importOption {
+	opt.options[key] = value
+	return opt
+}
+
+// WithTimeout sets the timeout of the import job, the job fails if it's not completed in time.
+func (opt *importOption) WithTimeout(timeout time.Duration) *importOption {
+	return opt.WithOption(importTimeoutKey, timeout.String())
+}
+
+func (opt *importOption) WithSkipDiskQuotaCheck(skip bool) *importOption {
+	return opt.WithOption(importSkipDQCKey, strconv.FormatBool(skip))
+}
+
+func (opt *importOption) WithCSVSeparator(sep string) *importOption {
+	return opt.WithOption(importCSVSepKey, sep)
+}
+
+func (opt *importOption) WithCSVNullKey(nullKey string) *importOption {
+	return opt.WithOption(importNullKeyKey, nullKey)
+}
+
+// WithBackup imports the binlog files of backup-restore, each group of files is the insert log
+// and delta log directories of a segment.
+func (opt *importOption) WithBackup(backup bool) *importOption {
+	return opt.WithOption(importBackupKey, strconv.FormatBool(backup))
+}
+
+// WithL0Import imports the l0 segments of backup-restore only, it works with WithBackup.
+func (opt *importOption) WithL0Import(l0Import bool) *importOption {
+	return opt.WithOption(importL0Key, strconv.FormatBool(l0Import))
+}
+
+// WithStartTs filters out the data of backup-restore before the timestamp.
+func (opt *importOption) WithStartTs(ts uint64) *importOption {
+	return opt.WithOption(importStartTsKey, strconv.FormatUint(ts, 10))
+}
+
+// WithEndTs filters out the data of backup-restore after the timestamp.
+func (opt *importOption) WithEndTs(ts uint64) *importOption {
+	return opt.WithOption(importEndTsKey, strconv.FormatUint(ts, 10))
+}
+
+// WithDryRun validates the files only without importing any data, the job completes once the validation is done,
+// and the reason of the job progress tells the number of bad rows and the paths of the bad rows reports.
+func (opt *importOption) WithDryRun(dryRun bool) *importOption {
+	return opt.WithOption(importDryRunKey, strconv.FormatBool(dryRun))
+}
+
+// WithMaxBadRows skips at most maxBadRows bad rows in each file instead of failing the job on the first bad row,
+// it is not supported by the parquet and numpy files.
+func (opt *importOption) WithMaxBadRows(maxBadRows int64) *importOption {
+	return opt.WithOption(importMaxBadRowsKey, strconv.FormatInt(maxBadRows, 10))
+}
+
+func (opt *importOption) WithCheckInterval(interval time.Duration) *importOption {
+	opt.interval = interval
+	return opt
+}
+
+// NewImportOption returns the option to import files into the collection, each group of files is
+// imported by one task, such as one parquet file or the numpy files of all fields.
+func NewImportOption(collectionName string, files ...[]string) *importOption {
+	return &importOption{
+		collectionName: collectionName,
+		files:          files,
+		options:        make(map[string]string),
+		interval:       time.Second,
+	}
+}
+
+type GetImportProgressOption interface {
+	Request() *internalpb.GetImportProgressRequest
+}
+
+type getImportProgressOption struct {
+	dbName string
+	jobID  string
+}
+
+func (opt *getImportProgressOption) Request() *internalpb.GetImportProgressRequest {
+	return &internalpb.GetImportProgressRequest{
+		DbName: opt.dbName,
+		JobID:  opt.jobID,
+	}
+}
+
+func (opt *getImportProgressOption) WithDBName(dbName string) *getImportProgressOption {
+	opt.dbName = dbName
+	return opt
+}
+
+func NewGetImportProgressOption(jobID string) *getImportProgressOption {
+	return &getImportProgressOption{
+		jobID: jobID,
+	}
+}
+
+type ListImportsOption interface {
+	Request() *internalpb.ListImportsRequest
+}
+
+type listImportsOption struct {
+	dbName         string
+	collectionName string
+}
+
+func (opt *listImportsOption) Request() *internalpb.ListImportsRequest {
+	return &internalpb.ListImportsRequest{
+		DbName:         opt.dbName,
+		CollectionName: opt.collectionName,
+	}
+}
+
+func (opt *listImportsOption) WithDBName(dbName string) *listImportsOption {
+	opt.dbName = dbName
+	return opt
+}
+
+func (opt *listImportsOption) WithCollectionName(collectionName string) *listImportsOption {
+	opt.collectionName = collectionName
+	return opt
+}
+
+// NewListImportsOption returns the option to list the import jobs of the database, filtered by collection if provided.
+func NewListImportsOption() *listImportsOption {
+	return &listImportsOption{}
+}
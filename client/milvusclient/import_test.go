@@ -0,0 +1,287 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package milvusclient
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/atomic"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/test/bufconn"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus/client/v2/entity"
+	"github.com/milvus-io/milvus/pkg/v2/proto/internalpb"
+	"github.com/milvus-io/milvus/pkg/v2/proto/proxypb"
+	"github.com/milvus-io/milvus/pkg/v2/util/merr"
+)
+
+// mockImportServer implements the import RPCs of proxy service with the provided functions.
+type mockImportServer struct {
+	proxypb.UnimplementedProxyServer
+
+	importFn   func(context.Context, *internalpb.ImportRequest) (*internalpb.ImportResponse, error)
+	progressFn func(context.Context, *internalpb.GetImportProgressRequest) (*internalpb.GetImportProgressResponse, error)
+	listFn     func(context.Context, *internalpb.ListImportsRequest) (*internalpb.ListImportsResponse, error)
+}
+
+func (m *mockImportServer) ImportV2(ctx context.Context, req *internalpb.ImportRequest) (*internalpb.ImportResponse, error) {
+	return m.importFn(ctx, req)
+}
+
+func (m *mockImportServer) GetImportProgress(ctx context.Context, req *internalpb.GetImportProgressRequest) (*internalpb.GetImportProgressResponse, error) {
+	return m.progressFn(ctx, req)
+}
+
+func (m *mockImportServer) ListImports(ctx context.Context, req *internalpb.ListImportsRequest) (*internalpb.ListImportsResponse, error) {
+	return m.listFn(ctx, req)
+}
+
+type ImportSuite struct {
+	MockSuiteBase
+
+	importServer *mockImportServer
+}
+
+func (s *ImportSuite) SetupSuite() {
+	s.lis = bufconn.Listen(bufSize)
+	s.svr = grpc.NewServer()
+
+	s.mock = &MilvusServiceServer{}
+	s.importServer = &mockImportServer{}
+
+	milvuspb.RegisterMilvusServiceServer(s.svr, s.mock)
+	proxypb.RegisterProxyServer(s.svr, s.importServer)
+
+	go func() {
+		if err := s.svr.Serve(s.lis); err != nil {
+			s.Fail("failed to start mock server", err.Error())
+		}
+	}()
+	s.setupConnect()
+}
+
+func (s *ImportSuite) TestImport() {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	s.Run("success", func() {
+		collectionName := fmt.Sprintf("coll_%s", s.randString(6))
+		jobID := s.randString(6)
+		files := [][]string{{"a.parquet"}, {"b/id.npy", "b/vector.npy"}}
+
+		done := atomic.NewBool(false)
+		s.importServer.importFn = func(ctx context.Context, req *internalpb.ImportRequest) (*internalpb.ImportResponse, error) {
+			s.Equal("db1", req.GetDbName())
+			s.Equal(collectionName, req.GetCollectionName())
+			s.Equal("part", req.GetPartitionName())
+			s.Len(req.GetFiles(), 2)
+			s.Equal(files[1], req.GetFiles()[1].GetPaths())
+			options := make(map[string]string)
+			for _, kv := range req.GetOptions() {
+				options[kv.GetKey()] = kv.GetValue()
+			}
+			s.Equal(map[string]string{
+				"backup":    "true",
+				"l0_import": "true",
+				"start_ts":  "100",
+				"end_ts":    "200",
+				"timeout":   "5m0s",
+			}, options)
+			return &internalpb.ImportResponse{Status: merr.Success(), JobID: jobID}, nil
+		}
+		s.importServer.progressFn = func(ctx context.Context, req *internalpb.GetImportProgressRequest) (*internalpb.GetImportProgressResponse, error) {
+			s.Equal("db1", req.GetDbName())
+			s.Equal(jobID, req.GetJobID())
+			state := internalpb.ImportJobState_Importing
+			if done.Load() {
+				state = internalpb.ImportJobState_Completed
+			}
+			return &internalpb.GetImportProgressResponse{Status: merr.Success(), State: state}, nil
+		}
+
+		task, err := s.client.Import(ctx, NewImportOption(collectionName, files...).
+			WithDBName("db1").
+			WithPartition("part").
+			WithBackup(true).
+			WithL0Import(true).
+			WithStartTs(100).
+			WithEndTs(200).
+			WithTimeout(5*time.Minute).
+			WithCheckInterval(10*time.Millisecond))
+		s.Require().NoError(err)
+		s.Equal(jobID, task.JobID())
+
+		ch := make(chan struct{})
+		go func() {
+			defer close(ch)
+			err := task.Await(ctx)
+			s.NoError(err)
+		}()
+
+		select {
+		case <-ch:
+			s.FailNow("task done before import completed")
+		case <-time.After(100 * time.Millisecond):
+		}
+
+		done.Store(true)
+
+		select {
+		case <-ch:
+		case <-time.After(time.Second):
+			s.FailNow("task not done after import completed")
+		}
+	})
+
+	s.Run("dry_run", func() {
+		s.importServer.importFn = func(ctx context.Context, req *internalpb.ImportRequest) (*internalpb.ImportResponse, error) {
+			options := make(map[string]string)
+			for _, kv := range req.GetOptions() {
+				options[kv.GetKey()] = kv.GetValue()
+			}
+			s.Equal(map[string]string{
+				"dry_run":      "true",
+				"max_bad_rows": "10",
+			}, options)
+			return &internalpb.ImportResponse{Status: merr.Success(), JobID: "1"}, nil
+		}
+		s.importServer.progressFn = func(ctx context.Context, req *internalpb.GetImportProgressRequest) (*internalpb.GetImportProgressResponse, error) {
+			return &internalpb.GetImportProgressResponse{
+				Status: merr.Success(),
+				State:  internalpb.ImportJobState_Completed,
+				Reason: "dry run done, no bad row found",
+			}, nil
+		}
+
+		task, err := s.client.Import(ctx, NewImportOption("coll", []string{"a.json"}).
+			WithDryRun(true).
+			WithMaxBadRows(10).
+			WithCheckInterval(10*time.Millisecond))
+		s.Require().NoError(err)
+		s.NoError(task.Await(ctx))
+	})
+
+	s.Run("failed", func() {
+		s.importServer.importFn = func(ctx context.Context, req *internalpb.ImportRequest) (*internalpb.ImportResponse, error) {
+			return &internalpb.ImportResponse{Status: merr.Success(), JobID: "1"}, nil
+		}
+		s.importServer.progressFn = func(ctx context.Context, req *internalpb.GetImportProgressRequest) (*internalpb.GetImportProgressResponse, error) {
+			return &internalpb.GetImportProgressResponse{
+				Status: merr.Success(),
+				State:  internalpb.ImportJobState_Failed,
+				Reason: "mocked failure",
+			}, nil
+		}
+
+		task, err := s.client.Import(ctx, NewImportOption("coll", []string{"a.json"}).WithCheckInterval(10*time.Millisecond))
+		s.Require().NoError(err)
+		err = task.Await(ctx)
+		s.ErrorIs(err, merr.ErrImportFailed)
+		s.ErrorContains(err, "mocked failure")
+	})
+
+	s.Run("server_error", func() {
+		s.importServer.importFn = func(ctx context.Context, req *internalpb.ImportRequest) (*internalpb.ImportResponse, error) {
+			return &internalpb.ImportResponse{Status: merr.Status(merr.WrapErrCollectionNotFound("coll"))}, nil
+		}
+
+		_, err := s.client.Import(ctx, NewImportOption("coll", []string{"a.json"}))
+		s.Error(err)
+	})
+}
+
+func (s *ImportSuite) TestGetImportProgress() {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	s.importServer.progressFn = func(ctx context.Context, req *internalpb.GetImportProgressRequest) (*internalpb.GetImportProgressResponse, error) {
+		s.Equal("100", req.GetJobID())
+		return &internalpb.GetImportProgressResponse{
+			Status:         merr.Success(),
+			State:          internalpb.ImportJobState_Importing,
+			Progress:       50,
+			CollectionName: "coll",
+			ImportedRows:   100,
+			TotalRows:      200,
+			TaskProgresses: []*internalpb.ImportTaskProgress{
+				{FileName: "a.parquet", FileSize: 1024, State: "InProgress", Progress: 50, ImportedRows: 100, TotalRows: 200},
+			},
+		}, nil
+	}
+
+	progress, err := s.client.GetImportProgress(ctx, NewGetImportProgressOption("100"))
+	s.Require().NoError(err)
+	s.Equal("100", progress.JobID)
+	s.Equal("coll", progress.CollectionName)
+	s.Equal(entity.ImportStateImporting, progress.State)
+	s.EqualValues(50, progress.Progress)
+	s.EqualValues(100, progress.ImportedRows)
+	s.EqualValues(200, progress.TotalRows)
+	s.Require().Len(progress.Tasks, 1)
+	s.Equal("a.parquet", progress.Tasks[0].FileName)
+	s.EqualValues(1024, progress.Tasks[0].FileSize)
+	s.Equal("InProgress", progress.Tasks[0].State)
+}
+
+func (s *ImportSuite) TestListImports() {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	s.Run("success", func() {
+		s.importServer.listFn = func(ctx context.Context, req *internalpb.ListImportsRequest) (*internalpb.ListImportsResponse, error) {
+			s.Equal("coll", req.GetCollectionName())
+			return &internalpb.ListImportsResponse{
+				Status:          merr.Success(),
+				JobIDs:          []string{"1", "2"},
+				States:          []internalpb.ImportJobState{internalpb.ImportJobState_Completed, internalpb.ImportJobState_Failed},
+				Reasons:         []string{"", "mocked failure"},
+				Progresses:      []int64{100, 0},
+				CollectionNames: []string{"coll", "coll"},
+			}, nil
+		}
+
+		jobs, err := s.client.ListImports(ctx, NewListImportsOption().WithCollectionName("coll"))
+		s.Require().NoError(err)
+		s.Require().Len(jobs, 2)
+		s.Equal("1", jobs[0].JobID)
+		s.Equal(entity.ImportStateCompleted, jobs[0].State)
+		s.EqualValues(100, jobs[0].Progress)
+		s.Equal(entity.ImportStateFailed, jobs[1].State)
+		s.Equal("mocked failure", jobs[1].Reason)
+	})
+
+	s.Run("mismatched_response", func() {
+		s.importServer.listFn = func(ctx context.Context, req *internalpb.ListImportsRequest) (*internalpb.ListImportsResponse, error) {
+			return &internalpb.ListImportsResponse{
+				Status: merr.Success(),
+				JobIDs: []string{"1"},
+			}, nil
+		}
+
+		_, err := s.client.ListImports(ctx, NewListImportsOption())
+		s.Error(err)
+	})
+}
+
+func TestImport(t *testing.T) {
+	suite.Run(t, new(ImportSuite))
+}
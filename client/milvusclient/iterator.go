@@ -0,0 +1,340 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package milvusclient
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+
+	"github.com/cockroachdb/errors"
+	"github.com/samber/lo"
+	"google.golang.org/grpc"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus/client/v2/entity"
+	"github.com/milvus-io/milvus/pkg/v2/util/merr"
+)
+
+// iteratorCursor is the progress of an iterator, encoded into the cursor token to resume the iteration.
+type iteratorCursor struct {
+	// the mvcc timestamp of the first batch, all the batches read the same snapshot
+	SessionTs uint64 `json:"session_ts,omitempty"`
+	// the number of rows returned so far
+	Count int `json:"count,omitempty"`
+
+	// the last primary key returned by query iterator
+	Int64PK  *int64  `json:"int64_pk,omitempty"`
+	StringPK *string `json:"string_pk,omitempty"`
+
+	// the server side iterator token and the last distance returned by search iterator
+	Token     string   `json:"token,omitempty"`
+	LastBound *float32 `json:"last_bound,omitempty"`
+}
+
+func (c *iteratorCursor) encode() string {
+	bs, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(bs)
+}
+
+func decodeIteratorCursor(token string) (*iteratorCursor, error) {
+	cursor := &iteratorCursor{}
+	if token == "" {
+		return cursor, nil
+	}
+	bs, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid iterator cursor")
+	}
+	if err := json.Unmarshal(bs, cursor); err != nil {
+		return nil, errors.Wrap(err, "invalid iterator cursor")
+	}
+	return cursor, nil
+}
+
+// nextBatchSize returns the size of next batch, zero if the limit is reached.
+func (c *iteratorCursor) nextBatchSize(batchSize, limit int) int {
+	if limit > 0 && limit-c.Count < batchSize {
+		return max(limit-c.Count, 0)
+	}
+	return batchSize
+}
+
+// QueryIterator iterates the query results in batches ordered by primary key.
+// All the batches read the snapshot when the first batch is queried, so the entities deleted or inserted
+// during iteration neither skip nor duplicate the rows, unlike the pagination by offset.
+type QueryIterator struct {
+	client      *Client
+	option      QueryIteratorOption
+	callOptions []grpc.CallOption
+
+	schema *entity.Schema
+	pk     *entity.Field
+	cursor *iteratorCursor
+	done   bool
+}
+
+func (c *Client) QueryIterator(ctx context.Context, option QueryIteratorOption, callOptions ...grpc.CallOption) (*QueryIterator, error) {
+	req, err := option.Request()
+	if err != nil {
+		return nil, err
+	}
+	cursor, err := decodeIteratorCursor(option.Cursor())
+	if err != nil {
+		return nil, err
+	}
+	collection, err := c.getCollection(ctx, req.GetCollectionName())
+	if err != nil {
+		return nil, err
+	}
+	pk := collection.Schema.PKField()
+	if pk == nil {
+		return nil, errors.Newf("primary key not found in collection %s", req.GetCollectionName())
+	}
+
+	return &QueryIterator{
+		client:      c,
+		option:      option,
+		callOptions: callOptions,
+		schema:      collection.Schema,
+		pk:          pk,
+		cursor:      cursor,
+	}, nil
+}
+
+// Next returns the next batch of rows, io.EOF is returned when the iteration is done.
+func (it *QueryIterator) Next(ctx context.Context) (ResultSet, error) {
+	var resultSet ResultSet
+	batchSize := it.cursor.nextBatchSize(it.option.BatchSize(), it.option.Limit())
+	if it.done || batchSize == 0 {
+		return resultSet, io.EOF
+	}
+
+	req, err := it.nextRequest(batchSize)
+	if err != nil {
+		return resultSet, err
+	}
+
+	var resp *milvuspb.QueryResults
+	err = it.client.callService(func(milvusService milvuspb.MilvusServiceClient) error {
+		resp, err = milvusService.Query(ctx, req, it.callOptions...)
+		return merr.CheckRPCCall(resp, err)
+	})
+	if err != nil {
+		return resultSet, err
+	}
+
+	columns, err := it.client.parseSearchResult(it.schema, resp.GetOutputFields(), resp.GetFieldsData(), 0, 0, -1)
+	if err != nil {
+		return resultSet, err
+	}
+	resultSet = ResultSet{
+		Fields: columns,
+		sch:    it.schema,
+	}
+	resultSet.ResultCount = resultSet.Fields.Len()
+	if resultSet.ResultCount == 0 {
+		it.done = true
+		return resultSet, io.EOF
+	}
+
+	pkColumn := resultSet.GetColumn(it.pk.Name)
+	if pkColumn == nil {
+		return resultSet, errors.Newf("primary key %s not found in query results", it.pk.Name)
+	}
+	lastPK, err := pkColumn.Get(pkColumn.Len() - 1)
+	if err != nil {
+		return resultSet, err
+	}
+	switch pk := lastPK.(type) {
+	case int64:
+		it.cursor.Int64PK = &pk
+	case string:
+		it.cursor.StringPK = &pk
+	default:
+		return resultSet, errors.Newf("unsupported primary key type %T", lastPK)
+	}
+	if it.cursor.SessionTs == 0 {
+		it.cursor.SessionTs = resp.GetSessionTs()
+	}
+	it.cursor.Count += resultSet.ResultCount
+	resultSet.IDs = pkColumn
+	return resultSet, nil
+}
+
+func (it *QueryIterator) nextRequest(batchSize int) (*milvuspb.QueryRequest, error) {
+	req, err := it.option.Request()
+	if err != nil {
+		return nil, err
+	}
+
+	// the primary key is the cursor of iteration
+	outputFields := req.GetOutputFields()
+	if !lo.Contains(outputFields, it.pk.Name) && !lo.Contains(outputFields, "*") {
+		req.OutputFields = append(outputFields, it.pk.Name)
+	}
+
+	var pkExpr string
+	switch {
+	case it.cursor.Int64PK != nil:
+		pkExpr = fmt.Sprintf("%s > %d", it.pk.Name, *it.cursor.Int64PK)
+	case it.cursor.StringPK != nil:
+		pkExpr = fmt.Sprintf("%s > %s", it.pk.Name, strconv.Quote(*it.cursor.StringPK))
+	}
+	if pkExpr != "" {
+		if req.GetExpr() != "" {
+			req.Expr = fmt.Sprintf("(%s) and %s", req.GetExpr(), pkExpr)
+		} else {
+			req.Expr = pkExpr
+		}
+	}
+
+	params := entity.KvPairsMap(req.GetQueryParams())
+	params[spIterator] = "true"
+	params[spReduceStopForBest] = "true"
+	params[spLimit] = strconv.Itoa(batchSize)
+	delete(params, spOffset)
+	req.QueryParams = entity.MapKvPairs(params)
+
+	if it.cursor.SessionTs > 0 {
+		req.GuaranteeTimestamp = it.cursor.SessionTs
+	}
+	return req, nil
+}
+
+// Cursor returns the token to resume the iteration after the last returned batch.
+func (it *QueryIterator) Cursor() string {
+	return it.cursor.encode()
+}
+
+// SearchIterator iterates the search results in batches ordered by distance,
+// which requires the server supporting search iterator v2.
+type SearchIterator struct {
+	client      *Client
+	option      SearchIteratorOption
+	callOptions []grpc.CallOption
+
+	schema *entity.Schema
+	cursor *iteratorCursor
+	done   bool
+}
+
+func (c *Client) SearchIterator(ctx context.Context, option SearchIteratorOption, callOptions ...grpc.CallOption) (*SearchIterator, error) {
+	req, err := option.Request()
+	if err != nil {
+		return nil, err
+	}
+	cursor, err := decodeIteratorCursor(option.Cursor())
+	if err != nil {
+		return nil, err
+	}
+	collection, err := c.getCollection(ctx, req.GetCollectionName())
+	if err != nil {
+		return nil, err
+	}
+
+	return &SearchIterator{
+		client:      c,
+		option:      option,
+		callOptions: callOptions,
+		schema:      collection.Schema,
+		cursor:      cursor,
+	}, nil
+}
+
+// Next returns the next batch of results, io.EOF is returned when the iteration is done.
+func (it *SearchIterator) Next(ctx context.Context) (ResultSet, error) {
+	var resultSet ResultSet
+	batchSize := it.cursor.nextBatchSize(it.option.BatchSize(), it.option.Limit())
+	if it.done || batchSize == 0 {
+		return resultSet, io.EOF
+	}
+
+	req, err := it.nextRequest(batchSize)
+	if err != nil {
+		return resultSet, err
+	}
+
+	var resp *milvuspb.SearchResults
+	err = it.client.callService(func(milvusService milvuspb.MilvusServiceClient) error {
+		resp, err = milvusService.Search(ctx, req, it.callOptions...)
+		return merr.CheckRPCCall(resp, err)
+	})
+	if err != nil {
+		return resultSet, err
+	}
+
+	iterResults := resp.GetResults().GetSearchIteratorV2Results()
+	if iterResults == nil {
+		return resultSet, errors.New("search iterator v2 is not supported by the server")
+	}
+	resultSets, err := it.client.handleSearchResult(it.schema, req.GetOutputFields(), int(resp.GetResults().GetNumQueries()), resp)
+	if err != nil {
+		return resultSet, err
+	}
+	if len(resultSets) == 0 || resultSets[0].ResultCount == 0 {
+		it.done = true
+		return resultSet, io.EOF
+	}
+	resultSet = resultSets[0]
+	if resultSet.Err != nil {
+		return resultSet, resultSet.Err
+	}
+
+	lastBound := iterResults.GetLastBound()
+	it.cursor.Token = iterResults.GetToken()
+	it.cursor.LastBound = &lastBound
+	if it.cursor.SessionTs == 0 {
+		it.cursor.SessionTs = resp.GetSessionTs()
+	}
+	it.cursor.Count += resultSet.ResultCount
+	return resultSet, nil
+}
+
+func (it *SearchIterator) nextRequest(batchSize int) (*milvuspb.SearchRequest, error) {
+	req, err := it.option.Request()
+	if err != nil {
+		return nil, err
+	}
+
+	params := entity.KvPairsMap(req.GetSearchParams())
+	params[spIterator] = "true"
+	params[spSearchIterV2] = "true"
+	params[spSearchIterBatch] = strconv.Itoa(batchSize)
+	params[spTopK] = strconv.Itoa(batchSize)
+	delete(params, spOffset)
+	if it.cursor.Token != "" {
+		params[spSearchIterID] = it.cursor.Token
+	}
+	if it.cursor.LastBound != nil {
+		params[spSearchIterBound] = strconv.FormatFloat(float64(*it.cursor.LastBound), 'g', -1, 32)
+	}
+	req.SearchParams = entity.MapKvPairs(params)
+
+	if it.cursor.SessionTs > 0 {
+		req.GuaranteeTimestamp = it.cursor.SessionTs
+	}
+	return req, nil
+}
+
+// Cursor returns the token to resume the iteration after the last returned batch.
+func (it *SearchIterator) Cursor() string {
+	return it.cursor.encode()
+}
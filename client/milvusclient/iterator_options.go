@@ -0,0 +1,234 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package milvusclient
+
+import (
+	"github.com/cockroachdb/errors"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus/client/v2/entity"
+	"github.com/milvus-io/milvus/client/v2/index"
+)
+
+const (
+	spIterator          = `iterator`
+	spReduceStopForBest = `reduce_stop_for_best`
+	spSearchIterV2      = `search_iter_v2`
+	spSearchIterBatch   = `search_iter_batch_size`
+	spSearchIterBound   = `search_iter_last_bound`
+	spSearchIterID      = `search_iter_id`
+
+	defaultIteratorBatchSize = 1000
+)
+
+type QueryIteratorOption interface {
+	// Request returns the query request shared by all the batches.
+	Request() (*milvuspb.QueryRequest, error)
+	BatchSize() int
+	// Limit returns the max number of rows to iterate, non-positive value means unlimited.
+	Limit() int
+	// Cursor returns the cursor token to resume the iteration, empty means iterating from the beginning.
+	Cursor() string
+}
+
+var _ QueryIteratorOption = (*queryIteratorOption)(nil)
+
+type queryIteratorOption struct {
+	queryOption *queryOption
+	batchSize   int
+	limit       int
+	cursor      string
+}
+
+func (opt *queryIteratorOption) Request() (*milvuspb.QueryRequest, error) {
+	if opt.batchSize <= 0 {
+		return nil, errors.Newf("batch size must be positive, got %d", opt.batchSize)
+	}
+	return opt.queryOption.Request()
+}
+
+func (opt *queryIteratorOption) BatchSize() int {
+	return opt.batchSize
+}
+
+func (opt *queryIteratorOption) Limit() int {
+	return opt.limit
+}
+
+func (opt *queryIteratorOption) Cursor() string {
+	return opt.cursor
+}
+
+func (opt *queryIteratorOption) WithBatchSize(batchSize int) *queryIteratorOption {
+	opt.batchSize = batchSize
+	return opt
+}
+
+// WithLimit limits the total number of rows returned by the iterator.
+func (opt *queryIteratorOption) WithLimit(limit int) *queryIteratorOption {
+	opt.limit = limit
+	return opt
+}
+
+// WithCursor resumes the iteration from the cursor token returned by `QueryIterator.Cursor`.
+func (opt *queryIteratorOption) WithCursor(cursor string) *queryIteratorOption {
+	opt.cursor = cursor
+	return opt
+}
+
+func (opt *queryIteratorOption) WithFilter(expr string) *queryIteratorOption {
+	opt.queryOption.WithFilter(expr)
+	return opt
+}
+
+func (opt *queryIteratorOption) WithTemplateParam(key string, val any) *queryIteratorOption {
+	opt.queryOption.WithTemplateParam(key, val)
+	return opt
+}
+
+// WithOutputFields sets the output fields, the primary key is always returned since it's the cursor of iteration.
+func (opt *queryIteratorOption) WithOutputFields(fieldNames ...string) *queryIteratorOption {
+	opt.queryOption.WithOutputFields(fieldNames...)
+	return opt
+}
+
+func (opt *queryIteratorOption) WithConsistencyLevel(consistencyLevel entity.ConsistencyLevel) *queryIteratorOption {
+	opt.queryOption.WithConsistencyLevel(consistencyLevel)
+	return opt
+}
+
+func (opt *queryIteratorOption) WithPartitions(partitionNames ...string) *queryIteratorOption {
+	opt.queryOption.WithPartitions(partitionNames...)
+	return opt
+}
+
+func NewQueryIteratorOption(collectionName string) *queryIteratorOption {
+	return &queryIteratorOption{
+		queryOption: NewQueryOption(collectionName),
+		batchSize:   defaultIteratorBatchSize,
+	}
+}
+
+type SearchIteratorOption interface {
+	// Request returns the search request shared by all the batches.
+	Request() (*milvuspb.SearchRequest, error)
+	BatchSize() int
+	// Limit returns the max number of results to iterate, non-positive value means unlimited.
+	Limit() int
+	// Cursor returns the cursor token to resume the iteration, empty means iterating from the beginning.
+	Cursor() string
+}
+
+var _ SearchIteratorOption = (*searchIteratorOption)(nil)
+
+type searchIteratorOption struct {
+	searchOption *searchOption
+	batchSize    int
+	limit        int
+	cursor       string
+}
+
+func (opt *searchIteratorOption) Request() (*milvuspb.SearchRequest, error) {
+	if opt.batchSize <= 0 {
+		return nil, errors.Newf("batch size must be positive, got %d", opt.batchSize)
+	}
+	if len(opt.searchOption.annRequest.vectors) != 1 {
+		return nil, errors.New("search iterator supports only one vector")
+	}
+	return opt.searchOption.Request()
+}
+
+func (opt *searchIteratorOption) BatchSize() int {
+	return opt.batchSize
+}
+
+func (opt *searchIteratorOption) Limit() int {
+	return opt.limit
+}
+
+func (opt *searchIteratorOption) Cursor() string {
+	return opt.cursor
+}
+
+func (opt *searchIteratorOption) WithBatchSize(batchSize int) *searchIteratorOption {
+	opt.batchSize = batchSize
+	return opt
+}
+
+// WithLimit limits the total number of results returned by the iterator.
+func (opt *searchIteratorOption) WithLimit(limit int) *searchIteratorOption {
+	opt.limit = limit
+	return opt
+}
+
+// WithCursor resumes the iteration from the cursor token returned by `SearchIterator.Cursor`.
+func (opt *searchIteratorOption) WithCursor(cursor string) *searchIteratorOption {
+	opt.cursor = cursor
+	return opt
+}
+
+func (opt *searchIteratorOption) WithFilter(expr string) *searchIteratorOption {
+	opt.searchOption.WithFilter(expr)
+	return opt
+}
+
+func (opt *searchIteratorOption) WithTemplateParam(key string, val any) *searchIteratorOption {
+	opt.searchOption.WithTemplateParam(key, val)
+	return opt
+}
+
+func (opt *searchIteratorOption) WithOutputFields(fieldNames ...string) *searchIteratorOption {
+	opt.searchOption.WithOutputFields(fieldNames...)
+	return opt
+}
+
+func (opt *searchIteratorOption) WithConsistencyLevel(consistencyLevel entity.ConsistencyLevel) *searchIteratorOption {
+	opt.searchOption.WithConsistencyLevel(consistencyLevel)
+	return opt
+}
+
+func (opt *searchIteratorOption) WithPartitions(partitionNames ...string) *searchIteratorOption {
+	opt.searchOption.WithPartitions(partitionNames...)
+	return opt
+}
+
+func (opt *searchIteratorOption) WithANNSField(annsField string) *searchIteratorOption {
+	opt.searchOption.WithANNSField(annsField)
+	return opt
+}
+
+func (opt *searchIteratorOption) WithIgnoreGrowing(ignoreGrowing bool) *searchIteratorOption {
+	opt.searchOption.WithIgnoreGrowing(ignoreGrowing)
+	return opt
+}
+
+func (opt *searchIteratorOption) WithAnnParam(ap index.AnnParam) *searchIteratorOption {
+	opt.searchOption.WithAnnParam(ap)
+	return opt
+}
+
+func (opt *searchIteratorOption) WithSearchParam(key, value string) *searchIteratorOption {
+	opt.searchOption.WithSearchParam(key, value)
+	return opt
+}
+
+func NewSearchIteratorOption(collectionName string, vector entity.Vector) *searchIteratorOption {
+	return &searchIteratorOption{
+		searchOption: NewSearchOption(collectionName, defaultIteratorBatchSize, []entity.Vector{vector}),
+		batchSize:    defaultIteratorBatchSize,
+	}
+}
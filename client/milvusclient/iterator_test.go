@@ -0,0 +1,276 @@
+package milvusclient
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"math/rand"
+	"testing"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/client/v2/entity"
+	"github.com/milvus-io/milvus/pkg/v2/util/merr"
+)
+
+type IteratorSuite struct {
+	MockSuiteBase
+
+	schema        *entity.Schema
+	schemaVarChar *entity.Schema
+}
+
+func (s *IteratorSuite) SetupSuite() {
+	s.MockSuiteBase.SetupSuite()
+	s.schema = entity.NewSchema().
+		WithField(entity.NewField().WithName("ID").WithDataType(entity.FieldTypeInt64).WithIsPrimaryKey(true)).
+		WithField(entity.NewField().WithName("Vector").WithDataType(entity.FieldTypeFloatVector).WithDim(128))
+
+	s.schemaVarChar = entity.NewSchema().
+		WithField(entity.NewField().WithName("Name").WithDataType(entity.FieldTypeVarChar).WithMaxLength(64).WithIsPrimaryKey(true)).
+		WithField(entity.NewField().WithName("Vector").WithDataType(entity.FieldTypeFloatVector).WithDim(128))
+}
+
+func (s *IteratorSuite) TestQueryIterator() {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	s.Run("success", func() {
+		collectionName := fmt.Sprintf("coll_%s", s.randString(6))
+		s.setupCache(collectionName, s.schema)
+
+		s.mock.EXPECT().Query(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, qr *milvuspb.QueryRequest) (*milvuspb.QueryResults, error) {
+			params := entity.KvPairsMap(qr.GetQueryParams())
+			s.Equal(collectionName, qr.GetCollectionName())
+			s.Equal("A > 1", qr.GetExpr())
+			s.Equal([]string{"A", "ID"}, qr.GetOutputFields())
+			s.Equal("true", params[spIterator])
+			s.Equal("true", params[spReduceStopForBest])
+			s.Equal("2", params[spLimit])
+			s.EqualValues(0, qr.GetGuaranteeTimestamp())
+
+			return &milvuspb.QueryResults{
+				Status:       merr.Success(),
+				OutputFields: []string{"ID"},
+				FieldsData:   []*schemapb.FieldData{s.getInt64FieldData("ID", []int64{1, 2})},
+				SessionTs:    100,
+			}, nil
+		}).Once()
+		// entity 3 is deleted during iteration, the cursor is the primary key so no entity is skipped
+		s.mock.EXPECT().Query(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, qr *milvuspb.QueryRequest) (*milvuspb.QueryResults, error) {
+			s.Equal("(A > 1) and ID > 2", qr.GetExpr())
+			s.EqualValues(100, qr.GetGuaranteeTimestamp())
+
+			return &milvuspb.QueryResults{
+				Status:       merr.Success(),
+				OutputFields: []string{"ID"},
+				FieldsData:   []*schemapb.FieldData{s.getInt64FieldData("ID", []int64{4, 5})},
+			}, nil
+		}).Once()
+		s.mock.EXPECT().Query(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, qr *milvuspb.QueryRequest) (*milvuspb.QueryResults, error) {
+			s.Equal("(A > 1) and ID > 5", qr.GetExpr())
+
+			return &milvuspb.QueryResults{
+				Status:       merr.Success(),
+				OutputFields: []string{"ID"},
+				FieldsData:   []*schemapb.FieldData{s.getInt64FieldData("ID", []int64{})},
+			}, nil
+		}).Once()
+
+		iter, err := s.client.QueryIterator(ctx, NewQueryIteratorOption(collectionName).WithBatchSize(2).
+			WithFilter("A > 1").WithOutputFields("A"))
+		s.Require().NoError(err)
+
+		var ids []int64
+		for {
+			rs, err := iter.Next(ctx)
+			if err == io.EOF {
+				break
+			}
+			s.Require().NoError(err)
+			for i := 0; i < rs.ResultCount; i++ {
+				id, err := rs.IDs.GetAsInt64(i)
+				s.Require().NoError(err)
+				ids = append(ids, id)
+			}
+		}
+		s.Equal([]int64{1, 2, 4, 5}, ids)
+
+		// exhausted iterator returns EOF without request
+		_, err = iter.Next(ctx)
+		s.ErrorIs(err, io.EOF)
+	})
+
+	s.Run("resume_with_limit", func() {
+		collectionName := fmt.Sprintf("coll_%s", s.randString(6))
+		s.setupCache(collectionName, s.schemaVarChar)
+
+		s.mock.EXPECT().Query(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, qr *milvuspb.QueryRequest) (*milvuspb.QueryResults, error) {
+			s.Equal("", qr.GetExpr())
+			return &milvuspb.QueryResults{
+				Status:       merr.Success(),
+				OutputFields: []string{"Name"},
+				FieldsData:   []*schemapb.FieldData{s.getVarcharFieldData("Name", []string{"a", "b\""})},
+				SessionTs:    200,
+			}, nil
+		}).Once()
+
+		iter, err := s.client.QueryIterator(ctx, NewQueryIteratorOption(collectionName).WithBatchSize(2).WithLimit(3))
+		s.Require().NoError(err)
+		rs, err := iter.Next(ctx)
+		s.Require().NoError(err)
+		s.Equal(2, rs.ResultCount)
+		cursor := iter.Cursor()
+
+		// resume from the cursor, only one entity left to reach the limit
+		s.mock.EXPECT().Query(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, qr *milvuspb.QueryRequest) (*milvuspb.QueryResults, error) {
+			s.Equal(`Name > "b\""`, qr.GetExpr())
+			s.Equal("1", entity.KvPairsMap(qr.GetQueryParams())[spLimit])
+			s.EqualValues(200, qr.GetGuaranteeTimestamp())
+			return &milvuspb.QueryResults{
+				Status:       merr.Success(),
+				OutputFields: []string{"Name"},
+				FieldsData:   []*schemapb.FieldData{s.getVarcharFieldData("Name", []string{"c"})},
+			}, nil
+		}).Once()
+
+		iter, err = s.client.QueryIterator(ctx, NewQueryIteratorOption(collectionName).WithBatchSize(2).WithLimit(3).WithCursor(cursor))
+		s.Require().NoError(err)
+		rs, err = iter.Next(ctx)
+		s.Require().NoError(err)
+		s.Equal(1, rs.ResultCount)
+		_, err = iter.Next(ctx)
+		s.ErrorIs(err, io.EOF)
+	})
+
+	s.Run("failure", func() {
+		collectionName := fmt.Sprintf("coll_%s", s.randString(6))
+		s.setupCache(collectionName, s.schema)
+
+		_, err := s.client.QueryIterator(ctx, NewQueryIteratorOption(collectionName).WithBatchSize(0))
+		s.Error(err)
+
+		_, err = s.client.QueryIterator(ctx, NewQueryIteratorOption(collectionName).WithCursor("invalid cursor"))
+		s.Error(err)
+
+		s.mock.EXPECT().Query(mock.Anything, mock.Anything).Return(nil, merr.WrapErrServiceInternal("mocked")).Once()
+		iter, err := s.client.QueryIterator(ctx, NewQueryIteratorOption(collectionName))
+		s.Require().NoError(err)
+		_, err = iter.Next(ctx)
+		s.Error(err)
+	})
+}
+
+func (s *IteratorSuite) TestSearchIterator() {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	vector := entity.FloatVector(lo.RepeatBy(128, func(_ int) float32 {
+		return rand.Float32()
+	}))
+	genResults := func(ids []int64, scores []float32) *schemapb.SearchResultData {
+		return &schemapb.SearchResultData{
+			NumQueries: 1,
+			TopK:       int64(len(ids)),
+			FieldsData: []*schemapb.FieldData{s.getInt64FieldData("ID", ids)},
+			Ids: &schemapb.IDs{
+				IdField: &schemapb.IDs_IntId{IntId: &schemapb.LongArray{Data: ids}},
+			},
+			Scores: scores,
+			Topks:  []int64{int64(len(ids))},
+		}
+	}
+
+	s.Run("success", func() {
+		collectionName := fmt.Sprintf("coll_%s", s.randString(6))
+		s.setupCache(collectionName, s.schema)
+
+		s.mock.EXPECT().Search(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, sr *milvuspb.SearchRequest) (*milvuspb.SearchResults, error) {
+			params := entity.KvPairsMap(sr.GetSearchParams())
+			s.Equal("true", params[spIterator])
+			s.Equal("true", params[spSearchIterV2])
+			s.Equal("2", params[spSearchIterBatch])
+			s.Equal("2", params[spTopK])
+			s.NotContains(params, spSearchIterID)
+			s.NotContains(params, spSearchIterBound)
+			s.EqualValues(0, sr.GetGuaranteeTimestamp())
+
+			results := genResults([]int64{1, 2}, []float32{0.1, 0.2})
+			results.SearchIteratorV2Results = &schemapb.SearchIteratorV2Results{Token: "token", LastBound: 0.2}
+			return &milvuspb.SearchResults{Status: merr.Success(), Results: results, SessionTs: 100}, nil
+		}).Once()
+		s.mock.EXPECT().Search(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, sr *milvuspb.SearchRequest) (*milvuspb.SearchResults, error) {
+			params := entity.KvPairsMap(sr.GetSearchParams())
+			s.Equal("token", params[spSearchIterID])
+			s.Equal("0.2", params[spSearchIterBound])
+			s.EqualValues(100, sr.GetGuaranteeTimestamp())
+
+			results := genResults([]int64{}, []float32{})
+			results.SearchIteratorV2Results = &schemapb.SearchIteratorV2Results{Token: "token", LastBound: 0.2}
+			return &milvuspb.SearchResults{Status: merr.Success(), Results: results}, nil
+		}).Once()
+
+		iter, err := s.client.SearchIterator(ctx, NewSearchIteratorOption(collectionName, vector).WithBatchSize(2).WithANNSField("Vector"))
+		s.Require().NoError(err)
+		rs, err := iter.Next(ctx)
+		s.Require().NoError(err)
+		s.Equal(2, rs.ResultCount)
+		s.Equal([]float32{0.1, 0.2}, rs.Scores)
+		_, err = iter.Next(ctx)
+		s.ErrorIs(err, io.EOF)
+	})
+
+	s.Run("resume_with_limit", func() {
+		collectionName := fmt.Sprintf("coll_%s", s.randString(6))
+		s.setupCache(collectionName, s.schema)
+
+		cursor := (&iteratorCursor{SessionTs: 100, Count: 2, Token: "token", LastBound: lo.ToPtr(float32(0.5))}).encode()
+		s.mock.EXPECT().Search(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, sr *milvuspb.SearchRequest) (*milvuspb.SearchResults, error) {
+			params := entity.KvPairsMap(sr.GetSearchParams())
+			s.Equal("token", params[spSearchIterID])
+			s.Equal("0.5", params[spSearchIterBound])
+			s.Equal("1", params[spSearchIterBatch])
+			s.EqualValues(100, sr.GetGuaranteeTimestamp())
+
+			results := genResults([]int64{3}, []float32{0.6})
+			results.SearchIteratorV2Results = &schemapb.SearchIteratorV2Results{Token: "token", LastBound: 0.6}
+			return &milvuspb.SearchResults{Status: merr.Success(), Results: results}, nil
+		}).Once()
+
+		iter, err := s.client.SearchIterator(ctx, NewSearchIteratorOption(collectionName, vector).WithBatchSize(2).WithLimit(3).WithCursor(cursor))
+		s.Require().NoError(err)
+		rs, err := iter.Next(ctx)
+		s.Require().NoError(err)
+		s.Equal(1, rs.ResultCount)
+		_, err = iter.Next(ctx)
+		s.ErrorIs(err, io.EOF)
+	})
+
+	s.Run("failure", func() {
+		collectionName := fmt.Sprintf("coll_%s", s.randString(6))
+		s.setupCache(collectionName, s.schema)
+
+		_, err := s.client.SearchIterator(ctx, NewSearchIteratorOption(collectionName, vector).WithBatchSize(-1))
+		s.Error(err)
+
+		_, err = s.client.SearchIterator(ctx, NewSearchIteratorOption(collectionName, nonSupportData{}))
+		s.Error(err)
+
+		// server without search iterator v2
+		s.mock.EXPECT().Search(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, sr *milvuspb.SearchRequest) (*milvuspb.SearchResults, error) {
+			return &milvuspb.SearchResults{Status: merr.Success(), Results: genResults([]int64{1}, []float32{0.1})}, nil
+		}).Once()
+		iter, err := s.client.SearchIterator(ctx, NewSearchIteratorOption(collectionName, vector))
+		s.Require().NoError(err)
+		_, err = iter.Next(ctx)
+		s.Error(err)
+	})
+}
+
+func TestIterator(t *testing.T) {
+	suite.Run(t, new(IteratorSuite))
+}
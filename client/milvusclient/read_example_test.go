@@ -19,6 +19,7 @@ package milvusclient_test
 
 import (
 	"context"
+	"io"
 	"log"
 
 	"github.com/milvus-io/milvus/client/v2/entity"
@@ -199,6 +200,45 @@ func ExampleClient_Search_offsetLimit() {
 	}
 }
 
+func ExampleClient_QueryIterator() {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	milvusAddr := "127.0.0.1:19530"
+	token := "root:Milvus"
+
+	cli, err := milvusclient.New(ctx, &milvusclient.ClientConfig{
+		Address: milvusAddr,
+		APIKey:  token,
+	})
+	if err != nil {
+		log.Fatal("failed to connect to milvus server: ", err.Error())
+	}
+
+	defer cli.Close(ctx)
+
+	iter, err := cli.QueryIterator(ctx, milvusclient.NewQueryIteratorOption("quick_setup").
+		WithBatchSize(100).
+		WithFilter("color like \"red%\"").
+		WithOutputFields("color"))
+	if err != nil {
+		log.Fatal("failed to create query iterator: ", err.Error())
+	}
+
+	for {
+		resultSet, err := iter.Next(ctx)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			// the iteration could be resumed later with `WithCursor(iter.Cursor())`
+			log.Fatal("failed to iterate, cursor: ", iter.Cursor(), err.Error())
+		}
+		log.Println("IDs: ", resultSet.IDs)
+		log.Println("Colors: ", resultSet.GetColumn("color"))
+	}
+}
+
 // func ExampleClient_Search_useLevel() {
 
 // }
@@ -46,6 +46,8 @@ const (
 	spGroupBy         = `group_by_field`
 	spGroupSize       = `group_size`
 	spStrictGroupSize = `strict_group_size`
+	spOrderBy         = `order_by`
+	spGroupByFields   = `group_by_fields`
 )
 
 type SearchOption interface {
@@ -513,16 +515,30 @@ type queryOption struct {
 	useDefaultConsistencyLevel bool
 	expr                       string
 	templateParams             map[string]any
+	orderBys                   []*OrderBy
 }
 
 func (opt *queryOption) Request() (*milvuspb.QueryRequest, error) {
+	queryParams := opt.queryParams
+	if len(opt.orderBys) > 0 {
+		queryParams = make(map[string]string, len(opt.queryParams)+1)
+		for k, v := range opt.queryParams {
+			queryParams[k] = v
+		}
+		clauses := make([]string, 0, len(opt.orderBys))
+		for _, orderBy := range opt.orderBys {
+			clauses = append(clauses, orderBy.String())
+		}
+		queryParams[spOrderBy] = strings.Join(clauses, ", ")
+	}
+
 	req := &milvuspb.QueryRequest{
 		CollectionName: opt.collectionName,
 		PartitionNames: opt.partitionNames,
 		OutputFields:   opt.outputFields,
 
 		Expr:                  opt.expr,
-		QueryParams:           entity.MapKvPairs(opt.queryParams),
+		QueryParams:           entity.MapKvPairs(queryParams),
 		ConsistencyLevel:      opt.consistencyLevel.CommonConsistencyLevel(),
 		UseDefaultConsistency: opt.useDefaultConsistencyLevel,
 	}
@@ -565,6 +581,24 @@ func (opt *queryOption) WithLimit(limit int) *queryOption {
 	return opt
 }
 
+// WithGroupBy groups the query results by the provided scalar fields,
+// the output fields shall be the group by fields or aggregates like `count(*)`, `sum(price)`.
+// Rows are aggregated on each segment, rows inserted with duplicate primary keys are aggregated more than once.
+func (opt *queryOption) WithGroupBy(fieldNames ...string) *queryOption {
+	if opt.queryParams == nil {
+		opt.queryParams = make(map[string]string)
+	}
+	opt.queryParams[spGroupByFields] = strings.Join(fieldNames, ",")
+	return opt
+}
+
+// WithOrderBy sorts the query results by the provided fields instead of primary key,
+// a limit shall be provided along with it.
+func (opt *queryOption) WithOrderBy(orderBys ...*OrderBy) *queryOption {
+	opt.orderBys = append(opt.orderBys, orderBys...)
+	return opt
+}
+
 func (opt *queryOption) WithOutputFields(fieldNames ...string) *queryOption {
 	opt.outputFields = fieldNames
 	return opt
@@ -602,6 +636,39 @@ func pks2Expr(ids column.Column) string {
 	return expr
 }
 
+// OrderBy is a sort key of query results, ascending with nulls last by default.
+type OrderBy struct {
+	fieldName  string
+	descending bool
+	nullsFirst bool
+}
+
+func NewOrderBy(fieldName string) *OrderBy {
+	return &OrderBy{fieldName: fieldName}
+}
+
+func (o *OrderBy) Desc() *OrderBy {
+	o.descending = true
+	return o
+}
+
+func (o *OrderBy) NullsFirst() *OrderBy {
+	o.nullsFirst = true
+	return o
+}
+
+func (o *OrderBy) String() string {
+	direction := "asc"
+	if o.descending {
+		direction = "desc"
+	}
+	nulls := "last"
+	if o.nullsFirst {
+		nulls = "first"
+	}
+	return fmt.Sprintf("%s %s nulls %s", o.fieldName, direction, nulls)
+}
+
 func NewQueryOption(collectionName string) *queryOption {
 	return &queryOption{
 		collectionName:             collectionName,
@@ -227,6 +227,40 @@ func (s *ReadSuite) TestQuery() {
 		s.NoError(err)
 	})
 
+	s.Run("order_by", func() {
+		collectionName := fmt.Sprintf("coll_%s", s.randString(6))
+		s.setupCache(collectionName, s.schema)
+
+		s.mock.EXPECT().Query(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, qr *milvuspb.QueryRequest) (*milvuspb.QueryResults, error) {
+			params := entity.KvPairsMap(qr.GetQueryParams())
+			s.Equal("age desc nulls first, name asc nulls last", params[spOrderBy])
+			s.Equal("10", params[spLimit])
+
+			return &milvuspb.QueryResults{}, nil
+		}).Once()
+
+		_, err := s.client.Query(ctx, NewQueryOption(collectionName).WithLimit(10).
+			WithOrderBy(NewOrderBy("age").Desc().NullsFirst(), NewOrderBy("name")))
+		s.NoError(err)
+	})
+
+	s.Run("group_by", func() {
+		collectionName := fmt.Sprintf("coll_%s", s.randString(6))
+		s.setupCache(collectionName, s.schema)
+
+		s.mock.EXPECT().Query(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, qr *milvuspb.QueryRequest) (*milvuspb.QueryResults, error) {
+			params := entity.KvPairsMap(qr.GetQueryParams())
+			s.Equal("category,age", params[spGroupByFields])
+			s.Equal([]string{"category", "age", "count(*)", "avg(price)"}, qr.GetOutputFields())
+
+			return &milvuspb.QueryResults{}, nil
+		}).Once()
+
+		_, err := s.client.Query(ctx, NewQueryOption(collectionName).WithGroupBy("category", "age").
+			WithOutputFields("category", "age", "count(*)", "avg(price)"))
+		s.NoError(err)
+	})
+
 	s.Run("bad_request", func() {
 		collectionName := fmt.Sprintf("coll_%s", s.randString(6))
 		s.setupCache(collectionName, s.schema)
@@ -11,9 +11,17 @@ const (
 	rerankParams  = "params"
 	rffParam      = "k"
 	weightedParam = "weights"
+	rerankQueries = "rerank_queries"
 
 	rrfRerankType      = `rrf`
 	weightedRerankType = `weighted`
+	decayRerankType    = `decay`
+	exprRerankType     = `expr`
+
+	// decay functions supported by decay reranker
+	GaussDecay  = `gauss`
+	ExpDecay    = `exp`
+	LinearDecay = `linear`
 )
 
 type Reranker interface {
@@ -60,3 +68,129 @@ func NewWeightedReranker(weights []float64) *weightedReranker {
 		Weights: weights,
 	}
 }
+
+// decayReranker fuses the sub results by rrf or weights, then multiplies the fused scores
+// by a decay factor computed from the distance between the field value and the origin.
+type decayReranker struct {
+	K        float64   `json:"k,omitempty"`
+	Weights  []float64 `json:"weights,omitempty"`
+	Function string    `json:"function"`
+	Field    string    `json:"field"`
+	Origin   float64   `json:"origin"`
+	Scale    float64   `json:"scale"`
+	Offset   float64   `json:"offset,omitempty"`
+	Decay    float64   `json:"decay,omitempty"`
+}
+
+func (r *decayReranker) WithK(k float64) *decayReranker {
+	r.K = k
+	return r
+}
+
+func (r *decayReranker) WithWeights(weights []float64) *decayReranker {
+	r.Weights = weights
+	return r
+}
+
+func (r *decayReranker) WithOffset(offset float64) *decayReranker {
+	r.Offset = offset
+	return r
+}
+
+func (r *decayReranker) WithDecay(decay float64) *decayReranker {
+	r.Decay = decay
+	return r
+}
+
+func (r *decayReranker) GetParams() []*commonpb.KeyValuePair {
+	bs, _ := json.Marshal(r)
+
+	return []*commonpb.KeyValuePair{
+		{Key: rerankType, Value: decayRerankType},
+		{Key: rerankParams, Value: string(bs)},
+	}
+}
+
+// NewDecayReranker creates a reranker with the decay function (GaussDecay, ExpDecay or LinearDecay),
+// the numeric field (e.g. a timestamp) and the origin & scale of the decay.
+func NewDecayReranker(function string, field string, origin float64, scale float64) *decayReranker {
+	return &decayReranker{
+		Function: function,
+		Field:    field,
+		Origin:   origin,
+		Scale:    scale,
+	}
+}
+
+// exprReranker fuses the sub results by rrf or weights, then replaces the fused scores
+// by the expression, which refers to the fused score by `score` and to the input fields by their names.
+type exprReranker struct {
+	K           float64   `json:"k,omitempty"`
+	Weights     []float64 `json:"weights,omitempty"`
+	Expr        string    `json:"expr"`
+	InputFields []string  `json:"input_fields,omitempty"`
+}
+
+func (r *exprReranker) WithK(k float64) *exprReranker {
+	r.K = k
+	return r
+}
+
+func (r *exprReranker) WithWeights(weights []float64) *exprReranker {
+	r.Weights = weights
+	return r
+}
+
+func (r *exprReranker) GetParams() []*commonpb.KeyValuePair {
+	bs, _ := json.Marshal(r)
+
+	return []*commonpb.KeyValuePair{
+		{Key: rerankType, Value: exprRerankType},
+		{Key: rerankParams, Value: string(bs)},
+	}
+}
+
+func NewExprReranker(expr string, inputFields ...string) *exprReranker {
+	return &exprReranker{
+		Expr:        expr,
+		InputFields: inputFields,
+	}
+}
+
+// modelReranker fuses the sub results by rrf or weights, then replaces the fused scores by the relevance
+// between the queries and the hits, which is computed by the rerank function declared in the collection schema.
+type modelReranker struct {
+	k       float64
+	weights []float64
+	queries []string
+}
+
+func (r *modelReranker) WithK(k float64) *modelReranker {
+	r.k = k
+	return r
+}
+
+func (r *modelReranker) WithWeights(weights []float64) *modelReranker {
+	r.weights = weights
+	return r
+}
+
+func (r *modelReranker) GetParams() []*commonpb.KeyValuePair {
+	var params []*commonpb.KeyValuePair
+	if len(r.weights) > 0 {
+		params = NewWeightedReranker(r.weights).GetParams()
+	} else {
+		params = NewRRFReranker().WithK(r.k).GetParams()
+	}
+	bs, _ := json.Marshal(r.queries)
+	return append(params, &commonpb.KeyValuePair{Key: rerankQueries, Value: string(bs)})
+}
+
+// NewModelReranker creates a reranker with the query text of each search query,
+// the collection shall declare a rerank function in its schema.
+func NewModelReranker(queries ...string) *modelReranker {
+	return &modelReranker{
+		k:       60,
+		queries: queries,
+	}
+}
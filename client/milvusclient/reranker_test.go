@@ -52,4 +52,36 @@ func TestReranker(t *testing.T) {
 		assert.True(t, checkParam(params, rerankType, weightedRerankType))
 		assert.True(t, checkParam(params, rerankParams, `{"weights":[1,2,1]}`))
 	})
+
+	t.Run("decayReranker", func(t *testing.T) {
+		rr := NewDecayReranker(GaussDecay, "ts", 100, 10)
+		params := rr.GetParams()
+		assert.True(t, checkParam(params, rerankType, decayRerankType))
+		assert.True(t, checkParam(params, rerankParams, `{"function":"gauss","field":"ts","origin":100,"scale":10}`))
+
+		rr.WithWeights([]float64{0.5, 0.5}).WithOffset(1).WithDecay(0.3)
+		params = rr.GetParams()
+		assert.True(t, checkParam(params, rerankParams, `{"weights":[0.5,0.5],"function":"gauss","field":"ts","origin":100,"scale":10,"offset":1,"decay":0.3}`))
+	})
+
+	t.Run("exprReranker", func(t *testing.T) {
+		rr := NewExprReranker("score * popularity", "popularity").WithK(50)
+		params := rr.GetParams()
+		assert.True(t, checkParam(params, rerankType, exprRerankType))
+		assert.True(t, checkParam(params, rerankParams, `{"k":50,"expr":"score * popularity","input_fields":["popularity"]}`))
+	})
+
+	t.Run("modelReranker", func(t *testing.T) {
+		rr := NewModelReranker("q1", "q2").WithK(50)
+		params := rr.GetParams()
+		assert.True(t, checkParam(params, rerankType, rrfRerankType))
+		assert.True(t, checkParam(params, rerankParams, `{"k":50}`))
+		assert.True(t, checkParam(params, rerankQueries, `["q1","q2"]`))
+
+		rr = NewModelReranker("q1").WithWeights([]float64{0.4, 0.6})
+		params = rr.GetParams()
+		assert.True(t, checkParam(params, rerankType, weightedRerankType))
+		assert.True(t, checkParam(params, rerankParams, `{"weights":[0.4,0.6]}`))
+		assert.True(t, checkParam(params, rerankQueries, `["q1"]`))
+	})
 }
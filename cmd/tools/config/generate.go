@@ -258,6 +258,10 @@ func WriteYaml(w io.Writer) {
 # natsmq configuration.
 # more detail: https://docs.nats.io/running-a-nats-service/configuration`,
 		},
+		{
+			name:   "filelog",
+			header: "\n# Related configuration of the local file wal, only available with streaming service in standalone mode.",
+		},
 		{
 			name:   "rootCoord",
 			header: "\n# Related configuration of rootCoord, used to handle data definition language (DDL) and data control language (DCL) requests",
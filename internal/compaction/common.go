@@ -20,21 +20,36 @@ import (
 	"context"
 	sio "io"
 
+	"github.com/samber/lo"
 	"go.uber.org/zap"
 
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/allocator"
 	"github.com/milvus-io/milvus/internal/flushcommon/io"
+	"github.com/milvus-io/milvus/internal/metastore/kv/binlog"
 	"github.com/milvus-io/milvus/internal/storage"
 	"github.com/milvus-io/milvus/pkg/v2/log"
+	"github.com/milvus-io/milvus/pkg/v2/proto/datapb"
 	"github.com/milvus-io/milvus/pkg/v2/util/typeutil"
 )
 
 func ComposeDeleteFromDeltalogs(ctx context.Context, io io.BinlogIO, paths []string) (map[interface{}]typeutil.Timestamp, error) {
+	pk2Ts, _, err := ComposeDeleteFromDeltalogsWithTimeTravel(ctx, io, paths, 0)
+	return pk2Ts, err
+}
+
+// ComposeDeleteFromDeltalogsWithTimeTravel composes the deletes older than or equal to the time travel ts
+// into the pk to latest delete ts map, the deletes later than the time travel ts are retained as they are,
+// so that the rows deleted in the time travel window survive compaction along with their deletes.
+// Time travel is disabled if timetravel is 0.
+func ComposeDeleteFromDeltalogsWithTimeTravel(ctx context.Context, io io.BinlogIO, paths []string, timetravel typeutil.Timestamp) (map[interface{}]typeutil.Timestamp, map[interface{}][]typeutil.Timestamp, error) {
 	pk2Ts := make(map[interface{}]typeutil.Timestamp)
+	retained := make(map[interface{}][]typeutil.Timestamp)
 
 	log := log.Ctx(ctx)
 	if len(paths) == 0 {
 		log.Debug("input deltalog paths is empty, skip")
-		return pk2Ts, nil
+		return pk2Ts, retained, nil
 	}
 
 	blobs := make([]*storage.Blob, 0)
@@ -43,7 +58,7 @@ func ComposeDeleteFromDeltalogs(ctx context.Context, io io.BinlogIO, paths []str
 		log.Warn("compose delete wrong, fail to download deltalogs",
 			zap.Strings("path", paths),
 			zap.Error(err))
-		return nil, err
+		return nil, nil, err
 	}
 
 	for i := range binaries {
@@ -52,7 +67,7 @@ func ComposeDeleteFromDeltalogs(ctx context.Context, io io.BinlogIO, paths []str
 	reader, err := storage.CreateDeltalogReader(blobs)
 	if err != nil {
 		log.Error("compose delete wrong, malformed delta file", zap.Error(err))
-		return nil, err
+		return nil, nil, err
 	}
 	defer reader.Close()
 
@@ -63,16 +78,69 @@ func ComposeDeleteFromDeltalogs(ctx context.Context, io io.BinlogIO, paths []str
 				break
 			}
 			log.Error("compose delete wrong, failed to read deltalogs", zap.Error(err))
-			return nil, err
+			return nil, nil, err
 		}
 
 		dl := reader.Value()
+		if timetravel > 0 && dl.Ts > timetravel {
+			retained[dl.Pk.GetValue()] = append(retained[dl.Pk.GetValue()], dl.Ts)
+			continue
+		}
 		if ts, ok := pk2Ts[dl.Pk.GetValue()]; ok && ts > dl.Ts {
 			continue
 		}
 		pk2Ts[dl.Pk.GetValue()] = dl.Ts
 	}
 
-	log.Info("compose delete end", zap.Int("delete entries counts", len(pk2Ts)))
-	return pk2Ts, nil
+	log.Info("compose delete end", zap.Int("delete entries counts", len(pk2Ts)), zap.Int("retained delete pks", len(retained)))
+	return pk2Ts, retained, nil
+}
+
+// WriteRetainedDeletes serializes and uploads the retained deletes of the rows written to the segment as a deltalog,
+// deletes maps the pk to the delete timestamps later than the row ts. Nil is returned if there is no delete.
+func WriteRetainedDeletes(ctx context.Context, binlogIO io.BinlogIO, logIDAlloc allocator.Interface, pkType schemapb.DataType,
+	collectionID, partitionID, segmentID int64, deletes map[interface{}][]typeutil.Timestamp,
+) (*datapb.FieldBinlog, error) {
+	deleteData := storage.NewDeleteData(nil, nil)
+	for rawPk, tss := range deletes {
+		pk, err := storage.GenPrimaryKeyByRawData(rawPk, pkType)
+		if err != nil {
+			return nil, err
+		}
+		for _, ts := range tss {
+			deleteData.Append(pk, ts)
+		}
+	}
+	if deleteData.RowCount == 0 {
+		return nil, nil
+	}
+
+	blob, err := storage.NewDeleteCodec().Serialize(collectionID, partitionID, segmentID, deleteData)
+	if err != nil {
+		return nil, err
+	}
+	logID, err := logIDAlloc.AllocOne()
+	if err != nil {
+		return nil, err
+	}
+	blobKey, err := binlog.BuildLogPath(storage.DeleteBinlog, collectionID, partitionID, segmentID, -1, logID)
+	if err != nil {
+		return nil, err
+	}
+	if err := binlogIO.Upload(ctx, map[string][]byte{blobKey: blob.GetValue()}); err != nil {
+		return nil, err
+	}
+
+	log.Ctx(ctx).Info("wrote retained deletes", zap.Int64("segmentID", segmentID), zap.Int64("deleteRows", deleteData.RowCount))
+	return &datapb.FieldBinlog{
+		Binlogs: []*datapb.Binlog{{
+			EntriesNum:    deleteData.RowCount,
+			LogSize:       int64(len(blob.GetValue())),
+			MemorySize:    blob.GetMemorySize(),
+			LogPath:       blobKey,
+			LogID:         logID,
+			TimestampFrom: lo.Min(deleteData.Tss),
+			TimestampTo:   lo.Max(deleteData.Tss),
+		}},
+	}, nil
 }
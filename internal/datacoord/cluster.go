@@ -47,6 +47,9 @@ type Cluster interface {
 	QueryPreImport(nodeID int64, in *datapb.QueryPreImportRequest) (*datapb.QueryPreImportResponse, error)
 	QueryImport(nodeID int64, in *datapb.QueryImportRequest) (*datapb.QueryImportResponse, error)
 	DropImport(nodeID int64, in *datapb.DropImportRequest) error
+	ExportV2(nodeID int64, in *datapb.ExportRequest) error
+	QueryExport(nodeID int64, in *datapb.QueryExportRequest) (*datapb.QueryExportResponse, error)
+	DropExport(nodeID int64, in *datapb.DropExportRequest) error
 	QuerySlots() map[int64]int64
 	GetSessions() []*session.Session
 	Close()
@@ -180,6 +183,18 @@ func (c *ClusterImpl) DropImport(nodeID int64, in *datapb.DropImportRequest) err
 	return c.sessionManager.DropImport(nodeID, in)
 }
 
+func (c *ClusterImpl) ExportV2(nodeID int64, in *datapb.ExportRequest) error {
+	return c.sessionManager.ExportV2(nodeID, in)
+}
+
+func (c *ClusterImpl) QueryExport(nodeID int64, in *datapb.QueryExportRequest) (*datapb.QueryExportResponse, error) {
+	return c.sessionManager.QueryExport(nodeID, in)
+}
+
+func (c *ClusterImpl) DropExport(nodeID int64, in *datapb.DropExportRequest) error {
+	return c.sessionManager.DropExport(nodeID, in)
+}
+
 func (c *ClusterImpl) QuerySlots() map[int64]int64 {
 	nodeIDs := c.sessionManager.GetSessionIDs()
 	nodeSlots := make(map[int64]int64)
@@ -62,13 +62,28 @@ type compactionPlanContext interface {
 	setTaskScheduler(scheduler *taskScheduler)
 	checkAndSetSegmentStating(channel string, segmentID int64) bool
 	getCompactionTasksNum(filters ...compactionTaskFilter) int
+	// listCompactionTasks returns the queued and executing compaction tasks matching the filters
+	listCompactionTasks(filters ...compactionTaskFilter) []*datapb.CompactionTask
+	// cancelCompaction cancels the queued or executing compaction task of the plan
+	cancelCompaction(planID int64) error
+	// pauseCompaction stops accepting and scheduling the compaction tasks of the collection
+	pauseCompaction(collectionID int64)
+	resumeCompaction(collectionID int64)
+	getPausedCollections() []int64
+	// markManualTrigger marks the tasks of the trigger manual, which are prioritized by the manual class.
+	// The mark is not persisted, the tasks fall back to their type class after datacoord restarts.
+	markManualTrigger(triggerID int64)
 }
 
 var (
 	errChannelNotWatched = errors.New("channel is not watched")
 	errChannelInBuffer   = errors.New("channel is in buffer")
+	errCompactionPaused  = errors.New("compaction is paused")
 )
 
+// compactionCanceledReason is the fail reason of the compaction tasks canceled by the management API.
+const compactionCanceledReason = "canceled by user"
+
 var _ compactionPlanContext = (*compactionPlanHandler)(nil)
 
 type compactionInfo struct {
@@ -77,6 +92,7 @@ type compactionInfo struct {
 	completedCnt int
 	failedCnt    int
 	timeoutCnt   int
+	canceledCnt  int
 	mergeInfos   map[int64]*milvuspb.CompactionMergeInfo
 }
 
@@ -155,6 +171,13 @@ type compactionPlanHandler struct {
 	cleaningGuard lock.RWMutex
 	cleaningTasks map[int64]CompactionTask // planID -> task
 
+	// collections whose compaction is paused by the management API, not persisted
+	pausedCollections typeutil.ConcurrentSet[int64]
+	// triggers of the manual compactions whose tasks are not finished yet. It's kept in memory only on purpose:
+	// the tasks are created and saved by the trigger before they are known to be manual, and losing the mark
+	// only affects the ordering, the unfinished tasks reloaded after a restart are scheduled by their type class.
+	manualTriggers typeutil.ConcurrentSet[int64]
+
 	meta             CompactionMeta
 	allocator        allocator.Allocator
 	sessions         session.DataNodeManager
@@ -174,7 +197,7 @@ func (c *compactionPlanHandler) getCompactionInfo(ctx context.Context, triggerID
 
 func summaryCompactionState(tasks []*datapb.CompactionTask) *compactionInfo {
 	ret := &compactionInfo{}
-	var executingCnt, pipeliningCnt, completedCnt, failedCnt, timeoutCnt, analyzingCnt, indexingCnt, cleanedCnt, metaSavedCnt, stats int
+	var executingCnt, pipeliningCnt, completedCnt, failedCnt, timeoutCnt, analyzingCnt, indexingCnt, cleanedCnt, metaSavedCnt, stats, canceledCnt int
 	mergeInfos := make(map[int64]*milvuspb.CompactionMergeInfo)
 
 	for _, task := range tasks {
@@ -189,7 +212,11 @@ func summaryCompactionState(tasks []*datapb.CompactionTask) *compactionInfo {
 		case datapb.CompactionTaskState_completed:
 			completedCnt++
 		case datapb.CompactionTaskState_failed:
-			failedCnt++
+			if task.GetFailReason() == compactionCanceledReason {
+				canceledCnt++
+			} else {
+				failedCnt++
+			}
 		case datapb.CompactionTaskState_timeout:
 			timeoutCnt++
 		case datapb.CompactionTaskState_analyzing:
@@ -197,7 +224,12 @@ func summaryCompactionState(tasks []*datapb.CompactionTask) *compactionInfo {
 		case datapb.CompactionTaskState_indexing:
 			indexingCnt++
 		case datapb.CompactionTaskState_cleaned:
-			cleanedCnt++
+			// the canceled tasks are cleaned after failed
+			if task.GetFailReason() == compactionCanceledReason {
+				canceledCnt++
+			} else {
+				cleanedCnt++
+			}
 		case datapb.CompactionTaskState_meta_saved:
 			metaSavedCnt++
 		case datapb.CompactionTaskState_statistic:
@@ -211,6 +243,7 @@ func summaryCompactionState(tasks []*datapb.CompactionTask) *compactionInfo {
 	ret.completedCnt = completedCnt
 	ret.timeoutCnt = timeoutCnt
 	ret.failedCnt = failedCnt
+	ret.canceledCnt = canceledCnt
 	ret.mergeInfos = mergeInfos
 
 	if ret.executingCnt != 0 {
@@ -229,7 +262,8 @@ func summaryCompactionState(tasks []*datapb.CompactionTask) *compactionInfo {
 		zap.Int("analyzingCnt", analyzingCnt),
 		zap.Int("indexingCnt", indexingCnt),
 		zap.Int("cleanedCnt", cleanedCnt),
-		zap.Int("metaSavedCnt", metaSavedCnt))
+		zap.Int("metaSavedCnt", metaSavedCnt),
+		zap.Int("canceledCnt", canceledCnt))
 	return ret
 }
 
@@ -271,8 +305,7 @@ func newCompactionPlanHandler(cluster Cluster, sessions session.DataNodeManager,
 	// Higher capacity will have better ordering in priority, but consumes more memory.
 	// TODO[GOOSE]: Higher capacity makes tasks waiting longer, which need to be get rid of.
 	capacity := paramtable.Get().DataCoordCfg.CompactionTaskQueueCapacity.GetAsInt()
-	return &compactionPlanHandler{
-		queueTasks:     NewCompactionQueue(capacity, getPrioritizer()),
+	c := &compactionPlanHandler{
 		meta:           meta,
 		sessions:       sessions,
 		allocator:      allocator,
@@ -282,6 +315,8 @@ func newCompactionPlanHandler(cluster Cluster, sessions session.DataNodeManager,
 		cleaningTasks:  make(map[int64]CompactionTask),
 		handler:        handler,
 	}
+	c.queueTasks = NewCompactionQueue(capacity, getPrioritizer(c.isManualTask))
+	return c
 }
 
 func (c *compactionPlanHandler) checkSchedule() {
@@ -304,6 +339,8 @@ func (c *compactionPlanHandler) schedule(assigner NodeAssigner) []CompactionTask
 		return selected
 	}
 
+	maxTasksPerCollection := paramtable.Get().DataCoordCfg.CompactionMaxConcurrentTasksPerCollection.GetAsInt()
+	collectionTaskNum := make(map[int64]int)
 	l0ChannelExcludes := typeutil.NewSet[string]()
 	mixChannelExcludes := typeutil.NewSet[string]()
 	clusterChannelExcludes := typeutil.NewSet[string]()
@@ -312,6 +349,7 @@ func (c *compactionPlanHandler) schedule(assigner NodeAssigner) []CompactionTask
 
 	c.executingGuard.RLock()
 	for _, t := range c.executingTasks {
+		collectionTaskNum[t.GetTaskProto().GetCollectionID()]++
 		switch t.GetTaskProto().GetType() {
 		case datapb.CompactionType_Level0DeleteCompaction:
 			l0ChannelExcludes.Insert(t.GetTaskProto().GetChannel())
@@ -333,10 +371,9 @@ func (c *compactionPlanHandler) schedule(assigner NodeAssigner) []CompactionTask
 		}
 	}()
 
-	p := getPrioritizer()
-	if &c.queueTasks.prioritizer != &p {
-		c.queueTasks.UpdatePrioritizer(p)
-	}
+	// refresh the priorities at every schedule, as the prioritizer is refreshable,
+	// and the tasks may be marked manual after they're enqueued.
+	c.queueTasks.UpdatePrioritizer(getPrioritizer(c.isManualTask))
 
 	// The schedule loop will stop if either:
 	// 1. no more task to schedule (the task queue is empty)
@@ -347,6 +384,15 @@ func (c *compactionPlanHandler) schedule(assigner NodeAssigner) []CompactionTask
 			break // 1. no more task to schedule
 		}
 
+		if c.pausedCollections.Contain(t.GetTaskProto().GetCollectionID()) {
+			excluded = append(excluded, t)
+			continue
+		}
+		if maxTasksPerCollection > 0 && collectionTaskNum[t.GetTaskProto().GetCollectionID()] >= maxTasksPerCollection {
+			excluded = append(excluded, t)
+			continue
+		}
+
 		switch t.GetTaskProto().GetType() {
 		case datapb.CompactionType_Level0DeleteCompaction:
 			if mixChannelExcludes.Contain(t.GetTaskProto().GetChannel()) ||
@@ -396,6 +442,7 @@ func (c *compactionPlanHandler) schedule(assigner NodeAssigner) []CompactionTask
 		}
 		c.executingTasks[t.GetTaskProto().GetPlanID()] = t
 		c.executingGuard.Unlock()
+		collectionTaskNum[t.GetTaskProto().GetCollectionID()]++
 		metrics.DataCoordCompactionTaskNum.WithLabelValues(fmt.Sprintf("%d", NullNodeID), t.GetTaskProto().GetType().String(), metrics.Pending).Dec()
 		metrics.DataCoordCompactionTaskNum.WithLabelValues(fmt.Sprintf("%d", t.GetTaskProto().GetNodeID()), t.GetTaskProto().GetType().String(), metrics.Executing).Inc()
 	}
@@ -656,6 +703,10 @@ func (c *compactionPlanHandler) getCompactionTask(planID int64) CompactionTask {
 
 func (c *compactionPlanHandler) enqueueCompaction(task *datapb.CompactionTask) error {
 	log := log.Ctx(context.TODO()).With(zap.Int64("planID", task.GetPlanID()), zap.Int64("triggerID", task.GetTriggerID()), zap.Int64("collectionID", task.GetCollectionID()), zap.String("type", task.GetType().String()))
+	if c.pausedCollections.Contain(task.GetCollectionID()) {
+		log.RatedInfo(60, "Failed to create compaction task, compaction of collection is paused")
+		return errors.Wrapf(errCompactionPaused, "collection %d", task.GetCollectionID())
+	}
 	t, err := c.createCompactTask(task)
 	if err != nil {
 		// Conflict is normal
@@ -748,6 +799,10 @@ func (c *compactionPlanHandler) checkCompaction(assigner NodeAssigner) error {
 	}
 	c.executingGuard.Unlock()
 
+	for _, t := range finishedTasks {
+		c.unmarkManualTriggerIfDone(t.GetTaskProto().GetTriggerID())
+	}
+
 	// insert task need to clean
 	c.cleaningGuard.Lock()
 	for _, t := range finishedTasks {
@@ -832,6 +887,114 @@ func (c *compactionPlanHandler) getCompactionTasksNum(filters ...compactionTaskF
 	return cnt
 }
 
+func (c *compactionPlanHandler) listCompactionTasks(filters ...compactionTaskFilter) []*datapb.CompactionTask {
+	tasks := make([]*datapb.CompactionTask, 0)
+	isMatch := func(task CompactionTask) bool {
+		for _, f := range filters {
+			if !f(task) {
+				return false
+			}
+		}
+		return true
+	}
+	c.queueTasks.ForEach(func(task CompactionTask) {
+		if isMatch(task) {
+			tasks = append(tasks, task.GetTaskProto())
+		}
+	})
+	c.executingGuard.RLock()
+	for _, t := range c.executingTasks {
+		if isMatch(t) {
+			tasks = append(tasks, t.GetTaskProto())
+		}
+	}
+	c.executingGuard.RUnlock()
+	return tasks
+}
+
+// cancelCompaction marks the queued, or the pipelining/executing/analyzing task of the plan failed, and hands it to the
+// cleaning tasks like the timeout ones, where Clean drops the plan on the datanode, which stops the running compactor,
+// and releases the input segments.
+// The tasks in the other states are about to finish, or have already saved the result, so they cannot be canceled.
+func (c *compactionPlanHandler) cancelCompaction(planID int64) error {
+	log := log.Ctx(context.TODO()).With(zap.Int64("planID", planID))
+	var task CompactionTask
+	c.queueTasks.RemoveAll(func(t CompactionTask) bool {
+		if t.GetTaskProto().GetPlanID() == planID {
+			task = t
+			return true
+		}
+		return false
+	})
+	if task != nil {
+		metrics.DataCoordCompactionTaskNum.WithLabelValues(fmt.Sprintf("%d", NullNodeID), task.GetTaskProto().GetType().String(), metrics.Pending).Dec()
+	} else {
+		c.executingGuard.Lock()
+		t, ok := c.executingTasks[planID]
+		if !ok {
+			c.executingGuard.Unlock()
+			return merr.WrapErrParameterInvalidMsg("compaction task of plan %d not found", planID)
+		}
+		state := t.GetTaskProto().GetState()
+		if state != datapb.CompactionTaskState_pipelining && state != datapb.CompactionTaskState_executing &&
+			state != datapb.CompactionTaskState_analyzing {
+			c.executingGuard.Unlock()
+			return merr.WrapErrParameterInvalidMsg("compaction task of plan %d cannot be canceled in state %s", planID, state.String())
+		}
+		delete(c.executingTasks, planID)
+		c.executingGuard.Unlock()
+		metrics.DataCoordCompactionTaskNum.WithLabelValues(fmt.Sprintf("%d", t.GetTaskProto().GetNodeID()), t.GetTaskProto().GetType().String(), metrics.Executing).Dec()
+		task = t
+	}
+	metrics.DataCoordCompactionTaskNum.WithLabelValues(fmt.Sprintf("%d", task.GetTaskProto().GetNodeID()), task.GetTaskProto().GetType().String(), metrics.Done).Inc()
+
+	previousState := task.GetTaskProto().GetState()
+	canceled := task.ShadowClone(setState(datapb.CompactionTaskState_failed),
+		setFailReason(compactionCanceledReason), setEndTime(time.Now().Unix()))
+	if err := c.meta.SaveCompactionTask(context.TODO(), canceled); err != nil {
+		// the task is cleaned anyway, the meta is saved again when the task is cleaned.
+		log.Warn("failed to save canceled compaction task", zap.Error(err))
+	}
+	task.SetTask(canceled)
+
+	c.cleaningGuard.Lock()
+	c.cleaningTasks[planID] = task
+	c.cleaningGuard.Unlock()
+	c.unmarkManualTriggerIfDone(task.GetTaskProto().GetTriggerID())
+	log.Info("compaction task canceled", zap.Int64("collectionID", task.GetTaskProto().GetCollectionID()),
+		zap.String("type", task.GetTaskProto().GetType().String()), zap.String("previousState", previousState.String()))
+	return nil
+}
+
+func (c *compactionPlanHandler) pauseCompaction(collectionID int64) {
+	c.pausedCollections.Insert(collectionID)
+	log.Ctx(context.TODO()).Info("compaction paused", zap.Int64("collectionID", collectionID))
+}
+
+func (c *compactionPlanHandler) resumeCompaction(collectionID int64) {
+	c.pausedCollections.Remove(collectionID)
+	log.Ctx(context.TODO()).Info("compaction resumed", zap.Int64("collectionID", collectionID))
+}
+
+func (c *compactionPlanHandler) getPausedCollections() []int64 {
+	return c.pausedCollections.Collect()
+}
+
+func (c *compactionPlanHandler) markManualTrigger(triggerID int64) {
+	c.manualTriggers.Insert(triggerID)
+}
+
+func (c *compactionPlanHandler) isManualTask(task CompactionTask) bool {
+	return c.manualTriggers.Contain(task.GetTaskProto().GetTriggerID())
+}
+
+// unmarkManualTriggerIfDone forgets the manual trigger once all of its tasks are finished.
+func (c *compactionPlanHandler) unmarkManualTriggerIfDone(triggerID int64) {
+	if c.manualTriggers.Contain(triggerID) && c.getCompactionTasksNumBySignalID(triggerID) == 0 {
+		c.manualTriggers.Remove(triggerID)
+	}
+}
+
 type compactionTaskFilter func(task CompactionTask) bool
 
 func CollectionIDCompactionTaskFilter(collectionID int64) compactionTaskFilter {
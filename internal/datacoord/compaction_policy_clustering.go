@@ -31,6 +31,7 @@ import (
 	"github.com/milvus-io/milvus/pkg/v2/log"
 	"github.com/milvus-io/milvus/pkg/v2/proto/datapb"
 	"github.com/milvus-io/milvus/pkg/v2/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/v2/util/typeutil"
 )
 
 type clusteringCompactionPolicy struct {
@@ -125,7 +126,9 @@ func (policy *clusteringCompactionPolicy) triggerOneCollection(ctx context.Conte
 			!segment.isCompacting && // not compacting now
 			!segment.GetIsImporting() && // not importing now
 			segment.GetLevel() != datapb.SegmentLevel_L0 && // ignore level zero segments
-			!segment.GetIsInvisible()
+			!segment.GetIsInvisible() &&
+			// the analyze task of vector clustering key locates the binlogs by log ids
+			!(typeutil.IsVectorType(clusteringKeyField.GetDataType()) && hasForeignBinlogs(segment))
 	}))
 
 	views := make([]CompactionView, 0)
@@ -0,0 +1,292 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/samber/lo"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus/internal/datacoord/allocator"
+	"github.com/milvus-io/milvus/pkg/v2/log"
+	"github.com/milvus-io/milvus/pkg/v2/metrics"
+	"github.com/milvus-io/milvus/pkg/v2/proto/datapb"
+	"github.com/milvus-io/milvus/pkg/v2/util/tsoutil"
+)
+
+// ttlCompactionPolicy reclaims the expired rows of the collections with ttl.
+// The segments are grouped by the insert time window of their latest rows,
+// the segments whose rows are all expired are dropped directly without rewriting,
+// while the partially expired ones are compacted with the ones in the same window only,
+// so that the rows of the result segments are inserted closely and expire together.
+type ttlCompactionPolicy struct {
+	meta              *meta
+	allocator         allocator.Allocator
+	handler           Handler
+	compactionHandler compactionPlanContext
+}
+
+func newTTLCompactionPolicy(meta *meta, allocator allocator.Allocator, handler Handler, compactionHandler compactionPlanContext) *ttlCompactionPolicy {
+	return &ttlCompactionPolicy{meta: meta, allocator: allocator, handler: handler, compactionHandler: compactionHandler}
+}
+
+func (policy *ttlCompactionPolicy) Enable() bool {
+	return Params.DataCoordCfg.EnableAutoCompaction.GetAsBool() &&
+		Params.DataCoordCfg.TTLCompactionEnable.GetAsBool()
+}
+
+func (policy *ttlCompactionPolicy) Trigger(ctx context.Context) (map[CompactionTriggerType][]CompactionView, error) {
+	collections := policy.meta.GetCollections()
+
+	events := make(map[CompactionTriggerType][]CompactionView, 0)
+	views := make([]CompactionView, 0)
+	for _, collection := range collections {
+		collectionViews, err := policy.triggerOneCollection(ctx, collection.ID, time.Now())
+		if err != nil {
+			// not throw this error because no need to fail because of one collection
+			log.Warn("fail to trigger ttl compaction", zap.Int64("collectionID", collection.ID), zap.Error(err))
+		}
+		views = append(views, collectionViews...)
+	}
+	events[TriggerTypeTTL] = views
+	return events, nil
+}
+
+func (policy *ttlCompactionPolicy) triggerOneCollection(ctx context.Context, collectionID int64, now time.Time) ([]CompactionView, error) {
+	log := log.Ctx(ctx).With(zap.Int64("collectionID", collectionID))
+	collection, err := policy.handler.GetCollection(ctx, collectionID)
+	if err != nil {
+		log.Warn("fail to apply ttlCompactionPolicy, unable to get collection from handler", zap.Error(err))
+		return nil, err
+	}
+	if collection == nil {
+		log.Warn("fail to apply ttlCompactionPolicy, collection not exist")
+		return nil, nil
+	}
+	if !isCollectionAutoCompactionEnabled(collection) {
+		log.RatedInfo(20, "collection auto compaction disabled")
+		return nil, nil
+	}
+	collectionTTL, err := getCollectionTTL(collection.Properties)
+	if err != nil {
+		log.Warn("fail to apply ttlCompactionPolicy, get collection ttl failed", zap.Error(err))
+		return nil, err
+	}
+	if collectionTTL <= 0 {
+		return nil, nil
+	}
+	// the rows must be expired at the earliest time the collection could be read at
+	travelTs, err := getTimeTravelTs(collection.Properties, now)
+	if err != nil {
+		log.Warn("fail to apply ttlCompactionPolicy, get collection time travel failed", zap.Error(err))
+		return nil, err
+	}
+	if travelTs != 0 {
+		now = tsoutil.PhysicalTime(travelTs)
+	}
+	expireTs := tsoutil.ComposeTSByTime(now.Add(-collectionTTL), 0)
+	window := Params.DataCoordCfg.TTLCompactionTimeWindow.GetAsDuration(time.Second)
+	expectedSize := getExpectedSegmentSize(policy.meta, collection)
+
+	partSegments := GetSegmentsChanPart(policy.meta, collectionID, SegmentFilterFunc(func(segment *SegmentInfo) bool {
+		return isSegmentHealthy(segment) &&
+			isFlush(segment) &&
+			!segment.isCompacting && // not compacting now
+			!segment.isStating && // not stating now
+			!segment.GetIsImporting() && // not importing now
+			segment.GetLevel() != datapb.SegmentLevel_L0 &&
+			!segment.GetIsInvisible()
+	}))
+
+	var triggerID int64
+	views := make([]CompactionView, 0)
+	for _, group := range partSegments {
+		expiredSegments := make([]*SegmentInfo, 0)
+		windows := make(map[int64][]*SegmentInfo)
+		for _, segment := range group.segments {
+			maxTs, expiredRows, ok := getSegmentExpiredRows(segment, expireTs)
+			if !ok || expiredRows == 0 {
+				continue
+			}
+			if maxTs < expireTs {
+				expiredSegments = append(expiredSegments, segment)
+				continue
+			}
+			var windowID int64
+			if window > 0 {
+				windowID = tsoutil.PhysicalTime(maxTs).UnixNano() / int64(window)
+			}
+			windows[windowID] = append(windows[windowID], segment)
+		}
+
+		policy.dropExpiredSegments(ctx, collectionID, expiredSegments)
+
+		for windowID, segments := range windows {
+			if Params.DataCoordCfg.IndexBasedCompaction.GetAsBool() {
+				segments = FilterInIndexedSegments(policy.handler, policy.meta, false, segments...)
+			}
+			segments = selectWindowSegments(segments, expireTs, expectedSize)
+			if len(segments) == 0 {
+				continue
+			}
+			if triggerID == 0 {
+				triggerID, err = policy.allocator.AllocID(ctx)
+				if err != nil {
+					log.Warn("fail to apply ttlCompactionPolicy, unable to allocate triggerID", zap.Error(err))
+					return views, err
+				}
+			}
+			segmentViews := GetViewsByInfo(segments...)
+			views = append(views, &MixSegmentView{
+				label:         segmentViews[0].label,
+				segments:      segmentViews,
+				collectionTTL: collectionTTL,
+				triggerID:     triggerID,
+			})
+			log.Info("ttl compaction window triggered", zap.Int64("windowID", windowID),
+				zap.Int64s("segmentIDs", lo.Map(segments, func(segment *SegmentInfo, _ int) int64 { return segment.GetID() })))
+		}
+	}
+
+	if len(views) > 0 {
+		log.Info("succeeded to apply ttlCompactionPolicy",
+			zap.Int64("triggerID", triggerID),
+			zap.Int("triggered view num", len(views)))
+	}
+	return views, nil
+}
+
+// dropExpiredSegments drops the segments whose rows are all expired without rewriting them.
+// The segments are marked compacting while being dropped, so that they're not picked by the other compactions.
+// The drop is gated like the compaction tasks, it's skipped if the compaction of the collection is paused,
+// or the trigger is canceled.
+func (policy *ttlCompactionPolicy) dropExpiredSegments(ctx context.Context, collectionID int64, segments []*SegmentInfo) {
+	if len(segments) == 0 {
+		return
+	}
+	segmentIDs := lo.Map(segments, func(segment *SegmentInfo, _ int) int64 { return segment.GetID() })
+	log := log.Ctx(ctx).With(zap.Int64("collectionID", collectionID), zap.Int64s("segmentIDs", segmentIDs))
+
+	if lo.Contains(policy.compactionHandler.getPausedCollections(), collectionID) {
+		log.RatedInfo(60, "skip dropping expired segments, compaction of collection is paused")
+		return
+	}
+
+	if _, canDo := policy.meta.CheckAndSetSegmentsCompacting(ctx, segmentIDs); !canDo {
+		log.Info("skip dropping expired segments, some of them are compacting")
+		return
+	}
+	defer policy.meta.SetSegmentsCompacting(ctx, segmentIDs, false)
+	if _, hasStating := policy.meta.CheckSegmentsStating(ctx, segmentIDs); hasStating {
+		log.Info("skip dropping expired segments, some of them are stating")
+		return
+	}
+	if err := ctx.Err(); err != nil {
+		log.Info("skip dropping expired segments, the trigger is canceled", zap.Error(err))
+		return
+	}
+
+	operators := lo.Map(segmentIDs, func(segmentID int64, _ int) UpdateOperator {
+		return UpdateStatusOperator(segmentID, commonpb.SegmentState_Dropped)
+	})
+	if err := policy.meta.UpdateSegmentsInfo(ctx, operators...); err != nil {
+		log.Warn("fail to drop expired segments", zap.Error(err))
+		return
+	}
+
+	var rows, size int64
+	for _, segment := range segments {
+		rows += segment.GetNumOfRows()
+		size += getSegmentLogSize(segment)
+	}
+	metrics.DataCoordTTLReclaimedRows.WithLabelValues(fmt.Sprint(collectionID)).Add(float64(rows))
+	metrics.DataCoordTTLReclaimedSize.WithLabelValues(fmt.Sprint(collectionID)).Add(float64(size))
+	log.Info("expired segments dropped", zap.Int64("reclaimedRows", rows), zap.Int64("reclaimedSize", size))
+}
+
+// selectWindowSegments selects the partially expired segments of a time window to compact,
+// the most expired ones first, until their total size reaches the expected segment size.
+// Nil is returned if the expired rows of the selected segments don't reach the single compaction ratio.
+func selectWindowSegments(segments []*SegmentInfo, expireTs uint64, expectedSize int64) []*SegmentInfo {
+	expiredRatio := func(segment *SegmentInfo) float64 {
+		_, expiredRows, _ := getSegmentExpiredRows(segment, expireTs)
+		return float64(expiredRows) / float64(segment.GetNumOfRows())
+	}
+	sort.Slice(segments, func(i, j int) bool {
+		return expiredRatio(segments[i]) > expiredRatio(segments[j])
+	})
+
+	var totalRows, expiredRows, totalSize int64
+	selected := make([]*SegmentInfo, 0, len(segments))
+	for _, segment := range segments {
+		if len(selected) > 0 && totalSize+segment.getSegmentSize() > expectedSize {
+			break
+		}
+		_, rows, _ := getSegmentExpiredRows(segment, expireTs)
+		selected = append(selected, segment)
+		totalRows += segment.GetNumOfRows()
+		expiredRows += rows
+		totalSize += segment.getSegmentSize()
+	}
+	if totalRows == 0 || float64(expiredRows)/float64(totalRows) < Params.DataCoordCfg.SingleCompactionRatioThreshold.GetAsFloat() {
+		return nil
+	}
+	return selected
+}
+
+// getSegmentExpiredRows returns the max insert timestamp of the binlogs of all fields and the expired rows of the segment.
+// The rows of a binlog are expired if its max timestamp is, and the expired rows are the least ones counted by the fields,
+// since the binlogs of the fields may not be written in the same batches.
+// False is returned if the timestamps are unknown, e.g. the binlogs written by the legacy versions.
+func getSegmentExpiredRows(segment *SegmentInfo, expireTs uint64) (maxTs uint64, expiredRows int64, ok bool) {
+	if len(segment.GetBinlogs()) == 0 || segment.GetNumOfRows() == 0 {
+		return 0, 0, false
+	}
+	for i, fieldBinlog := range segment.GetBinlogs() {
+		var fieldExpiredRows int64
+		for _, binlog := range fieldBinlog.GetBinlogs() {
+			if binlog.GetTimestampTo() == 0 {
+				return 0, 0, false
+			}
+			maxTs = max(maxTs, binlog.GetTimestampTo())
+			if binlog.GetTimestampTo() < expireTs {
+				fieldExpiredRows += binlog.GetEntriesNum()
+			}
+		}
+		if i == 0 || fieldExpiredRows < expiredRows {
+			expiredRows = fieldExpiredRows
+		}
+	}
+	return maxTs, expiredRows, maxTs != 0
+}
+
+func getSegmentLogSize(segment *SegmentInfo) int64 {
+	var size int64
+	for _, fieldBinlogs := range [][]*datapb.FieldBinlog{segment.GetBinlogs(), segment.GetStatslogs(), segment.GetDeltalogs()} {
+		for _, fieldBinlog := range fieldBinlogs {
+			for _, binlog := range fieldBinlog.GetBinlogs() {
+				size += binlog.GetLogSize()
+			}
+		}
+	}
+	return size
+}
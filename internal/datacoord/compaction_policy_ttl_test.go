@@ -0,0 +1,227 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus/internal/metastore"
+	"github.com/milvus-io/milvus/internal/metastore/mocks"
+	"github.com/milvus-io/milvus/internal/metastore/model"
+	"github.com/milvus-io/milvus/pkg/v2/common"
+	"github.com/milvus-io/milvus/pkg/v2/proto/datapb"
+	"github.com/milvus-io/milvus/pkg/v2/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/v2/util/tsoutil"
+)
+
+const (
+	ttlTestCollectionID = 100
+	ttlTestPartitionID  = 200
+)
+
+func TestTTLCompactionPolicySuite(t *testing.T) {
+	suite.Run(t, new(TTLCompactionPolicySuite))
+}
+
+type TTLCompactionPolicySuite struct {
+	suite.Suite
+
+	now               time.Time
+	catalog           *mocks.DataCoordCatalog
+	handler           *NMockHandler
+	compactionHandler *MockCompactionPlanContext
+	meta              *meta
+
+	ttlPolicy *ttlCompactionPolicy
+}
+
+func (s *TTLCompactionPolicySuite) SetupTest() {
+	paramtable.Get().Save(paramtable.Get().DataCoordCfg.IndexBasedCompaction.Key, "false")
+	s.now = time.Date(2024, 1, 1, 12, 30, 0, 0, time.UTC)
+	s.catalog = mocks.NewDataCoordCatalog(s.T())
+	s.meta = &meta{
+		catalog:  s.catalog,
+		segments: NewSegmentsInfo(),
+		indexMeta: &indexMeta{
+			segmentIndexes: map[UniqueID]map[UniqueID]*model.SegmentIndex{},
+			indexes:        map[UniqueID]map[UniqueID]*model.Index{},
+		},
+	}
+	s.handler = NewNMockHandler(s.T())
+	s.handler.EXPECT().GetCollection(mock.Anything, mock.Anything).Return(&collectionInfo{
+		ID:         ttlTestCollectionID,
+		Schema:     newTestSchema(),
+		Properties: map[string]string{common.CollectionTTLConfigKey: "3600"},
+	}, nil).Maybe()
+	s.compactionHandler = NewMockCompactionPlanContext(s.T())
+	s.compactionHandler.EXPECT().getPausedCollections().Return(nil).Maybe()
+	s.ttlPolicy = newTTLCompactionPolicy(s.meta, newMockAllocator(s.T()), s.handler, s.compactionHandler)
+}
+
+func (s *TTLCompactionPolicySuite) TearDownTest() {
+	paramtable.Get().Reset(paramtable.Get().DataCoordCfg.IndexBasedCompaction.Key)
+}
+
+// addSegment adds a flushed segment with one binlog of rows for each of the insert time.
+func (s *TTLCompactionPolicySuite) addSegment(id int64, rows int64, insertTimes ...time.Time) {
+	binlogs := lo.Map(insertTimes, func(t time.Time, _ int) *datapb.Binlog {
+		var ts uint64
+		if !t.IsZero() {
+			ts = tsoutil.ComposeTSByTime(t, 0)
+		}
+		return &datapb.Binlog{EntriesNum: rows, TimestampFrom: ts, TimestampTo: ts, LogSize: 1024}
+	})
+	s.meta.segments.SetSegment(id, &SegmentInfo{
+		SegmentInfo: &datapb.SegmentInfo{
+			ID:            id,
+			CollectionID:  ttlTestCollectionID,
+			PartitionID:   ttlTestPartitionID,
+			InsertChannel: "ch-1",
+			Level:         datapb.SegmentLevel_L1,
+			State:         commonpb.SegmentState_Flushed,
+			NumOfRows:     rows * int64(len(insertTimes)),
+			Binlogs:       []*datapb.FieldBinlog{{FieldID: 100, Binlogs: binlogs}},
+		},
+	})
+}
+
+func (s *TTLCompactionPolicySuite) TestTriggerOneCollection() {
+	// all rows are expired
+	s.addSegment(1, 100, s.now.Add(-3*time.Hour), s.now.Add(-2*time.Hour))
+	// partially expired in the window of 12:00
+	s.addSegment(2, 500, s.now.Add(-2*time.Hour), s.now.Add(-10*time.Minute))
+	s.addSegment(3, 100, s.now.Add(-2*time.Hour), s.now.Add(-6*time.Minute), s.now.Add(-5*time.Minute))
+	// partially expired in the window of 11:00
+	s.addSegment(4, 100, s.now.Add(-3*time.Hour), s.now.Add(-40*time.Minute))
+	// not expired
+	s.addSegment(5, 100, s.now.Add(-20*time.Minute))
+	// the timestamps are unknown
+	s.addSegment(6, 100, time.Time{})
+
+	s.catalog.EXPECT().AlterSegments(mock.Anything, mock.Anything).RunAndReturn(
+		func(ctx context.Context, segments []*datapb.SegmentInfo, _ ...metastore.BinlogsIncrement) error {
+			s.Len(segments, 1)
+			s.EqualValues(1, segments[0].GetID())
+			s.Equal(commonpb.SegmentState_Dropped, segments[0].GetState())
+			return nil
+		}).Once()
+
+	views, err := s.ttlPolicy.triggerOneCollection(context.TODO(), ttlTestCollectionID, s.now)
+	s.NoError(err)
+	s.Equal(commonpb.SegmentState_Dropped, s.meta.GetSegment(context.TODO(), 1).GetState())
+	s.False(s.meta.GetSegment(context.TODO(), 1).isCompacting)
+
+	s.Len(views, 2)
+	windowSegments := lo.Map(views, func(view CompactionView, _ int) []int64 {
+		return lo.Map(view.GetSegmentsView(), func(segment *SegmentView, _ int) int64 { return segment.ID })
+	})
+	s.ElementsMatch([][]int64{{2, 3}, {4}}, windowSegments)
+	s.Equal(views[0].(*MixSegmentView).triggerID, views[1].(*MixSegmentView).triggerID)
+}
+
+func (s *TTLCompactionPolicySuite) TestSkipCompactingSegments() {
+	s.addSegment(1, 100, s.now.Add(-3*time.Hour))
+	s.meta.segments.SetIsCompacting(1, true)
+
+	views, err := s.ttlPolicy.triggerOneCollection(context.TODO(), ttlTestCollectionID, s.now)
+	s.NoError(err)
+	s.Empty(views)
+	s.Equal(commonpb.SegmentState_Flushed, s.meta.GetSegment(context.TODO(), 1).GetState())
+}
+
+func (s *TTLCompactionPolicySuite) TestSkipPausedCollection() {
+	s.compactionHandler.ExpectedCalls = nil
+	s.compactionHandler.EXPECT().getPausedCollections().Return([]int64{ttlTestCollectionID})
+	s.addSegment(1, 100, s.now.Add(-3*time.Hour))
+
+	_, err := s.ttlPolicy.triggerOneCollection(context.TODO(), ttlTestCollectionID, s.now)
+	s.NoError(err)
+	s.Equal(commonpb.SegmentState_Flushed, s.meta.GetSegment(context.TODO(), 1).GetState())
+	s.False(s.meta.GetSegment(context.TODO(), 1).isCompacting)
+}
+
+func (s *TTLCompactionPolicySuite) TestSkipCanceledTrigger() {
+	s.addSegment(1, 100, s.now.Add(-3*time.Hour))
+
+	ctx, cancel := context.WithCancel(context.TODO())
+	cancel()
+	_, err := s.ttlPolicy.triggerOneCollection(ctx, ttlTestCollectionID, s.now)
+	s.NoError(err)
+	s.Equal(commonpb.SegmentState_Flushed, s.meta.GetSegment(context.TODO(), 1).GetState())
+	s.False(s.meta.GetSegment(context.TODO(), 1).isCompacting)
+}
+
+func (s *TTLCompactionPolicySuite) TestExpiredRowsOfAllFields() {
+	s.addSegment(1, 100, s.now.Add(-3*time.Hour), s.now.Add(-2*time.Hour))
+	// the binlog of another field is written later than the ones of the first field
+	segment := s.meta.GetSegment(context.TODO(), 1).Clone()
+	segment.Binlogs = append(segment.Binlogs, &datapb.FieldBinlog{FieldID: 101, Binlogs: []*datapb.Binlog{
+		{EntriesNum: 100, TimestampFrom: tsoutil.ComposeTSByTime(s.now.Add(-3*time.Hour), 0), TimestampTo: tsoutil.ComposeTSByTime(s.now.Add(-3*time.Hour), 0)},
+		{EntriesNum: 100, TimestampFrom: tsoutil.ComposeTSByTime(s.now.Add(-10*time.Minute), 0), TimestampTo: tsoutil.ComposeTSByTime(s.now.Add(-10*time.Minute), 0)},
+	}})
+	s.meta.segments.SetSegment(1, segment)
+
+	maxTs, expiredRows, ok := getSegmentExpiredRows(segment, tsoutil.ComposeTSByTime(s.now.Add(-time.Hour), 0))
+	s.True(ok)
+	s.Equal(tsoutil.ComposeTSByTime(s.now.Add(-10*time.Minute), 0), maxTs)
+	s.EqualValues(100, expiredRows)
+
+	// the segment is partially expired, so it's compacted instead of being dropped.
+	views, err := s.ttlPolicy.triggerOneCollection(context.TODO(), ttlTestCollectionID, s.now)
+	s.NoError(err)
+	s.Equal(commonpb.SegmentState_Flushed, s.meta.GetSegment(context.TODO(), 1).GetState())
+	s.Len(views, 1)
+}
+
+func (s *TTLCompactionPolicySuite) TestTimeTravel() {
+	s.handler.ExpectedCalls = nil
+	s.handler.EXPECT().GetCollection(mock.Anything, mock.Anything).Return(&collectionInfo{
+		ID:     ttlTestCollectionID,
+		Schema: newTestSchema(),
+		Properties: map[string]string{
+			common.CollectionTTLConfigKey:  "3600",
+			common.CollectionTimeTravelKey: "7200",
+		},
+	}, nil)
+	// the rows are expired now, but not at the earliest time travel time
+	s.addSegment(1, 100, s.now.Add(-2*time.Hour))
+
+	views, err := s.ttlPolicy.triggerOneCollection(context.TODO(), ttlTestCollectionID, s.now)
+	s.NoError(err)
+	s.Empty(views)
+	s.Equal(commonpb.SegmentState_Flushed, s.meta.GetSegment(context.TODO(), 1).GetState())
+}
+
+func (s *TTLCompactionPolicySuite) TestNoTTL() {
+	s.handler.ExpectedCalls = nil
+	s.handler.EXPECT().GetCollection(mock.Anything, mock.Anything).Return(&collectionInfo{
+		ID:     ttlTestCollectionID,
+		Schema: newTestSchema(),
+	}, nil)
+	s.addSegment(1, 100, s.now.Add(-3*time.Hour))
+
+	views, err := s.ttlPolicy.triggerOneCollection(context.TODO(), ttlTestCollectionID, s.now)
+	s.NoError(err)
+	s.Empty(views)
+}
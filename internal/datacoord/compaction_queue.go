@@ -121,9 +121,9 @@ func (q *CompactionQueue) Dequeue() (CompactionTask, error) {
 }
 
 func (q *CompactionQueue) UpdatePrioritizer(prioritizer Prioritizer) {
-	q.prioritizer = prioritizer
 	q.lock.Lock()
 	defer q.lock.Unlock()
+	q.prioritizer = prioritizer
 	for i := range q.pq {
 		q.pq[i].priority = q.prioritizer(q.pq[i].value)
 	}
@@ -187,13 +187,57 @@ var (
 	}
 )
 
-func getPrioritizer() Prioritizer {
+const (
+	manualCompactionClass     = "manual"
+	l0CompactionClass         = "l0"
+	mixCompactionClass        = "mix"
+	clusteringCompactionClass = "clustering"
+)
+
+// getCompactionTaskClass returns the class of the task by its compaction type.
+func getCompactionTaskClass(task CompactionTask) string {
+	switch task.GetTaskProto().GetType() {
+	case datapb.CompactionType_Level0DeleteCompaction:
+		return l0CompactionClass
+	case datapb.CompactionType_MixCompaction:
+		return mixCompactionClass
+	case datapb.CompactionType_ClusteringCompaction:
+		return clusteringCompactionClass
+	default:
+		return task.GetTaskProto().GetType().String()
+	}
+}
+
+// NewClassPrioritizer prioritizes the tasks by the order of their classes in the classes list.
+// The manually triggered tasks are of the manual class if it's listed, otherwise of the class by their type.
+// The tasks of the unlisted classes are scheduled at last.
+func NewClassPrioritizer(classes []string, isManual func(task CompactionTask) bool) Prioritizer {
+	ranks := make(map[string]int, len(classes))
+	for i, class := range classes {
+		if _, ok := ranks[class]; !ok {
+			ranks[class] = i
+		}
+	}
+	return func(task CompactionTask) int {
+		if rank, ok := ranks[manualCompactionClass]; ok && isManual(task) {
+			return rank
+		}
+		if rank, ok := ranks[getCompactionTaskClass(task)]; ok {
+			return rank
+		}
+		return len(classes)
+	}
+}
+
+func getPrioritizer(isManual func(task CompactionTask) bool) Prioritizer {
 	p := Params.DataCoordCfg.CompactionTaskPrioritizer.GetValue()
 	switch p {
 	case "level":
 		return LevelPrioritizer
 	case "mix":
 		return MixFirstPrioritizer
+	case "class":
+		return NewClassPrioritizer(Params.DataCoordCfg.CompactionTaskPriorityClasses.GetAsStrings(), isManual)
 	default:
 		return DefaultPrioritizer
 	}
@@ -110,6 +110,42 @@ func TestCompactionQueue(t *testing.T) {
 		assert.Equal(t, datapb.CompactionType_ClusteringCompaction, task.GetTaskProto().GetType())
 	})
 
+	t.Run("class prioritizer", func(t *testing.T) {
+		isManual := func(task CompactionTask) bool {
+			return task.GetTaskProto().GetPlanID() == 2
+		}
+		cq := NewCompactionQueue(3, NewClassPrioritizer([]string{"manual", "l0", "mix", "clustering"}, isManual))
+		assert.NoError(t, cq.Enqueue(t1))
+		assert.NoError(t, cq.Enqueue(t2))
+		assert.NoError(t, cq.Enqueue(t3))
+
+		// the manual clustering task goes first
+		task, err := cq.Dequeue()
+		assert.NoError(t, err)
+		assert.Equal(t, int64(2), task.GetTaskProto().GetPlanID())
+		task, err = cq.Dequeue()
+		assert.NoError(t, err)
+		assert.Equal(t, datapb.CompactionType_Level0DeleteCompaction, task.GetTaskProto().GetType())
+		task, err = cq.Dequeue()
+		assert.NoError(t, err)
+		assert.Equal(t, datapb.CompactionType_MixCompaction, task.GetTaskProto().GetType())
+
+		// the manual class and the clustering class are not listed
+		cq = NewCompactionQueue(3, NewClassPrioritizer([]string{"mix", "l0"}, isManual))
+		assert.NoError(t, cq.Enqueue(t3))
+		assert.NoError(t, cq.Enqueue(t2))
+		assert.NoError(t, cq.Enqueue(t1))
+		task, err = cq.Dequeue()
+		assert.NoError(t, err)
+		assert.Equal(t, datapb.CompactionType_MixCompaction, task.GetTaskProto().GetType())
+		task, err = cq.Dequeue()
+		assert.NoError(t, err)
+		assert.Equal(t, datapb.CompactionType_Level0DeleteCompaction, task.GetTaskProto().GetType())
+		task, err = cq.Dequeue()
+		assert.NoError(t, err)
+		assert.Equal(t, datapb.CompactionType_ClusteringCompaction, task.GetTaskProto().GetType())
+	})
+
 	t.Run("update prioritizer", func(t *testing.T) {
 		cq := NewCompactionQueue(3, LevelPrioritizer)
 		err := cq.Enqueue(t1)
@@ -38,6 +38,7 @@ import (
 	"github.com/milvus-io/milvus/pkg/v2/metrics"
 	"github.com/milvus-io/milvus/pkg/v2/proto/datapb"
 	"github.com/milvus-io/milvus/pkg/v2/proto/indexpb"
+	"github.com/milvus-io/milvus/pkg/v2/util/funcutil"
 	"github.com/milvus-io/milvus/pkg/v2/util/merr"
 	"github.com/milvus-io/milvus/pkg/v2/util/metautil"
 	"github.com/milvus-io/milvus/pkg/v2/util/paramtable"
@@ -191,12 +192,18 @@ func (t *clusteringCompactionTask) BuildCompactionRequest() (*datapb.CompactionP
 		return nil, err
 	}
 	taskProto := t.taskProto.Load().(*datapb.CompactionTask)
+	// the deletes in the time travel window are kept by compaction
+	timetravel, err := getTimeTravelTs(funcutil.KeyValuePair2Map(taskProto.GetSchema().GetProperties()), time.Now())
+	if err != nil {
+		return nil, err
+	}
 	plan := &datapb.CompactionPlan{
 		PlanID:                 taskProto.GetPlanID(),
 		StartTime:              taskProto.GetStartTime(),
 		TimeoutInSeconds:       taskProto.GetTimeoutInSeconds(),
 		Type:                   taskProto.GetType(),
 		Channel:                taskProto.GetChannel(),
+		Timetravel:             timetravel,
 		CollectionTtl:          taskProto.GetCollectionTtl(),
 		TotalRows:              taskProto.GetTotalRows(),
 		Schema:                 taskProto.GetSchema(),
@@ -16,6 +16,7 @@ import (
 	"github.com/milvus-io/milvus/pkg/v2/log"
 	"github.com/milvus-io/milvus/pkg/v2/metrics"
 	"github.com/milvus-io/milvus/pkg/v2/proto/datapb"
+	"github.com/milvus-io/milvus/pkg/v2/util/funcutil"
 	"github.com/milvus-io/milvus/pkg/v2/util/merr"
 	"github.com/milvus-io/milvus/pkg/v2/util/paramtable"
 )
@@ -312,12 +313,19 @@ func (t *mixCompactionTask) BuildCompactionRequest() (*datapb.CompactionPlan, er
 		return nil, err
 	}
 	taskProto := t.taskProto.Load().(*datapb.CompactionTask)
+	// the deletes in the time travel window are kept by compaction
+	timetravel, err := getTimeTravelTs(funcutil.KeyValuePair2Map(taskProto.GetSchema().GetProperties()), time.Now())
+	if err != nil {
+		log.Warn("failed to get collection time travel", zap.Error(err))
+		return nil, err
+	}
 	plan := &datapb.CompactionPlan{
 		PlanID:                 taskProto.GetPlanID(),
 		StartTime:              taskProto.GetStartTime(),
 		TimeoutInSeconds:       taskProto.GetTimeoutInSeconds(),
 		Type:                   taskProto.GetType(),
 		Channel:                taskProto.GetChannel(),
+		Timetravel:             timetravel,
 		CollectionTtl:          taskProto.GetCollectionTtl(),
 		TotalRows:              taskProto.GetTotalRows(),
 		Schema:                 taskProto.GetSchema(),
@@ -33,6 +33,7 @@ import (
 	"github.com/milvus-io/milvus/internal/metastore/kv/binlog"
 	"github.com/milvus-io/milvus/internal/metastore/kv/datacoord"
 	"github.com/milvus-io/milvus/pkg/v2/proto/datapb"
+	"github.com/milvus-io/milvus/pkg/v2/util/merr"
 	"github.com/milvus-io/milvus/pkg/v2/util/metautil"
 	"github.com/milvus-io/milvus/pkg/v2/util/paramtable"
 	"github.com/milvus-io/milvus/pkg/v2/util/typeutil"
@@ -581,6 +582,138 @@ func (s *CompactionPlanHandlerSuite) TestRemoveTasksByChannel() {
 	s.handler.removeTasksByChannel(ch)
 }
 
+func (s *CompactionPlanHandlerSuite) TestCancelCompaction() {
+	s.SetupTest()
+
+	queued := newMixCompactionTask(&datapb.CompactionTask{
+		PlanID:  1,
+		Type:    datapb.CompactionType_MixCompaction,
+		Channel: "ch-1",
+		State:   datapb.CompactionTaskState_pipelining,
+		NodeID:  NullNodeID,
+	}, nil, s.mockMeta, s.mockSessMgr)
+	executing := newMixCompactionTask(&datapb.CompactionTask{
+		PlanID:  2,
+		Type:    datapb.CompactionType_MixCompaction,
+		Channel: "ch-1",
+		State:   datapb.CompactionTaskState_executing,
+		NodeID:  1,
+	}, nil, s.mockMeta, s.mockSessMgr)
+	metaSaved := newMixCompactionTask(&datapb.CompactionTask{
+		PlanID:  3,
+		Type:    datapb.CompactionType_MixCompaction,
+		Channel: "ch-2",
+		State:   datapb.CompactionTaskState_meta_saved,
+		NodeID:  1,
+	}, nil, s.mockMeta, s.mockSessMgr)
+	s.Require().NoError(s.handler.submitTask(queued))
+	s.handler.restoreTask(executing)
+	s.handler.restoreTask(metaSaved)
+	s.Len(s.handler.listCompactionTasks(), 3)
+
+	s.NoError(s.handler.cancelCompaction(1))
+	s.NoError(s.handler.cancelCompaction(2))
+	s.Equal(0, s.handler.queueTasks.Len())
+	s.Len(s.handler.executingTasks, 1)
+	s.Len(s.handler.cleaningTasks, 2)
+	for _, planID := range []int64{1, 2} {
+		task := s.handler.cleaningTasks[planID]
+		s.Equal(datapb.CompactionTaskState_failed, task.GetTaskProto().GetState())
+		s.Equal("canceled by user", task.GetTaskProto().GetFailReason())
+	}
+
+	// the task which has saved the result cannot be canceled
+	s.ErrorIs(s.handler.cancelCompaction(3), merr.ErrParameterInvalid)
+	s.ErrorIs(s.handler.cancelCompaction(4), merr.ErrParameterInvalid)
+	s.Len(s.handler.listCompactionTasks(CollectionIDCompactionTaskFilter(0)), 1)
+}
+
+func (s *CompactionPlanHandlerSuite) TestPauseCompaction() {
+	s.SetupTest()
+	s.cluster.EXPECT().QuerySlots().Return(map[int64]int64{1: 100}).Maybe()
+
+	task := newMixCompactionTask(&datapb.CompactionTask{
+		PlanID:       1,
+		CollectionID: 100,
+		Type:         datapb.CompactionType_MixCompaction,
+		Channel:      "ch-1",
+		State:        datapb.CompactionTaskState_pipelining,
+		NodeID:       NullNodeID,
+	}, nil, s.mockMeta, s.mockSessMgr)
+	s.Require().NoError(s.handler.submitTask(task))
+
+	s.handler.pauseCompaction(100)
+	s.ElementsMatch([]int64{100}, s.handler.getPausedCollections())
+	err := s.handler.enqueueCompaction(&datapb.CompactionTask{
+		PlanID:       2,
+		CollectionID: 100,
+		Type:         datapb.CompactionType_MixCompaction,
+	})
+	s.ErrorIs(err, errCompactionPaused)
+	// the queued task of the paused collection is not scheduled
+	s.Empty(s.handler.schedule(newSlotBasedNodeAssigner(s.cluster)))
+	s.Equal(1, s.handler.queueTasks.Len())
+
+	s.handler.resumeCompaction(100)
+	s.Empty(s.handler.getPausedCollections())
+	s.Len(s.handler.schedule(newSlotBasedNodeAssigner(s.cluster)), 1)
+}
+
+func (s *CompactionPlanHandlerSuite) TestMaxConcurrentTasksPerCollection() {
+	s.SetupTest()
+	s.cluster.EXPECT().QuerySlots().Return(map[int64]int64{1: 100}).Maybe()
+	paramtable.Get().Save(Params.DataCoordCfg.CompactionMaxConcurrentTasksPerCollection.Key, "1")
+	defer paramtable.Get().Reset(Params.DataCoordCfg.CompactionMaxConcurrentTasksPerCollection.Key)
+
+	for _, t := range []*datapb.CompactionTask{
+		{PlanID: 1, CollectionID: 100, Channel: "ch-1"},
+		{PlanID: 2, CollectionID: 100, Channel: "ch-2"},
+		{PlanID: 3, CollectionID: 200, Channel: "ch-3"},
+	} {
+		t.Type = datapb.CompactionType_MixCompaction
+		t.State = datapb.CompactionTaskState_pipelining
+		t.NodeID = NullNodeID
+		s.Require().NoError(s.handler.submitTask(newMixCompactionTask(t, nil, s.mockMeta, s.mockSessMgr)))
+	}
+
+	selected := s.handler.schedule(newSlotBasedNodeAssigner(s.cluster))
+	s.Len(selected, 2)
+	s.ElementsMatch([]int64{100, 200}, lo.Map(selected, func(t CompactionTask, _ int) int64 {
+		return t.GetTaskProto().GetCollectionID()
+	}))
+	s.Equal(1, s.handler.queueTasks.Len())
+	// the executing task of collection 100 still occupies its quota
+	s.Empty(s.handler.schedule(newSlotBasedNodeAssigner(s.cluster)))
+	s.Equal(1, s.handler.queueTasks.Len())
+}
+
+func (s *CompactionPlanHandlerSuite) TestManualTriggerPriority() {
+	s.SetupTest()
+	paramtable.Get().Save(Params.DataCoordCfg.CompactionTaskPrioritizer.Key, "class")
+	defer paramtable.Get().Reset(Params.DataCoordCfg.CompactionTaskPrioritizer.Key)
+
+	l0Task := newL0CompactionTask(&datapb.CompactionTask{
+		PlanID:    1,
+		TriggerID: 10,
+		Type:      datapb.CompactionType_Level0DeleteCompaction,
+	}, nil, s.mockMeta, s.mockSessMgr)
+	clusteringTask := newClusteringCompactionTask(&datapb.CompactionTask{
+		PlanID:    2,
+		TriggerID: 20,
+		Type:      datapb.CompactionType_ClusteringCompaction,
+	}, nil, s.mockMeta, nil, nil, nil)
+
+	p := getPrioritizer(s.handler.isManualTask)
+	s.Less(p(l0Task), p(clusteringTask))
+
+	s.handler.markManualTrigger(20)
+	s.Less(p(clusteringTask), p(l0Task))
+
+	// the trigger is forgotten once it has no task
+	s.handler.unmarkManualTriggerIfDone(20)
+	s.False(s.handler.isManualTask(clusteringTask))
+}
+
 func (s *CompactionPlanHandlerSuite) TestGetCompactionTask() {
 	s.SetupTest()
 
@@ -72,6 +72,24 @@ var _ compactionPlanContext = (*spyCompactionHandler)(nil)
 
 func (h *spyCompactionHandler) removeTasksByChannel(channel string) {}
 
+func (h *spyCompactionHandler) listCompactionTasks(filters ...compactionTaskFilter) []*datapb.CompactionTask {
+	return nil
+}
+
+func (h *spyCompactionHandler) cancelCompaction(planID int64) error {
+	return nil
+}
+
+func (h *spyCompactionHandler) pauseCompaction(collectionID int64) {}
+
+func (h *spyCompactionHandler) resumeCompaction(collectionID int64) {}
+
+func (h *spyCompactionHandler) getPausedCollections() []int64 {
+	return nil
+}
+
+func (h *spyCompactionHandler) markManualTrigger(triggerID int64) {}
+
 // enqueueCompaction start to execute plan and return immediately
 func (h *spyCompactionHandler) enqueueCompaction(task *datapb.CompactionTask) error {
 	t := newMixCompactionTask(task, nil, h.meta, nil)
@@ -39,6 +39,7 @@ const (
 	TriggerTypeSegmentSizeViewChange
 	TriggerTypeClustering
 	TriggerTypeSingle
+	TriggerTypeTTL
 )
 
 func (t CompactionTriggerType) String() string {
@@ -53,6 +54,8 @@ func (t CompactionTriggerType) String() string {
 		return "Clustering"
 	case TriggerTypeSingle:
 		return "Single"
+	case TriggerTypeTTL:
+		return "TTL"
 	default:
 		return ""
 	}
@@ -89,6 +92,7 @@ type CompactionTriggerManager struct {
 	l0Policy         *l0CompactionPolicy
 	clusteringPolicy *clusteringCompactionPolicy
 	singlePolicy     *singleCompactionPolicy
+	ttlPolicy        *ttlCompactionPolicy
 
 	cancel  context.CancelFunc
 	closeWg sync.WaitGroup
@@ -117,6 +121,7 @@ func NewCompactionTriggerManager(alloc allocator.Allocator, handler Handler, com
 	m.l0Policy = newL0CompactionPolicy(meta)
 	m.clusteringPolicy = newClusteringCompactionPolicy(meta, m.allocator, m.handler)
 	m.singlePolicy = newSingleCompactionPolicy(meta, m.allocator, m.handler)
+	m.ttlPolicy = newTTLCompactionPolicy(meta, m.allocator, m.handler, m.compactionHandler)
 	return m
 }
 
@@ -211,6 +216,8 @@ func (m *CompactionTriggerManager) loop(ctx context.Context) {
 	defer clusteringTicker.Stop()
 	singleTicker := time.NewTicker(Params.DataCoordCfg.MixCompactionTriggerInterval.GetAsDuration(time.Second))
 	defer singleTicker.Stop()
+	ttlTicker := time.NewTicker(Params.DataCoordCfg.TTLCompactionTriggerInterval.GetAsDuration(time.Second))
+	defer ttlTicker.Stop()
 	log.Info("Compaction trigger manager start")
 	for {
 		select {
@@ -274,6 +281,24 @@ func (m *CompactionTriggerManager) loop(ctx context.Context) {
 					m.notify(ctx, triggerType, views)
 				}
 			}
+		case <-ttlTicker.C:
+			if !m.ttlPolicy.Enable() {
+				continue
+			}
+			if m.compactionHandler.isFull() {
+				log.RatedInfo(10, "Skip trigger ttl compaction since compactionHandler is full")
+				continue
+			}
+			events, err := m.ttlPolicy.Trigger(ctx)
+			if err != nil {
+				log.Warn("Fail to trigger ttl policy", zap.Error(err))
+				continue
+			}
+			if len(events) > 0 {
+				for triggerType, views := range events {
+					m.notify(ctx, triggerType, views)
+				}
+			}
 		}
 	}
 }
@@ -315,7 +340,7 @@ func (m *CompactionTriggerManager) notify(ctx context.Context, eventType Compact
 				m.SubmitL0ViewToScheduler(ctx, outView)
 			case TriggerTypeClustering:
 				m.SubmitClusteringViewToScheduler(ctx, outView)
-			case TriggerTypeSingle:
+			case TriggerTypeSingle, TriggerTypeTTL:
 				m.SubmitSingleViewToScheduler(ctx, outView)
 			}
 		}
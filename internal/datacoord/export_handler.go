@@ -0,0 +1,265 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"path"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/samber/lo"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	management "github.com/milvus-io/milvus/internal/http"
+	"github.com/milvus-io/milvus/internal/json"
+	"github.com/milvus-io/milvus/internal/util/exportutil"
+	"github.com/milvus-io/milvus/pkg/v2/log"
+	"github.com/milvus-io/milvus/pkg/v2/proto/datapb"
+	"github.com/milvus-io/milvus/pkg/v2/util/merr"
+)
+
+// exportDir is the directory under the storage root path where the exported files are written,
+// each job writes to its own <exportDir>/<jobID> sub directory.
+const exportDir = "export"
+
+// this file contains the export management restful API handler
+var exportRouteRegisterOnce sync.Once
+
+func registerExportRoute(s *Server) {
+	exportRouteRegisterOnce.Do(func() {
+		management.Register(&management.Handler{
+			Path:        management.RouteCreateExportJob,
+			HandlerFunc: s.CreateExportJob,
+		})
+		management.Register(&management.Handler{
+			Path:        management.RouteGetExportProgress,
+			HandlerFunc: s.GetExportProgress,
+		})
+		management.Register(&management.Handler{
+			Path:        management.RouteListExportJobs,
+			HandlerFunc: s.ListExportJobs,
+		})
+	})
+}
+
+// CreateExportJob creates a job to export the data of a collection to Parquet files, params:
+//   - collection_id: required
+//   - partition_ids: optional, comma separated, all the partitions are exported if not set
+//   - output_fields: optional, comma separated, all the fields except the function outputs are exported if not set
+//   - filter: optional, an expression on the scalar fields
+//   - ts: optional, the snapshot timestamp, the collection is flushed and the flush ts is used if not set
+func (s *Server) CreateExportJob(w http.ResponseWriter, req *http.Request) {
+	if err := merr.CheckHealthy(s.GetStateCode()); err != nil {
+		w.WriteHeader(http.StatusServiceUnavailable)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to create export job, %s"}`, err.Error())))
+		return
+	}
+	err := req.ParseForm()
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to create export job, %s"}`, err.Error())))
+		return
+	}
+	job, err := s.newExportJob(req.Context(), req)
+	if err != nil {
+		status := http.StatusInternalServerError
+		if errors.Is(err, merr.ErrParameterInvalid) || errors.Is(err, merr.ErrCollectionNotFound) ||
+			errors.Is(err, merr.ErrPartitionNotFound) || errors.Is(err, merr.ErrFieldNotFound) {
+			status = http.StatusBadRequest
+		}
+		w.WriteHeader(status)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to create export job, %s"}`, err.Error())))
+		return
+	}
+	if err = s.exportMeta.AddJob(req.Context(), job); err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to create export job, %s"}`, err.Error())))
+		return
+	}
+	log.Info("export job created", zap.Int64("jobID", job.GetJobID()), zap.Int64("collectionID", job.GetCollectionID()),
+		zap.Int64s("partitionIDs", job.GetPartitionIDs()), zap.Strings("outputFields", job.GetOutputFields()),
+		zap.String("filter", job.GetFilter()), zap.Uint64("ts", job.GetTs()))
+
+	w.WriteHeader(http.StatusOK)
+	w.Write([]byte(fmt.Sprintf(`{"msg": "OK", "job_id": "%d", "output_path": "%s"}`, job.GetJobID(), job.GetOutputPath())))
+}
+
+func (s *Server) newExportJob(ctx context.Context, req *http.Request) (*ExportJob, error) {
+	collectionID, err := strconv.ParseInt(req.FormValue("collection_id"), 10, 64)
+	if err != nil {
+		return nil, merr.WrapErrParameterInvalidMsg("invalid collection_id '%s'", req.FormValue("collection_id"))
+	}
+	var partitionIDs []int64
+	for _, str := range splitFormList(req.FormValue("partition_ids")) {
+		partitionID, err := strconv.ParseInt(str, 10, 64)
+		if err != nil {
+			return nil, merr.WrapErrParameterInvalidMsg("invalid partition id '%s'", str)
+		}
+		partitionIDs = append(partitionIDs, partitionID)
+	}
+	var ts uint64
+	if str := req.FormValue("ts"); str != "" {
+		ts, err = strconv.ParseUint(str, 10, 64)
+		if err != nil {
+			return nil, merr.WrapErrParameterInvalidMsg("invalid ts '%s'", str)
+		}
+	}
+	outputFields := splitFormList(req.FormValue("output_fields"))
+	filter := strings.TrimSpace(req.FormValue("filter"))
+
+	coll, err := s.handler.GetCollection(ctx, collectionID)
+	if err != nil {
+		return nil, err
+	}
+	if coll == nil {
+		return nil, merr.WrapErrCollectionNotFound(collectionID)
+	}
+	for _, partitionID := range partitionIDs {
+		if !lo.Contains(coll.Partitions, partitionID) {
+			return nil, merr.WrapErrPartitionNotFound(partitionID)
+		}
+	}
+	fields, _, err := exportutil.GetFields(coll.Schema, outputFields, filter)
+	if err != nil {
+		return nil, err
+	}
+	if filter != "" {
+		if _, err = exportutil.NewRowFilter(coll.Schema, filter); err != nil {
+			return nil, err
+		}
+	}
+
+	// flush the collection so that the rows before the snapshot ts are persisted eventually,
+	// the scheduler starts the job once the channel checkpoints pass the snapshot ts.
+	resp, err := s.Flush(ctx, &datapb.FlushRequest{
+		DbID:         coll.DatabaseID,
+		CollectionID: collectionID,
+	})
+	if err = merr.CheckRPCCall(resp, err); err != nil {
+		return nil, err
+	}
+	if ts == 0 {
+		ts = resp.GetFlushTs()
+	} else if ts > resp.GetFlushTs() {
+		return nil, merr.WrapErrParameterInvalidMsg("ts %d is later than the flush ts %d", ts, resp.GetFlushTs())
+	}
+
+	jobID, err := s.allocator.AllocID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	job := &ExportJob{ExportJob: &datapb.ExportJob{
+		JobID:          jobID,
+		DbID:           coll.DatabaseID,
+		CollectionID:   collectionID,
+		CollectionName: coll.Schema.GetName(),
+		PartitionIDs:   partitionIDs,
+		Vchannels:      coll.VChannelNames,
+		Schema:         coll.Schema,
+		OutputFields: lo.Map(fields, func(field *schemapb.FieldSchema, _ int) string {
+			return field.GetName()
+		}),
+		Filter:     filter,
+		Ts:         ts,
+		OutputPath: path.Join(s.meta.chunkManager.RootPath(), exportDir, strconv.FormatInt(jobID, 10)),
+		State:      datapb.ImportTaskStateV2_Pending,
+		StartTime:  time.Now().Format("2006-01-02T15:04:05Z07:00"),
+	}}
+	// the check is done again when the job starts, since the segments may be compacted in the meantime
+	segments := lo.Map(s.meta.SelectSegments(ctx, exportSegmentFilters(job)...), func(segment *SegmentInfo, _ int) *datapb.SegmentInfo {
+		return segment.SegmentInfo
+	})
+	if err = checkExportTs(s.meta, coll.Properties, ts, segments, time.Now()); err != nil {
+		return nil, err
+	}
+	return job, nil
+}
+
+// GetExportProgress returns the export job of the job_id param.
+func (s *Server) GetExportProgress(w http.ResponseWriter, req *http.Request) {
+	err := req.ParseForm()
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to get export progress, %s"}`, err.Error())))
+		return
+	}
+	jobID, err := strconv.ParseInt(req.FormValue("job_id"), 10, 64)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to get export progress, %s"}`, err.Error())))
+		return
+	}
+	job := s.exportMeta.GetJob(req.Context(), jobID)
+	if job == nil {
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to get export progress, export job %d not found"}`, jobID)))
+		return
+	}
+	bytes, err := json.Marshal(newExportJobView(job, s.exportMeta.GetTaskBy(req.Context(), WithExportJob(jobID))))
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to get export progress, %s"}`, err.Error())))
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+	w.Write(bytes)
+}
+
+// ListExportJobs returns the export jobs, of the collection_id param if set.
+func (s *Server) ListExportJobs(w http.ResponseWriter, req *http.Request) {
+	err := req.ParseForm()
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to list export jobs, %s"}`, err.Error())))
+		return
+	}
+	filters := make([]ExportJobFilter, 0)
+	if str := req.FormValue("collection_id"); str != "" {
+		collectionID, err := strconv.ParseInt(str, 10, 64)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(fmt.Sprintf(`{"msg": "failed to list export jobs, %s"}`, err.Error())))
+			return
+		}
+		filters = append(filters, WithExportCollectionID(collectionID))
+	}
+	views := lo.Map(s.exportMeta.GetJobBy(req.Context(), filters...), func(job *ExportJob, _ int) *exportJobView {
+		return newExportJobView(job, s.exportMeta.GetTaskBy(req.Context(), WithExportJob(job.GetJobID())))
+	})
+	bytes, err := json.Marshal(views)
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to list export jobs, %s"}`, err.Error())))
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+	w.Write(bytes)
+}
+
+func splitFormList(value string) []string {
+	return lo.FilterMap(strings.Split(value, ","), func(str string, _ int) (string, bool) {
+		str = strings.TrimSpace(str)
+		return str, str != ""
+	})
+}
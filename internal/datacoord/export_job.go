@@ -0,0 +1,201 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"time"
+
+	"github.com/samber/lo"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/milvus-io/milvus/pkg/v2/log"
+	"github.com/milvus-io/milvus/pkg/v2/proto/datapb"
+	"github.com/milvus-io/milvus/pkg/v2/util/tsoutil"
+)
+
+// ExportJob dumps the data of a collection, or some of its partitions, visible at the snapshot ts
+// to Parquet files in the object storage. A job is split into a task per channel which is executed
+// by a datanode, the jobs and tasks are persisted so that they survive the datacoord restarts.
+//
+// The job is pending until the data before the snapshot ts is flushed, then the segments to export are
+// assigned to the tasks, and pinned against the garbage collection until the job completes or fails.
+type ExportJob struct {
+	*datapb.ExportJob
+}
+
+func (j *ExportJob) Clone() *ExportJob {
+	return &ExportJob{
+		ExportJob: proto.Clone(j.ExportJob).(*datapb.ExportJob),
+	}
+}
+
+type ExportJobFilter func(job *ExportJob) bool
+
+func WithExportCollectionID(collectionID int64) ExportJobFilter {
+	return func(job *ExportJob) bool {
+		return job.GetCollectionID() == collectionID
+	}
+}
+
+func WithExportJobStates(states ...datapb.ImportTaskStateV2) ExportJobFilter {
+	return func(job *ExportJob) bool {
+		return lo.Contains(states, job.GetState())
+	}
+}
+
+type UpdateExportJobAction func(job *ExportJob)
+
+func UpdateExportJobState(state datapb.ImportTaskStateV2) UpdateExportJobAction {
+	return func(job *ExportJob) {
+		job.State = state
+		if state == datapb.ImportTaskStateV2_Completed || state == datapb.ImportTaskStateV2_Failed {
+			job.CompleteTime = time.Now().Format("2006-01-02T15:04:05Z07:00")
+			// set cleanup ts
+			dur := Params.DataCoordCfg.ExportJobRetention.GetAsDuration(time.Second)
+			cleanupTime := time.Now().Add(dur)
+			job.CleanupTs = tsoutil.ComposeTSByTime(cleanupTime, 0)
+			log.Info("set export job cleanup ts", zap.Int64("jobID", job.GetJobID()),
+				zap.Time("cleanupTime", cleanupTime), zap.Uint64("cleanupTs", job.CleanupTs))
+		}
+	}
+}
+
+func UpdateExportJobReason(reason string) UpdateExportJobAction {
+	return func(job *ExportJob) {
+		job.Reason = reason
+	}
+}
+
+// ExportTask exports the segments of a channel of the job, the dropped segments are kept in the meta while pinned.
+type ExportTask struct {
+	*datapb.ExportTask
+}
+
+func (t *ExportTask) Clone() *ExportTask {
+	return &ExportTask{
+		ExportTask: proto.Clone(t.ExportTask).(*datapb.ExportTask),
+	}
+}
+
+type ExportTaskFilter func(task *ExportTask) bool
+
+func WithExportJob(jobID int64) ExportTaskFilter {
+	return func(task *ExportTask) bool {
+		return task.GetJobID() == jobID
+	}
+}
+
+func WithExportTaskStates(states ...datapb.ImportTaskStateV2) ExportTaskFilter {
+	return func(task *ExportTask) bool {
+		return lo.Contains(states, task.GetState())
+	}
+}
+
+type UpdateExportTaskAction func(task *ExportTask)
+
+func UpdateExportTaskState(state datapb.ImportTaskStateV2) UpdateExportTaskAction {
+	return func(task *ExportTask) {
+		task.State = state
+		if state == datapb.ImportTaskStateV2_Completed || state == datapb.ImportTaskStateV2_Failed {
+			task.CompleteTime = time.Now().Format("2006-01-02T15:04:05Z07:00")
+		}
+	}
+}
+
+func UpdateExportTaskReason(reason string) UpdateExportTaskAction {
+	return func(task *ExportTask) {
+		task.Reason = reason
+	}
+}
+
+func UpdateExportTaskNodeID(nodeID int64) UpdateExportTaskAction {
+	return func(task *ExportTask) {
+		task.NodeID = nodeID
+	}
+}
+
+// UpdateExportTaskProgress sets the progress reported by the datanode, the files are those
+// written by the current execution of the task.
+func UpdateExportTaskProgress(scannedRows, exportedRows int64, files []string) UpdateExportTaskAction {
+	return func(task *ExportTask) {
+		task.ScannedRows = scannedRows
+		task.ExportedRows = exportedRows
+		task.Files = files
+	}
+}
+
+// exportJobView is the export job returned by the management API, with the progress of its tasks.
+type exportJobView struct {
+	JobID          int64    `json:"job_id,string"`
+	CollectionID   int64    `json:"collection_id,string"`
+	CollectionName string   `json:"collection_name"`
+	PartitionIDs   []int64  `json:"partition_ids,omitempty"`
+	OutputFields   []string `json:"output_fields"`
+	Filter         string   `json:"filter,omitempty"`
+	// Ts is the snapshot timestamp, the rows inserted after it are skipped and the deletes after it are ignored.
+	Ts         uint64 `json:"ts,string"`
+	OutputPath string `json:"output_path"`
+
+	State  string `json:"state"`
+	Reason string `json:"reason,omitempty"`
+	// Progress is the percentage of the scanned rows among the rows of the segments to export.
+	Progress       int64    `json:"progress"`
+	TotalTasks     int      `json:"total_tasks"`
+	CompletedTasks int      `json:"completed_tasks"`
+	TotalRows      int64    `json:"total_rows"`
+	ScannedRows    int64    `json:"scanned_rows"`
+	ExportedRows   int64    `json:"exported_rows"`
+	Files          []string `json:"files,omitempty"`
+	StartTime      string   `json:"start_time"`
+	CompleteTime   string   `json:"complete_time,omitempty"`
+}
+
+func newExportJobView(job *ExportJob, tasks []*ExportTask) *exportJobView {
+	view := &exportJobView{
+		JobID:          job.GetJobID(),
+		CollectionID:   job.GetCollectionID(),
+		CollectionName: job.GetCollectionName(),
+		PartitionIDs:   job.GetPartitionIDs(),
+		OutputFields:   job.GetOutputFields(),
+		Filter:         job.GetFilter(),
+		Ts:             job.GetTs(),
+		OutputPath:     job.GetOutputPath(),
+		State:          job.GetState().String(),
+		Reason:         job.GetReason(),
+		TotalTasks:     len(tasks),
+		StartTime:      job.GetStartTime(),
+		CompleteTime:   job.GetCompleteTime(),
+	}
+	for _, task := range tasks {
+		if task.GetState() == datapb.ImportTaskStateV2_Completed {
+			view.CompletedTasks++
+		}
+		view.TotalRows += task.GetTotalRows()
+		view.ScannedRows += task.GetScannedRows()
+		view.ExportedRows += task.GetExportedRows()
+		view.Files = append(view.Files, task.GetFiles()...)
+	}
+	switch {
+	case job.GetState() == datapb.ImportTaskStateV2_Completed:
+		view.Progress = 100
+	case view.TotalRows > 0:
+		// the rows count of the segments is an estimation, keep 100 for the completed state
+		view.Progress = min(view.ScannedRows*100/view.TotalRows, 99)
+	}
+	return view
+}
@@ -0,0 +1,221 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"context"
+	"sort"
+
+	"github.com/samber/lo"
+
+	"github.com/milvus-io/milvus/internal/metastore"
+	"github.com/milvus-io/milvus/pkg/v2/proto/datapb"
+	"github.com/milvus-io/milvus/pkg/v2/util/lock"
+)
+
+type ExportMeta interface {
+	AddJob(ctx context.Context, job *ExportJob) error
+	UpdateJob(ctx context.Context, jobID int64, actions ...UpdateExportJobAction) error
+	GetJob(ctx context.Context, jobID int64) *ExportJob
+	GetJobBy(ctx context.Context, filters ...ExportJobFilter) []*ExportJob
+	RemoveJob(ctx context.Context, jobID int64) error
+
+	AddTask(ctx context.Context, task *ExportTask) error
+	UpdateTask(ctx context.Context, taskID int64, actions ...UpdateExportTaskAction) error
+	GetTask(ctx context.Context, taskID int64) *ExportTask
+	GetTaskBy(ctx context.Context, filters ...ExportTaskFilter) []*ExportTask
+	RemoveTask(ctx context.Context, taskID int64) error
+
+	IsSegmentPinned(segmentID int64) bool
+}
+
+// exportMeta keeps the export jobs and tasks, which are saved by the catalog before they are
+// changed in memory. The jobs and tasks returned are read-only snapshots.
+type exportMeta struct {
+	mu      lock.RWMutex // guards jobs and tasks
+	jobs    map[int64]*ExportJob
+	tasks   map[int64]*ExportTask
+	catalog metastore.DataCoordCatalog
+}
+
+func NewExportMeta(ctx context.Context, catalog metastore.DataCoordCatalog) (ExportMeta, error) {
+	restoredJobs, err := catalog.ListExportJobs(ctx)
+	if err != nil {
+		return nil, err
+	}
+	restoredTasks, err := catalog.ListExportTasks(ctx)
+	if err != nil {
+		return nil, err
+	}
+	jobs := make(map[int64]*ExportJob)
+	for _, job := range restoredJobs {
+		jobs[job.GetJobID()] = &ExportJob{ExportJob: job}
+	}
+	tasks := make(map[int64]*ExportTask)
+	for _, task := range restoredTasks {
+		tasks[task.GetTaskID()] = &ExportTask{ExportTask: task}
+	}
+	return &exportMeta{
+		jobs:    jobs,
+		tasks:   tasks,
+		catalog: catalog,
+	}, nil
+}
+
+func (m *exportMeta) AddJob(ctx context.Context, job *ExportJob) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if err := m.catalog.SaveExportJob(ctx, job.ExportJob); err != nil {
+		return err
+	}
+	m.jobs[job.GetJobID()] = job
+	return nil
+}
+
+func (m *exportMeta) UpdateJob(ctx context.Context, jobID int64, actions ...UpdateExportJobAction) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if job, ok := m.jobs[jobID]; ok {
+		updatedJob := job.Clone()
+		for _, action := range actions {
+			action(updatedJob)
+		}
+		if err := m.catalog.SaveExportJob(ctx, updatedJob.ExportJob); err != nil {
+			return err
+		}
+		m.jobs[jobID] = updatedJob
+	}
+	return nil
+}
+
+func (m *exportMeta) GetJob(ctx context.Context, jobID int64) *ExportJob {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.jobs[jobID]
+}
+
+// GetJobBy returns the jobs matching all the filters, ordered by job id.
+func (m *exportMeta) GetJobBy(ctx context.Context, filters ...ExportJobFilter) []*ExportJob {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	ret := make([]*ExportJob, 0)
+OUTER:
+	for _, job := range m.jobs {
+		for _, f := range filters {
+			if !f(job) {
+				continue OUTER
+			}
+		}
+		ret = append(ret, job)
+	}
+	sort.Slice(ret, func(i, j int) bool {
+		return ret[i].GetJobID() < ret[j].GetJobID()
+	})
+	return ret
+}
+
+func (m *exportMeta) RemoveJob(ctx context.Context, jobID int64) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if _, ok := m.jobs[jobID]; ok {
+		if err := m.catalog.DropExportJob(ctx, jobID); err != nil {
+			return err
+		}
+		delete(m.jobs, jobID)
+	}
+	return nil
+}
+
+func (m *exportMeta) AddTask(ctx context.Context, task *ExportTask) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if err := m.catalog.SaveExportTask(ctx, task.ExportTask); err != nil {
+		return err
+	}
+	m.tasks[task.GetTaskID()] = task
+	return nil
+}
+
+func (m *exportMeta) UpdateTask(ctx context.Context, taskID int64, actions ...UpdateExportTaskAction) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if task, ok := m.tasks[taskID]; ok {
+		updatedTask := task.Clone()
+		for _, action := range actions {
+			action(updatedTask)
+		}
+		if err := m.catalog.SaveExportTask(ctx, updatedTask.ExportTask); err != nil {
+			return err
+		}
+		m.tasks[taskID] = updatedTask
+	}
+	return nil
+}
+
+func (m *exportMeta) GetTask(ctx context.Context, taskID int64) *ExportTask {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.tasks[taskID]
+}
+
+// GetTaskBy returns the tasks matching all the filters, ordered by task id.
+func (m *exportMeta) GetTaskBy(ctx context.Context, filters ...ExportTaskFilter) []*ExportTask {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	ret := make([]*ExportTask, 0)
+OUTER:
+	for _, task := range m.tasks {
+		for _, f := range filters {
+			if !f(task) {
+				continue OUTER
+			}
+		}
+		ret = append(ret, task)
+	}
+	sort.Slice(ret, func(i, j int) bool {
+		return ret[i].GetTaskID() < ret[j].GetTaskID()
+	})
+	return ret
+}
+
+func (m *exportMeta) RemoveTask(ctx context.Context, taskID int64) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if _, ok := m.tasks[taskID]; ok {
+		if err := m.catalog.DropExportTask(ctx, taskID); err != nil {
+			return err
+		}
+		delete(m.tasks, taskID)
+	}
+	return nil
+}
+
+// IsSegmentPinned checks whether the segment is to be exported by a task of a pending or running job.
+func (m *exportMeta) IsSegmentPinned(segmentID int64) bool {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	for _, task := range m.tasks {
+		job, ok := m.jobs[task.GetJobID()]
+		if !ok || (job.GetState() != datapb.ImportTaskStateV2_Pending && job.GetState() != datapb.ImportTaskStateV2_InProgress) {
+			continue
+		}
+		if lo.Contains(task.GetSegmentIDs(), segmentID) || lo.Contains(task.GetL0SegmentIDs(), segmentID) {
+			return true
+		}
+	}
+	return false
+}
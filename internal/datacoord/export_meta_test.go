@@ -0,0 +1,146 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/json"
+	"github.com/milvus-io/milvus/internal/metastore/kv/datacoord"
+	"github.com/milvus-io/milvus/pkg/v2/proto/datapb"
+)
+
+func TestExportMeta_Normal(t *testing.T) {
+	ctx := context.TODO()
+	catalog := datacoord.NewCatalog(NewMetaMemoryKV(), "", "")
+	em, err := NewExportMeta(ctx, catalog)
+	assert.NoError(t, err)
+	newJob := func(jobID, collectionID int64) *ExportJob {
+		return &ExportJob{ExportJob: &datapb.ExportJob{
+			JobID: jobID, CollectionID: collectionID, State: datapb.ImportTaskStateV2_Pending,
+		}}
+	}
+	assert.NoError(t, em.AddJob(ctx, newJob(2, 100)))
+	assert.NoError(t, em.AddJob(ctx, newJob(1, 100)))
+	assert.NoError(t, em.AddJob(ctx, newJob(3, 200)))
+
+	jobs := em.GetJobBy(ctx)
+	assert.Equal(t, 3, len(jobs))
+	assert.Equal(t, int64(1), jobs[0].GetJobID())
+	assert.Equal(t, int64(3), jobs[2].GetJobID())
+	assert.Equal(t, 2, len(em.GetJobBy(ctx, WithExportCollectionID(100))))
+
+	// update on a snapshot, the job returned before is not changed
+	job := em.GetJob(ctx, 1)
+	assert.NoError(t, em.UpdateJob(ctx, 1, UpdateExportJobState(datapb.ImportTaskStateV2_InProgress)))
+	assert.Equal(t, datapb.ImportTaskStateV2_Pending, job.GetState())
+	assert.Equal(t, datapb.ImportTaskStateV2_InProgress, em.GetJob(ctx, 1).GetState())
+	assert.Equal(t, 1, len(em.GetJobBy(ctx, WithExportJobStates(datapb.ImportTaskStateV2_InProgress))))
+
+	assert.NoError(t, em.UpdateJob(ctx, 2, UpdateExportJobState(datapb.ImportTaskStateV2_Failed), UpdateExportJobReason("mock reason")))
+	job = em.GetJob(ctx, 2)
+	assert.Equal(t, datapb.ImportTaskStateV2_Failed, job.GetState())
+	assert.Equal(t, "mock reason", job.GetReason())
+	assert.NotEqual(t, "", job.GetCompleteTime())
+	assert.NotZero(t, job.GetCleanupTs())
+
+	// update a missing job
+	assert.NoError(t, em.UpdateJob(ctx, 4, UpdateExportJobState(datapb.ImportTaskStateV2_Failed)))
+	assert.Nil(t, em.GetJob(ctx, 4))
+
+	newTask := func(jobID, taskID int64) *ExportTask {
+		return &ExportTask{ExportTask: &datapb.ExportTask{
+			JobID: jobID, TaskID: taskID, NodeID: NullNodeID, State: datapb.ImportTaskStateV2_Pending,
+			SegmentIDs: []int64{taskID * 10}, L0SegmentIDs: []int64{taskID*10 + 1},
+		}}
+	}
+	assert.NoError(t, em.AddTask(ctx, newTask(1, 11)))
+	assert.NoError(t, em.AddTask(ctx, newTask(1, 10)))
+	assert.NoError(t, em.AddTask(ctx, newTask(3, 30)))
+	tasks := em.GetTaskBy(ctx, WithExportJob(1))
+	assert.Equal(t, 2, len(tasks))
+	assert.Equal(t, int64(10), tasks[0].GetTaskID())
+
+	// the segments of the running jobs are pinned
+	assert.True(t, em.IsSegmentPinned(100))
+	assert.True(t, em.IsSegmentPinned(101))
+	assert.True(t, em.IsSegmentPinned(300))
+	assert.False(t, em.IsSegmentPinned(102))
+	assert.NoError(t, em.AddTask(ctx, newTask(2, 20)))
+	assert.False(t, em.IsSegmentPinned(200))
+
+	assert.NoError(t, em.UpdateTask(ctx, 10, UpdateExportTaskState(datapb.ImportTaskStateV2_InProgress), UpdateExportTaskNodeID(5),
+		UpdateExportTaskProgress(100, 40, []string{"a/10_0.parquet"})))
+	task := em.GetTask(ctx, 10)
+	assert.Equal(t, datapb.ImportTaskStateV2_InProgress, task.GetState())
+	assert.Equal(t, int64(5), task.GetNodeID())
+	assert.Equal(t, int64(40), task.GetExportedRows())
+	assert.Equal(t, 1, len(em.GetTaskBy(ctx, WithExportTaskStates(datapb.ImportTaskStateV2_InProgress))))
+
+	// the jobs and tasks are restored from the catalog
+	restored, err := NewExportMeta(ctx, catalog)
+	assert.NoError(t, err)
+	assert.Equal(t, 3, len(restored.GetJobBy(ctx)))
+	assert.Equal(t, datapb.ImportTaskStateV2_InProgress, restored.GetJob(ctx, 1).GetState())
+	assert.Equal(t, 4, len(restored.GetTaskBy(ctx)))
+	assert.Equal(t, []string{"a/10_0.parquet"}, restored.GetTask(ctx, 10).GetFiles())
+
+	assert.NoError(t, em.RemoveTask(ctx, 10))
+	assert.Nil(t, em.GetTask(ctx, 10))
+	assert.NoError(t, em.RemoveJob(ctx, 1))
+	assert.Nil(t, em.GetJob(ctx, 1))
+	assert.Equal(t, 2, len(em.GetJobBy(ctx)))
+	restored, err = NewExportMeta(ctx, catalog)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(restored.GetJobBy(ctx)))
+	assert.Equal(t, 3, len(restored.GetTaskBy(ctx)))
+}
+
+func TestExportJobView(t *testing.T) {
+	job := &ExportJob{ExportJob: &datapb.ExportJob{
+		JobID: 1, CollectionID: 2, Ts: 3, State: datapb.ImportTaskStateV2_InProgress,
+	}}
+	tasks := []*ExportTask{
+		{ExportTask: &datapb.ExportTask{
+			TaskID: 10, State: datapb.ImportTaskStateV2_Completed, TotalRows: 100, ScannedRows: 100, ExportedRows: 60,
+			Files: []string{"a/10_0.parquet"},
+		}},
+		{ExportTask: &datapb.ExportTask{
+			TaskID: 11, State: datapb.ImportTaskStateV2_InProgress, TotalRows: 100, ScannedRows: 50, ExportedRows: 20,
+		}},
+	}
+	view := newExportJobView(job, tasks)
+	assert.Equal(t, int64(75), view.Progress)
+	assert.Equal(t, 2, view.TotalTasks)
+	assert.Equal(t, 1, view.CompletedTasks)
+	assert.Equal(t, int64(80), view.ExportedRows)
+	assert.Equal(t, []string{"a/10_0.parquet"}, view.Files)
+
+	job.State = datapb.ImportTaskStateV2_Completed
+	bytes, err := json.Marshal(newExportJobView(job, tasks))
+	assert.NoError(t, err)
+	res := make(map[string]any)
+	assert.NoError(t, json.Unmarshal(bytes, &res))
+	assert.Equal(t, "1", res["job_id"])
+	assert.Equal(t, "2", res["collection_id"])
+	assert.Equal(t, "3", res["ts"])
+	assert.Equal(t, "Completed", res["state"])
+	assert.Equal(t, float64(100), res["progress"])
+}
@@ -0,0 +1,474 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/samber/lo"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/milvus-io/milvus/internal/datacoord/allocator"
+	"github.com/milvus-io/milvus/internal/metastore/kv/binlog"
+	"github.com/milvus-io/milvus/pkg/v2/common"
+	"github.com/milvus-io/milvus/pkg/v2/log"
+	"github.com/milvus-io/milvus/pkg/v2/proto/datapb"
+	"github.com/milvus-io/milvus/pkg/v2/util/merr"
+	"github.com/milvus-io/milvus/pkg/v2/util/tsoutil"
+	"github.com/milvus-io/milvus/pkg/v2/util/typeutil"
+)
+
+type ExportScheduler interface {
+	Start()
+	Close()
+}
+
+// exportScheduler drives the export jobs. A pending job starts once the checkpoints of all the
+// collection's channels pass the snapshot ts, i.e. all the data before the snapshot ts has been
+// persisted, then the segments selected at that moment are assigned to a task for each channel,
+// which are pinned against the garbage collection until the job finishes. The tasks are dispatched to the datanodes like the import tasks.
+type exportScheduler struct {
+	meta    *meta
+	cluster Cluster
+	alloc   allocator.Allocator
+	emeta   ExportMeta
+
+	closeOnce sync.Once
+	closeChan chan struct{}
+}
+
+func NewExportScheduler(meta *meta,
+	cluster Cluster,
+	alloc allocator.Allocator,
+	emeta ExportMeta,
+) ExportScheduler {
+	return &exportScheduler{
+		meta:      meta,
+		cluster:   cluster,
+		alloc:     alloc,
+		emeta:     emeta,
+		closeChan: make(chan struct{}),
+	}
+}
+
+func (s *exportScheduler) Start() {
+	log.Info("start export scheduler")
+	ticker := time.NewTicker(Params.DataCoordCfg.ExportScheduleInterval.GetAsDuration(time.Second))
+	defer ticker.Stop()
+	for {
+		select {
+		case <-s.closeChan:
+			log.Info("export scheduler exited")
+			return
+		case <-ticker.C:
+			s.process()
+		}
+	}
+}
+
+func (s *exportScheduler) Close() {
+	s.closeOnce.Do(func() {
+		close(s.closeChan)
+	})
+}
+
+func (s *exportScheduler) process() {
+	ctx := context.TODO()
+	running := len(s.emeta.GetJobBy(ctx, WithExportJobStates(datapb.ImportTaskStateV2_InProgress)))
+	nodeTasks := s.countNodeTasks()
+	for _, job := range s.emeta.GetJobBy(ctx) {
+		switch job.GetState() {
+		case datapb.ImportTaskStateV2_Pending:
+			if running >= Params.DataCoordCfg.MaxConcurrentExportJobs.GetAsInt() || !s.isFlushed(job) {
+				continue
+			}
+			if s.startJob(job) {
+				running++
+			}
+		case datapb.ImportTaskStateV2_InProgress:
+			s.processInProgressJob(job, nodeTasks)
+		case datapb.ImportTaskStateV2_Completed, datapb.ImportTaskStateV2_Failed:
+			s.processFinishedJob(job)
+		}
+	}
+}
+
+// countNodeTasks returns the number of the export tasks running on each datanode.
+func (s *exportScheduler) countNodeTasks() map[int64]int {
+	nodeTasks := make(map[int64]int)
+	for _, sess := range s.cluster.GetSessions() {
+		nodeTasks[sess.NodeID()] = 0
+	}
+	for _, task := range s.emeta.GetTaskBy(context.TODO(), WithExportTaskStates(datapb.ImportTaskStateV2_InProgress)) {
+		if _, ok := nodeTasks[task.GetNodeID()]; ok {
+			nodeTasks[task.GetNodeID()]++
+		}
+	}
+	return nodeTasks
+}
+
+// isFlushed checks whether the data before the snapshot ts has been persisted in all the channels.
+func (s *exportScheduler) isFlushed(job *ExportJob) bool {
+	for _, vchannel := range job.GetVchannels() {
+		cp := s.meta.GetChannelCheckpoint(vchannel)
+		if cp == nil || cp.GetTimestamp() < job.GetTs() {
+			return false
+		}
+	}
+	return true
+}
+
+// startJob selects the segments to export and creates a task for each channel, it returns whether the job is started.
+// The tasks left by a previous attempt are replaced.
+func (s *exportScheduler) startJob(job *ExportJob) bool {
+	ctx := context.TODO()
+	log := log.With(zap.Int64("jobID", job.GetJobID()), zap.Int64("collectionID", job.GetCollectionID()))
+	segments := lo.Map(s.meta.SelectSegments(ctx, exportSegmentFilters(job)...), func(segment *SegmentInfo, _ int) *datapb.SegmentInfo {
+		return segment.SegmentInfo
+	})
+	var properties map[string]string
+	if coll := s.meta.GetCollection(job.GetCollectionID()); coll != nil {
+		properties = coll.Properties
+	}
+	err := checkExportTs(s.meta, properties, job.GetTs(), segments, time.Now())
+	if err != nil {
+		log.Warn("export job failed", zap.Error(err))
+		s.failJob(job, err.Error())
+		return false
+	}
+
+	// remove the tasks created by a previous attempt
+	for _, task := range s.emeta.GetTaskBy(ctx, WithExportJob(job.GetJobID())) {
+		if err = s.emeta.RemoveTask(ctx, task.GetTaskID()); err != nil {
+			log.Warn("failed to remove stale export task", zap.Int64("taskID", task.GetTaskID()), zap.Error(err))
+			return false
+		}
+	}
+	tasks := newExportTasks(job, segments)
+	for _, task := range tasks {
+		task.TaskID, err = s.alloc.AllocID(ctx)
+		if err != nil {
+			log.Warn("failed to alloc export task id", zap.Error(err))
+			return false
+		}
+		if err = s.emeta.AddTask(ctx, task); err != nil {
+			log.Warn("failed to add export task", zap.Error(err))
+			return false
+		}
+	}
+	if err = s.emeta.UpdateJob(ctx, job.GetJobID(), UpdateExportJobState(datapb.ImportTaskStateV2_InProgress)); err != nil {
+		log.Warn("failed to update export job state", zap.Error(err))
+		return false
+	}
+	log.Info("export job started", zap.Uint64("ts", job.GetTs()), zap.Int("tasks", len(tasks)),
+		zap.Int("segments", len(segments)))
+	return true
+}
+
+// exportSegmentFilters selects the segments holding the rows of the job and the L0 segments holding the deletes.
+// The segments started after the snapshot ts are skipped.
+func exportSegmentFilters(job *ExportJob) []SegmentFilter {
+	partitions := typeutil.NewSet(job.GetPartitionIDs()...)
+	return []SegmentFilter{WithCollection(job.GetCollectionID()), SegmentFilterFunc(func(segment *SegmentInfo) bool {
+		if !isSegmentHealthy(segment) || segment.GetIsImporting() {
+			return false
+		}
+		if segment.GetStartPosition() != nil && segment.GetStartPosition().GetTimestamp() > job.GetTs() {
+			return false
+		}
+		if segment.GetLevel() != datapb.SegmentLevel_L0 && len(segment.GetBinlogs()) == 0 {
+			return false
+		}
+		if partitions.Len() == 0 || partitions.Contain(segment.GetPartitionID()) {
+			return true
+		}
+		return segment.GetLevel() == datapb.SegmentLevel_L0 && segment.GetPartitionID() == common.AllPartitionsID
+	})}
+}
+
+// checkExportTs checks the rows deleted at the snapshot ts are still kept by the segments. A segment created
+// by compaction has lost the rows deleted before the time travel ts at the compaction start, or before the
+// compaction start if the collection time travel is not enabled.
+func checkExportTs(m *meta, properties map[string]string, ts uint64, segments []*datapb.SegmentInfo, now time.Time) error {
+	compactionStarts := make(map[int64]int64)
+	if len(segments) > 0 {
+		for _, tasks := range m.GetCompactionTaskMeta().GetCompactionTasksByCollection(segments[0].GetCollectionID()) {
+			for _, task := range tasks {
+				for _, segmentID := range task.GetResultSegments() {
+					compactionStarts[segmentID] = task.GetStartTime()
+				}
+			}
+		}
+	}
+	// the compaction tasks are dropped from the history the drop tolerance after they start
+	tolerance := Params.DataCoordCfg.CompactionDropToleranceInSeconds.GetAsDuration(time.Second)
+	for _, segment := range segments {
+		if !segment.GetCreatedByCompaction() {
+			continue
+		}
+		compactedAt := now.Add(-tolerance)
+		if start, ok := compactionStarts[segment.GetID()]; ok {
+			compactedAt = time.Unix(start, 0)
+		}
+		bound, err := getTimeTravelTs(properties, compactedAt)
+		if err != nil {
+			return err
+		}
+		if bound == 0 {
+			bound = tsoutil.ComposeTSByTime(compactedAt, 0)
+		}
+		if ts < bound {
+			return merr.WrapErrParameterInvalidMsg("ts %d is earlier than %d, the deletes before it have been compacted in segment %d",
+				ts, bound, segment.GetID())
+		}
+	}
+	return nil
+}
+
+// newExportTasks creates a task for each channel holding the segments to export, with the L0 segments of the channel.
+func newExportTasks(job *ExportJob, segments []*datapb.SegmentInfo) []*ExportTask {
+	tasks := make(map[string]*ExportTask)
+	sort.Slice(segments, func(i, j int) bool {
+		return segments[i].GetID() < segments[j].GetID()
+	})
+	for _, segment := range segments {
+		if segment.GetLevel() == datapb.SegmentLevel_L0 {
+			continue
+		}
+		task, ok := tasks[segment.GetInsertChannel()]
+		if !ok {
+			task = &ExportTask{ExportTask: &datapb.ExportTask{
+				JobID:        job.GetJobID(),
+				CollectionID: job.GetCollectionID(),
+				Vchannel:     segment.GetInsertChannel(),
+				NodeID:       NullNodeID,
+				State:        datapb.ImportTaskStateV2_Pending,
+				CreatedTime:  time.Now().Format("2006-01-02T15:04:05Z07:00"),
+			}}
+			tasks[segment.GetInsertChannel()] = task
+		}
+		task.SegmentIDs = append(task.SegmentIDs, segment.GetID())
+		task.TotalRows += segment.GetNumOfRows()
+	}
+	for _, segment := range segments {
+		if task, ok := tasks[segment.GetInsertChannel()]; ok && segment.GetLevel() == datapb.SegmentLevel_L0 {
+			task.L0SegmentIDs = append(task.L0SegmentIDs, segment.GetID())
+		}
+	}
+	ret := lo.Values(tasks)
+	sort.Slice(ret, func(i, j int) bool {
+		return ret[i].GetVchannel() < ret[j].GetVchannel()
+	})
+	return ret
+}
+
+func (s *exportScheduler) processInProgressJob(job *ExportJob, nodeTasks map[int64]int) {
+	tasks := s.emeta.GetTaskBy(context.TODO(), WithExportJob(job.GetJobID()))
+	for _, task := range tasks {
+		switch task.GetState() {
+		case datapb.ImportTaskStateV2_Pending:
+			s.processPendingTask(job, task, nodeTasks)
+		case datapb.ImportTaskStateV2_InProgress:
+			s.processInProgressTask(task)
+		}
+	}
+
+	tasks = s.emeta.GetTaskBy(context.TODO(), WithExportJob(job.GetJobID()))
+	if failed, ok := lo.Find(tasks, func(task *ExportTask) bool {
+		return task.GetState() == datapb.ImportTaskStateV2_Failed
+	}); ok {
+		log.Warn("export job failed", zap.Int64("jobID", job.GetJobID()), zap.Int64("taskID", failed.GetTaskID()),
+			zap.String("reason", failed.GetReason()))
+		s.failJob(job, failed.GetReason())
+		return
+	}
+	if lo.EveryBy(tasks, func(task *ExportTask) bool {
+		return task.GetState() == datapb.ImportTaskStateV2_Completed
+	}) {
+		err := s.emeta.UpdateJob(context.TODO(), job.GetJobID(), UpdateExportJobState(datapb.ImportTaskStateV2_Completed))
+		if err != nil {
+			log.Warn("failed to update export job state", zap.Int64("jobID", job.GetJobID()), zap.Error(err))
+			return
+		}
+		log.Info("export job completed", zap.Int64("jobID", job.GetJobID()), zap.Int64("exportedRows",
+			lo.SumBy(tasks, func(task *ExportTask) int64 { return task.GetExportedRows() })))
+	}
+}
+
+// processPendingTask dispatches the task to the datanode running the fewest export tasks.
+func (s *exportScheduler) processPendingTask(job *ExportJob, task *ExportTask, nodeTasks map[int64]int) {
+	var nodeID int64 = NullNodeID
+	for id, num := range nodeTasks {
+		if num < Params.DataNodeCfg.MaxConcurrentExportTaskNum.GetAsInt() && (nodeID == NullNodeID || num < nodeTasks[nodeID]) {
+			nodeID = id
+		}
+	}
+	if nodeID == NullNodeID {
+		return
+	}
+	log := log.With(zap.Int64("jobID", task.GetJobID()), zap.Int64("taskID", task.GetTaskID()), zap.Int64("nodeID", nodeID))
+	req, err := s.assembleExportRequest(job, task)
+	if err != nil {
+		log.Warn("failed to assemble export request", zap.Error(err))
+		return
+	}
+	if err = s.cluster.ExportV2(nodeID, req); err != nil {
+		log.Warn("export failed", zap.Error(err))
+		return
+	}
+	err = s.emeta.UpdateTask(context.TODO(), task.GetTaskID(),
+		UpdateExportTaskState(datapb.ImportTaskStateV2_InProgress), UpdateExportTaskNodeID(nodeID))
+	if err != nil {
+		log.Warn("failed to update export task", zap.Error(err))
+		return
+	}
+	nodeTasks[nodeID]++
+	log.Info("export task start to execute", zap.String("vchannel", task.GetVchannel()),
+		zap.Int("segments", len(task.GetSegmentIDs())))
+}
+
+// assembleExportRequest reads the segments of the task from the meta, with the binlog paths decompressed.
+// The segments compacted after the job starts are dropped but still kept in the meta since they are pinned.
+func (s *exportScheduler) assembleExportRequest(job *ExportJob, task *ExportTask) (*datapb.ExportRequest, error) {
+	getSegments := func(segmentIDs []int64) ([]*datapb.SegmentInfo, error) {
+		ret := make([]*datapb.SegmentInfo, 0, len(segmentIDs))
+		for _, segmentID := range segmentIDs {
+			info := s.meta.GetSegment(context.TODO(), segmentID)
+			if info == nil {
+				return nil, merr.WrapErrSegmentNotFound(segmentID)
+			}
+			segment := proto.Clone(info.SegmentInfo).(*datapb.SegmentInfo)
+			if err := binlog.DecompressBinLogs(segment); err != nil {
+				return nil, err
+			}
+			ret = append(ret, segment)
+		}
+		return ret, nil
+	}
+	segmentInfos, err := getSegments(task.GetSegmentIDs())
+	if err != nil {
+		return nil, err
+	}
+	l0SegmentInfos, err := getSegments(task.GetL0SegmentIDs())
+	if err != nil {
+		return nil, err
+	}
+	return &datapb.ExportRequest{
+		ClusterID:    Params.CommonCfg.ClusterPrefix.GetValue(),
+		JobID:        job.GetJobID(),
+		TaskID:       task.GetTaskID(),
+		CollectionID: job.GetCollectionID(),
+		Schema:       job.GetSchema(),
+		OutputFields: job.GetOutputFields(),
+		Filter:       job.GetFilter(),
+		Ts:           job.GetTs(),
+		OutputPath:   job.GetOutputPath(),
+		Segments:     segmentInfos,
+		L0Segments:   l0SegmentInfos,
+		MaxFileSize:  Params.DataCoordCfg.MaxSizeInMBPerExportFile.GetAsInt64() * 1024 * 1024,
+	}, nil
+}
+
+func (s *exportScheduler) processInProgressTask(task *ExportTask) {
+	log := log.With(zap.Int64("jobID", task.GetJobID()), zap.Int64("taskID", task.GetTaskID()), zap.Int64("nodeID", task.GetNodeID()))
+	resp, err := s.cluster.QueryExport(task.GetNodeID(), &datapb.QueryExportRequest{
+		ClusterID: Params.CommonCfg.ClusterPrefix.GetValue(),
+		JobID:     task.GetJobID(),
+		TaskID:    task.GetTaskID(),
+	})
+	if err != nil {
+		// the task is lost if the datanode restarts, execute it again
+		updateErr := s.emeta.UpdateTask(context.TODO(), task.GetTaskID(), UpdateExportTaskState(datapb.ImportTaskStateV2_Pending),
+			UpdateExportTaskNodeID(NullNodeID), UpdateExportTaskProgress(0, 0, nil))
+		if updateErr != nil {
+			log.Warn("failed to reset export task state to pending", zap.Error(updateErr))
+		}
+		log.Info("reset export task state to pending due to error occurs", zap.Error(err))
+		return
+	}
+	actions := []UpdateExportTaskAction{UpdateExportTaskProgress(resp.GetScannedRows(), resp.GetExportedRows(), resp.GetFiles())}
+	switch resp.GetState() {
+	case datapb.ImportTaskStateV2_Completed, datapb.ImportTaskStateV2_Failed:
+		actions = append(actions, UpdateExportTaskState(resp.GetState()), UpdateExportTaskReason(resp.GetReason()))
+	}
+	if err = s.emeta.UpdateTask(context.TODO(), task.GetTaskID(), actions...); err != nil {
+		log.Warn("failed to update export task", zap.Error(err))
+		return
+	}
+	log.Info("query export", zap.String("state", resp.GetState().String()), zap.String("reason", resp.GetReason()),
+		zap.Int64("exportedRows", resp.GetExportedRows()))
+	if resp.GetState() == datapb.ImportTaskStateV2_Completed || resp.GetState() == datapb.ImportTaskStateV2_Failed {
+		s.dropTask(task)
+	}
+}
+
+// dropTask releases the task on the datanode, the running task is canceled.
+func (s *exportScheduler) dropTask(task *ExportTask) {
+	if task.GetNodeID() == NullNodeID {
+		return
+	}
+	err := s.cluster.DropExport(task.GetNodeID(), &datapb.DropExportRequest{
+		ClusterID: Params.CommonCfg.ClusterPrefix.GetValue(),
+		JobID:     task.GetJobID(),
+		TaskID:    task.GetTaskID(),
+	})
+	if err != nil {
+		log.Warn("drop export failed", zap.Int64("taskID", task.GetTaskID()), zap.Int64("nodeID", task.GetNodeID()), zap.Error(err))
+		return
+	}
+	if err = s.emeta.UpdateTask(context.TODO(), task.GetTaskID(), UpdateExportTaskNodeID(NullNodeID)); err != nil {
+		log.Warn("failed to update export task", zap.Int64("taskID", task.GetTaskID()), zap.Error(err))
+	}
+}
+
+func (s *exportScheduler) failJob(job *ExportJob, reason string) {
+	err := s.emeta.UpdateJob(context.TODO(), job.GetJobID(), UpdateExportJobState(datapb.ImportTaskStateV2_Failed),
+		UpdateExportJobReason(reason))
+	if err != nil {
+		log.Warn("failed to update export job state", zap.Int64("jobID", job.GetJobID()), zap.Error(err))
+	}
+}
+
+// processFinishedJob releases the tasks still held by the datanodes, the segments of the job are unpinned
+// once it finishes, then removes the job and its tasks after the retention.
+func (s *exportScheduler) processFinishedJob(job *ExportJob) {
+	ctx := context.TODO()
+	log := log.With(zap.Int64("jobID", job.GetJobID()))
+	tasks := s.emeta.GetTaskBy(ctx, WithExportJob(job.GetJobID()))
+	for _, task := range tasks {
+		s.dropTask(task)
+	}
+	cleanupTime := tsoutil.PhysicalTime(job.GetCleanupTs())
+	if time.Now().Before(cleanupTime) {
+		return
+	}
+	for _, task := range tasks {
+		if err := s.emeta.RemoveTask(ctx, task.GetTaskID()); err != nil {
+			log.Warn("failed to remove export task", zap.Int64("taskID", task.GetTaskID()), zap.Error(err))
+			return
+		}
+	}
+	if err := s.emeta.RemoveJob(ctx, job.GetJobID()); err != nil {
+		log.Warn("failed to remove export job", zap.Error(err))
+		return
+	}
+	log.Info("export job removed")
+}
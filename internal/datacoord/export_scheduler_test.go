@@ -0,0 +1,244 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"context"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/msgpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/datacoord/session"
+	"github.com/milvus-io/milvus/pkg/v2/common"
+	"github.com/milvus-io/milvus/pkg/v2/proto/datapb"
+	"github.com/milvus-io/milvus/pkg/v2/util/merr"
+	"github.com/milvus-io/milvus/pkg/v2/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/v2/util/tsoutil"
+)
+
+type ExportSchedulerSuite struct {
+	suite.Suite
+
+	meta      *meta
+	emeta     ExportMeta
+	cluster   *MockCluster
+	scheduler *exportScheduler
+}
+
+func (s *ExportSchedulerSuite) SetupTest() {
+	var err error
+	s.meta, err = newMemoryMeta(s.T())
+	s.NoError(err)
+	s.meta.AddCollection(&collectionInfo{
+		ID: 1,
+		Schema: &schemapb.CollectionSchema{
+			Name: "coll",
+			Fields: []*schemapb.FieldSchema{
+				{FieldID: 100, Name: "pk", DataType: schemapb.DataType_Int64, IsPrimaryKey: true},
+			},
+		},
+		VChannelNames: []string{"ch-0", "ch-1"},
+	})
+	s.emeta, err = NewExportMeta(context.TODO(), s.meta.catalog)
+	s.NoError(err)
+	s.cluster = NewMockCluster(s.T())
+	s.cluster.EXPECT().GetSessions().Return([]*session.Session{session.NewSession(&session.NodeInfo{NodeID: 1}, nil)}).Maybe()
+	s.scheduler = NewExportScheduler(s.meta, s.cluster, newMockAllocator(s.T()), s.emeta).(*exportScheduler)
+}
+
+func (s *ExportSchedulerSuite) addSegment(id, partitionID int64, channel string, level datapb.SegmentLevel, startTs uint64) {
+	s.NoError(s.meta.AddSegment(context.TODO(), NewSegmentInfo(&datapb.SegmentInfo{
+		ID:            id,
+		CollectionID:  1,
+		PartitionID:   partitionID,
+		InsertChannel: channel,
+		Level:         level,
+		State:         commonpb.SegmentState_Flushed,
+		NumOfRows:     100,
+		StartPosition: &msgpb.MsgPosition{Timestamp: startTs},
+		Binlogs: []*datapb.FieldBinlog{{
+			FieldID: 100,
+			Binlogs: []*datapb.Binlog{{LogID: id * 10, EntriesNum: 100}},
+		}},
+	})))
+}
+
+func (s *ExportSchedulerSuite) newJob(partitionIDs ...int64) *ExportJob {
+	return &ExportJob{ExportJob: &datapb.ExportJob{
+		JobID:        1000,
+		CollectionID: 1,
+		PartitionIDs: partitionIDs,
+		Vchannels:    []string{"ch-0", "ch-1"},
+		Ts:           200,
+		OutputPath:   "export/1000",
+		State:        datapb.ImportTaskStateV2_Pending,
+	}}
+}
+
+func (s *ExportSchedulerSuite) TestSelectSegments() {
+	s.addSegment(1, 10, "ch-0", datapb.SegmentLevel_L1, 100)
+	s.addSegment(2, 20, "ch-0", datapb.SegmentLevel_L1, 100)
+	s.addSegment(3, 10, "ch-0", datapb.SegmentLevel_L0, 100)
+	s.addSegment(4, common.AllPartitionsID, "ch-0", datapb.SegmentLevel_L0, 100)
+	// started after the snapshot ts
+	s.addSegment(5, 10, "ch-0", datapb.SegmentLevel_L1, 300)
+	// without binlogs
+	s.NoError(s.meta.AddSegment(context.TODO(), NewSegmentInfo(&datapb.SegmentInfo{
+		ID: 6, CollectionID: 1, PartitionID: 10, InsertChannel: "ch-0", State: commonpb.SegmentState_Growing,
+	})))
+	ids := func(segments []*SegmentInfo) []int64 {
+		return lo.Map(segments, func(segment *SegmentInfo, _ int) int64 {
+			return segment.GetID()
+		})
+	}
+	s.ElementsMatch([]int64{1, 2, 3, 4}, ids(s.meta.SelectSegments(context.TODO(), exportSegmentFilters(s.newJob())...)))
+	s.ElementsMatch([]int64{2, 4}, ids(s.meta.SelectSegments(context.TODO(), exportSegmentFilters(s.newJob(20))...)))
+}
+
+func (s *ExportSchedulerSuite) TestCheckExportTs() {
+	now := time.Now()
+	compactionStart := now.Add(-time.Hour)
+	segments := []*datapb.SegmentInfo{
+		{ID: 1, CollectionID: 1},
+		{ID: 2, CollectionID: 1, CreatedByCompaction: true},
+	}
+	s.NoError(s.meta.SaveCompactionTask(context.TODO(), &datapb.CompactionTask{
+		PlanID: 1, TriggerID: 1, CollectionID: 1, Type: datapb.CompactionType_MixCompaction,
+		State: datapb.CompactionTaskState_completed, StartTime: compactionStart.Unix(), ResultSegments: []int64{2},
+	}))
+	startTs := tsoutil.ComposeTSByTime(time.Unix(compactionStart.Unix(), 0), 0)
+
+	// the deletes before the compaction start are compacted
+	s.NoError(checkExportTs(s.meta, nil, startTs, segments, now))
+	s.ErrorIs(checkExportTs(s.meta, nil, startTs-1, segments, now), merr.ErrParameterInvalid)
+	s.NoError(checkExportTs(s.meta, nil, 0, segments[:1], now))
+
+	// the deletes within the time travel window are kept
+	properties := map[string]string{common.CollectionTimeTravelKey: "600"}
+	travelTs := tsoutil.ComposeTSByTime(time.Unix(compactionStart.Unix(), 0).Add(-10*time.Minute), 0)
+	s.NoError(checkExportTs(s.meta, properties, travelTs, segments, now))
+	s.ErrorIs(checkExportTs(s.meta, properties, travelTs-1, segments, now), merr.ErrParameterInvalid)
+
+	// the compaction dropped from the history started before the drop tolerance
+	segments[1].ID = 3
+	tolerance := Params.DataCoordCfg.CompactionDropToleranceInSeconds.GetAsDuration(time.Second)
+	s.NoError(checkExportTs(s.meta, nil, tsoutil.ComposeTSByTime(now.Add(-tolerance), 0), segments, now))
+	s.Error(checkExportTs(s.meta, nil, tsoutil.ComposeTSByTime(now.Add(-tolerance-time.Second), 0), segments, now))
+}
+
+func (s *ExportSchedulerSuite) TestProcess() {
+	ctx := context.TODO()
+	s.addSegment(1, 10, "ch-0", datapb.SegmentLevel_L1, 100)
+	s.addSegment(2, 10, "ch-1", datapb.SegmentLevel_L1, 100)
+	s.addSegment(3, common.AllPartitionsID, "ch-0", datapb.SegmentLevel_L0, 100)
+	s.NoError(s.emeta.AddJob(ctx, s.newJob()))
+
+	// wait for the data before the snapshot ts flushed
+	s.scheduler.process()
+	s.Equal(datapb.ImportTaskStateV2_Pending, s.emeta.GetJob(ctx, 1000).GetState())
+	for _, ch := range []string{"ch-0", "ch-1"} {
+		s.NoError(s.meta.UpdateChannelCheckpoint(ctx, ch, &msgpb.MsgPosition{ChannelName: ch, MsgID: []byte{1}, Timestamp: 200}))
+	}
+
+	// the job starts and the tasks are dispatched
+	requests := make(map[string]*datapb.ExportRequest)
+	s.cluster.EXPECT().ExportV2(int64(1), mock.Anything).RunAndReturn(func(nodeID int64, req *datapb.ExportRequest) error {
+		requests[req.GetSegments()[0].GetInsertChannel()] = req
+		return nil
+	}).Times(3)
+	s.scheduler.process()
+	s.Equal(datapb.ImportTaskStateV2_InProgress, s.emeta.GetJob(ctx, 1000).GetState())
+	s.True(s.emeta.IsSegmentPinned(1))
+	s.True(s.emeta.IsSegmentPinned(3))
+	tasks := s.emeta.GetTaskBy(ctx, WithExportJob(1000))
+	s.Equal(2, len(tasks))
+	s.Equal([]int64{1}, tasks[0].GetSegmentIDs())
+	s.Equal([]int64{3}, tasks[0].GetL0SegmentIDs())
+	s.Empty(tasks[1].GetL0SegmentIDs())
+	s.Equal(2, len(requests))
+	s.Equal(1, len(requests["ch-0"].GetL0Segments()))
+	// the binlog paths are decompressed
+	s.NotEmpty(requests["ch-0"].GetSegments()[0].GetBinlogs()[0].GetBinlogs()[0].GetLogPath())
+
+	// the task of ch-1 is lost and executed again
+	s.cluster.EXPECT().QueryExport(int64(1), mock.Anything).RunAndReturn(func(nodeID int64, req *datapb.QueryExportRequest) (*datapb.QueryExportResponse, error) {
+		if req.GetTaskID() == tasks[0].GetTaskID() {
+			return &datapb.QueryExportResponse{
+				Status: merr.Success(), State: datapb.ImportTaskStateV2_Completed, ScannedRows: 100, ExportedRows: 90,
+				Files: []string{"export/1000/" + strconv.FormatInt(req.GetTaskID(), 10) + "_0.parquet"},
+			}, nil
+		}
+		return nil, errors.New("mock error")
+	}).Times(2)
+	s.cluster.EXPECT().DropExport(int64(1), mock.Anything).Return(nil).Once()
+	s.scheduler.process()
+	s.Equal(datapb.ImportTaskStateV2_Completed, s.emeta.GetTask(ctx, tasks[0].GetTaskID()).GetState())
+	s.Equal(int64(NullNodeID), s.emeta.GetTask(ctx, tasks[0].GetTaskID()).GetNodeID())
+	s.Equal(datapb.ImportTaskStateV2_Pending, s.emeta.GetTask(ctx, tasks[1].GetTaskID()).GetState())
+	s.scheduler.process()
+	s.Equal(datapb.ImportTaskStateV2_InProgress, s.emeta.GetTask(ctx, tasks[1].GetTaskID()).GetState())
+
+	s.cluster.EXPECT().QueryExport(int64(1), mock.Anything).Return(&datapb.QueryExportResponse{
+		Status: merr.Success(), State: datapb.ImportTaskStateV2_Completed,
+	}, nil).Once()
+	s.cluster.EXPECT().DropExport(int64(1), mock.Anything).Return(nil).Once()
+	s.scheduler.process()
+	s.Equal(datapb.ImportTaskStateV2_Completed, s.emeta.GetJob(ctx, 1000).GetState())
+
+	// the segments are unpinned, and the job is removed after the retention
+	s.scheduler.process()
+	s.False(s.emeta.IsSegmentPinned(1))
+	s.NotNil(s.emeta.GetJob(ctx, 1000))
+	paramtable.Get().Save(Params.DataCoordCfg.ExportJobRetention.Key, "0")
+	defer paramtable.Get().Reset(Params.DataCoordCfg.ExportJobRetention.Key)
+	s.NoError(s.emeta.UpdateJob(ctx, 1000, UpdateExportJobState(datapb.ImportTaskStateV2_Completed)))
+	s.scheduler.process()
+	s.Nil(s.emeta.GetJob(ctx, 1000))
+	s.Empty(s.emeta.GetTaskBy(ctx))
+}
+
+func (s *ExportSchedulerSuite) TestCompactedBeforeStart() {
+	ctx := context.TODO()
+	s.addSegment(1, 10, "ch-0", datapb.SegmentLevel_L1, 100)
+	segment := s.meta.GetSegment(ctx, 1).Clone()
+	segment.CreatedByCompaction = true
+	s.meta.segments.SetSegment(1, segment)
+	s.NoError(s.emeta.AddJob(ctx, s.newJob()))
+	for _, ch := range []string{"ch-0", "ch-1"} {
+		s.NoError(s.meta.UpdateChannelCheckpoint(ctx, ch, &msgpb.MsgPosition{ChannelName: ch, MsgID: []byte{1}, Timestamp: 200}))
+	}
+
+	// the job fails since the segment is compacted after the snapshot ts, and the segments are unpinned
+	s.scheduler.process()
+	job := s.emeta.GetJob(ctx, 1000)
+	s.Equal(datapb.ImportTaskStateV2_Failed, job.GetState())
+	s.Contains(job.GetReason(), "compacted")
+	s.scheduler.process()
+	s.False(s.emeta.IsSegmentPinned(1))
+}
+
+func TestExportScheduler(t *testing.T) {
+	suite.Run(t, new(ExportSchedulerSuite))
+}
@@ -55,6 +55,7 @@ type GcOption struct {
 	scanInterval     time.Duration        // interval for scan residue for interupted log wrttien
 
 	broker           broker.Broker
+	exportMeta       ExportMeta // the segments to export are kept until the export jobs finish
 	removeObjectPool *conc.Pool[struct{}]
 }
 
@@ -464,6 +465,16 @@ func (gc *garbageCollector) recycleDroppedSegments(ctx context.Context) {
 		if !gc.checkDroppedSegmentGC(segment, compactTo[segment.GetID()], indexedSet, channelCPs[segInsertChannel]) {
 			continue
 		}
+		if gc.option.exportMeta != nil && gc.option.exportMeta.IsSegmentPinned(segmentID) {
+			log.WithRateGroup("GC_SKIP_PINNED_BY_EXPORT", 1, 60).
+				RatedInfo(60, "skipping GC when the dropped segment is pinned by export job")
+			continue
+		}
+		if gc.meta.snapshotMeta.IsSegmentPinned(segmentID) {
+			log.WithRateGroup("GC_SKIP_PINNED_BY_SNAPSHOT", 1, 60).
+				RatedInfo(60, "skipping GC when the dropped segment is pinned by snapshot")
+			continue
+		}
 
 		logs := getLogs(segment)
 		for key := range getTextLogs(segment) {
@@ -549,28 +560,23 @@ func (gc *garbageCollector) isExpire(dropts Timestamp) bool {
 	return time.Since(droptime) > gc.option.dropTolerance
 }
 
+// getLogs returns the log paths of the segment, except the foreign ones which belong to other segments.
 func getLogs(sinfo *SegmentInfo) map[string]struct{} {
 	logs := make(map[string]struct{})
-	for _, flog := range sinfo.GetBinlogs() {
-		for _, l := range flog.GetBinlogs() {
-			logs[l.GetLogPath()] = struct{}{}
-		}
-	}
-	for _, flog := range sinfo.GetStatslogs() {
-		for _, l := range flog.GetBinlogs() {
-			logs[l.GetLogPath()] = struct{}{}
-		}
-	}
-	for _, flog := range sinfo.GetDeltalogs() {
-		for _, l := range flog.GetBinlogs() {
-			logs[l.GetLogPath()] = struct{}{}
-		}
-	}
-	for _, flog := range sinfo.GetBm25Statslogs() {
-		for _, l := range flog.GetBinlogs() {
-			logs[l.GetLogPath()] = struct{}{}
+	collect := func(binlogType storage.BinlogType, fieldBinlogs []*datapb.FieldBinlog) {
+		for _, flog := range fieldBinlogs {
+			for _, l := range flog.GetBinlogs() {
+				if binlog.IsForeignLogPath(binlogType, sinfo.GetID(), l.GetLogPath()) {
+					continue
+				}
+				logs[l.GetLogPath()] = struct{}{}
+			}
 		}
 	}
+	collect(storage.InsertBinlog, sinfo.GetBinlogs())
+	collect(storage.StatsBinlog, sinfo.GetStatslogs())
+	collect(storage.DeleteBinlog, sinfo.GetDeltalogs())
+	collect(storage.BM25Binlog, sinfo.GetBm25Statslogs())
 	return logs
 }
 
@@ -645,6 +651,11 @@ func (gc *garbageCollector) recycleUnusedSegIndexes(ctx context.Context) {
 			return
 		}
 
+		// the segment index pinned by snapshot is kept even if the index is deleted.
+		if gc.meta.snapshotMeta.IsBuildIDPinned(segIdx.BuildID) {
+			continue
+		}
+
 		// 1. segment belongs to is deleted.
 		// 2. index is deleted.
 		if gc.meta.GetSegment(ctx, segIdx.SegmentID) == nil || !gc.meta.indexMeta.IsIndexExist(segIdx.CollectionID, segIdx.IndexID) {
@@ -694,6 +705,10 @@ func (gc *garbageCollector) recycleUnusedIndexFiles(ctx context.Context) {
 			return true
 		}
 		logger = logger.With(zap.Int64("buildID", buildID))
+		if gc.meta.snapshotMeta.IsBuildIDPinned(buildID) {
+			logger.Info("garbageCollector skip index files pinned by snapshot")
+			return true
+		}
 		logger.Info("garbageCollector will recycle index files")
 		canRecycle, segIdx := gc.meta.indexMeta.CheckCleanSegmentIndex(buildID)
 		if !canRecycle {
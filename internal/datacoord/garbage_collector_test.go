@@ -369,6 +369,7 @@ func createMetaForRecycleUnusedIndexes(catalog metastore.DataCoordCatalog) *meta
 		segments:     nil,
 		channelCPs:   newChannelCps(),
 		chunkManager: nil,
+		snapshotMeta: &snapshotMeta{},
 		indexMeta: &indexMeta{
 			catalog: catalog,
 			indexes: map[UniqueID]map[UniqueID]*model.Index{
@@ -477,11 +478,12 @@ func createMetaForRecycleUnusedSegIndexes(catalog metastore.DataCoordCatalog) *m
 		},
 	}
 	meta := &meta{
-		RWMutex:     lock.RWMutex{},
-		ctx:         ctx,
-		catalog:     catalog,
-		collections: nil,
-		segments:    NewSegmentsInfo(),
+		RWMutex:      lock.RWMutex{},
+		ctx:          ctx,
+		catalog:      catalog,
+		collections:  nil,
+		segments:     NewSegmentsInfo(),
+		snapshotMeta: &snapshotMeta{},
 		indexMeta: &indexMeta{
 			catalog: catalog,
 			segmentIndexes: map[UniqueID]map[UniqueID]*model.SegmentIndex{
@@ -642,11 +644,12 @@ func createMetaTableForRecycleUnusedIndexFiles(catalog *datacoord.Catalog) *meta
 		},
 	}
 	meta := &meta{
-		RWMutex:     lock.RWMutex{},
-		ctx:         ctx,
-		catalog:     catalog,
-		collections: nil,
-		segments:    NewSegmentsInfo(),
+		RWMutex:      lock.RWMutex{},
+		ctx:          ctx,
+		catalog:      catalog,
+		collections:  nil,
+		segments:     NewSegmentsInfo(),
+		snapshotMeta: &snapshotMeta{},
 		indexMeta: &indexMeta{
 			catalog: catalog,
 			segmentIndexes: map[UniqueID]map[UniqueID]*model.SegmentIndex{
@@ -1044,9 +1047,10 @@ func TestGarbageCollector_clearETCD(t *testing.T) {
 		},
 	}
 	m := &meta{
-		catalog:    catalog,
-		channelCPs: channelCPs,
-		segments:   NewSegmentsInfo(),
+		catalog:      catalog,
+		channelCPs:   channelCPs,
+		segments:     NewSegmentsInfo(),
+		snapshotMeta: &snapshotMeta{},
 		indexMeta: &indexMeta{
 			catalog: catalog,
 			segmentIndexes: map[UniqueID]map[UniqueID]*model.SegmentIndex{
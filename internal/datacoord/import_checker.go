@@ -19,6 +19,7 @@ package datacoord
 import (
 	"context"
 	"fmt"
+	"strings"
 	"sync"
 	"time"
 
@@ -250,6 +251,12 @@ func (c *importChecker) checkPreImportingJob(job ImportJob) {
 		return
 	}
 
+	badRowsReason := c.getBadRowsReason(job)
+	if importutilv2.IsDryRun(job.GetOptions()) {
+		c.completeDryRunJob(job, badRowsReason)
+		return
+	}
+
 	requestSize, err := CheckDiskQuota(job, c.meta, c.imeta)
 	if err != nil {
 		log.Warn("import failed, disk quota exceeded", zap.Error(err))
@@ -280,7 +287,8 @@ func (c *importChecker) checkPreImportingJob(job ImportJob) {
 		log.Info("add new import task", WrapTaskLog(t)...)
 	}
 
-	err = c.imeta.UpdateJob(context.TODO(), job.GetJobID(), UpdateJobState(internalpb.ImportJobState_Importing), UpdateRequestedDiskSize(requestSize))
+	err = c.imeta.UpdateJob(context.TODO(), job.GetJobID(), UpdateJobState(internalpb.ImportJobState_Importing),
+		UpdateRequestedDiskSize(requestSize), UpdateJobReason(badRowsReason))
 	if err != nil {
 		log.Warn("failed to update job state to Importing", zap.Error(err))
 		return
@@ -290,6 +298,34 @@ func (c *importChecker) checkPreImportingJob(job ImportJob) {
 	log.Info("import job preimport done", zap.Duration("jobTimeCost/preimport", preImportDuration))
 }
 
+// getBadRowsReason joins the bad rows found by the preimport tasks, which is empty if no bad row is found.
+func (c *importChecker) getBadRowsReason(job ImportJob) string {
+	preimports := c.imeta.GetTaskBy(context.TODO(), WithType(PreImportTaskType), WithJob(job.GetJobID()))
+	reasons := lo.FilterMap(preimports, func(t ImportTask, _ int) (string, bool) {
+		return t.GetReason(), t.GetReason() != ""
+	})
+	return strings.Join(reasons, "; ")
+}
+
+// completeDryRunJob completes the dry-run job once the preimport is done, no data is imported.
+func (c *importChecker) completeDryRunJob(job ImportJob, badRowsReason string) {
+	log := log.With(zap.Int64("jobID", job.GetJobID()))
+	reason := "dry run done, no bad row found"
+	if badRowsReason != "" {
+		reason = "dry run done, " + badRowsReason
+	}
+	completeTime := time.Now().Format("2006-01-02T15:04:05Z07:00")
+	err := c.imeta.UpdateJob(context.TODO(), job.GetJobID(), UpdateJobState(internalpb.ImportJobState_Completed),
+		UpdateJobReason(reason), UpdateJobCompleteTime(completeTime))
+	if err != nil {
+		log.Warn("failed to update dry-run job state to Completed", zap.Error(err))
+		return
+	}
+	preImportDuration := job.GetTR().RecordSpan()
+	metrics.ImportJobLatency.WithLabelValues(metrics.ImportStagePreImport).Observe(float64(preImportDuration.Milliseconds()))
+	log.Info("import dry-run job completed", zap.String("reason", reason), zap.Duration("jobTimeCost/preimport", preImportDuration))
+}
+
 func (c *importChecker) checkImportingJob(job ImportJob) {
 	log := log.With(zap.Int64("jobID", job.GetJobID()))
 	tasks := c.imeta.GetTaskBy(context.TODO(), WithType(ImportTaskType), WithJob(job.GetJobID()), WithRequestSource())
@@ -33,6 +33,7 @@ import (
 	"github.com/milvus-io/milvus/internal/datacoord/allocator"
 	broker2 "github.com/milvus-io/milvus/internal/datacoord/broker"
 	"github.com/milvus-io/milvus/internal/metastore/mocks"
+	"github.com/milvus-io/milvus/internal/util/importutilv2"
 	"github.com/milvus-io/milvus/pkg/v2/log"
 	"github.com/milvus-io/milvus/pkg/v2/proto/datapb"
 	"github.com/milvus-io/milvus/pkg/v2/proto/indexpb"
@@ -64,6 +65,7 @@ func (s *ImportCheckerSuite) SetupTest() {
 	catalog.EXPECT().ListCompactionTask(mock.Anything).Return(nil, nil)
 	catalog.EXPECT().ListPartitionStatsInfos(mock.Anything).Return(nil, nil)
 	catalog.EXPECT().ListStatsTasks(mock.Anything).Return(nil, nil)
+	catalog.EXPECT().ListSnapshots(mock.Anything).Return(nil, nil)
 
 	cluster := NewMockCluster(s.T())
 	s.alloc = allocator.NewMockAllocator(s.T())
@@ -258,6 +260,40 @@ func (s *ImportCheckerSuite) TestCheckJob() {
 	s.Equal(internalpb.ImportJobState_Completed, s.imeta.GetJob(context.TODO(), job.GetJobID()).GetState())
 }
 
+func (s *ImportCheckerSuite) TestCheckJob_DryRun() {
+	job := s.imeta.GetJob(context.TODO(), s.jobID)
+	job.(*importJob).ImportJob.Options = []*commonpb.KeyValuePair{{Key: importutilv2.DryRun, Value: "true"}}
+
+	alloc := s.alloc
+	alloc.EXPECT().AllocN(mock.Anything).RunAndReturn(func(n int64) (int64, int64, error) {
+		id := rand.Int63()
+		return id, id + n, nil
+	})
+	catalog := s.imeta.(*importMeta).catalog.(*mocks.DataCoordCatalog)
+	catalog.EXPECT().SavePreImportTask(mock.Anything, mock.Anything).Return(nil)
+
+	s.checker.checkPendingJob(job)
+	preimportTasks := s.imeta.GetTaskBy(context.TODO(), WithJob(job.GetJobID()), WithType(PreImportTaskType))
+	s.Equal(2, len(preimportTasks))
+	err := s.imeta.UpdateTask(context.TODO(), preimportTasks[0].GetTaskID(), UpdateState(datapb.ImportTaskStateV2_Completed),
+		UpdateReason("found 1 bad rows in file [a.json], report=import_bad_rows/0/1.json"))
+	s.NoError(err)
+
+	// not all the preimport tasks are completed
+	s.checker.checkPreImportingJob(job)
+	s.Equal(internalpb.ImportJobState_PreImporting, s.imeta.GetJob(context.TODO(), job.GetJobID()).GetState())
+
+	err = s.imeta.UpdateTask(context.TODO(), preimportTasks[1].GetTaskID(), UpdateState(datapb.ImportTaskStateV2_Completed))
+	s.NoError(err)
+	s.checker.checkPreImportingJob(job)
+	importTasks := s.imeta.GetTaskBy(context.TODO(), WithJob(job.GetJobID()), WithType(ImportTaskType))
+	s.Equal(0, len(importTasks))
+	job = s.imeta.GetJob(context.TODO(), job.GetJobID())
+	s.Equal(internalpb.ImportJobState_Completed, job.GetState())
+	s.Equal("dry run done, found 1 bad rows in file [a.json], report=import_bad_rows/0/1.json", job.GetReason())
+	s.NotEmpty(job.GetCompleteTime())
+}
+
 func (s *ImportCheckerSuite) TestCheckJob_Failed() {
 	mockErr := errors.New("mock err")
 	job := s.imeta.GetJob(context.TODO(), s.jobID)
@@ -538,6 +574,7 @@ func TestImportCheckerCompaction(t *testing.T) {
 	catalog.EXPECT().ListCompactionTask(mock.Anything).Return(nil, nil)
 	catalog.EXPECT().ListPartitionStatsInfos(mock.Anything).Return(nil, nil)
 	catalog.EXPECT().ListStatsTasks(mock.Anything).Return(nil, nil)
+	catalog.EXPECT().ListSnapshots(mock.Anything).Return(nil, nil)
 
 	cluster := NewMockCluster(t)
 	alloc := allocator.NewMockAllocator(t)
@@ -247,7 +247,8 @@ func (s *importScheduler) processInProgressPreImport(task ImportTask) {
 	}
 	actions := []UpdateAction{UpdateFileStats(resp.GetFileStats())}
 	if resp.GetState() == datapb.ImportTaskStateV2_Completed {
-		actions = append(actions, UpdateState(datapb.ImportTaskStateV2_Completed))
+		// The reason of a completed preimport task tells the bad rows found, if any.
+		actions = append(actions, UpdateState(datapb.ImportTaskStateV2_Completed), UpdateReason(resp.GetReason()))
 	}
 	err = s.imeta.UpdateTask(context.TODO(), task.GetTaskID(), actions...)
 	if err != nil {
@@ -538,7 +538,7 @@ func GetJobProgress(jobID int64, imeta ImportMeta, meta *meta, sjm StatsJobManag
 
 	case internalpb.ImportJobState_Completed:
 		_, totalRows := getImportRowsInfo(jobID, imeta, meta)
-		return 100, internalpb.ImportJobState_Completed, totalRows, totalRows, ""
+		return 100, internalpb.ImportJobState_Completed, totalRows, totalRows, job.GetReason()
 
 	case internalpb.ImportJobState_Failed:
 		return 0, internalpb.ImportJobState_Failed, 0, 0, job.GetReason()
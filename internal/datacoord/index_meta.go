@@ -904,6 +904,33 @@ func (m *indexMeta) BuildIndex(buildID UniqueID) error {
 	return nil
 }
 
+// ResetTask resets the failed index build to be unissued, so that it could be scheduled and built again.
+func (m *indexMeta) ResetTask(buildID UniqueID) error {
+	m.Lock()
+	defer m.Unlock()
+
+	segIdx, ok := m.segmentBuildInfo.Get(buildID)
+	if !ok {
+		return fmt.Errorf("there is no index with buildID: %d", buildID)
+	}
+
+	updateFunc := func(segIdx *model.SegmentIndex) error {
+		segIdx.IndexState = commonpb.IndexState_Unissued
+		segIdx.FailReason = ""
+		segIdx.NodeID = 0
+		segIdx.IndexFileKeys = nil
+		segIdx.IndexSerializedSize = 0
+		segIdx.IndexMemSize = 0
+		segIdx.FinishedUTCTime = 0
+		return m.alterSegmentIndexes([]*model.SegmentIndex{segIdx})
+	}
+	if err := m.updateSegIndexMeta(segIdx, updateFunc); err != nil {
+		return err
+	}
+	log.Ctx(m.ctx).Info("reset index task success", zap.Int64("buildID", buildID), zap.Int64("segmentID", segIdx.SegmentID))
+	return nil
+}
+
 func (m *indexMeta) GetAllSegIndexes() map[int64]*model.SegmentIndex {
 	m.RLock()
 	defer m.RUnlock()
@@ -1681,3 +1681,39 @@ func TestMeta_GetSegmentIndexStatus(t *testing.T) {
 		assert.Empty(t, segmentIndexes)
 	})
 }
+
+func TestMeta_ResetTask(t *testing.T) {
+	m := updateSegmentIndexMeta(t)
+	ec := catalogmocks.NewDataCoordCatalog(t)
+	ec.On("AlterSegmentIndexes",
+		mock.Anything,
+		mock.Anything,
+	).Return(errors.New("fail"))
+
+	t.Run("success", func(t *testing.T) {
+		err := m.FinishTask(&workerpb.IndexTaskInfo{
+			BuildID:    buildID,
+			State:      commonpb.IndexState_Failed,
+			FailReason: "canceled",
+		})
+		assert.NoError(t, err)
+
+		err = m.ResetTask(buildID)
+		assert.NoError(t, err)
+		segIdx, ok := m.GetIndexJob(buildID)
+		assert.True(t, ok)
+		assert.Equal(t, commonpb.IndexState_Unissued, segIdx.IndexState)
+		assert.Empty(t, segIdx.FailReason)
+	})
+
+	t.Run("fail", func(t *testing.T) {
+		m.catalog = ec
+		err := m.ResetTask(buildID)
+		assert.Error(t, err)
+	})
+
+	t.Run("not exist", func(t *testing.T) {
+		err := m.ResetTask(buildID + 1)
+		assert.Error(t, err)
+	})
+}
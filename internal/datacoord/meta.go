@@ -36,6 +36,7 @@ import (
 	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
 	"github.com/milvus-io/milvus/internal/datacoord/broker"
 	"github.com/milvus-io/milvus/internal/metastore"
+	"github.com/milvus-io/milvus/internal/metastore/model"
 	"github.com/milvus-io/milvus/internal/storage"
 	"github.com/milvus-io/milvus/internal/util/segmentutil"
 	"github.com/milvus-io/milvus/pkg/v2/common"
@@ -54,6 +55,7 @@ import (
 	"github.com/milvus-io/milvus/pkg/v2/util/retry"
 	"github.com/milvus-io/milvus/pkg/v2/util/timerecord"
 	"github.com/milvus-io/milvus/pkg/v2/util/tsoutil"
+	"github.com/milvus-io/milvus/pkg/v2/util/typeutil"
 )
 
 type CompactionMeta interface {
@@ -95,6 +97,7 @@ type meta struct {
 	partitionStatsMeta *partitionStatsMeta
 	compactionTaskMeta *compactionTaskMeta
 	statsTaskMeta      *statsTaskMeta
+	snapshotMeta       *snapshotMeta
 }
 
 func (m *meta) GetIndexMeta() *indexMeta {
@@ -175,6 +178,11 @@ func newMeta(ctx context.Context, catalog metastore.DataCoordCatalog, chunkManag
 	if err != nil {
 		return nil, err
 	}
+
+	sm, err := newSnapshotMeta(ctx, catalog)
+	if err != nil {
+		return nil, err
+	}
 	mt := &meta{
 		ctx:                ctx,
 		catalog:            catalog,
@@ -187,6 +195,7 @@ func newMeta(ctx context.Context, catalog metastore.DataCoordCatalog, chunkManag
 		partitionStatsMeta: psm,
 		compactionTaskMeta: ctm,
 		statsTaskMeta:      stm,
+		snapshotMeta:       sm,
 	}
 	err = mt.reloadFromKV(ctx, broker)
 	if err != nil {
@@ -1586,6 +1595,7 @@ func (m *meta) completeClusterCompactionMutation(t *datapb.CompactionTask, resul
 			MaxRowNum:           compactFromSegInfos[0].MaxRowNum,
 			Binlogs:             seg.GetInsertLogs(),
 			Statslogs:           seg.GetField2StatslogPaths(),
+			Deltalogs:           seg.GetDeltalogs(),
 			CreatedByCompaction: true,
 			CompactionFrom:      compactFromSegIDs,
 			LastExpireTime:      tsoutil.ComposeTSByTime(time.Unix(t.GetStartTime(), 0), 0),
@@ -2144,7 +2154,7 @@ func (m *meta) SaveStatsResultSegment(oldSegmentID int64, result *workerpb.Stats
 		Statslogs:                 result.GetStatsLogs(),
 		TextStatsLogs:             result.GetTextStatsLogs(),
 		Bm25Statslogs:             result.GetBm25Logs(),
-		Deltalogs:                 nil,
+		Deltalogs:                 result.GetDeltaLogs(),
 		CompactionFrom:            []int64{oldSegmentID},
 		IsSorted:                  true,
 	}
@@ -2194,3 +2204,94 @@ func (m *meta) getSegmentsMetrics(collectionID int64) []*metricsinfo.Segment {
 
 	return segments
 }
+
+// AddSnapshot fills the snapshot with the segments selected by the filters and their finished segment
+// indexes, and saves it. The segments are selected and pinned under the meta lock, so that none of them
+// could be dropped and recycled by the garbage collector before the snapshot pins it.
+func (m *meta) AddSnapshot(ctx context.Context, snapshot *model.Snapshot, filters ...SegmentFilter) error {
+	m.RLock()
+	defer m.RUnlock()
+	m.indexMeta.RLock()
+	defer m.indexMeta.RUnlock()
+	for _, segment := range m.segments.GetSegmentsBySelector(filters...) {
+		snapshot.Segments = append(snapshot.Segments, proto.Clone(segment.SegmentInfo).(*datapb.SegmentInfo))
+		for _, segIdx := range m.indexMeta.getSegmentIndexes(segment.GetCollectionID(), segment.GetID()) {
+			if segIdx.IndexState == commonpb.IndexState_Finished {
+				snapshot.SegmentIndexes = append(snapshot.SegmentIndexes, segIdx)
+			}
+		}
+	}
+	return m.snapshotMeta.AddSnapshot(snapshot)
+}
+
+// DropSnapshot drops the snapshot, it fails if any segment only pinned by the snapshot still shares
+// its binlogs with the segments cloned from the snapshot.
+func (m *meta) DropSnapshot(ctx context.Context, collectionID int64, name string) error {
+	m.RLock()
+	defer m.RUnlock()
+	referenced := typeutil.NewUniqueSet()
+	for _, segment := range m.segments.GetSegments() {
+		referenced.Insert(getForeignBinlogOwners(segment).Collect()...)
+	}
+	return m.snapshotMeta.DropSnapshot(collectionID, name, referenced)
+}
+
+// AddClonedSegments adds the segments cloned from the snapshot, which share the binlogs of the snapshot.
+// The segments are saved as importing first and become visible together after all of them are saved,
+// the saved segments are marked dropped if any of them fails.
+func (m *meta) AddClonedSegments(ctx context.Context, snapshot *model.Snapshot, segments []*SegmentInfo) error {
+	log := log.Ctx(ctx).With(zap.Int64("collectionID", snapshot.CollectionID), zap.String("snapshot", snapshot.Name))
+	m.Lock()
+	defer m.Unlock()
+	if m.snapshotMeta.GetSnapshot(snapshot.CollectionID, snapshot.Name) == nil {
+		return merr.WrapErrParameterInvalidMsg("snapshot %s not found in collection %d", snapshot.Name, snapshot.CollectionID)
+	}
+
+	added := make([]*SegmentInfo, 0, len(segments))
+	err := func() error {
+		for _, segment := range segments {
+			segment.IsImporting = true
+			if err := m.catalog.AddSegment(ctx, segment.SegmentInfo); err != nil {
+				return err
+			}
+			m.segments.SetSegment(segment.GetID(), segment)
+			added = append(added, segment)
+		}
+		visible := lo.Map(added, func(segment *SegmentInfo, _ int) *SegmentInfo {
+			cloned := segment.Clone()
+			cloned.IsImporting = false
+			return cloned
+		})
+		if err := m.catalog.AlterSegments(ctx, lo.Map(visible, func(segment *SegmentInfo, _ int) *datapb.SegmentInfo {
+			return segment.SegmentInfo
+		})); err != nil {
+			return err
+		}
+		for _, segment := range visible {
+			m.segments.SetSegment(segment.GetID(), segment)
+			metrics.DataCoordNumSegments.WithLabelValues(segment.GetState().String(), segment.GetLevel().String(), getSortStatus(segment.GetIsSorted())).Inc()
+		}
+		return nil
+	}()
+	if err == nil {
+		return nil
+	}
+
+	log.Warn("failed to add cloned segments, drop the added ones", zap.Int("added", len(added)), zap.Error(err))
+	dropped := lo.Map(added, func(segment *SegmentInfo, _ int) *SegmentInfo {
+		cloned := segment.Clone()
+		cloned.State = commonpb.SegmentState_Dropped
+		cloned.DroppedAt = uint64(time.Now().UnixNano())
+		return cloned
+	})
+	if dropErr := m.catalog.AlterSegments(ctx, lo.Map(dropped, func(segment *SegmentInfo, _ int) *datapb.SegmentInfo {
+		return segment.SegmentInfo
+	})); dropErr != nil {
+		log.Warn("failed to drop the added cloned segments", zap.Error(dropErr))
+		return err
+	}
+	for _, segment := range dropped {
+		m.segments.SetSegment(segment.GetID(), segment)
+	}
+	return err
+}
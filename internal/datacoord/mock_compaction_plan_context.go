@@ -22,6 +22,52 @@ func (_m *MockCompactionPlanContext) EXPECT() *MockCompactionPlanContext_Expecte
 	return &MockCompactionPlanContext_Expecter{mock: &_m.Mock}
 }
 
+// cancelCompaction provides a mock function with given fields: planID
+func (_m *MockCompactionPlanContext) cancelCompaction(planID int64) error {
+	ret := _m.Called(planID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for cancelCompaction")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(int64) error); ok {
+		r0 = rf(planID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockCompactionPlanContext_cancelCompaction_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'cancelCompaction'
+type MockCompactionPlanContext_cancelCompaction_Call struct {
+	*mock.Call
+}
+
+// cancelCompaction is a helper method to define mock.On call
+//   - planID int64
+func (_e *MockCompactionPlanContext_Expecter) cancelCompaction(planID interface{}) *MockCompactionPlanContext_cancelCompaction_Call {
+	return &MockCompactionPlanContext_cancelCompaction_Call{Call: _e.mock.On("cancelCompaction", planID)}
+}
+
+func (_c *MockCompactionPlanContext_cancelCompaction_Call) Run(run func(planID int64)) *MockCompactionPlanContext_cancelCompaction_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int64))
+	})
+	return _c
+}
+
+func (_c *MockCompactionPlanContext_cancelCompaction_Call) Return(_a0 error) *MockCompactionPlanContext_cancelCompaction_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockCompactionPlanContext_cancelCompaction_Call) RunAndReturn(run func(int64) error) *MockCompactionPlanContext_cancelCompaction_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // checkAndSetSegmentStating provides a mock function with given fields: channel, segmentID
 func (_m *MockCompactionPlanContext) checkAndSetSegmentStating(channel string, segmentID int64) bool {
 	ret := _m.Called(channel, segmentID)
@@ -269,6 +315,53 @@ func (_c *MockCompactionPlanContext_getCompactionTasksNumBySignalID_Call) RunAnd
 	return _c
 }
 
+// getPausedCollections provides a mock function with given fields:
+func (_m *MockCompactionPlanContext) getPausedCollections() []int64 {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for getPausedCollections")
+	}
+
+	var r0 []int64
+	if rf, ok := ret.Get(0).(func() []int64); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]int64)
+		}
+	}
+
+	return r0
+}
+
+// MockCompactionPlanContext_getPausedCollections_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'getPausedCollections'
+type MockCompactionPlanContext_getPausedCollections_Call struct {
+	*mock.Call
+}
+
+// getPausedCollections is a helper method to define mock.On call
+func (_e *MockCompactionPlanContext_Expecter) getPausedCollections() *MockCompactionPlanContext_getPausedCollections_Call {
+	return &MockCompactionPlanContext_getPausedCollections_Call{Call: _e.mock.On("getPausedCollections")}
+}
+
+func (_c *MockCompactionPlanContext_getPausedCollections_Call) Run(run func()) *MockCompactionPlanContext_getPausedCollections_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockCompactionPlanContext_getPausedCollections_Call) Return(_a0 []int64) *MockCompactionPlanContext_getPausedCollections_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockCompactionPlanContext_getPausedCollections_Call) RunAndReturn(run func() []int64) *MockCompactionPlanContext_getPausedCollections_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // isFull provides a mock function with given fields:
 func (_m *MockCompactionPlanContext) isFull() bool {
 	ret := _m.Called()
@@ -314,6 +407,133 @@ func (_c *MockCompactionPlanContext_isFull_Call) RunAndReturn(run func() bool) *
 	return _c
 }
 
+// listCompactionTasks provides a mock function with given fields: filters
+func (_m *MockCompactionPlanContext) listCompactionTasks(filters ...compactionTaskFilter) []*datapb.CompactionTask {
+	_va := make([]interface{}, len(filters))
+	for _i := range filters {
+		_va[_i] = filters[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for listCompactionTasks")
+	}
+
+	var r0 []*datapb.CompactionTask
+	if rf, ok := ret.Get(0).(func(...compactionTaskFilter) []*datapb.CompactionTask); ok {
+		r0 = rf(filters...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*datapb.CompactionTask)
+		}
+	}
+
+	return r0
+}
+
+// MockCompactionPlanContext_listCompactionTasks_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'listCompactionTasks'
+type MockCompactionPlanContext_listCompactionTasks_Call struct {
+	*mock.Call
+}
+
+// listCompactionTasks is a helper method to define mock.On call
+//   - filters ...compactionTaskFilter
+func (_e *MockCompactionPlanContext_Expecter) listCompactionTasks(filters ...interface{}) *MockCompactionPlanContext_listCompactionTasks_Call {
+	return &MockCompactionPlanContext_listCompactionTasks_Call{Call: _e.mock.On("listCompactionTasks",
+		append([]interface{}{}, filters...)...)}
+}
+
+func (_c *MockCompactionPlanContext_listCompactionTasks_Call) Run(run func(filters ...compactionTaskFilter)) *MockCompactionPlanContext_listCompactionTasks_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]compactionTaskFilter, len(args)-0)
+		for i, a := range args[0:] {
+			if a != nil {
+				variadicArgs[i] = a.(compactionTaskFilter)
+			}
+		}
+		run(variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockCompactionPlanContext_listCompactionTasks_Call) Return(_a0 []*datapb.CompactionTask) *MockCompactionPlanContext_listCompactionTasks_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockCompactionPlanContext_listCompactionTasks_Call) RunAndReturn(run func(...compactionTaskFilter) []*datapb.CompactionTask) *MockCompactionPlanContext_listCompactionTasks_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// markManualTrigger provides a mock function with given fields: triggerID
+func (_m *MockCompactionPlanContext) markManualTrigger(triggerID int64) {
+	_m.Called(triggerID)
+}
+
+// MockCompactionPlanContext_markManualTrigger_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'markManualTrigger'
+type MockCompactionPlanContext_markManualTrigger_Call struct {
+	*mock.Call
+}
+
+// markManualTrigger is a helper method to define mock.On call
+//   - triggerID int64
+func (_e *MockCompactionPlanContext_Expecter) markManualTrigger(triggerID interface{}) *MockCompactionPlanContext_markManualTrigger_Call {
+	return &MockCompactionPlanContext_markManualTrigger_Call{Call: _e.mock.On("markManualTrigger", triggerID)}
+}
+
+func (_c *MockCompactionPlanContext_markManualTrigger_Call) Run(run func(triggerID int64)) *MockCompactionPlanContext_markManualTrigger_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int64))
+	})
+	return _c
+}
+
+func (_c *MockCompactionPlanContext_markManualTrigger_Call) Return() *MockCompactionPlanContext_markManualTrigger_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockCompactionPlanContext_markManualTrigger_Call) RunAndReturn(run func(int64)) *MockCompactionPlanContext_markManualTrigger_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// pauseCompaction provides a mock function with given fields: collectionID
+func (_m *MockCompactionPlanContext) pauseCompaction(collectionID int64) {
+	_m.Called(collectionID)
+}
+
+// MockCompactionPlanContext_pauseCompaction_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'pauseCompaction'
+type MockCompactionPlanContext_pauseCompaction_Call struct {
+	*mock.Call
+}
+
+// pauseCompaction is a helper method to define mock.On call
+//   - collectionID int64
+func (_e *MockCompactionPlanContext_Expecter) pauseCompaction(collectionID interface{}) *MockCompactionPlanContext_pauseCompaction_Call {
+	return &MockCompactionPlanContext_pauseCompaction_Call{Call: _e.mock.On("pauseCompaction", collectionID)}
+}
+
+func (_c *MockCompactionPlanContext_pauseCompaction_Call) Run(run func(collectionID int64)) *MockCompactionPlanContext_pauseCompaction_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int64))
+	})
+	return _c
+}
+
+func (_c *MockCompactionPlanContext_pauseCompaction_Call) Return() *MockCompactionPlanContext_pauseCompaction_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockCompactionPlanContext_pauseCompaction_Call) RunAndReturn(run func(int64)) *MockCompactionPlanContext_pauseCompaction_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // removeTasksByChannel provides a mock function with given fields: channel
 func (_m *MockCompactionPlanContext) removeTasksByChannel(channel string) {
 	_m.Called(channel)
@@ -347,6 +567,39 @@ func (_c *MockCompactionPlanContext_removeTasksByChannel_Call) RunAndReturn(run
 	return _c
 }
 
+// resumeCompaction provides a mock function with given fields: collectionID
+func (_m *MockCompactionPlanContext) resumeCompaction(collectionID int64) {
+	_m.Called(collectionID)
+}
+
+// MockCompactionPlanContext_resumeCompaction_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'resumeCompaction'
+type MockCompactionPlanContext_resumeCompaction_Call struct {
+	*mock.Call
+}
+
+// resumeCompaction is a helper method to define mock.On call
+//   - collectionID int64
+func (_e *MockCompactionPlanContext_Expecter) resumeCompaction(collectionID interface{}) *MockCompactionPlanContext_resumeCompaction_Call {
+	return &MockCompactionPlanContext_resumeCompaction_Call{Call: _e.mock.On("resumeCompaction", collectionID)}
+}
+
+func (_c *MockCompactionPlanContext_resumeCompaction_Call) Run(run func(collectionID int64)) *MockCompactionPlanContext_resumeCompaction_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int64))
+	})
+	return _c
+}
+
+func (_c *MockCompactionPlanContext_resumeCompaction_Call) Return() *MockCompactionPlanContext_resumeCompaction_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockCompactionPlanContext_resumeCompaction_Call) RunAndReturn(run func(int64)) *MockCompactionPlanContext_resumeCompaction_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // setTaskScheduler provides a mock function with given fields: scheduler
 func (_m *MockCompactionPlanContext) setTaskScheduler(scheduler *taskScheduler) {
 	_m.Called(scheduler)
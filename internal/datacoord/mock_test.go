@@ -302,6 +302,18 @@ func (c *mockDataNodeClient) DropCompactionPlan(ctx context.Context, req *datapb
 	return merr.Success(), nil
 }
 
+func (c *mockDataNodeClient) ExportV2(ctx context.Context, req *datapb.ExportRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return merr.Success(), nil
+}
+
+func (c *mockDataNodeClient) QueryExport(ctx context.Context, req *datapb.QueryExportRequest, opts ...grpc.CallOption) (*datapb.QueryExportResponse, error) {
+	return &datapb.QueryExportResponse{Status: merr.Success()}, nil
+}
+
+func (c *mockDataNodeClient) DropExport(ctx context.Context, req *datapb.DropExportRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return merr.Success(), nil
+}
+
 func (c *mockDataNodeClient) Stop() error {
 	c.state = commonpb.StateCode_Abnormal
 	return nil
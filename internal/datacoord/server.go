@@ -125,6 +125,8 @@ type Server struct {
 	importMeta       ImportMeta
 	importScheduler  ImportScheduler
 	importChecker    ImportChecker
+	exportMeta       ExportMeta
+	exportScheduler  ExportScheduler
 
 	compactionTrigger        trigger
 	compactionHandler        compactionPlanContext
@@ -386,6 +388,10 @@ func (s *Server) initDataCoord() error {
 	if err != nil {
 		return err
 	}
+	s.exportMeta, err = NewExportMeta(s.ctx, s.meta.catalog)
+	if err != nil {
+		return err
+	}
 	s.initCompaction()
 	log.Info("init compaction done")
 
@@ -405,6 +411,8 @@ func (s *Server) initDataCoord() error {
 	s.importScheduler = NewImportScheduler(s.meta, s.cluster, s.allocator, s.importMeta)
 	s.importChecker = NewImportChecker(s.meta, s.broker, s.cluster, s.allocator, s.importMeta, s.jobManager, s.compactionTriggerManager)
 
+	s.exportScheduler = NewExportScheduler(s.meta, s.cluster, s.allocator, s.exportMeta)
+
 	s.syncSegmentsScheduler = newSyncSegmentsScheduler(s.meta, s.channelManager, s.sessionManager)
 
 	s.serverLoopCtx, s.serverLoopCancel = context.WithCancel(s.ctx)
@@ -433,6 +441,9 @@ func (s *Server) Start() error {
 func (s *Server) startDataCoord() {
 	s.startTaskScheduler()
 	s.startServerLoop()
+	registerExportRoute(s)
+	registerSnapshotRoute(s)
+	registerTaskControlRoute(s)
 
 	// http.Register(&http.Handler{
 	// 	Path: "/datacoord/garbage_collection/pause",
@@ -544,6 +555,7 @@ func (s *Server) initGarbageCollection(cli storage.ChunkManager) {
 	s.garbageCollector = newGarbageCollector(s.meta, s.handler, GcOption{
 		cli:              cli,
 		broker:           s.broker,
+		exportMeta:       s.exportMeta,
 		enabled:          Params.DataCoordCfg.EnableGarbageCollection.GetAsBool(),
 		checkInterval:    Params.DataCoordCfg.GCInterval.GetAsDuration(time.Second),
 		scanInterval:     Params.DataCoordCfg.GCScanIntervalInHour.GetAsDuration(time.Hour),
@@ -747,6 +759,7 @@ func (s *Server) startServerLoop() {
 	s.startFlushLoop(s.serverLoopCtx)
 	go s.importScheduler.Start()
 	go s.importChecker.Start()
+	go s.exportScheduler.Start()
 	s.garbageCollector.start()
 
 	if !(streamingutil.IsStreamingServiceEnabled() || paramtable.Get().DataNodeCfg.SkipBFStatsLoad.GetAsBool()) {
@@ -1079,6 +1092,7 @@ func (s *Server) Stop() error {
 
 	s.importScheduler.Close()
 	s.importChecker.Close()
+	s.exportScheduler.Close()
 	s.syncSegmentsScheduler.Stop()
 
 	s.stopCompaction()
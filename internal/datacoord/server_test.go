@@ -1705,6 +1705,8 @@ func TestGetCompactionState(t *testing.T) {
 				{State: datapb.CompactionTaskState_timeout},
 				{State: datapb.CompactionTaskState_timeout},
 				{State: datapb.CompactionTaskState_timeout},
+				{State: datapb.CompactionTaskState_failed, PlanID: 3, FailReason: compactionCanceledReason},
+				{State: datapb.CompactionTaskState_cleaned, PlanID: 4, FailReason: compactionCanceledReason},
 			})
 		mockHandler := newCompactionPlanHandler(nil, nil, mockMeta, nil, nil)
 		svr.compactionHandler = mockHandler
@@ -1714,7 +1716,8 @@ func TestGetCompactionState(t *testing.T) {
 		assert.Equal(t, commonpb.CompactionState_Executing, resp.GetState())
 		assert.EqualValues(t, 3, resp.GetExecutingPlanNo())
 		assert.EqualValues(t, 2, resp.GetCompletedPlanNo())
-		assert.EqualValues(t, 1, resp.GetFailedPlanNo())
+		// the canceled plans are counted as failed
+		assert.EqualValues(t, 3, resp.GetFailedPlanNo())
 		assert.EqualValues(t, 4, resp.GetTimeoutPlanNo())
 	})
 
@@ -1744,6 +1747,7 @@ func TestManualCompaction(t *testing.T) {
 
 		mockHandler := NewMockCompactionPlanContext(t)
 		mockHandler.EXPECT().getCompactionTasksNumBySignalID(mock.Anything).Return(1)
+		mockHandler.EXPECT().markManualTrigger(mock.Anything).Return()
 		svr.compactionHandler = mockHandler
 		resp, err := svr.ManualCompaction(context.TODO(), &milvuspb.ManualCompactionRequest{
 			CollectionID: 1,
@@ -1179,6 +1179,7 @@ func (s *Server) ManualCompaction(ctx context.Context, req *milvuspb.ManualCompa
 		resp.CompactionID = -1
 		resp.CompactionPlanCount = 0
 	} else {
+		s.compactionHandler.markManualTrigger(id)
 		resp.CompactionID = id
 		resp.CompactionPlanCount = int32(taskCnt)
 	}
@@ -1214,9 +1215,11 @@ func (s *Server) GetCompactionState(ctx context.Context, req *milvuspb.GetCompac
 	resp.ExecutingPlanNo = int64(info.executingCnt)
 	resp.CompletedPlanNo = int64(info.completedCnt)
 	resp.TimeoutPlanNo = int64(info.timeoutCnt)
-	resp.FailedPlanNo = int64(info.failedCnt)
+	// the canceled plans are reported as failed ones
+	resp.FailedPlanNo = int64(info.failedCnt + info.canceledCnt)
 	log.Info("success to get compaction state", zap.Any("state", info.state), zap.Int("executing", info.executingCnt),
-		zap.Int("completed", info.completedCnt), zap.Int("failed", info.failedCnt), zap.Int("timeout", info.timeoutCnt))
+		zap.Int("completed", info.completedCnt), zap.Int("failed", info.failedCnt), zap.Int("timeout", info.timeoutCnt),
+		zap.Int("canceled", info.canceledCnt))
 
 	return resp, nil
 }
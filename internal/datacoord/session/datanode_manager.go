@@ -69,6 +69,9 @@ type DataNodeManager interface {
 	QueryPreImport(nodeID int64, in *datapb.QueryPreImportRequest) (*datapb.QueryPreImportResponse, error)
 	QueryImport(nodeID int64, in *datapb.QueryImportRequest) (*datapb.QueryImportResponse, error)
 	DropImport(nodeID int64, in *datapb.DropImportRequest) error
+	ExportV2(nodeID int64, in *datapb.ExportRequest) error
+	QueryExport(nodeID int64, in *datapb.QueryExportRequest) (*datapb.QueryExportResponse, error)
+	DropExport(nodeID int64, in *datapb.DropExportRequest) error
 	CheckHealth(ctx context.Context) error
 	QuerySlot(nodeID int64) (*datapb.QuerySlotResponse, error)
 	DropCompactionPlan(nodeID int64, req *datapb.DropCompactionPlanRequest) error
@@ -507,6 +510,61 @@ func (c *DataNodeManagerImpl) DropImport(nodeID int64, in *datapb.DropImportRequ
 	return merr.CheckRPCCall(status, err)
 }
 
+func (c *DataNodeManagerImpl) ExportV2(nodeID int64, in *datapb.ExportRequest) error {
+	log := log.With(
+		zap.Int64("nodeID", nodeID),
+		zap.Int64("jobID", in.GetJobID()),
+		zap.Int64("taskID", in.GetTaskID()),
+		zap.Int64("collectionID", in.GetCollectionID()),
+	)
+	ctx, cancel := context.WithTimeout(context.Background(), importTaskTimeout)
+	defer cancel()
+	cli, err := c.getClient(ctx, nodeID)
+	if err != nil {
+		log.Info("failed to get client", zap.Error(err))
+		return err
+	}
+	status, err := cli.ExportV2(ctx, in)
+	return merr.CheckRPCCall(status, err)
+}
+
+func (c *DataNodeManagerImpl) QueryExport(nodeID int64, in *datapb.QueryExportRequest) (*datapb.QueryExportResponse, error) {
+	log := log.With(
+		zap.Int64("nodeID", nodeID),
+		zap.Int64("jobID", in.GetJobID()),
+		zap.Int64("taskID", in.GetTaskID()),
+	)
+	ctx, cancel := context.WithTimeout(context.Background(), importTaskTimeout)
+	defer cancel()
+	cli, err := c.getClient(ctx, nodeID)
+	if err != nil {
+		log.Info("failed to get client", zap.Error(err))
+		return nil, err
+	}
+	resp, err := cli.QueryExport(ctx, in)
+	if err = merr.CheckRPCCall(resp.GetStatus(), err); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (c *DataNodeManagerImpl) DropExport(nodeID int64, in *datapb.DropExportRequest) error {
+	log := log.With(
+		zap.Int64("nodeID", nodeID),
+		zap.Int64("jobID", in.GetJobID()),
+		zap.Int64("taskID", in.GetTaskID()),
+	)
+	ctx, cancel := context.WithTimeout(context.Background(), importTaskTimeout)
+	defer cancel()
+	cli, err := c.getClient(ctx, nodeID)
+	if err != nil {
+		log.Info("failed to get client", zap.Error(err))
+		return err
+	}
+	status, err := cli.DropExport(ctx, in)
+	return merr.CheckRPCCall(status, err)
+}
+
 func (c *DataNodeManagerImpl) CheckHealth(ctx context.Context) error {
 	group, ctx := errgroup.WithContext(ctx)
 
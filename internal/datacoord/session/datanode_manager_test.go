@@ -220,3 +220,39 @@ func (s *DataNodeManagerSuite) TestImportV2() {
 		s.NoError(err)
 	})
 }
+
+func (s *DataNodeManagerSuite) TestExportV2() {
+	mockErr := errors.New("mock error")
+
+	s.Run("ExportV2", func() {
+		err := s.m.ExportV2(0, &datapb.ExportRequest{})
+		s.Error(err)
+
+		s.SetupTest()
+		s.dn.EXPECT().ExportV2(mock.Anything, mock.Anything).Return(merr.Success(), nil)
+		err = s.m.ExportV2(1000, &datapb.ExportRequest{})
+		s.NoError(err)
+	})
+
+	s.Run("QueryExport", func() {
+		_, err := s.m.QueryExport(0, &datapb.QueryExportRequest{})
+		s.Error(err)
+
+		s.SetupTest()
+		s.dn.EXPECT().QueryExport(mock.Anything, mock.Anything).Return(&datapb.QueryExportResponse{
+			Status: merr.Status(mockErr),
+		}, nil)
+		_, err = s.m.QueryExport(1000, &datapb.QueryExportRequest{})
+		s.Error(err)
+	})
+
+	s.Run("DropExport", func() {
+		err := s.m.DropExport(0, &datapb.DropExportRequest{})
+		s.Error(err)
+
+		s.SetupTest()
+		s.dn.EXPECT().DropExport(mock.Anything, mock.Anything).Return(merr.Success(), nil)
+		err = s.m.DropExport(1000, &datapb.DropExportRequest{})
+		s.NoError(err)
+	})
+}
@@ -0,0 +1,460 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/samber/lo"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/msgpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	management "github.com/milvus-io/milvus/internal/http"
+	"github.com/milvus-io/milvus/internal/json"
+	"github.com/milvus-io/milvus/internal/metastore/kv/binlog"
+	"github.com/milvus-io/milvus/internal/metastore/model"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/pkg/v2/common"
+	"github.com/milvus-io/milvus/pkg/v2/log"
+	"github.com/milvus-io/milvus/pkg/v2/proto/datapb"
+	"github.com/milvus-io/milvus/pkg/v2/util/funcutil"
+	"github.com/milvus-io/milvus/pkg/v2/util/merr"
+)
+
+// this file contains the snapshot management restful API handler
+var snapshotRouteRegisterOnce sync.Once
+
+func registerSnapshotRoute(s *Server) {
+	snapshotRouteRegisterOnce.Do(func() {
+		management.Register(&management.Handler{
+			Path:        management.RouteCreateSnapshot,
+			HandlerFunc: s.CreateSnapshot,
+		})
+		management.Register(&management.Handler{
+			Path:        management.RouteDropSnapshot,
+			HandlerFunc: s.DropSnapshot,
+		})
+		management.Register(&management.Handler{
+			Path:        management.RouteListSnapshots,
+			HandlerFunc: s.ListSnapshots,
+		})
+		management.Register(&management.Handler{
+			Path:        management.RouteCloneSnapshot,
+			HandlerFunc: s.CloneSnapshot,
+		})
+	})
+}
+
+// snapshotSummary is the snapshot returned by the list API, without the segment details.
+type snapshotSummary struct {
+	*model.Snapshot
+	NumSegments   int   `json:"num_segments"`
+	NumL0Segments int   `json:"num_l0_segments"`
+	NumRows       int64 `json:"num_rows"`
+	NumIndexes    int   `json:"num_segment_indexes"`
+}
+
+func newSnapshotSummary(snapshot *model.Snapshot) *snapshotSummary {
+	l0Segments := lo.Filter(snapshot.Segments, func(segment *datapb.SegmentInfo, _ int) bool {
+		return segment.GetLevel() == datapb.SegmentLevel_L0
+	})
+	return &snapshotSummary{
+		Snapshot:      snapshot,
+		NumSegments:   len(snapshot.Segments) - len(l0Segments),
+		NumL0Segments: len(l0Segments),
+		NumRows: lo.SumBy(snapshot.Segments, func(segment *datapb.SegmentInfo) int64 {
+			return segment.GetNumOfRows()
+		}),
+		NumIndexes: len(snapshot.SegmentIndexes),
+	}
+}
+
+// CreateSnapshot creates a snapshot named by the name param for the collection_id param. The collection
+// is flushed first, then the snapshot pins the flushed segments, including the L0 segments, and their
+// finished segment indexes.
+func (s *Server) CreateSnapshot(w http.ResponseWriter, req *http.Request) {
+	if err := merr.CheckHealthy(s.GetStateCode()); err != nil {
+		w.WriteHeader(http.StatusServiceUnavailable)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to create snapshot, %s"}`, err.Error())))
+		return
+	}
+	collectionID, name, err := parseSnapshotParams(req)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to create snapshot, %s"}`, err.Error())))
+		return
+	}
+	snapshot, err := s.createSnapshot(req.Context(), collectionID, name)
+	if err != nil {
+		status := http.StatusInternalServerError
+		if errors.Is(err, merr.ErrParameterInvalid) || errors.Is(err, merr.ErrCollectionNotFound) {
+			status = http.StatusBadRequest
+		}
+		w.WriteHeader(status)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to create snapshot, %s"}`, err.Error())))
+		return
+	}
+	bytes, err := json.Marshal(newSnapshotSummary(snapshot))
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to create snapshot, %s"}`, err.Error())))
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+	w.Write(bytes)
+}
+
+func (s *Server) createSnapshot(ctx context.Context, collectionID int64, name string) (*model.Snapshot, error) {
+	coll, err := s.handler.GetCollection(ctx, collectionID)
+	if err != nil {
+		return nil, err
+	}
+	if coll == nil {
+		return nil, merr.WrapErrCollectionNotFound(collectionID)
+	}
+	if s.meta.snapshotMeta.GetSnapshot(collectionID, name) != nil {
+		return nil, merr.WrapErrParameterInvalidMsg("snapshot %s already exists in collection %d", name, collectionID)
+	}
+	partitions, err := s.broker.ShowPartitions(ctx, collectionID)
+	if err != nil {
+		return nil, err
+	}
+	snapshotID, err := s.allocator.AllocID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	flushTs, err := s.flushCollection(ctx, coll)
+	if err != nil {
+		return nil, err
+	}
+
+	snapshot := &model.Snapshot{
+		ID:             snapshotID,
+		Name:           name,
+		CollectionID:   collectionID,
+		CreateTs:       flushTs,
+		VChannels:      coll.VChannelNames,
+		PartitionNames: make(map[int64]string),
+		Schema:         coll.Schema,
+	}
+	for i, partitionID := range partitions.GetPartitionIDs() {
+		snapshot.PartitionNames[partitionID] = partitions.GetPartitionNames()[i]
+	}
+	err = s.meta.AddSnapshot(ctx, snapshot, WithCollection(collectionID), SegmentFilterFunc(func(segment *SegmentInfo) bool {
+		return segment.GetState() == commonpb.SegmentState_Flushed && !segment.GetIsImporting() && !segment.GetIsInvisible() &&
+			// the segments created after the flush only hold the rows after the flush ts
+			segment.GetStartPosition().GetTimestamp() <= flushTs
+	}))
+	if err != nil {
+		return nil, err
+	}
+	log.Info("snapshot created", zap.Int64("collectionID", collectionID), zap.String("name", name),
+		zap.Int64("snapshotID", snapshotID), zap.Uint64("ts", flushTs), zap.Int("segments", len(snapshot.Segments)),
+		zap.Int("segmentIndexes", len(snapshot.SegmentIndexes)))
+	return snapshot, nil
+}
+
+// flushCollection flushes the collection and waits until the rows before the returned flush ts are flushed.
+func (s *Server) flushCollection(ctx context.Context, coll *collectionInfo) (Timestamp, error) {
+	resp, err := s.Flush(ctx, &datapb.FlushRequest{
+		DbID:         coll.DatabaseID,
+		CollectionID: coll.ID,
+	})
+	if err = merr.CheckRPCCall(resp, err); err != nil {
+		return 0, err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, Params.DataCoordCfg.SnapshotFlushTimeout.GetAsDuration(time.Second))
+	defer cancel()
+	ticker := time.NewTicker(time.Second)
+	defer ticker.Stop()
+	for {
+		state, err := s.GetFlushState(ctx, &datapb.GetFlushStateRequest{
+			SegmentIDs:   append(resp.GetSegmentIDs(), resp.GetFlushSegmentIDs()...),
+			FlushTs:      resp.GetFlushTs(),
+			CollectionID: coll.ID,
+		})
+		if err = merr.CheckRPCCall(state, err); err != nil {
+			return 0, err
+		}
+		if state.GetFlushed() {
+			return resp.GetFlushTs(), nil
+		}
+		select {
+		case <-ctx.Done():
+			return 0, errors.Wrapf(ctx.Err(), "wait for collection %d flushed", coll.ID)
+		case <-ticker.C:
+		}
+	}
+}
+
+// DropSnapshot drops the snapshot named by the name param of the collection_id param, the files
+// only referenced by the snapshot are recycled by the garbage collector afterwards.
+func (s *Server) DropSnapshot(w http.ResponseWriter, req *http.Request) {
+	collectionID, name, err := parseSnapshotParams(req)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to drop snapshot, %s"}`, err.Error())))
+		return
+	}
+	if err = s.meta.DropSnapshot(req.Context(), collectionID, name); err != nil {
+		status := http.StatusInternalServerError
+		if errors.Is(err, merr.ErrParameterInvalid) {
+			status = http.StatusBadRequest
+		}
+		w.WriteHeader(status)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to drop snapshot, %s"}`, err.Error())))
+		return
+	}
+	log.Info("snapshot dropped", zap.Int64("collectionID", collectionID), zap.String("name", name))
+	w.WriteHeader(http.StatusOK)
+	w.Write([]byte(`{"msg": "OK"}`))
+}
+
+// ListSnapshots returns the snapshots, of the collection_id param if set.
+func (s *Server) ListSnapshots(w http.ResponseWriter, req *http.Request) {
+	err := req.ParseForm()
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to list snapshots, %s"}`, err.Error())))
+		return
+	}
+	var collectionID int64
+	if str := req.FormValue("collection_id"); str != "" {
+		collectionID, err = strconv.ParseInt(str, 10, 64)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(fmt.Sprintf(`{"msg": "failed to list snapshots, %s"}`, err.Error())))
+			return
+		}
+	}
+	summaries := lo.Map(s.meta.snapshotMeta.ListSnapshots(collectionID), func(snapshot *model.Snapshot, _ int) *snapshotSummary {
+		return newSnapshotSummary(snapshot)
+	})
+	bytes, err := json.Marshal(summaries)
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to list snapshots, %s"}`, err.Error())))
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+	w.Write(bytes)
+}
+
+// CloneSnapshot clones the snapshot named by the name param of the collection_id param to the empty collection
+// of the target_collection_id param, which must have the same fields and shards as the snapshot, and the partitions
+// of the snapshot segments. The cloned segments share the binlogs of the snapshot without copying the data, and
+// their segment indexes are rebuilt by the indexes of the target collection.
+func (s *Server) CloneSnapshot(w http.ResponseWriter, req *http.Request) {
+	if err := merr.CheckHealthy(s.GetStateCode()); err != nil {
+		w.WriteHeader(http.StatusServiceUnavailable)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to clone snapshot, %s"}`, err.Error())))
+		return
+	}
+	collectionID, name, err := parseSnapshotParams(req)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to clone snapshot, %s"}`, err.Error())))
+		return
+	}
+	targetID, err := strconv.ParseInt(req.FormValue("target_collection_id"), 10, 64)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to clone snapshot, invalid target_collection_id '%s'"}`, req.FormValue("target_collection_id"))))
+		return
+	}
+	segments, err := s.cloneSnapshot(req.Context(), collectionID, name, targetID)
+	if err != nil {
+		status := http.StatusInternalServerError
+		if errors.Is(err, merr.ErrParameterInvalid) || errors.Is(err, merr.ErrCollectionNotFound) {
+			status = http.StatusBadRequest
+		}
+		w.WriteHeader(status)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to clone snapshot, %s"}`, err.Error())))
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+	w.Write([]byte(fmt.Sprintf(`{"msg": "OK", "num_segments": %d}`, len(segments))))
+}
+
+func (s *Server) cloneSnapshot(ctx context.Context, collectionID int64, name string, targetID int64) ([]*SegmentInfo, error) {
+	snapshot := s.meta.snapshotMeta.GetSnapshot(collectionID, name)
+	if snapshot == nil {
+		return nil, merr.WrapErrParameterInvalidMsg("snapshot %s not found in collection %d", name, collectionID)
+	}
+	if snapshot.Schema == nil {
+		return nil, merr.WrapErrParameterInvalidMsg("snapshot %s has no schema and can't be cloned", name)
+	}
+	target, err := s.handler.GetCollection(ctx, targetID)
+	if err != nil {
+		return nil, err
+	}
+	if target == nil {
+		return nil, merr.WrapErrCollectionNotFound(targetID)
+	}
+	if err = checkCloneSchema(snapshot.Schema, target.Schema); err != nil {
+		return nil, err
+	}
+	if len(target.VChannelNames) != len(snapshot.VChannels) {
+		return nil, merr.WrapErrParameterInvalidMsg("the target collection has %d shards, but the snapshot has %d",
+			len(target.VChannelNames), len(snapshot.VChannels))
+	}
+	existing := s.meta.SelectSegments(ctx, WithCollection(targetID), SegmentFilterFunc(func(segment *SegmentInfo) bool {
+		return segment.GetState() != commonpb.SegmentState_Dropped
+	}))
+	if len(existing) > 0 {
+		return nil, merr.WrapErrParameterInvalidMsg("the target collection %d is not empty", targetID)
+	}
+	partitions, err := s.broker.ShowPartitions(ctx, targetID)
+	if err != nil {
+		return nil, err
+	}
+	targetPartitions := make(map[string]int64)
+	for i, partitionName := range partitions.GetPartitionNames() {
+		targetPartitions[partitionName] = partitions.GetPartitionIDs()[i]
+	}
+	// the shards are mapped by the shard index
+	targetChannels := make(map[string]string)
+	for i, vchannel := range snapshot.VChannels {
+		targetChannels[vchannel] = target.VChannelNames[i]
+	}
+
+	start, _, err := s.allocator.AllocN(int64(len(snapshot.Segments)))
+	if err != nil {
+		return nil, err
+	}
+	segments := make([]*SegmentInfo, 0, len(snapshot.Segments))
+	for i, source := range snapshot.Segments {
+		partitionID := source.GetPartitionID()
+		if partitionID != common.AllPartitionsID {
+			var ok bool
+			partitionName := snapshot.PartitionNames[partitionID]
+			if partitionID, ok = targetPartitions[partitionName]; !ok {
+				return nil, merr.WrapErrParameterInvalidMsg("partition %s not found in the target collection", partitionName)
+			}
+		}
+		vchannel, ok := targetChannels[source.GetInsertChannel()]
+		if !ok {
+			return nil, merr.WrapErrParameterInvalidMsg("channel %s of segment %d not found in the snapshot", source.GetInsertChannel(), source.GetID())
+		}
+		segment, err := cloneSnapshotSegment(source, start+int64(i), targetID, partitionID, vchannel, s.meta.GetChannelCheckpoint(vchannel))
+		if err != nil {
+			return nil, err
+		}
+		segments = append(segments, segment)
+	}
+	if err = s.meta.AddClonedSegments(ctx, snapshot, segments); err != nil {
+		return nil, err
+	}
+	log.Info("snapshot cloned", zap.Int64("collectionID", collectionID), zap.String("name", name),
+		zap.Int64("targetCollectionID", targetID), zap.Int("segments", len(segments)))
+	return segments, nil
+}
+
+// checkCloneSchema checks the fields of the target collection are the same as the snapshot's,
+// so that the binlogs of the snapshot could be read as the target collection's.
+func checkCloneSchema(source, target *schemapb.CollectionSchema) error {
+	if len(source.GetFields()) != len(target.GetFields()) {
+		return merr.WrapErrParameterInvalidMsg("the target collection has %d fields, but the snapshot has %d",
+			len(target.GetFields()), len(source.GetFields()))
+	}
+	targetFields := lo.SliceToMap(target.GetFields(), func(field *schemapb.FieldSchema) (int64, *schemapb.FieldSchema) {
+		return field.GetFieldID(), field
+	})
+	for _, field := range source.GetFields() {
+		targetField, ok := targetFields[field.GetFieldID()]
+		if !ok || targetField.GetName() != field.GetName() ||
+			targetField.GetDataType() != field.GetDataType() ||
+			targetField.GetElementType() != field.GetElementType() ||
+			targetField.GetIsPrimaryKey() != field.GetIsPrimaryKey() ||
+			targetField.GetNullable() != field.GetNullable() ||
+			!maps.Equal(funcutil.KeyValuePair2Map(targetField.GetTypeParams()), funcutil.KeyValuePair2Map(field.GetTypeParams())) {
+			return merr.WrapErrParameterInvalidMsg("field %s of the target collection mismatches the snapshot", field.GetName())
+		}
+	}
+	return nil
+}
+
+// cloneSnapshotSegment clones the segment of the snapshot to the target collection, the binlogs are
+// referenced by the full paths of the source segment. The segment is cloned as an L1 segment since
+// the partition stats of the source are not cloned, and its text indexes are rebuilt.
+func cloneSnapshotSegment(source *datapb.SegmentInfo, segmentID, collectionID, partitionID int64,
+	vchannel string, checkpoint *msgpb.MsgPosition,
+) (*SegmentInfo, error) {
+	if source.GetStorageVersion() == storage.StorageV2 {
+		return nil, merr.WrapErrParameterInvalidMsg("segment %d of storage v2 can't be cloned", source.GetID())
+	}
+	info := proto.Clone(source).(*datapb.SegmentInfo)
+	if err := binlog.DecompressBinLogs(info); err != nil {
+		return nil, err
+	}
+	clonePosition := func(pos *msgpb.MsgPosition) *msgpb.MsgPosition {
+		if pos == nil {
+			return nil
+		}
+		return &msgpb.MsgPosition{
+			ChannelName: vchannel,
+			MsgID:       checkpoint.GetMsgID(),
+			MsgGroup:    checkpoint.GetMsgGroup(),
+			Timestamp:   pos.GetTimestamp(),
+		}
+	}
+	info.ID = segmentID
+	info.CollectionID = collectionID
+	info.PartitionID = partitionID
+	info.InsertChannel = vchannel
+	info.StartPosition = clonePosition(source.GetStartPosition())
+	info.DmlPosition = clonePosition(source.GetDmlPosition())
+	info.CreatedByCompaction = false
+	info.CompactionFrom = nil
+	info.Compacted = false
+	info.DroppedAt = 0
+	info.TextStatsLogs = nil
+	if info.GetLevel() == datapb.SegmentLevel_L2 {
+		info.Level = datapb.SegmentLevel_L1
+	}
+	info.LastLevel = info.GetLevel()
+	info.PartitionStatsVersion = 0
+	info.LastPartitionStatsVersion = 0
+	return NewSegmentInfo(info), nil
+}
+
+func parseSnapshotParams(req *http.Request) (int64, string, error) {
+	if err := req.ParseForm(); err != nil {
+		return 0, "", err
+	}
+	collectionID, err := strconv.ParseInt(req.FormValue("collection_id"), 10, 64)
+	if err != nil {
+		return 0, "", merr.WrapErrParameterInvalidMsg("invalid collection_id '%s'", req.FormValue("collection_id"))
+	}
+	name := strings.TrimSpace(req.FormValue("name"))
+	if name == "" {
+		return 0, "", merr.WrapErrParameterInvalidMsg("snapshot name is empty")
+	}
+	return collectionID, name, nil
+}
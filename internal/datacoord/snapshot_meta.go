@@ -0,0 +1,182 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/metastore"
+	"github.com/milvus-io/milvus/internal/metastore/model"
+	"github.com/milvus-io/milvus/pkg/v2/log"
+	"github.com/milvus-io/milvus/pkg/v2/util/merr"
+	"github.com/milvus-io/milvus/pkg/v2/util/timerecord"
+	"github.com/milvus-io/milvus/pkg/v2/util/typeutil"
+)
+
+// snapshotMeta keeps the snapshots of the collections. The segments and the segment indexes
+// referenced by the snapshots are pinned, the garbage collector keeps their meta and files
+// even after they are dropped, until all the snapshots referencing them are dropped.
+type snapshotMeta struct {
+	sync.RWMutex
+	ctx     context.Context
+	catalog metastore.DataCoordCatalog
+
+	snapshots map[int64]*model.Snapshot // snapshot id -> snapshot
+	// the reference counts of the pinned segments and index builds
+	pinnedSegments map[int64]int
+	pinnedBuildIDs map[int64]int
+}
+
+func newSnapshotMeta(ctx context.Context, catalog metastore.DataCoordCatalog) (*snapshotMeta, error) {
+	sm := &snapshotMeta{
+		ctx:            ctx,
+		catalog:        catalog,
+		snapshots:      make(map[int64]*model.Snapshot),
+		pinnedSegments: make(map[int64]int),
+		pinnedBuildIDs: make(map[int64]int),
+	}
+	if err := sm.reloadFromKV(); err != nil {
+		return nil, err
+	}
+	return sm, nil
+}
+
+func (sm *snapshotMeta) reloadFromKV() error {
+	record := timerecord.NewTimeRecorder("snapshotMeta-reloadFromKV")
+	snapshots, err := sm.catalog.ListSnapshots(sm.ctx)
+	if err != nil {
+		return err
+	}
+	for _, snapshot := range snapshots {
+		sm.addSnapshot(snapshot)
+	}
+	log.Info("DataCoord snapshotMeta reloadFromKV done", zap.Int("snapshots", len(snapshots)),
+		zap.Duration("duration", record.ElapseSpan()))
+	return nil
+}
+
+func (sm *snapshotMeta) addSnapshot(snapshot *model.Snapshot) {
+	sm.snapshots[snapshot.ID] = snapshot
+	for _, segment := range snapshot.Segments {
+		sm.pinnedSegments[segment.GetID()]++
+	}
+	for _, segIdx := range snapshot.SegmentIndexes {
+		sm.pinnedBuildIDs[segIdx.BuildID]++
+	}
+}
+
+func (sm *snapshotMeta) removeSnapshot(snapshot *model.Snapshot) {
+	delete(sm.snapshots, snapshot.ID)
+	for _, segment := range snapshot.Segments {
+		if sm.pinnedSegments[segment.GetID()]--; sm.pinnedSegments[segment.GetID()] <= 0 {
+			delete(sm.pinnedSegments, segment.GetID())
+		}
+	}
+	for _, segIdx := range snapshot.SegmentIndexes {
+		if sm.pinnedBuildIDs[segIdx.BuildID]--; sm.pinnedBuildIDs[segIdx.BuildID] <= 0 {
+			delete(sm.pinnedBuildIDs, segIdx.BuildID)
+		}
+	}
+}
+
+func (sm *snapshotMeta) getSnapshot(collectionID int64, name string) *model.Snapshot {
+	for _, snapshot := range sm.snapshots {
+		if snapshot.CollectionID == collectionID && snapshot.Name == name {
+			return snapshot
+		}
+	}
+	return nil
+}
+
+// AddSnapshot saves the snapshot, the snapshot names are unique in a collection.
+func (sm *snapshotMeta) AddSnapshot(snapshot *model.Snapshot) error {
+	sm.Lock()
+	defer sm.Unlock()
+	if sm.getSnapshot(snapshot.CollectionID, snapshot.Name) != nil {
+		return merr.WrapErrParameterInvalidMsg("snapshot %s already exists in collection %d",
+			snapshot.Name, snapshot.CollectionID)
+	}
+	if err := sm.catalog.SaveSnapshot(sm.ctx, snapshot); err != nil {
+		return err
+	}
+	sm.addSnapshot(snapshot)
+	return nil
+}
+
+// DropSnapshot drops the snapshot and unpins its segments and segment indexes. The snapshot can't be
+// dropped if any segment only pinned by it is in the referenced segments, whose binlogs are shared by
+// the segments cloned from the snapshot.
+func (sm *snapshotMeta) DropSnapshot(collectionID int64, name string, referenced typeutil.UniqueSet) error {
+	sm.Lock()
+	defer sm.Unlock()
+	snapshot := sm.getSnapshot(collectionID, name)
+	if snapshot == nil {
+		return merr.WrapErrParameterInvalidMsg("snapshot %s not found in collection %d", name, collectionID)
+	}
+	for _, segment := range snapshot.Segments {
+		if referenced.Contain(segment.GetID()) && sm.pinnedSegments[segment.GetID()] <= 1 {
+			return merr.WrapErrParameterInvalidMsg("the binlogs of segment %d in snapshot %s are still shared by the cloned segments, "+
+				"drop the collections cloned from the snapshot first", segment.GetID(), name)
+		}
+	}
+	if err := sm.catalog.DropSnapshot(sm.ctx, collectionID, snapshot.ID); err != nil {
+		return err
+	}
+	sm.removeSnapshot(snapshot)
+	return nil
+}
+
+func (sm *snapshotMeta) GetSnapshot(collectionID int64, name string) *model.Snapshot {
+	sm.RLock()
+	defer sm.RUnlock()
+	return sm.getSnapshot(collectionID, name)
+}
+
+// ListSnapshots returns the snapshots of the collection ordered by snapshot id,
+// the snapshots of all the collections are returned if collectionID is 0.
+func (sm *snapshotMeta) ListSnapshots(collectionID int64) []*model.Snapshot {
+	sm.RLock()
+	defer sm.RUnlock()
+	res := make([]*model.Snapshot, 0)
+	for _, snapshot := range sm.snapshots {
+		if collectionID == 0 || snapshot.CollectionID == collectionID {
+			res = append(res, snapshot)
+		}
+	}
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].ID < res[j].ID
+	})
+	return res
+}
+
+// IsSegmentPinned returns whether the segment is referenced by any snapshot.
+func (sm *snapshotMeta) IsSegmentPinned(segmentID int64) bool {
+	sm.RLock()
+	defer sm.RUnlock()
+	return sm.pinnedSegments[segmentID] > 0
+}
+
+// IsBuildIDPinned returns whether the index build is referenced by any snapshot.
+func (sm *snapshotMeta) IsBuildIDPinned(buildID int64) bool {
+	sm.RLock()
+	defer sm.RUnlock()
+	return sm.pinnedBuildIDs[buildID] > 0
+}
@@ -0,0 +1,206 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cockroachdb/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/msgpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/metastore/kv/binlog"
+	"github.com/milvus-io/milvus/internal/metastore/mocks"
+	"github.com/milvus-io/milvus/internal/metastore/model"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/pkg/v2/common"
+	"github.com/milvus-io/milvus/pkg/v2/proto/datapb"
+	"github.com/milvus-io/milvus/pkg/v2/util/merr"
+	"github.com/milvus-io/milvus/pkg/v2/util/typeutil"
+)
+
+func newTestSnapshot(id int64, name string, segmentIDs []int64, buildIDs []int64) *model.Snapshot {
+	snapshot := &model.Snapshot{ID: id, Name: name, CollectionID: 1}
+	for _, segmentID := range segmentIDs {
+		snapshot.Segments = append(snapshot.Segments, &datapb.SegmentInfo{ID: segmentID, CollectionID: 1})
+	}
+	for _, buildID := range buildIDs {
+		snapshot.SegmentIndexes = append(snapshot.SegmentIndexes, &model.SegmentIndex{CollectionID: 1, BuildID: buildID})
+	}
+	return snapshot
+}
+
+func TestSnapshotMeta(t *testing.T) {
+	ctx := context.TODO()
+	mockErr := errors.New("mock error")
+
+	t.Run("reload failed", func(t *testing.T) {
+		catalog := mocks.NewDataCoordCatalog(t)
+		catalog.EXPECT().ListSnapshots(mock.Anything).Return(nil, mockErr)
+		_, err := newSnapshotMeta(ctx, catalog)
+		assert.Error(t, err)
+	})
+
+	t.Run("normal", func(t *testing.T) {
+		catalog := mocks.NewDataCoordCatalog(t)
+		catalog.EXPECT().ListSnapshots(mock.Anything).Return([]*model.Snapshot{
+			newTestSnapshot(1, "s1", []int64{100, 101}, []int64{1000}),
+		}, nil)
+		sm, err := newSnapshotMeta(ctx, catalog)
+		assert.NoError(t, err)
+		assert.True(t, sm.IsSegmentPinned(100))
+		assert.True(t, sm.IsBuildIDPinned(1000))
+		assert.False(t, sm.IsSegmentPinned(102))
+
+		// duplicated name
+		err = sm.AddSnapshot(newTestSnapshot(2, "s1", []int64{101, 102}, nil))
+		assert.ErrorIs(t, err, merr.ErrParameterInvalid)
+
+		catalog.EXPECT().SaveSnapshot(mock.Anything, mock.Anything).Return(mockErr).Once()
+		err = sm.AddSnapshot(newTestSnapshot(2, "s2", []int64{101, 102}, []int64{1001}))
+		assert.Error(t, err)
+		assert.False(t, sm.IsSegmentPinned(102))
+
+		catalog.EXPECT().SaveSnapshot(mock.Anything, mock.Anything).Return(nil).Once()
+		err = sm.AddSnapshot(newTestSnapshot(2, "s2", []int64{101, 102}, []int64{1001}))
+		assert.NoError(t, err)
+		assert.True(t, sm.IsSegmentPinned(102))
+		assert.NotNil(t, sm.GetSnapshot(1, "s2"))
+		assert.Nil(t, sm.GetSnapshot(2, "s2"))
+
+		snapshots := sm.ListSnapshots(1)
+		assert.Equal(t, 2, len(snapshots))
+		assert.Equal(t, "s1", snapshots[0].Name)
+		assert.Empty(t, sm.ListSnapshots(2))
+		assert.Equal(t, 2, len(sm.ListSnapshots(0)))
+
+		err = sm.DropSnapshot(1, "s3", nil)
+		assert.ErrorIs(t, err, merr.ErrParameterInvalid)
+
+		// segment 100 only pinned by s1 is referenced by the cloned segments
+		err = sm.DropSnapshot(1, "s1", typeutil.NewUniqueSet(100))
+		assert.ErrorIs(t, err, merr.ErrParameterInvalid)
+		assert.True(t, sm.IsSegmentPinned(100))
+
+		catalog.EXPECT().DropSnapshot(mock.Anything, int64(1), int64(1)).Return(mockErr).Once()
+		err = sm.DropSnapshot(1, "s1", nil)
+		assert.Error(t, err)
+		assert.True(t, sm.IsSegmentPinned(100))
+
+		// the segment shared with s2 is still pinned, so it could be referenced by the cloned segments
+		catalog.EXPECT().DropSnapshot(mock.Anything, int64(1), int64(1)).Return(nil).Once()
+		err = sm.DropSnapshot(1, "s1", typeutil.NewUniqueSet(101))
+		assert.NoError(t, err)
+		assert.False(t, sm.IsSegmentPinned(100))
+		assert.True(t, sm.IsSegmentPinned(101))
+		assert.False(t, sm.IsBuildIDPinned(1000))
+		assert.True(t, sm.IsBuildIDPinned(1001))
+	})
+}
+
+func TestMeta_Snapshot(t *testing.T) {
+	ctx := context.TODO()
+	m, err := newMemoryMeta(t)
+	assert.NoError(t, err)
+
+	newSegment := func(id int64, state commonpb.SegmentState) *SegmentInfo {
+		return NewSegmentInfo(&datapb.SegmentInfo{
+			ID:            id,
+			CollectionID:  1,
+			PartitionID:   10,
+			InsertChannel: "ch-0",
+			State:         state,
+			NumOfRows:     100,
+			Binlogs: []*datapb.FieldBinlog{{
+				FieldID: 100,
+				Binlogs: []*datapb.Binlog{{LogID: id * 10, EntriesNum: 100}},
+			}},
+		})
+	}
+	assert.NoError(t, m.AddSegment(ctx, newSegment(100, commonpb.SegmentState_Flushed)))
+	assert.NoError(t, m.AddSegment(ctx, newSegment(101, commonpb.SegmentState_Growing)))
+
+	snapshot := &model.Snapshot{ID: 1, Name: "s1", CollectionID: 1}
+	err = m.AddSnapshot(ctx, snapshot, WithCollection(1), SegmentFilterFunc(func(segment *SegmentInfo) bool {
+		return segment.GetState() == commonpb.SegmentState_Flushed
+	}))
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(snapshot.Segments))
+	assert.True(t, m.snapshotMeta.IsSegmentPinned(100))
+	assert.False(t, m.snapshotMeta.IsSegmentPinned(101))
+
+	// clone the snapshot to collection 2
+	cloned, err := cloneSnapshotSegment(snapshot.Segments[0], 200, 2, 20, "ch-1",
+		&msgpb.MsgPosition{ChannelName: "ch-1", MsgID: []byte{1}})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2), cloned.GetCollectionID())
+	assert.Equal(t, "ch-1", cloned.GetInsertChannel())
+	assert.True(t, hasForeignBinlogs(cloned))
+	assert.True(t, getForeignBinlogOwners(cloned).Contain(100))
+	assert.Empty(t, getLogs(cloned))
+
+	err = m.AddClonedSegments(ctx, &model.Snapshot{Name: "s2", CollectionID: 1}, []*SegmentInfo{cloned})
+	assert.ErrorIs(t, err, merr.ErrParameterInvalid)
+	err = m.AddClonedSegments(ctx, snapshot, []*SegmentInfo{cloned})
+	assert.NoError(t, err)
+	segment := m.GetSegment(ctx, 200)
+	assert.NotNil(t, segment)
+	assert.False(t, segment.GetIsImporting())
+	sourcePath, err := binlog.BuildLogPath(storage.InsertBinlog, 1, 10, 100, 100, 1000)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{sourcePath}, getBinLogPaths(segment, 100))
+
+	// segment 100 is only pinned by the snapshot and shared by the cloned segment
+	m.segments.DropSegment(100)
+	err = m.DropSnapshot(ctx, 1, "s1")
+	assert.ErrorIs(t, err, merr.ErrParameterInvalid)
+	assert.True(t, m.snapshotMeta.IsSegmentPinned(100))
+
+	m.segments.DropSegment(200)
+	err = m.DropSnapshot(ctx, 1, "s1")
+	assert.NoError(t, err)
+	assert.False(t, m.snapshotMeta.IsSegmentPinned(100))
+}
+
+func TestCheckCloneSchema(t *testing.T) {
+	source := &schemapb.CollectionSchema{
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: 100, Name: "pk", DataType: schemapb.DataType_Int64, IsPrimaryKey: true},
+			{FieldID: 101, Name: "vec", DataType: schemapb.DataType_FloatVector, TypeParams: []*commonpb.KeyValuePair{
+				{Key: common.DimKey, Value: "8"},
+			}},
+		},
+	}
+	target := proto.Clone(source).(*schemapb.CollectionSchema)
+	assert.NoError(t, checkCloneSchema(source, target))
+
+	target.Fields[1].TypeParams[0].Value = "16"
+	assert.ErrorIs(t, checkCloneSchema(source, target), merr.ErrParameterInvalid)
+
+	target = proto.Clone(source).(*schemapb.CollectionSchema)
+	target.Fields[0].Name = "id"
+	assert.ErrorIs(t, checkCloneSchema(source, target), merr.ErrParameterInvalid)
+
+	target = proto.Clone(source).(*schemapb.CollectionSchema)
+	target.Fields = target.Fields[:1]
+	assert.ErrorIs(t, checkCloneSchema(source, target), merr.ErrParameterInvalid)
+}
@@ -0,0 +1,416 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"sync"
+
+	"github.com/cockroachdb/errors"
+	"github.com/samber/lo"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	management "github.com/milvus-io/milvus/internal/http"
+	"github.com/milvus-io/milvus/internal/json"
+	"github.com/milvus-io/milvus/internal/metastore/model"
+	"github.com/milvus-io/milvus/pkg/v2/log"
+	"github.com/milvus-io/milvus/pkg/v2/proto/datapb"
+	"github.com/milvus-io/milvus/pkg/v2/proto/internalpb"
+	"github.com/milvus-io/milvus/pkg/v2/proto/workerpb"
+	"github.com/milvus-io/milvus/pkg/v2/util/merr"
+	"github.com/milvus-io/milvus/pkg/v2/util/metricsinfo"
+)
+
+// this file contains the restful API handler to inspect and control the compaction, index build and import tasks
+var taskControlRouteRegisterOnce sync.Once
+
+func registerTaskControlRoute(s *Server) {
+	taskControlRouteRegisterOnce.Do(func() {
+		management.Register(&management.Handler{
+			Path:        management.RouteListCompactionTasks,
+			HandlerFunc: s.ListCompactionTasks,
+		})
+		management.Register(&management.Handler{
+			Path:        management.RouteCancelCompactionTask,
+			HandlerFunc: s.CancelCompactionTask,
+		})
+		management.Register(&management.Handler{
+			Path:        management.RoutePauseCompaction,
+			HandlerFunc: s.PauseCompaction,
+		})
+		management.Register(&management.Handler{
+			Path:        management.RouteResumeCompaction,
+			HandlerFunc: s.ResumeCompaction,
+		})
+		management.Register(&management.Handler{
+			Path:        management.RouteListIndexTasks,
+			HandlerFunc: s.ListIndexTasks,
+		})
+		management.Register(&management.Handler{
+			Path:        management.RouteCancelIndexTask,
+			HandlerFunc: s.CancelIndexTask,
+		})
+		management.Register(&management.Handler{
+			Path:        management.RouteResumeIndexTask,
+			HandlerFunc: s.ResumeIndexTask,
+		})
+		management.Register(&management.Handler{
+			Path:        management.RouteListImportJobs,
+			HandlerFunc: s.ListImportJobs,
+		})
+		management.Register(&management.Handler{
+			Path:        management.RouteCancelImportJob,
+			HandlerFunc: s.CancelImportJob,
+		})
+	})
+}
+
+// compactionTaskList is returned by the compaction list API.
+type compactionTaskList struct {
+	PausedCollections []string                      `json:"paused_collections"`
+	Tasks             []*metricsinfo.CompactionTask `json:"tasks"`
+}
+
+// importJobSummary is the import job returned by the import list API, without the files and the schema.
+type importJobSummary struct {
+	JobID          int64  `json:"job_id,string"`
+	CollectionID   int64  `json:"collection_id,string"`
+	CollectionName string `json:"collection_name,omitempty"`
+	State          string `json:"state"`
+	Reason         string `json:"reason,omitempty"`
+	Progress       int64  `json:"progress"`
+	ImportedRows   int64  `json:"imported_rows,string"`
+	TotalRows      int64  `json:"total_rows,string"`
+	StartTime      string `json:"start_time,omitempty"`
+	CompleteTime   string `json:"complete_time,omitempty"`
+}
+
+// ListCompactionTasks returns the queued and executing compaction tasks, of the collection_id param if set,
+// along with the collections whose compaction is paused.
+func (s *Server) ListCompactionTasks(w http.ResponseWriter, req *http.Request) {
+	collectionID, err := parseOptionalInt64Param(req, "collection_id")
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to list compaction tasks, %s"}`, err.Error())))
+		return
+	}
+	filters := make([]compactionTaskFilter, 0)
+	if collectionID != 0 {
+		filters = append(filters, CollectionIDCompactionTaskFilter(collectionID))
+	}
+	result := &compactionTaskList{
+		PausedCollections: lo.Map(s.compactionHandler.getPausedCollections(), func(collectionID int64, _ int) string {
+			return strconv.FormatInt(collectionID, 10)
+		}),
+		Tasks: lo.Map(s.compactionHandler.listCompactionTasks(filters...), func(task *datapb.CompactionTask, _ int) *metricsinfo.CompactionTask {
+			return newCompactionTaskStats(task)
+		}),
+	}
+	bytes, err := json.Marshal(result)
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to list compaction tasks, %s"}`, err.Error())))
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+	w.Write(bytes)
+}
+
+// CancelCompactionTask cancels the queued or executing compaction task of the plan_id param,
+// the input segments are released once the task is cleaned.
+func (s *Server) CancelCompactionTask(w http.ResponseWriter, req *http.Request) {
+	planID, err := parseRequiredInt64Param(req, "plan_id")
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to cancel compaction task, %s"}`, err.Error())))
+		return
+	}
+	if err = s.compactionHandler.cancelCompaction(planID); err != nil {
+		status := http.StatusInternalServerError
+		if errors.Is(err, merr.ErrParameterInvalid) {
+			status = http.StatusBadRequest
+		}
+		w.WriteHeader(status)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to cancel compaction task, %s"}`, err.Error())))
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+	w.Write([]byte(`{"msg": "OK"}`))
+}
+
+// PauseCompaction pauses the compaction of the collection_id param, no new compaction task is accepted
+// and the queued ones are not scheduled until resumed, while the executing ones run to the end.
+// The pause is kept in memory only, the compaction is resumed after datacoord restarts.
+func (s *Server) PauseCompaction(w http.ResponseWriter, req *http.Request) {
+	collectionID, err := parseRequiredInt64Param(req, "collection_id")
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to pause compaction, %s"}`, err.Error())))
+		return
+	}
+	coll, err := s.handler.GetCollection(req.Context(), collectionID)
+	if err == nil && coll == nil {
+		err = merr.WrapErrCollectionNotFound(collectionID)
+	}
+	if err != nil {
+		status := http.StatusInternalServerError
+		if errors.Is(err, merr.ErrCollectionNotFound) {
+			status = http.StatusBadRequest
+		}
+		w.WriteHeader(status)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to pause compaction, %s"}`, err.Error())))
+		return
+	}
+	s.compactionHandler.pauseCompaction(collectionID)
+	w.WriteHeader(http.StatusOK)
+	w.Write([]byte(`{"msg": "OK"}`))
+}
+
+// ResumeCompaction resumes the compaction of the collection_id param paused before.
+func (s *Server) ResumeCompaction(w http.ResponseWriter, req *http.Request) {
+	collectionID, err := parseRequiredInt64Param(req, "collection_id")
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to resume compaction, %s"}`, err.Error())))
+		return
+	}
+	s.compactionHandler.resumeCompaction(collectionID)
+	w.WriteHeader(http.StatusOK)
+	w.Write([]byte(`{"msg": "OK"}`))
+}
+
+// ListIndexTasks returns the unfinished index build tasks, of the collection_id param if set.
+func (s *Server) ListIndexTasks(w http.ResponseWriter, req *http.Request) {
+	collectionID, err := parseOptionalInt64Param(req, "collection_id")
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to list index tasks, %s"}`, err.Error())))
+		return
+	}
+	tasks := make([]*metricsinfo.IndexTaskStats, 0)
+	for _, segIdx := range s.meta.indexMeta.GetAllSegIndexes() {
+		if segIdx.IsDeleted || isIndexTaskDone(segIdx) {
+			continue
+		}
+		if collectionID != 0 && segIdx.CollectionID != collectionID {
+			continue
+		}
+		tasks = append(tasks, newIndexTaskStats(segIdx))
+	}
+	bytes, err := json.Marshal(tasks)
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to list index tasks, %s"}`, err.Error())))
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+	w.Write(bytes)
+}
+
+// CancelIndexTask cancels the unfinished index build task of the build_id param, the segment index is marked failed
+// and not rebuilt until it's resumed by ResumeIndexTask.
+func (s *Server) CancelIndexTask(w http.ResponseWriter, req *http.Request) {
+	buildID, err := parseRequiredInt64Param(req, "build_id")
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to cancel index task, %s"}`, err.Error())))
+		return
+	}
+	if err = s.cancelIndexTask(buildID); err != nil {
+		status := http.StatusInternalServerError
+		if errors.Is(err, merr.ErrParameterInvalid) {
+			status = http.StatusBadRequest
+		}
+		w.WriteHeader(status)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to cancel index task, %s"}`, err.Error())))
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+	w.Write([]byte(`{"msg": "OK"}`))
+}
+
+func (s *Server) cancelIndexTask(buildID int64) error {
+	segIdx, ok := s.meta.indexMeta.GetIndexJob(buildID)
+	if !ok || segIdx.IsDeleted {
+		return merr.WrapErrParameterInvalidMsg("index task %d not found", buildID)
+	}
+	if isIndexTaskDone(segIdx) {
+		return merr.WrapErrParameterInvalidMsg("index task %d is already %s", buildID, segIdx.IndexState.String())
+	}
+	// the task in the scheduler is failed and dropped from the worker by the scheduler,
+	// otherwise it's not scheduled yet and could be failed by the meta directly.
+	if s.taskScheduler.exist(buildID) {
+		s.taskScheduler.AbortTask(buildID)
+	} else if err := s.meta.indexMeta.FinishTask(&workerpb.IndexTaskInfo{
+		BuildID:    buildID,
+		State:      commonpb.IndexState_Failed,
+		FailReason: "canceled",
+	}); err != nil {
+		return err
+	}
+	log.Info("index task canceled", zap.Int64("buildID", buildID), zap.Int64("collectionID", segIdx.CollectionID),
+		zap.Int64("segmentID", segIdx.SegmentID), zap.Int64("indexID", segIdx.IndexID))
+	return nil
+}
+
+// ResumeIndexTask resets the canceled or failed index build task of the build_id param, and enqueues it to be built again.
+func (s *Server) ResumeIndexTask(w http.ResponseWriter, req *http.Request) {
+	buildID, err := parseRequiredInt64Param(req, "build_id")
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to resume index task, %s"}`, err.Error())))
+		return
+	}
+	if err = s.resumeIndexTask(buildID); err != nil {
+		status := http.StatusInternalServerError
+		if errors.Is(err, merr.ErrParameterInvalid) {
+			status = http.StatusBadRequest
+		}
+		w.WriteHeader(status)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to resume index task, %s"}`, err.Error())))
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+	w.Write([]byte(`{"msg": "OK"}`))
+}
+
+func (s *Server) resumeIndexTask(buildID int64) error {
+	segIdx, ok := s.meta.indexMeta.GetIndexJob(buildID)
+	if !ok || segIdx.IsDeleted {
+		return merr.WrapErrParameterInvalidMsg("index task %d not found", buildID)
+	}
+	if segIdx.IndexState != commonpb.IndexState_Failed {
+		return merr.WrapErrParameterInvalidMsg("index task %d is %s, only the failed task could be resumed", buildID, segIdx.IndexState.String())
+	}
+	// the canceled task is dropped from the scheduler once it's dropped from the worker.
+	if s.taskScheduler.exist(buildID) {
+		return merr.WrapErrParameterInvalidMsg("index task %d is still being canceled, retry later", buildID)
+	}
+	if err := s.meta.indexMeta.ResetTask(buildID); err != nil {
+		return err
+	}
+	s.taskScheduler.enqueue(newIndexBuildTask(buildID))
+	log.Info("index task resumed", zap.Int64("buildID", buildID), zap.Int64("collectionID", segIdx.CollectionID),
+		zap.Int64("segmentID", segIdx.SegmentID), zap.Int64("indexID", segIdx.IndexID))
+	return nil
+}
+
+func isIndexTaskDone(segIdx *model.SegmentIndex) bool {
+	return segIdx.IndexState == commonpb.IndexState_Finished || segIdx.IndexState == commonpb.IndexState_Failed
+}
+
+// ListImportJobs returns the import jobs, of the collection_id param if set.
+func (s *Server) ListImportJobs(w http.ResponseWriter, req *http.Request) {
+	collectionID, err := parseOptionalInt64Param(req, "collection_id")
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to list import jobs, %s"}`, err.Error())))
+		return
+	}
+	filters := make([]ImportJobFilter, 0)
+	if collectionID != 0 {
+		filters = append(filters, WithCollectionID(collectionID))
+	}
+	jobs := s.importMeta.GetJobBy(req.Context(), filters...)
+	summaries := lo.Map(jobs, func(job ImportJob, _ int) *importJobSummary {
+		progress, _, importedRows, totalRows, _ := GetJobProgress(job.GetJobID(), s.importMeta, s.meta, s.jobManager)
+		return &importJobSummary{
+			JobID:          job.GetJobID(),
+			CollectionID:   job.GetCollectionID(),
+			CollectionName: job.GetCollectionName(),
+			State:          job.GetState().String(),
+			Reason:         job.GetReason(),
+			Progress:       progress,
+			ImportedRows:   importedRows,
+			TotalRows:      totalRows,
+			StartTime:      job.GetStartTime(),
+			CompleteTime:   job.GetCompleteTime(),
+		}
+	})
+	bytes, err := json.Marshal(summaries)
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to list import jobs, %s"}`, err.Error())))
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+	w.Write(bytes)
+}
+
+// CancelImportJob cancels the unfinished import job of the job_id param, the job is failed
+// and its tasks and imported segments are cleaned up by the import checker.
+func (s *Server) CancelImportJob(w http.ResponseWriter, req *http.Request) {
+	jobID, err := parseRequiredInt64Param(req, "job_id")
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to cancel import job, %s"}`, err.Error())))
+		return
+	}
+	if err = s.cancelImportJob(req.Context(), jobID); err != nil {
+		status := http.StatusInternalServerError
+		if errors.Is(err, merr.ErrParameterInvalid) {
+			status = http.StatusBadRequest
+		}
+		w.WriteHeader(status)
+		w.Write([]byte(fmt.Sprintf(`{"msg": "failed to cancel import job, %s"}`, err.Error())))
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+	w.Write([]byte(`{"msg": "OK"}`))
+}
+
+func (s *Server) cancelImportJob(ctx context.Context, jobID int64) error {
+	job := s.importMeta.GetJob(ctx, jobID)
+	if job == nil {
+		return merr.WrapErrParameterInvalidMsg("import job %d not found", jobID)
+	}
+	if job.GetState() == internalpb.ImportJobState_Completed || job.GetState() == internalpb.ImportJobState_Failed {
+		return merr.WrapErrParameterInvalidMsg("import job %d is already %s", jobID, job.GetState().String())
+	}
+	err := s.importMeta.UpdateJob(ctx, jobID, UpdateJobState(internalpb.ImportJobState_Failed), UpdateJobReason("canceled by user"))
+	if err != nil {
+		return err
+	}
+	log.Info("import job canceled", zap.Int64("jobID", jobID), zap.Int64("collectionID", job.GetCollectionID()),
+		zap.String("previousState", job.GetState().String()))
+	return nil
+}
+
+func parseRequiredInt64Param(req *http.Request, name string) (int64, error) {
+	if err := req.ParseForm(); err != nil {
+		return 0, err
+	}
+	value, err := strconv.ParseInt(req.FormValue(name), 10, 64)
+	if err != nil {
+		return 0, merr.WrapErrParameterInvalidMsg("invalid %s '%s'", name, req.FormValue(name))
+	}
+	return value, nil
+}
+
+func parseOptionalInt64Param(req *http.Request, name string) (int64, error) {
+	if err := req.ParseForm(); err != nil {
+		return 0, err
+	}
+	if req.FormValue(name) == "" {
+		return 0, nil
+	}
+	return parseRequiredInt64Param(req, name)
+}
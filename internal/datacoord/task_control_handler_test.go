@@ -0,0 +1,100 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus/pkg/v2/proto/indexpb"
+	"github.com/milvus-io/milvus/pkg/v2/util/lock"
+	"github.com/milvus-io/milvus/pkg/v2/util/merr"
+)
+
+func TestCancelAndResumeIndexTask(t *testing.T) {
+	mt := &meta{indexMeta: updateSegmentIndexMeta(t)}
+	scheduler := &taskScheduler{
+		ctx:          context.Background(),
+		meta:         mt,
+		pendingTasks: newFairQueuePolicy(),
+		runningTasks: make(map[UniqueID]Task),
+		notifyChan:   make(chan struct{}, 1),
+		taskLock:     lock.NewKeyLock[int64](),
+	}
+	s := &Server{meta: mt, taskScheduler: scheduler}
+
+	t.Run("resume unfinished task", func(t *testing.T) {
+		err := s.resumeIndexTask(buildID)
+		assert.ErrorIs(t, err, merr.ErrParameterInvalid)
+	})
+
+	t.Run("resume not exist task", func(t *testing.T) {
+		err := s.resumeIndexTask(buildID + 1)
+		assert.ErrorIs(t, err, merr.ErrParameterInvalid)
+	})
+
+	t.Run("cancel and resume", func(t *testing.T) {
+		err := s.cancelIndexTask(buildID)
+		assert.NoError(t, err)
+		segIdx, ok := mt.indexMeta.GetIndexJob(buildID)
+		assert.True(t, ok)
+		assert.Equal(t, commonpb.IndexState_Failed, segIdx.IndexState)
+		assert.Equal(t, "canceled", segIdx.FailReason)
+
+		err = s.resumeIndexTask(buildID)
+		assert.NoError(t, err)
+		segIdx, ok = mt.indexMeta.GetIndexJob(buildID)
+		assert.True(t, ok)
+		assert.Equal(t, commonpb.IndexState_Unissued, segIdx.IndexState)
+		assert.Empty(t, segIdx.FailReason)
+		// the task is enqueued to be built again.
+		assert.True(t, scheduler.exist(buildID))
+		assert.Equal(t, indexpb.JobState_JobStateInit, scheduler.pendingTasks.Get(buildID).GetState())
+	})
+
+	t.Run("resume task being canceled", func(t *testing.T) {
+		err := s.cancelIndexTask(buildID)
+		assert.NoError(t, err)
+		// the task is failed by the scheduler, and dropped once it's processed.
+		assert.True(t, scheduler.exist(buildID))
+		err = scheduler.getRunningTask(buildID).SetJobInfo(mt)
+		assert.NoError(t, err)
+
+		err = s.resumeIndexTask(buildID)
+		assert.ErrorIs(t, err, merr.ErrParameterInvalid)
+
+		scheduler.removeRunningTask(buildID)
+		err = s.resumeIndexTask(buildID)
+		assert.NoError(t, err)
+		assert.True(t, scheduler.exist(buildID))
+	})
+
+	t.Run("http handler", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		s.ResumeIndexTask(w, httptest.NewRequest(http.MethodGet, "/management/datacoord/index/resume", nil))
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+
+		w = httptest.NewRecorder()
+		s.ResumeIndexTask(w, httptest.NewRequest(http.MethodGet, "/management/datacoord/index/resume?build_id=600", nil))
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
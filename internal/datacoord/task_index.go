@@ -27,6 +27,7 @@ import (
 	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
 	"github.com/milvus-io/milvus/internal/storage"
 	"github.com/milvus-io/milvus/internal/types"
+	"github.com/milvus-io/milvus/internal/util/indexparamcheck"
 	"github.com/milvus-io/milvus/internal/util/vecindexmgr"
 	"github.com/milvus-io/milvus/pkg/v2/common"
 	"github.com/milvus-io/milvus/pkg/v2/log"
@@ -184,6 +185,10 @@ func (it *indexBuildTask) PreCheck(ctx context.Context, dependency *taskSchedule
 		}
 	}
 
+	if indexparamcheck.IsBitmapIndex(indexType) {
+		indexParams = indexparams.AppendBitmapIndexBuildParams(Params, indexParams)
+	}
+
 	collectionInfo, err := dependency.handler.GetCollection(ctx, segment.GetCollectionID())
 	if err != nil {
 		log.Ctx(ctx).Info("index builder get collection info failed", zap.Int64("collectionID", segment.GetCollectionID()), zap.Error(err))
@@ -223,6 +228,7 @@ func (it *indexBuildTask) PreCheck(ctx context.Context, dependency *taskSchedule
 				FieldID:   partitionKeyField.FieldID,
 				FieldName: partitionKeyField.Name,
 				FieldType: int32(partitionKeyField.DataType),
+				DataPaths: getBinLogPaths(segment, partitionKeyField.FieldID),
 				DataIds:   getBinLogIDs(segment, partitionKeyField.FieldID),
 			})
 			iso, isoErr := common.IsPartitionKeyIsolationPropEnabled(collectionInfo.Properties)
@@ -254,6 +260,7 @@ func (it *indexBuildTask) PreCheck(ctx context.Context, dependency *taskSchedule
 		FieldType:                 field.GetDataType(),
 		Dim:                       int64(dim),
 		DataIds:                   binlogIDs,
+		DataPaths:                 getBinLogPaths(segment, fieldID),
 		OptionalScalarFields:      optionalFields,
 		Field:                     field,
 		PartitionKeyIsolation:     partitionKeyIsolation,
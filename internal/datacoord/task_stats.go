@@ -211,9 +211,17 @@ func (st *statsTask) PreCheck(ctx context.Context, dependency *taskScheduler) bo
 		return false
 	}
 
+	// the deletes in the time travel window are kept by sort
+	timetravel, err := getTimeTravelTs(collInfo.Properties, time.Now())
+	if err != nil {
+		log.Warn("stats task get collection time travel failed", zap.Int64("collectionID", segment.GetCollectionID()), zap.Error(err))
+		st.SetState(indexpb.JobState_JobStateInit, err.Error())
+		return false
+	}
+
 	binlogNum := (segment.getSegmentSize()/Params.DataNodeCfg.BinLogMaxSize.GetAsInt64() + 1) * int64(len(collInfo.Schema.GetFields())) * 100
-	// binlogNum + BM25logNum + statslogNum
-	start, end, err := dependency.allocator.AllocN(binlogNum + int64(len(collInfo.Schema.GetFunctions())) + 1)
+	// binlogNum + BM25logNum + statslogNum + deltalogNum
+	start, end, err := dependency.allocator.AllocN(binlogNum + int64(len(collInfo.Schema.GetFunctions())) + 2)
 	if err != nil {
 		log.Warn("stats task alloc logID failed", zap.Int64("collectionID", segment.GetCollectionID()), zap.Error(err))
 		st.SetState(indexpb.JobState_JobStateInit, err.Error())
@@ -239,6 +247,7 @@ func (st *statsTask) PreCheck(ctx context.Context, dependency *taskScheduler) bo
 		// update version after check
 		TaskVersion:   statsMeta.GetVersion() + 1,
 		BinlogMaxSize: Params.DataNodeCfg.BinLogMaxSize.GetAsUint64(),
+		Timetravel:    timetravel,
 	}
 
 	return true
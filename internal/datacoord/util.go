@@ -25,9 +25,12 @@ import (
 
 	"github.com/samber/lo"
 	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus/internal/metastore/kv/binlog"
+	"github.com/milvus-io/milvus/internal/storage"
 	"github.com/milvus-io/milvus/internal/util/vecindexmgr"
 	"github.com/milvus-io/milvus/pkg/v2/common"
 	"github.com/milvus-io/milvus/pkg/v2/log"
@@ -146,6 +149,32 @@ func getCollectionTTL(properties map[string]string) (time.Duration, error) {
 	return Params.CommonCfg.EntityExpirationTTL.GetAsDuration(time.Second), nil
 }
 
+// getCollectionTimeTravel returns the time travel window of the collection, 0 if it's not specified
+func getCollectionTimeTravel(properties map[string]string) (time.Duration, error) {
+	v, ok := properties[common.CollectionTimeTravelKey]
+	if !ok {
+		return 0, nil
+	}
+	seconds, err := strconv.Atoi(v)
+	if err != nil {
+		return 0, err
+	}
+	if seconds < 0 {
+		return 0, fmt.Errorf("invalid %s: %s", common.CollectionTimeTravelKey, v)
+	}
+	return time.Duration(seconds) * time.Second, nil
+}
+
+// getTimeTravelTs returns the ts before which the deletes could be applied by compaction,
+// 0 if the collection time travel is not enabled.
+func getTimeTravelTs(properties map[string]string, now time.Time) (uint64, error) {
+	window, err := getCollectionTimeTravel(properties)
+	if err != nil || window == 0 {
+		return 0, err
+	}
+	return tsoutil.ComposeTSByTime(now.Add(-window), 0), nil
+}
+
 func UpdateCompactionSegmentSizeMetrics(segments []*datapb.CompactionSegment) {
 	var totalSize int64
 	for _, seg := range segments {
@@ -285,6 +314,61 @@ func getBinLogIDs(segment *SegmentInfo, fieldID int64) []int64 {
 	return binlogIDs
 }
 
+// getBinLogPaths returns the binlog paths of the field if the segment shares any binlog of another segment,
+// e.g. a segment cloned from a snapshot, such binlogs can't be located by the log ids.
+// nil is returned if all the binlogs belong to the segment.
+func getBinLogPaths(segment *SegmentInfo, fieldID int64) []string {
+	for _, fieldBinLog := range segment.GetBinlogs() {
+		if fieldBinLog.GetFieldID() != fieldID {
+			continue
+		}
+		if !lo.ContainsBy(fieldBinLog.GetBinlogs(), func(l *datapb.Binlog) bool { return l.GetLogPath() != "" }) {
+			return nil
+		}
+		cloned := proto.Clone(fieldBinLog).(*datapb.FieldBinlog)
+		err := binlog.DecompressBinLog(storage.InsertBinlog, segment.GetCollectionID(), segment.GetPartitionID(),
+			segment.GetID(), []*datapb.FieldBinlog{cloned})
+		if err != nil {
+			return nil
+		}
+		return lo.Map(cloned.GetBinlogs(), func(l *datapb.Binlog, _ int) string {
+			return l.GetLogPath()
+		})
+	}
+	return nil
+}
+
+// hasForeignBinlogs returns whether the segment shares any insert binlog of another segment.
+func hasForeignBinlogs(segment *SegmentInfo) bool {
+	for _, fieldBinLog := range segment.GetBinlogs() {
+		for _, l := range fieldBinLog.GetBinlogs() {
+			if l.GetLogPath() != "" && binlog.IsForeignLogPath(storage.InsertBinlog, segment.GetID(), l.GetLogPath()) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// getForeignBinlogOwners returns the ids of the segments whose binlogs are shared by the segment.
+func getForeignBinlogOwners(segment *SegmentInfo) typeutil.UniqueSet {
+	owners := typeutil.NewUniqueSet()
+	collect := func(binlogType storage.BinlogType, fieldBinlogs []*datapb.FieldBinlog) {
+		for _, fieldBinLog := range fieldBinlogs {
+			for _, l := range fieldBinLog.GetBinlogs() {
+				if l.GetLogPath() != "" && binlog.IsForeignLogPath(binlogType, segment.GetID(), l.GetLogPath()) {
+					owners.Insert(binlog.GetSegmentIDFromLogPath(binlogType, l.GetLogPath()))
+				}
+			}
+		}
+	}
+	collect(storage.InsertBinlog, segment.GetBinlogs())
+	collect(storage.StatsBinlog, segment.GetStatslogs())
+	collect(storage.DeleteBinlog, segment.GetDeltalogs())
+	collect(storage.BM25Binlog, segment.GetBm25Statslogs())
+	return owners
+}
+
 func CheckCheckPointsHealth(meta *meta) error {
 	for channel, cp := range meta.GetChannelCheckpoints() {
 		collectionID := funcutil.GetCollectionIDFromVChannel(channel)
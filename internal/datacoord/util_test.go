@@ -169,6 +169,27 @@ func (suite *UtilSuite) TestGetCollectionTTL() {
 	suite.Equal(ttl, Params.CommonCfg.EntityExpirationTTL.GetAsDuration(time.Second))
 }
 
+func (suite *UtilSuite) TestGetTimeTravelTs() {
+	now := time.Now()
+	ts, err := getTimeTravelTs(map[string]string{}, now)
+	suite.NoError(err)
+	suite.EqualValues(0, ts)
+
+	ts, err = getTimeTravelTs(map[string]string{common.CollectionTimeTravelKey: "0"}, now)
+	suite.NoError(err)
+	suite.EqualValues(0, ts)
+
+	ts, err = getTimeTravelTs(map[string]string{common.CollectionTimeTravelKey: "3600"}, now)
+	suite.NoError(err)
+	suite.Equal(tsoutil.ComposeTSByTime(now.Add(-time.Hour), 0), ts)
+
+	_, err = getTimeTravelTs(map[string]string{common.CollectionTimeTravelKey: "bad_value"}, now)
+	suite.Error(err)
+
+	_, err = getTimeTravelTs(map[string]string{common.CollectionTimeTravelKey: "-1"}, now)
+	suite.Error(err)
+}
+
 func (suite *UtilSuite) TestGetCollectionAutoCompactionEnabled() {
 	properties := map[string]string{
 		common.CollectionAutoCompactionKey: "true",
@@ -128,9 +128,34 @@ type ClusterBuffer struct {
 	uploadedSegments     []*datapb.CompactionSegment
 	uploadedSegmentStats map[typeutil.UniqueID]storage.SegmentStats
 
+	// segID -> pk -> the deletes in the time travel window later than the row written to the segment
+	retainedDeletes     map[typeutil.UniqueID]map[interface{}][]typeutil.Timestamp
+	retainedDeletesLock sync.Mutex
+
 	clusteringKeyFieldStats *storage.FieldStats
 }
 
+func (b *ClusterBuffer) retainDeletes(segmentID typeutil.UniqueID, pk interface{}, tss []typeutil.Timestamp) {
+	b.retainedDeletesLock.Lock()
+	defer b.retainedDeletesLock.Unlock()
+	if b.retainedDeletes == nil {
+		b.retainedDeletes = make(map[typeutil.UniqueID]map[interface{}][]typeutil.Timestamp)
+	}
+	if b.retainedDeletes[segmentID] == nil {
+		b.retainedDeletes[segmentID] = make(map[interface{}][]typeutil.Timestamp)
+	}
+	b.retainedDeletes[segmentID][pk] = lo.Uniq(append(b.retainedDeletes[segmentID][pk], tss...))
+}
+
+// takeRetainedDeletes returns and clears the retained deletes of the rows written to the segment.
+func (b *ClusterBuffer) takeRetainedDeletes(segmentID typeutil.UniqueID) map[interface{}][]typeutil.Timestamp {
+	b.retainedDeletesLock.Lock()
+	defer b.retainedDeletesLock.Unlock()
+	deletes := b.retainedDeletes[segmentID]
+	delete(b.retainedDeletes, segmentID)
+	return deletes
+}
+
 type FlushSignal struct {
 	writer *SegmentWriter
 	pack   bool
@@ -572,7 +597,8 @@ func (t *clusteringCompactionTask) mappingSegment(
 			deltaPaths = append(deltaPaths, l.GetLogPath())
 		}
 	}
-	delta, err := compaction.ComposeDeleteFromDeltalogs(ctx, t.binlogIO, deltaPaths)
+	// the rows deleted in the time travel window are kept along with their deletes
+	delta, retained, err := compaction.ComposeDeleteFromDeltalogsWithTimeTravel(ctx, t.binlogIO, deltaPaths, t.plan.GetTimetravel())
 	if err != nil {
 		return err
 	}
@@ -659,7 +685,7 @@ func (t *clusteringCompactionTask) mappingSegment(
 			} else {
 				clusterBuffer = t.keyToBufferFunc(clusteringKey)
 			}
-			err = t.writeToBuffer(ctx, clusterBuffer, v)
+			err = t.writeToBuffer(ctx, clusterBuffer, v, retained[v.PK.GetValue()])
 			if err != nil {
 				return err
 			}
@@ -747,7 +773,8 @@ func (t *clusteringCompactionTask) mappingSegment(
 	return nil
 }
 
-func (t *clusteringCompactionTask) writeToBuffer(ctx context.Context, clusterBuffer *ClusterBuffer, value *storage.Value) error {
+// writeToBuffer writes the row to the buffer, retainedTss are the deletes of the row pk in the time travel window.
+func (t *clusteringCompactionTask) writeToBuffer(ctx context.Context, clusterBuffer *ClusterBuffer, value *storage.Value, retainedTss []typeutil.Timestamp) error {
 	t.clusterBufferLocks.Lock(clusterBuffer.id)
 	defer t.clusterBufferLocks.Unlock(clusterBuffer.id)
 	// prepare
@@ -760,6 +787,9 @@ func (t *clusteringCompactionTask) writeToBuffer(ctx context.Context, clusterBuf
 	if err != nil {
 		return err
 	}
+	if tss := lo.Filter(retainedTss, func(ts typeutil.Timestamp, _ int) bool { return ts > uint64(value.Timestamp) }); len(tss) > 0 {
+		clusterBuffer.retainDeletes(writer.(*SegmentWriter).GetSegmentID(), value.PK.GetValue(), tss)
+	}
 	t.writtenRowNum.Inc()
 	clusterBuffer.currentSegmentRowNum.Inc()
 	return nil
@@ -955,6 +985,15 @@ func (t *clusteringCompactionTask) packBufferToSegment(ctx context.Context, buff
 		seg.Bm25Logs = bm25Logs
 	}
 
+	deltalog, err := compaction.WriteRetainedDeletes(ctx, t.binlogIO, t.logIDAlloc, t.primaryKeyField.GetDataType(),
+		t.collectionID, t.partitionID, segmentID, buffer.takeRetainedDeletes(segmentID))
+	if err != nil {
+		return err
+	}
+	if deltalog != nil {
+		seg.Deltalogs = []*datapb.FieldBinlog{deltalog}
+	}
+
 	buffer.uploadedSegments = append(buffer.uploadedSegments, seg)
 	segmentStats := storage.SegmentStats{
 		FieldStats: []storage.FieldStats{buffer.clusteringKeyFieldStats.Clone()},
@@ -256,6 +256,70 @@ func (s *ClusteringCompactionTaskSuite) TestScalarCompactionNormal() {
 	)
 }
 
+func (s *ClusteringCompactionTaskSuite) TestScalarCompactionWithTimeTravel() {
+	deleteTs := tsoutil.ComposeTSByTime(getMilvusBirthday().Add(time.Second), 0)
+	dblobs, err := getInt64DeltaBlobs(1, []int64{100}, []uint64{deleteTs})
+	s.Require().NoError(err)
+	s.mockBinlogIO.EXPECT().Download(mock.Anything, []string{"1"}).
+		Return([][]byte{dblobs.GetValue()}, nil).Once()
+
+	schema := genCollectionSchema()
+	var segmentID int64 = 1001
+	segWriter, err := NewSegmentWriter(schema, 1000, compactionBatchSize, segmentID, PartitionID, CollectionID, []int64{})
+	s.Require().NoError(err)
+	for i := 0; i < 10240; i++ {
+		v := storage.Value{
+			PK:        storage.NewInt64PrimaryKey(int64(i)),
+			Timestamp: int64(tsoutil.ComposeTSByTime(getMilvusBirthday(), 0)),
+			Value:     genRow(int64(i)),
+		}
+		err = segWriter.Write(&v)
+		s.Require().NoError(err)
+	}
+	segWriter.FlushAndIsFull()
+
+	kvs, fBinlogs, err := serializeWrite(context.TODO(), s.mockAlloc, segWriter)
+	s.NoError(err)
+	s.mockBinlogIO.EXPECT().Download(mock.Anything, mock.Anything).Return(lo.Values(kvs), nil)
+
+	s.plan.SegmentBinlogs = []*datapb.CompactionSegmentBinlogs{
+		{
+			SegmentID:    segmentID,
+			FieldBinlogs: lo.Values(fBinlogs),
+			Deltalogs: []*datapb.FieldBinlog{
+				{Binlogs: []*datapb.Binlog{{LogID: 1, LogPath: "1"}}},
+			},
+		},
+	}
+
+	s.task.plan.Schema = genCollectionSchema()
+	s.task.plan.ClusteringKeyField = 100
+	s.task.plan.PreferSegmentRows = 2048
+	s.task.plan.MaxSegmentRows = 2048
+	s.task.plan.PreAllocatedSegmentIDs = &datapb.IDRange{
+		Begin: time.Now().UnixMilli(),
+		End:   time.Now().UnixMilli() + 1000,
+	}
+	// the delete is in the time travel window
+	s.task.plan.Timetravel = deleteTs - 1
+
+	compactionResult, err := s.task.Compact()
+	s.Require().NoError(err)
+	// the deleted row is kept along with its delete
+	s.EqualValues(10240,
+		lo.SumBy(compactionResult.GetSegments(), func(seg *datapb.CompactionSegment) int64 {
+			return seg.GetNumOfRows()
+		}),
+	)
+	deltalogs := lo.FlatMap(compactionResult.GetSegments(), func(seg *datapb.CompactionSegment, _ int) []*datapb.FieldBinlog {
+		return seg.GetDeltalogs()
+	})
+	s.Require().Equal(1, len(deltalogs))
+	s.Require().Equal(1, len(deltalogs[0].GetBinlogs()))
+	s.EqualValues(1, deltalogs[0].GetBinlogs()[0].GetEntriesNum())
+	s.Equal(deleteTs, deltalogs[0].GetBinlogs()[0].GetTimestampTo())
+}
+
 func (s *ClusteringCompactionTaskSuite) TestScalarCompactionNormalByMemoryLimit() {
 	schema := genCollectionSchema()
 	var segmentID int64 = 1001
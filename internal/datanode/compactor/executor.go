@@ -61,6 +61,10 @@ type executor struct {
 	// To prevent concurrency of release channel and compaction get results
 	// all released channel's compaction tasks will be discarded
 	resultGuard sync.RWMutex
+	// the plans stopped while executing, their results are discarded once they quit, guarded by resultGuard
+	canceled typeutil.UniqueSet
+	// the plans which started compacting, guarded by resultGuard
+	started typeutil.UniqueSet
 }
 
 func NewExecutor() *executor {
@@ -72,6 +76,8 @@ func NewExecutor() *executor {
 		taskSem:            semaphore.NewWeighted(maxParallelTaskNum),
 		dropped:            typeutil.NewConcurrentSet[string](),
 		usingSlots:         0,
+		canceled:           typeutil.NewUniqueSet(),
+		started:            typeutil.NewUniqueSet(),
 	}
 }
 
@@ -117,11 +123,6 @@ func (e *executor) getUsingSlots() int64 {
 	return e.usingSlots
 }
 
-func (e *executor) toCompleteState(task Compactor) {
-	task.Complete()
-	e.getAndRemoveExecuting(task.GetPlanID())
-}
-
 func (e *executor) getAndRemoveExecuting(planID typeutil.UniqueID) (Compactor, bool) {
 	task, ok := e.executing.GetAndRemove(planID)
 	if ok {
@@ -132,7 +133,18 @@ func (e *executor) getAndRemoveExecuting(planID typeutil.UniqueID) (Compactor, b
 	return task, ok
 }
 
+// RemoveTask removes the result of the plan, and stops the plan if it's still executing,
+// e.g. the plan is canceled by datacoord.
 func (e *executor) RemoveTask(planID int64) {
+	e.resultGuard.Lock()
+	stop := e.cancelTask(planID)
+	e.removeTask(planID)
+	e.resultGuard.Unlock()
+	// stop the plan without the result guard, which the plan needs to quit
+	stop()
+}
+
+func (e *executor) removeTask(planID int64) {
 	e.completed.GetAndRemove(planID)
 	task, loaded := e.completedCompactor.GetAndRemove(planID)
 	if loaded {
@@ -165,19 +177,42 @@ func (e *executor) executeTask(task Compactor) {
 		zap.String("channel", task.GetChannelName()),
 	)
 
-	defer func() {
-		e.toCompleteState(task)
-	}()
-
 	log.Info("start to execute compaction")
 
+	planID := task.GetPlanID()
+	e.resultGuard.Lock()
+	if e.canceled.Contain(planID) {
+		// the plan is canceled before started, which is completed by the canceler
+		e.canceled.Remove(planID)
+		e.resultGuard.Unlock()
+		log.Info("compaction task is canceled before started")
+		return
+	}
+	e.started.Insert(planID)
+	e.resultGuard.Unlock()
+
 	result, err := task.Compact()
+	task.Complete()
+
+	e.resultGuard.Lock()
+	canceled := e.canceled.Contain(planID)
+	e.canceled.Remove(planID)
+	e.started.Remove(planID)
+	e.getAndRemoveExecuting(planID)
+	if err == nil && !canceled {
+		e.completed.Insert(result.GetPlanID(), result)
+		e.completedCompactor.Insert(result.GetPlanID(), task)
+	}
+	e.resultGuard.Unlock()
+
 	if err != nil {
 		log.Warn("compaction task failed", zap.Error(err))
 		return
 	}
-	e.completed.Insert(result.GetPlanID(), result)
-	e.completedCompactor.Insert(result.GetPlanID(), task)
+	if canceled {
+		log.Info("compaction task is canceled, discard the result")
+		return
+	}
 
 	getDataCount := func(binlogs []*datapb.FieldBinlog) int64 {
 		count := int64(0)
@@ -208,12 +243,31 @@ func (e *executor) executeTask(task Compactor) {
 	log.Info("end to execute compaction")
 }
 
+// stopTask stops the executing plan and waits for it to quit.
 func (e *executor) stopTask(planID int64) {
+	e.resultGuard.Lock()
+	stop := e.cancelTask(planID)
+	e.resultGuard.Unlock()
+	stop()
+}
+
+// cancelTask marks the executing plan canceled and removes it, the caller shall hold the result guard.
+// The returned function stops the plan and waits for it to quit, which shall be called without the result guard.
+func (e *executor) cancelTask(planID int64) func() {
 	task, loaded := e.getAndRemoveExecuting(planID)
-	if loaded {
-		log.Warn("compaction executor stop task", zap.Int64("planID", planID), zap.String("vChannelName", task.GetChannelName()))
-		task.Stop()
+	if !loaded {
+		return func() {}
+	}
+	e.canceled.Insert(planID)
+	log.Warn("compaction executor stop task", zap.Int64("planID", planID), zap.String("vChannelName", task.GetChannelName()))
+	if !e.started.Contain(planID) {
+		// the queued plan quits without compacting, complete it for the Stop
+		return func() {
+			task.Complete()
+			task.Stop()
+		}
 	}
+	return task.Stop
 }
 
 func (e *executor) isValidChannel(channel string) bool {
@@ -228,11 +282,17 @@ func (e *executor) DiscardByDroppedChannel(channel string) {
 
 func (e *executor) DiscardPlan(channel string) {
 	e.resultGuard.Lock()
-	defer e.resultGuard.Unlock()
+	var stops []func()
+	defer func() {
+		e.resultGuard.Unlock()
+		for _, stop := range stops {
+			stop()
+		}
+	}()
 
 	e.executing.Range(func(planID int64, task Compactor) bool {
 		if task.GetChannelName() == channel {
-			e.stopTask(planID)
+			stops = append(stops, e.cancelTask(planID))
 		}
 		return true
 	})
@@ -240,7 +300,7 @@ func (e *executor) DiscardPlan(channel string) {
 	// remove all completed plans of channel
 	e.completed.Range(func(planID int64, result *datapb.CompactionPlanResult) bool {
 		if result.GetChannel() == channel {
-			e.RemoveTask(planID)
+			e.removeTask(planID)
 			log.Info("remove compaction plan and results",
 				zap.String("channel", channel),
 				zap.Int64("planID", planID))
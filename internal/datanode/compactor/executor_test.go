@@ -19,6 +19,7 @@ package compactor
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/cockroachdb/errors"
 	"github.com/stretchr/testify/assert"
@@ -44,10 +45,126 @@ func TestCompactionExecutor(t *testing.T) {
 		assert.EqualValues(t, 1, len(executor.taskCh))
 		assert.EqualValues(t, 1, executor.executing.Len())
 
+		// the queued task is completed by the executor
+		mockC.EXPECT().Complete().Return().Once()
 		mockC.EXPECT().Stop().Return().Once()
 		executor.stopTask(planID)
 	})
 
+	t.Run("Test remove executing task", func(t *testing.T) {
+		paramtable.Get().Init(paramtable.NewBaseTable())
+		planID := int64(1)
+		mockC := NewMockCompactor(t)
+		mockC.EXPECT().GetPlanID().Return(planID)
+		mockC.EXPECT().GetChannelName().Return("ch1")
+		mockC.EXPECT().GetSlotUsage().Return(8)
+		executor := NewExecutor()
+		succeed, err := executor.Execute(mockC)
+		assert.True(t, succeed)
+		assert.NoError(t, err)
+		assert.EqualValues(t, 8, executor.getUsingSlots())
+
+		stopped := make(chan struct{})
+		mockC.EXPECT().Complete().Return().Once()
+		mockC.EXPECT().Stop().Run(func() { close(stopped) }).Return().Once()
+		executor.RemoveTask(planID)
+		<-stopped
+		assert.EqualValues(t, 0, executor.executing.Len())
+		assert.EqualValues(t, 0, executor.getUsingSlots())
+	})
+
+	t.Run("Test remove task discards the result", func(t *testing.T) {
+		paramtable.Get().Init(paramtable.NewBaseTable())
+		planID := int64(1)
+		mockC := NewMockCompactor(t)
+		mockC.EXPECT().GetPlanID().Return(planID)
+		mockC.EXPECT().GetCollection().Return(int64(1))
+		mockC.EXPECT().GetChannelName().Return("ch1")
+		mockC.EXPECT().GetSlotUsage().Return(8)
+		executor := NewExecutor()
+		succeed, err := executor.Execute(mockC)
+		assert.True(t, succeed)
+		assert.NoError(t, err)
+
+		started := make(chan struct{})
+		canceled := make(chan struct{})
+		done := make(chan struct{})
+		mockC.EXPECT().Compact().RunAndReturn(func() (*datapb.CompactionPlanResult, error) {
+			close(started)
+			<-canceled
+			// the plan finishes regardless of the cancellation
+			return &datapb.CompactionPlanResult{PlanID: planID, State: datapb.CompactionTaskState_completed}, nil
+		}).Once()
+		mockC.EXPECT().Complete().Run(func() { close(done) }).Return().Once()
+		mockC.EXPECT().Stop().Run(func() {
+			close(canceled)
+			<-done
+		}).Return().Once()
+
+		finished := make(chan struct{})
+		go func() {
+			defer close(finished)
+			executor.executeTask(mockC)
+		}()
+		<-started
+		executor.RemoveTask(planID)
+		<-finished
+
+		assert.EqualValues(t, 0, executor.executing.Len())
+		assert.EqualValues(t, 0, executor.completed.Len())
+		assert.EqualValues(t, 0, executor.completedCompactor.Len())
+		assert.EqualValues(t, 0, executor.getUsingSlots())
+		assert.False(t, executor.canceled.Contain(planID))
+		assert.Equal(t, datapb.CompactionTaskState_failed, executor.GetResults(planID)[0].GetState())
+	})
+
+	t.Run("Test remove queued task", func(t *testing.T) {
+		paramtable.Get().Init(paramtable.NewBaseTable())
+		planID := int64(1)
+		mockC := NewMockCompactor(t)
+		mockC.EXPECT().GetPlanID().Return(planID)
+		mockC.EXPECT().GetCollection().Return(int64(1))
+		mockC.EXPECT().GetChannelName().Return("ch1")
+		mockC.EXPECT().GetSlotUsage().Return(8)
+		// Stop waits for Complete like the compactors do
+		done := make(chan struct{}, 1)
+		mockC.EXPECT().Complete().Run(func() { done <- struct{}{} }).Return().Once()
+		mockC.EXPECT().Stop().Run(func() { <-done }).Return().Once()
+
+		executor := NewExecutor()
+		// the task is blocked by the full taskSem
+		require.NoError(t, executor.taskSem.Acquire(context.Background(), maxParallelTaskNum))
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		go executor.Start(ctx)
+		succeed, err := executor.Execute(mockC)
+		assert.True(t, succeed)
+		assert.NoError(t, err)
+
+		removed := make(chan struct{})
+		go func() {
+			defer close(removed)
+			executor.RemoveTask(planID)
+		}()
+		select {
+		case <-removed:
+		case <-time.After(10 * time.Second):
+			t.Fatal("remove the queued task timeout")
+		}
+		assert.EqualValues(t, 0, executor.executing.Len())
+		assert.EqualValues(t, 0, executor.getUsingSlots())
+		assert.Equal(t, datapb.CompactionTaskState_failed, executor.GetResults(planID)[0].GetState())
+
+		// the task quits without compacting once dequeued
+		executor.taskSem.Release(maxParallelTaskNum)
+		assert.Eventually(t, func() bool {
+			executor.resultGuard.RLock()
+			defer executor.resultGuard.RUnlock()
+			return !executor.canceled.Contain(planID)
+		}, 10*time.Second, 10*time.Millisecond)
+		mockC.AssertNotCalled(t, "Compact")
+	})
+
 	t.Run("Test deplicate execute", func(t *testing.T) {
 		paramtable.Get().Init(paramtable.NewBaseTable())
 		planID := int64(1)
@@ -68,6 +185,8 @@ func TestCompactionExecutor(t *testing.T) {
 		assert.EqualValues(t, 1, len(executor.taskCh))
 		assert.EqualValues(t, 1, executor.executing.Len())
 
+		// the queued task is completed by the executor
+		mockC.EXPECT().Complete().Return().Once()
 		mockC.EXPECT().Stop().Return().Once()
 		executor.stopTask(planID)
 	})
@@ -105,6 +224,8 @@ func TestCompactionExecutor(t *testing.T) {
 		assert.EqualValues(t, 1, len(executor.taskCh))
 		assert.EqualValues(t, 1, executor.executing.Len())
 
+		// the queued task is completed by the executor
+		mockC.EXPECT().Complete().Return().Once()
 		mockC.EXPECT().Stop().Return().Once()
 		executor.stopTask(planID)
 	})
@@ -181,6 +302,7 @@ func TestCompactionExecutor(t *testing.T) {
 		mc.EXPECT().GetChannelName().Return("mock")
 		mc.EXPECT().Compact().Return(&datapb.CompactionPlanResult{PlanID: 1}, nil).Maybe()
 		mc.EXPECT().GetSlotUsage().Return(8)
+		mc.EXPECT().Complete().Return().Once()
 		mc.EXPECT().Stop().Return().Once()
 
 		ex.Execute(mc)
@@ -82,10 +82,11 @@ func mergeSortMultipleSegments(ctx context.Context,
 				deltalogPaths = append(deltalogPaths, l.GetLogPath())
 			}
 		}
-		delta, err := compaction.ComposeDeleteFromDeltalogs(ctx, binlogIO, deltalogPaths)
+		delta, retained, err := compaction.ComposeDeleteFromDeltalogsWithTimeTravel(ctx, binlogIO, deltalogPaths, plan.GetTimetravel())
 		if err != nil {
 			return nil, err
 		}
+		writer.RetainDeletes(retained)
 		segmentFilters[i] = compaction.NewEntityFilter(delta, collectionTtl, currentTime)
 	}
 
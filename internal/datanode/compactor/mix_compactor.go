@@ -187,11 +187,13 @@ func (t *mixCompactionTask) writeSegment(ctx context.Context,
 			deltaPaths = append(deltaPaths, binlog.GetLogPath())
 		}
 	}
-	delta, err := compaction.ComposeDeleteFromDeltalogs(ctx, t.binlogIO, deltaPaths)
+	delta, retained, err := compaction.ComposeDeleteFromDeltalogsWithTimeTravel(ctx, t.binlogIO, deltaPaths, t.plan.GetTimetravel())
 	if err != nil {
 		log.Warn("compact wrong, fail to merge deltalogs", zap.Error(err))
 		return
 	}
+	// the rows deleted in the time travel window are kept along with their deletes
+	mWriter.RetainDeletes(retained)
 	entityFilter := compaction.NewEntityFilter(delta, t.plan.GetCollectionTtl(), t.currentTime)
 
 	reader, err := storage.NewBinlogRecordReader(ctx, seg.GetFieldBinlogs(), t.plan.GetSchema(), storage.WithDownloader(t.binlogIO.Download))
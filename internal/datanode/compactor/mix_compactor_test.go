@@ -185,6 +185,64 @@ func (s *MixCompactionTaskSuite) TestCompactDupPK() {
 	s.Empty(segment.Deltalogs)
 }
 
+func (s *MixCompactionTaskSuite) TestCompactDupPKWithTimeTravel() {
+	// The deletion of pk=100 is in the time travel window,
+	// the merged segment should keep all the 6 rows along with the deletion
+	segments := []int64{7, 8, 9}
+	deleteTs := tsoutil.ComposeTSByTime(getMilvusBirthday().Add(time.Second), 0)
+	dblobs, err := getInt64DeltaBlobs(1, []int64{100}, []uint64{deleteTs})
+	s.Require().NoError(err)
+
+	s.mockBinlogIO.EXPECT().Download(mock.Anything, []string{"1"}).
+		Return([][]byte{dblobs.GetValue()}, nil).Times(3)
+	s.mockBinlogIO.EXPECT().Upload(mock.Anything, mock.Anything).Return(nil)
+	alloc := allocator.NewLocalAllocator(7777777, math.MaxInt64)
+
+	s.task.plan.Timetravel = tsoutil.ComposeTSByTime(getMilvusBirthday(), 0)
+	s.task.plan.SegmentBinlogs = make([]*datapb.CompactionSegmentBinlogs, 0)
+	for _, segID := range segments {
+		s.initSegBuffer(1, segID)
+		v := &storage.Value{
+			PK:        storage.NewInt64PrimaryKey(100),
+			Timestamp: int64(tsoutil.ComposeTSByTime(getMilvusBirthday(), 0)),
+			Value:     getRow(100),
+		}
+		err := s.segWriter.Write(v)
+		s.segWriter.FlushAndIsFull()
+		s.Require().NoError(err)
+
+		kvs, fBinlogs, err := serializeWrite(context.TODO(), alloc, s.segWriter)
+		s.Require().NoError(err)
+		s.mockBinlogIO.EXPECT().Download(mock.Anything, mock.MatchedBy(func(keys []string) bool {
+			left, right := lo.Difference(keys, lo.Keys(kvs))
+			return len(left) == 0 && len(right) == 0
+		})).Return(lo.Values(kvs), nil).Once()
+
+		s.task.plan.SegmentBinlogs = append(s.task.plan.SegmentBinlogs, &datapb.CompactionSegmentBinlogs{
+			SegmentID:    segID,
+			FieldBinlogs: lo.Values(fBinlogs),
+			Deltalogs: []*datapb.FieldBinlog{
+				{Binlogs: []*datapb.Binlog{{LogID: 1, LogPath: "1"}}},
+			},
+		})
+	}
+	result, err := s.task.Compact()
+	s.NoError(err)
+	s.NotNil(result)
+	s.Equal(1, len(result.GetSegments()))
+
+	segment := result.GetSegments()[0]
+	s.EqualValues(19531, segment.GetSegmentID())
+	s.EqualValues(6, segment.GetNumOfRows())
+	s.Require().Equal(1, len(segment.GetDeltalogs()))
+	s.Require().Equal(1, len(segment.GetDeltalogs()[0].GetBinlogs()))
+	deltalog := segment.GetDeltalogs()[0].GetBinlogs()[0]
+	// the same deletion of the 3 input segments is written once
+	s.EqualValues(1, deltalog.GetEntriesNum())
+	s.Equal(deleteTs, deltalog.GetTimestampFrom())
+	s.Equal(deleteTs, deltalog.GetTimestampTo())
+}
+
 func (s *MixCompactionTaskSuite) TestCompactTwoToOne() {
 	segments := []int64{5, 6, 7}
 	alloc := allocator.NewLocalAllocator(7777777, math.MaxInt64)
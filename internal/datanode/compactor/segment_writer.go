@@ -31,6 +31,7 @@ import (
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
 	"github.com/milvus-io/milvus/internal/allocator"
+	"github.com/milvus-io/milvus/internal/compaction"
 	"github.com/milvus-io/milvus/internal/flushcommon/io"
 	"github.com/milvus-io/milvus/internal/flushcommon/writebuffer"
 	"github.com/milvus-io/milvus/internal/metastore/kv/binlog"
@@ -65,6 +66,12 @@ type MultiSegmentWriter struct {
 	res []*datapb.CompactionSegment
 	// DONOT leave it empty of all segments are deleted, just return a segment with zero meta for datacoord
 	bm25Fields []int64
+
+	// retainedDeletes are the deletes in the time travel window, pk to delete timestamps,
+	// they are written to the deltalogs of the segments the deleted rows are written to.
+	retainedDeletes map[interface{}][]typeutil.Timestamp
+	// retainedRowTs is the earliest ts of the rows in the current segment which have retained deletes.
+	retainedRowTs map[interface{}]typeutil.Timestamp
 }
 
 var _ storage.RecordWriter = &MultiSegmentWriter{}
@@ -116,11 +123,19 @@ func (w *MultiSegmentWriter) closeWriter() error {
 		}
 
 		fieldBinlogs, statsLog, bm25Logs := w.writer.GetLogs()
+		deltalogs, err := w.writeRetainedDeletes()
+		if err != nil {
+			return err
+		}
+
+		// the zone maps are written along with the pk stats
+		statsLogs := append([]*datapb.FieldBinlog{statsLog}, lo.Values(w.writer.GetZoneMapLogs())...)
 
 		result := &datapb.CompactionSegment{
 			SegmentID:           w.currentSegmentID,
 			InsertLogs:          lo.Values(fieldBinlogs),
-			Field2StatslogPaths: []*datapb.FieldBinlog{statsLog},
+			Field2StatslogPaths: statsLogs,
+			Deltalogs:           deltalogs,
 			NumOfRows:           w.writer.GetRowNum(),
 			Channel:             w.channel,
 			Bm25Logs:            lo.Values(bm25Logs),
@@ -181,9 +196,89 @@ func (w *MultiSegmentWriter) Write(r storage.Record) error {
 		}
 	}
 
+	if len(w.retainedDeletes) > 0 {
+		if err := w.trackRetainedRows(r); err != nil {
+			return err
+		}
+	}
 	return w.writer.Write(r)
 }
 
+// RetainDeletes adds the deletes in the time travel window, the rows deleted by them are kept by compaction,
+// so the deletes are carried over to the deltalogs of the result segments the rows are written to.
+func (w *MultiSegmentWriter) RetainDeletes(deletes map[interface{}][]typeutil.Timestamp) {
+	if len(deletes) == 0 {
+		return
+	}
+	if w.retainedDeletes == nil {
+		w.retainedDeletes = make(map[interface{}][]typeutil.Timestamp, len(deletes))
+	}
+	for pk, tss := range deletes {
+		// the same delete might be dispatched to multiple input segments
+		w.retainedDeletes[pk] = lo.Uniq(append(w.retainedDeletes[pk], tss...))
+	}
+}
+
+func (w *MultiSegmentWriter) trackRetainedRows(r storage.Record) error {
+	pkField, err := typeutil.GetPrimaryFieldSchema(w.schema)
+	if err != nil {
+		return err
+	}
+	if w.retainedRowTs == nil {
+		w.retainedRowTs = make(map[interface{}]typeutil.Timestamp)
+	}
+	tsArray := r.Column(common.TimeStampField).(*array.Int64)
+	for i := 0; i < r.Len(); i++ {
+		var pk interface{}
+		switch pkField.GetDataType() {
+		case schemapb.DataType_Int64:
+			pk = r.Column(pkField.GetFieldID()).(*array.Int64).Value(i)
+		case schemapb.DataType_VarChar:
+			pk = r.Column(pkField.GetFieldID()).(*array.String).Value(i)
+		default:
+			return fmt.Errorf("unsupported primary key type %s", pkField.GetDataType())
+		}
+		tss, ok := w.retainedDeletes[pk]
+		if !ok {
+			continue
+		}
+		ts := typeutil.Timestamp(tsArray.Value(i))
+		// only the deletes later than the row ts apply to it
+		if !lo.ContainsBy(tss, func(deleteTs typeutil.Timestamp) bool { return deleteTs > ts }) {
+			continue
+		}
+		if rowTs, ok := w.retainedRowTs[pk]; !ok || ts < rowTs {
+			w.retainedRowTs[pk] = ts
+		}
+	}
+	return nil
+}
+
+// writeRetainedDeletes serializes and uploads the retained deletes applying to the rows of the current segment.
+func (w *MultiSegmentWriter) writeRetainedDeletes() ([]*datapb.FieldBinlog, error) {
+	if len(w.retainedRowTs) == 0 {
+		return nil, nil
+	}
+	defer func() {
+		w.retainedRowTs = nil
+	}()
+
+	pkField, err := typeutil.GetPrimaryFieldSchema(w.schema)
+	if err != nil {
+		return nil, err
+	}
+	deletes := make(map[interface{}][]typeutil.Timestamp, len(w.retainedRowTs))
+	for pk, rowTs := range w.retainedRowTs {
+		deletes[pk] = lo.Filter(w.retainedDeletes[pk], func(ts typeutil.Timestamp, _ int) bool { return ts > rowTs })
+	}
+	deltalog, err := compaction.WriteRetainedDeletes(context.TODO(), w.binlogIO, w.allocator.logIDAlloc, pkField.GetDataType(),
+		w.collectionID, w.partitionID, w.currentSegmentID, deletes)
+	if err != nil || deltalog == nil {
+		return nil, err
+	}
+	return []*datapb.FieldBinlog{deltalog}, nil
+}
+
 // DONOT return an empty list if every insert of the segment is deleted,
 // append an empty segment instead
 func (w *MultiSegmentWriter) Close() error {
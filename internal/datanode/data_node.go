@@ -39,6 +39,7 @@ import (
 	"github.com/milvus-io/milvus/internal/datanode/allocator"
 	"github.com/milvus-io/milvus/internal/datanode/channel"
 	"github.com/milvus-io/milvus/internal/datanode/compactor"
+	"github.com/milvus-io/milvus/internal/datanode/exportv2"
 	"github.com/milvus-io/milvus/internal/datanode/importv2"
 	"github.com/milvus-io/milvus/internal/datanode/msghandlerimpl"
 	"github.com/milvus-io/milvus/internal/datanode/util"
@@ -101,6 +102,7 @@ type DataNode struct {
 	writeBufferManager writebuffer.BufferManager
 	importTaskMgr      importv2.TaskManager
 	importScheduler    importv2.Scheduler
+	exportTaskMgr      exportv2.Manager
 
 	segmentCache             *util.Cache
 	compactionExecutor       compactor.Executor
@@ -272,6 +274,7 @@ func (node *DataNode) Init() error {
 
 		node.importTaskMgr = importv2.NewTaskManager()
 		node.importScheduler = importv2.NewScheduler(node.importTaskMgr)
+		node.exportTaskMgr = exportv2.NewManager()
 		node.channelCheckpointUpdater = util2.NewChannelCheckpointUpdater(node.broker)
 		node.flowgraphManager = pipeline.NewFlowgraphManager()
 
@@ -437,6 +440,10 @@ func (node *DataNode) Stop() error {
 			node.importScheduler.Close()
 		}
 
+		if node.exportTaskMgr != nil {
+			node.exportTaskMgr.Close()
+		}
+
 		// Delay the cancellation of ctx to ensure that the session is automatically recycled after closed the flow graph
 		node.cancel()
 	})
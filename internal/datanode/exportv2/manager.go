@@ -0,0 +1,89 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package exportv2
+
+import (
+	"sync"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/pkg/v2/log"
+	"github.com/milvus-io/milvus/pkg/v2/util/conc"
+	"github.com/milvus-io/milvus/pkg/v2/util/paramtable"
+)
+
+// Manager keeps the export tasks assigned to the datanode and executes them in a pool.
+// The tasks are kept until the datacoord drops them.
+type Manager interface {
+	Add(task *Task)
+	Get(taskID int64) *Task
+	Remove(taskID int64)
+	Close()
+}
+
+type manager struct {
+	mu    sync.RWMutex // guards tasks
+	tasks map[int64]*Task
+	pool  *conc.Pool[any]
+}
+
+func NewManager() Manager {
+	return &manager{
+		tasks: make(map[int64]*Task),
+		pool:  conc.NewPool[any](paramtable.Get().DataNodeCfg.MaxConcurrentExportTaskNum.GetAsInt()),
+	}
+}
+
+// Add submits the task for execution, the task is ignored if a task with the same id exists.
+func (m *manager) Add(task *Task) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if _, ok := m.tasks[task.GetTaskID()]; ok {
+		log.Warn("duplicated export task", zap.Int64("jobID", task.GetJobID()), zap.Int64("taskID", task.GetTaskID()))
+		return
+	}
+	m.tasks[task.GetTaskID()] = task
+	m.pool.Submit(func() (any, error) {
+		task.Execute()
+		return nil, nil
+	})
+}
+
+func (m *manager) Get(taskID int64) *Task {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.tasks[taskID]
+}
+
+// Remove cancels the task if it is still running.
+func (m *manager) Remove(taskID int64) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if task, ok := m.tasks[taskID]; ok {
+		task.Cancel()
+	}
+	delete(m.tasks, taskID)
+}
+
+func (m *manager) Close() {
+	m.mu.Lock()
+	for _, task := range m.tasks {
+		task.Cancel()
+	}
+	m.mu.Unlock()
+	m.pool.Release()
+}
@@ -0,0 +1,166 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package exportv2
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/pkg/v2/common"
+	"github.com/milvus-io/milvus/pkg/v2/proto/datapb"
+	"github.com/milvus-io/milvus/pkg/v2/proto/etcdpb"
+	"github.com/milvus-io/milvus/pkg/v2/util/paramtable"
+)
+
+type ManagerSuite struct {
+	suite.Suite
+
+	cm      storage.ChunkManager
+	schema  *schemapb.CollectionSchema
+	segment *datapb.SegmentInfo
+	l0      *datapb.SegmentInfo
+}
+
+func (s *ManagerSuite) SetupSuite() {
+	paramtable.Init()
+}
+
+func (s *ManagerSuite) SetupTest() {
+	ctx := context.Background()
+	s.cm = storage.NewLocalChunkManager(storage.RootPath(s.T().TempDir()))
+	s.schema = &schemapb.CollectionSchema{
+		Name: "coll",
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: common.RowIDField, Name: common.RowIDFieldName, DataType: schemapb.DataType_Int64},
+			{FieldID: common.TimeStampField, Name: common.TimeStampFieldName, DataType: schemapb.DataType_Int64},
+			{FieldID: 100, Name: "pk", DataType: schemapb.DataType_Int64, IsPrimaryKey: true},
+			{FieldID: 101, Name: "int32", DataType: schemapb.DataType_Int32},
+			{
+				FieldID: 102, Name: "vec", DataType: schemapb.DataType_FloatVector,
+				TypeParams: []*commonpb.KeyValuePair{{Key: "dim", Value: "2"}},
+			},
+		},
+	}
+
+	// 10 rows with pk 0~9 inserted at ts 1~10
+	const rows = 10
+	insertData := &storage.InsertData{Data: map[int64]storage.FieldData{
+		common.RowIDField:     &storage.Int64FieldData{Data: make([]int64, rows)},
+		common.TimeStampField: &storage.Int64FieldData{Data: make([]int64, rows)},
+		100:                   &storage.Int64FieldData{Data: make([]int64, rows)},
+		101:                   &storage.Int32FieldData{Data: make([]int32, rows)},
+		102:                   &storage.FloatVectorFieldData{Data: make([]float32, rows*2), Dim: 2},
+	}}
+	for i := 0; i < rows; i++ {
+		insertData.Data[common.RowIDField].(*storage.Int64FieldData).Data[i] = int64(i)
+		insertData.Data[common.TimeStampField].(*storage.Int64FieldData).Data[i] = int64(i + 1)
+		insertData.Data[100].(*storage.Int64FieldData).Data[i] = int64(i)
+		insertData.Data[101].(*storage.Int32FieldData).Data[i] = int32(i)
+	}
+	codec := storage.NewInsertCodecWithSchema(&etcdpb.CollectionMeta{ID: 1, Schema: s.schema})
+	blobs, err := codec.Serialize(10, 1000, insertData)
+	s.NoError(err)
+	s.segment = &datapb.SegmentInfo{ID: 1000, CollectionID: 1, PartitionID: 10, NumOfRows: rows}
+	for _, blob := range blobs {
+		logPath := path.Join(s.cm.RootPath(), "insert_log", blob.Key)
+		s.NoError(s.cm.Write(ctx, logPath, blob.Value))
+		var fieldID int64
+		fmt.Sscanf(blob.Key, "%d", &fieldID)
+		s.segment.Binlogs = append(s.segment.Binlogs, &datapb.FieldBinlog{
+			FieldID: fieldID,
+			Binlogs: []*datapb.Binlog{{EntriesNum: rows, TimestampFrom: 1, TimestampTo: rows, LogPath: logPath}},
+		})
+	}
+
+	// pk 2 is deleted at ts 5, pk 3 is deleted at ts 9
+	deleteData := storage.NewDeleteData([]storage.PrimaryKey{storage.NewInt64PrimaryKey(2), storage.NewInt64PrimaryKey(3)},
+		[]uint64{5, 9})
+	blob, err := storage.NewDeleteCodec().Serialize(1, common.AllPartitionsID, 2000, deleteData)
+	s.NoError(err)
+	logPath := path.Join(s.cm.RootPath(), "delta_log", "2000")
+	s.NoError(s.cm.Write(ctx, logPath, blob.Value))
+	s.l0 = &datapb.SegmentInfo{
+		ID: 2000, CollectionID: 1, PartitionID: common.AllPartitionsID, Level: datapb.SegmentLevel_L0,
+		Deltalogs: []*datapb.FieldBinlog{{Binlogs: []*datapb.Binlog{{EntriesNum: 2, TimestampFrom: 5, TimestampTo: 9, LogPath: logPath}}}},
+	}
+}
+
+func (s *ManagerSuite) newRequest(taskID int64, filter string) *datapb.ExportRequest {
+	return &datapb.ExportRequest{
+		JobID:        1,
+		TaskID:       taskID,
+		CollectionID: 1,
+		Schema:       s.schema,
+		Filter:       filter,
+		Ts:           8,
+		OutputPath:   path.Join(s.cm.RootPath(), "export", "1"),
+		Segments:     []*datapb.SegmentInfo{s.segment},
+		L0Segments:   []*datapb.SegmentInfo{s.l0},
+		MaxFileSize:  1024 * 1024,
+	}
+}
+
+func (s *ManagerSuite) TestExecute() {
+	// the rows inserted after ts 8 and pk 2 deleted before ts 8 are skipped, the delete of pk 3 is ignored
+	task := NewTask(s.newRequest(1, ""), s.cm)
+	task.Execute()
+	s.Equal(datapb.ImportTaskStateV2_Completed, task.GetState(), task.GetReason())
+	scanned, exported, files := task.GetProgress()
+	s.Equal(int64(10), scanned)
+	s.Equal(int64(7), exported)
+	s.Equal([]string{path.Join(s.cm.RootPath(), "export", "1", "1_0.parquet")}, files)
+	exist, err := s.cm.Exist(context.Background(), files[0])
+	s.NoError(err)
+	s.True(exist)
+
+	task = NewTask(s.newRequest(2, "int32 > 5"), s.cm)
+	task.Execute()
+	s.Equal(datapb.ImportTaskStateV2_Completed, task.GetState(), task.GetReason())
+	_, exported, _ = task.GetProgress()
+	s.Equal(int64(2), exported)
+
+	task = NewTask(s.newRequest(3, "int32 >"), s.cm)
+	task.Execute()
+	s.Equal(datapb.ImportTaskStateV2_Failed, task.GetState())
+	s.NotEmpty(task.GetReason())
+}
+
+func (s *ManagerSuite) TestManager() {
+	m := NewManager()
+	defer m.Close()
+	task := NewTask(s.newRequest(1, ""), s.cm)
+	m.Add(task)
+	m.Add(NewTask(s.newRequest(1, "int32 >"), s.cm))
+	s.Eventually(func() bool {
+		return m.Get(1).GetState() == datapb.ImportTaskStateV2_Completed
+	}, 10*time.Second, 10*time.Millisecond)
+	s.Equal(task, m.Get(1))
+	m.Remove(1)
+	s.Nil(m.Get(1))
+}
+
+func TestManager(t *testing.T) {
+	suite.Run(t, new(ManagerSuite))
+}
@@ -0,0 +1,323 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package exportv2
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"path"
+	"slices"
+	"sync"
+
+	"github.com/cockroachdb/errors"
+	"github.com/samber/lo"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/exportutil"
+	importbinlog "github.com/milvus-io/milvus/internal/util/importutilv2/binlog"
+	"github.com/milvus-io/milvus/pkg/v2/common"
+	"github.com/milvus-io/milvus/pkg/v2/log"
+	"github.com/milvus-io/milvus/pkg/v2/proto/datapb"
+	"github.com/milvus-io/milvus/pkg/v2/util/merr"
+	"github.com/milvus-io/milvus/pkg/v2/util/typeutil"
+)
+
+// Task exports the rows of the segments of a channel visible at the snapshot ts of the request.
+// The binlog paths of the segments in the request are decompressed by the datacoord.
+type Task struct {
+	ctx    context.Context
+	cancel context.CancelFunc
+	req    *datapb.ExportRequest
+	cm     storage.ChunkManager
+
+	mu           sync.RWMutex // guards the fields below
+	state        datapb.ImportTaskStateV2
+	reason       string
+	scannedRows  int64
+	exportedRows int64
+	files        []string
+
+	// readSchema holds the fields to read from the binlogs, including the system fields
+	readSchema *schemapb.CollectionSchema
+	pkField    *schemapb.FieldSchema
+	filters    []importbinlog.Filter
+	writer     *writer
+	// the deletes of the L0 segments, keyed by partition
+	l0Deletes map[int64]map[any]typeutil.Timestamp
+}
+
+func NewTask(req *datapb.ExportRequest, cm storage.ChunkManager) *Task {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &Task{
+		ctx:       ctx,
+		cancel:    cancel,
+		req:       req,
+		cm:        cm,
+		state:     datapb.ImportTaskStateV2_Pending,
+		l0Deletes: make(map[int64]map[any]typeutil.Timestamp),
+	}
+}
+
+func (t *Task) GetJobID() int64 {
+	return t.req.GetJobID()
+}
+
+func (t *Task) GetTaskID() int64 {
+	return t.req.GetTaskID()
+}
+
+func (t *Task) GetState() datapb.ImportTaskStateV2 {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	return t.state
+}
+
+func (t *Task) GetReason() string {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	return t.reason
+}
+
+// GetProgress returns the number of the scanned rows and exported rows, and the files written.
+func (t *Task) GetProgress() (int64, int64, []string) {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	return t.scannedRows, t.exportedRows, slices.Clone(t.files)
+}
+
+func (t *Task) Cancel() {
+	t.cancel()
+}
+
+func (t *Task) setState(state datapb.ImportTaskStateV2, reason string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.state = state
+	t.reason = reason
+}
+
+func (t *Task) addProgress(scannedRows, exportedRows int64, files ...string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.scannedRows += scannedRows
+	t.exportedRows += exportedRows
+	t.files = append(t.files, files...)
+}
+
+func (t *Task) Execute() {
+	log := log.With(zap.Int64("jobID", t.GetJobID()), zap.Int64("taskID", t.GetTaskID()),
+		zap.Int64("collectionID", t.req.GetCollectionID()))
+	t.setState(datapb.ImportTaskStateV2_InProgress, "")
+	log.Info("start to execute export task", zap.Uint64("ts", t.req.GetTs()),
+		zap.Int("segments", len(t.req.GetSegments())), zap.Int("l0Segments", len(t.req.GetL0Segments())))
+	if err := t.execute(); err != nil {
+		log.Warn("export task failed", zap.Error(err))
+		t.setState(datapb.ImportTaskStateV2_Failed, err.Error())
+		return
+	}
+	scanned, exported, files := t.GetProgress()
+	log.Info("export task completed", zap.Int64("scannedRows", scanned), zap.Int64("exportedRows", exported),
+		zap.Int("files", len(files)))
+	t.setState(datapb.ImportTaskStateV2_Completed, "")
+}
+
+func (t *Task) execute() error {
+	// the files left by a previous attempt of the task are overwritten or removed
+	if err := t.cm.RemoveWithPrefix(t.ctx, path.Join(t.req.GetOutputPath(), fmt.Sprintf("%d_", t.GetTaskID()))); err != nil {
+		return err
+	}
+	schema := t.req.GetSchema()
+	outputFields, readSchema, err := exportutil.GetFields(schema, t.req.GetOutputFields(), t.req.GetFilter())
+	if err != nil {
+		return err
+	}
+	t.readSchema = readSchema
+	t.pkField, err = typeutil.GetPrimaryFieldSchema(schema)
+	if err != nil {
+		return err
+	}
+	t.filters = []importbinlog.Filter{importbinlog.FilterWithTimeRange(0, t.req.GetTs())}
+	if t.req.GetFilter() != "" {
+		rowFilter, err := exportutil.NewRowFilter(schema, t.req.GetFilter())
+		if err != nil {
+			return err
+		}
+		t.filters = append(t.filters, importbinlog.Filter(rowFilter))
+	}
+	t.writer, err = newWriter(t.ctx, t.cm, t.req.GetOutputPath(), t.GetTaskID(), outputFields, int(t.req.GetMaxFileSize()))
+	if err != nil {
+		return err
+	}
+
+	for _, segment := range t.req.GetSegments() {
+		scanned, exported, err := t.exportSegment(segment)
+		if err != nil {
+			return errors.Wrapf(err, "failed to export segment %d", segment.GetID())
+		}
+		t.addProgress(scanned, exported, t.writer.takeFiles()...)
+	}
+	if err = t.writer.Close(); err != nil {
+		return err
+	}
+	t.addProgress(0, 0, t.writer.takeFiles()...)
+	return nil
+}
+
+// exportSegment writes the rows of the segment visible at the snapshot ts, returns the number of
+// scanned rows and the number of exported rows.
+func (t *Task) exportSegment(segment *datapb.SegmentInfo) (int64, int64, error) {
+	if segment.GetStorageVersion() == storage.StorageV2 {
+		return 0, 0, merr.WrapErrServiceInternal(fmt.Sprintf("export of storage v2 segment %d is not supported", segment.GetID()))
+	}
+	deletes, err := t.readDeletes(lo.Flatten(lo.Map(segment.GetDeltalogs(), func(fieldBinlog *datapb.FieldBinlog, _ int) []*datapb.Binlog {
+		return fieldBinlog.GetBinlogs()
+	})))
+	if err != nil {
+		return 0, 0, err
+	}
+	l0Deletes, err := t.getL0Deletes(segment.GetPartitionID())
+	if err != nil {
+		return 0, 0, err
+	}
+	filters := append([]importbinlog.Filter{
+		importbinlog.FilterWithDeletedPks(t.pkField.GetFieldID(), deletes),
+		importbinlog.FilterWithDeletedPks(t.pkField.GetFieldID(), l0Deletes),
+	}, t.filters...)
+
+	fieldBinlogs := make([][]*datapb.Binlog, 0, len(t.readSchema.GetFields()))
+	for _, fieldBinlog := range segment.GetBinlogs() {
+		// fields without binlogs, e.g. added after the segment was written, are filled with nulls or the default values
+		if typeutil.GetField(t.readSchema, fieldBinlog.GetFieldID()) != nil {
+			fieldBinlogs = append(fieldBinlogs, fieldBinlog.GetBinlogs())
+		}
+	}
+	var scanned, exported int64
+	if len(fieldBinlogs) == 0 {
+		return 0, 0, nil
+	}
+	for idx := range fieldBinlogs[0] {
+		paths := lo.Map(fieldBinlogs, func(binlogs []*datapb.Binlog, _ int) string {
+			return binlogs[idx].GetLogPath()
+		})
+		values, err := t.cm.MultiRead(t.ctx, paths)
+		if err != nil {
+			return 0, 0, err
+		}
+		blobs := lo.Map(values, func(value []byte, i int) *storage.Blob {
+			return &storage.Blob{Key: paths[i], Value: value}
+		})
+		reader, err := storage.NewBinlogDeserializeReader(t.readSchema, storage.MakeBlobsReader(blobs))
+		if err != nil {
+			return 0, 0, err
+		}
+		n, m, err := t.exportRows(reader, filters)
+		reader.Close()
+		if err != nil {
+			return 0, 0, err
+		}
+		scanned += n
+		exported += m
+	}
+	return scanned, exported, nil
+}
+
+func (t *Task) exportRows(reader *storage.DeserializeReader[*storage.Value], filters []importbinlog.Filter) (int64, int64, error) {
+	var scanned, exported int64
+OUTER:
+	for {
+		err := reader.Next()
+		if err != nil {
+			if err == io.EOF {
+				return scanned, exported, nil
+			}
+			return 0, 0, err
+		}
+		scanned++
+		row := reader.Value().Value.(map[int64]any)
+		for _, filter := range filters {
+			if !filter(row) {
+				continue OUTER
+			}
+		}
+		if err = t.writer.Write(row); err != nil {
+			return 0, 0, err
+		}
+		exported++
+	}
+}
+
+// getL0Deletes returns the deletes of the L0 segments applied to the partition.
+func (t *Task) getL0Deletes(partitionID int64) (map[any]typeutil.Timestamp, error) {
+	if deletes, ok := t.l0Deletes[partitionID]; ok {
+		return deletes, nil
+	}
+	var deltalogs []*datapb.Binlog
+	for _, segment := range t.req.GetL0Segments() {
+		if segment.GetPartitionID() != partitionID && segment.GetPartitionID() != common.AllPartitionsID {
+			continue
+		}
+		for _, fieldBinlog := range segment.GetDeltalogs() {
+			deltalogs = append(deltalogs, fieldBinlog.GetBinlogs()...)
+		}
+	}
+	deletes, err := t.readDeletes(deltalogs)
+	if err != nil {
+		return nil, err
+	}
+	t.l0Deletes[partitionID] = deletes
+	return deletes, nil
+}
+
+// readDeletes reads the delta logs and returns the latest delete ts of each primary key,
+// the deletes after the snapshot ts are ignored.
+func (t *Task) readDeletes(deltalogs []*datapb.Binlog) (map[any]typeutil.Timestamp, error) {
+	deletes := make(map[any]typeutil.Timestamp)
+	for _, deltalog := range deltalogs {
+		if deltalog.GetTimestampFrom() > t.req.GetTs() {
+			continue
+		}
+		value, err := t.cm.Read(t.ctx, deltalog.GetLogPath())
+		if err != nil {
+			return nil, err
+		}
+		reader, err := storage.CreateDeltalogReader([]*storage.Blob{{Key: deltalog.GetLogPath(), Value: value}})
+		if err != nil {
+			return nil, err
+		}
+		for {
+			err = reader.Next()
+			if err != nil {
+				break
+			}
+			dl := reader.Value()
+			if dl.Ts > t.req.GetTs() {
+				continue
+			}
+			if ts, ok := deletes[dl.Pk.GetValue()]; !ok || dl.Ts > ts {
+				deletes[dl.Pk.GetValue()] = dl.Ts
+			}
+		}
+		reader.Close()
+		if err != io.EOF {
+			return nil, err
+		}
+	}
+	return deletes, nil
+}
@@ -0,0 +1,157 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package exportv2
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"path"
+
+	"github.com/apache/arrow/go/v17/arrow"
+	"github.com/apache/arrow/go/v17/arrow/array"
+	"github.com/apache/arrow/go/v17/parquet"
+	"github.com/apache/arrow/go/v17/parquet/compress"
+	"github.com/apache/arrow/go/v17/parquet/pqarrow"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/storage"
+)
+
+// batchRows is the number of rows in each row group of the exported Parquet files.
+const batchRows = 4096
+
+// writer writes the exported rows to Parquet files named <dir>/<taskID>_<index>.parquet. The columns
+// use the same arrow types as the binlogs, e.g. the vectors are fixed size binaries, the JSON and
+// array values are binaries. A new file is started once the size of the current one reaches maxFileSize.
+type writer struct {
+	ctx         context.Context
+	cm          storage.ChunkManager
+	dir         string
+	taskID      int64
+	fields      []*schemapb.FieldSchema
+	schema      *arrow.Schema
+	maxFileSize int
+
+	batch []*storage.Value
+	buf   *bytes.Buffer
+	fw    *pqarrow.FileWriter
+
+	fileIndex int
+	// files written since the last takeFiles call
+	files []string
+}
+
+func newWriter(ctx context.Context, cm storage.ChunkManager, dir string, taskID int64,
+	fields []*schemapb.FieldSchema, maxFileSize int,
+) (*writer, error) {
+	schema, err := storage.ConvertToArrowSchema(fields)
+	if err != nil {
+		return nil, err
+	}
+	return &writer{
+		ctx:         ctx,
+		cm:          cm,
+		dir:         dir,
+		taskID:      taskID,
+		fields:      fields,
+		schema:      schema,
+		maxFileSize: maxFileSize,
+		batch:       make([]*storage.Value, 0, batchRows),
+	}, nil
+}
+
+// Write appends a row, the fields not to export are ignored.
+func (w *writer) Write(row map[int64]any) error {
+	values := make(map[int64]any, len(w.fields))
+	for _, field := range w.fields {
+		values[field.GetFieldID()] = row[field.GetFieldID()]
+	}
+	w.batch = append(w.batch, &storage.Value{Value: values})
+	if len(w.batch) < batchRows {
+		return nil
+	}
+	return w.flushBatch()
+}
+
+func (w *writer) flushBatch() error {
+	if len(w.batch) == 0 {
+		return nil
+	}
+	r, err := storage.ValueSerializer(w.batch, w.fields)
+	if err != nil {
+		return err
+	}
+	defer r.Release()
+	columns := make([]arrow.Array, len(w.fields))
+	for i, field := range w.fields {
+		columns[i] = r.Column(field.GetFieldID())
+	}
+	rec := array.NewRecord(w.schema, columns, int64(r.Len()))
+	defer rec.Release()
+
+	if w.fw == nil {
+		w.buf = new(bytes.Buffer)
+		w.fw, err = pqarrow.NewFileWriter(w.schema, w.buf,
+			parquet.NewWriterProperties(parquet.WithCompression(compress.Codecs.Zstd)),
+			pqarrow.DefaultWriterProps())
+		if err != nil {
+			return err
+		}
+	}
+	if err = w.fw.Write(rec); err != nil {
+		return err
+	}
+	w.batch = w.batch[:0]
+	if w.buf.Len() >= w.maxFileSize {
+		return w.finishFile()
+	}
+	return nil
+}
+
+func (w *writer) finishFile() error {
+	if w.fw == nil {
+		return nil
+	}
+	if err := w.fw.Close(); err != nil {
+		return err
+	}
+	filePath := path.Join(w.dir, fmt.Sprintf("%d_%d.parquet", w.taskID, w.fileIndex))
+	if err := w.cm.Write(w.ctx, filePath, w.buf.Bytes()); err != nil {
+		return err
+	}
+	w.fileIndex++
+	w.files = append(w.files, filePath)
+	w.fw = nil
+	w.buf = nil
+	return nil
+}
+
+// takeFiles returns the files written since the last call.
+func (w *writer) takeFiles() []string {
+	files := w.files
+	w.files = nil
+	return files
+}
+
+// Close writes the buffered rows and finishes the current file.
+func (w *writer) Close() error {
+	if err := w.flushBatch(); err != nil {
+		return err
+	}
+	return w.finishFile()
+}
@@ -0,0 +1,189 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package importv2
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"strings"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/json"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/importutilv2"
+	"github.com/milvus-io/milvus/internal/util/importutilv2/common"
+	"github.com/milvus-io/milvus/pkg/v2/proto/internalpb"
+	"github.com/milvus-io/milvus/pkg/v2/util/merr"
+	"github.com/milvus-io/milvus/pkg/v2/util/typeutil"
+)
+
+const badRowsReportDir = "import_bad_rows"
+
+// BadRowsReport is the report of the bad rows found in an import file,
+// which is written to the object storage in JSON format.
+type BadRowsReport struct {
+	JobID        int64    `json:"job_id"`
+	FileID       int64    `json:"file_id"`
+	Paths        []string `json:"paths"`
+	TotalBadRows int64    `json:"total_bad_rows"`
+	// BadRows holds the first bad rows found, the number is capped by dataNode.import.maxBadRowsInReport.
+	BadRows []*common.BadRow `json:"bad_rows"`
+	// PKCheckTruncated is true if the number of primary keys exceeds dataNode.import.maxPrimaryKeysInDryRun,
+	// the rows after that are only checked against the primary keys tracked before.
+	PKCheckTruncated bool `json:"pk_check_truncated,omitempty"`
+}
+
+// GetBadRowsReportPath returns the path of the bad rows report of an import file.
+func GetBadRowsReportPath(rootPath string, jobID int64, fileID int64) string {
+	return path.Join(rootPath, badRowsReportDir, fmt.Sprint(jobID), fmt.Sprintf("%d.json", fileID))
+}
+
+// badRowCollector collects the bad rows of an import file. In dry-run mode, all the bad rows
+// are accepted, otherwise the import fails once the number of bad rows exceeds max_bad_rows.
+type badRowCollector struct {
+	file        *internalpb.ImportFile
+	dryRun      bool
+	maxBadRows  int64
+	reportLimit int
+
+	total   int64
+	badRows []*common.BadRow
+
+	// the indexes of the rows skipped by the reader, used to locate the parsed rows in the file
+	skipped []int64
+	cursor  int
+
+	// the primary keys seen so far and the indexes of their first rows, only checked in dry-run mode,
+	// at most maxPKs keys are tracked to bound the memory
+	pkField      *schemapb.FieldSchema
+	pks          map[any]int64
+	maxPKs       int
+	pksTruncated bool
+}
+
+func newBadRowCollector(file *internalpb.ImportFile, schema *schemapb.CollectionSchema,
+	dryRun bool, maxBadRows int64, reportLimit int, maxPKs int,
+) *badRowCollector {
+	c := &badRowCollector{
+		file:        file,
+		dryRun:      dryRun,
+		maxBadRows:  maxBadRows,
+		reportLimit: reportLimit,
+		maxPKs:      maxPKs,
+	}
+	pkField, err := typeutil.GetPrimaryFieldSchema(schema)
+	if dryRun && maxPKs > 0 && err == nil && !pkField.GetAutoID() {
+		c.pkField = pkField
+		c.pks = make(map[any]int64)
+	}
+	return c
+}
+
+func (c *badRowCollector) add(row *common.BadRow) {
+	c.total++
+	if len(c.badRows) < c.reportLimit {
+		c.badRows = append(c.badRows, row)
+	}
+}
+
+// Handle is the bad row handler passed to the reader.
+func (c *badRowCollector) Handle(row *common.BadRow) error {
+	c.add(row)
+	c.skipped = append(c.skipped, row.Row)
+	if !c.dryRun && c.total > c.maxBadRows {
+		return merr.WrapErrImportFailed(fmt.Sprintf("the number of bad rows exceeds the limit %s=%d, "+
+			"the last bad row is row %d of file '%s', reason: %s", importutilv2.MaxBadRows, c.maxBadRows, row.Row, row.File, row.Reason))
+	}
+	return nil
+}
+
+// HandleReadError records the error which stops reading the file in dry-run mode.
+func (c *badRowCollector) HandleReadError(err error) {
+	c.add(&common.BadRow{
+		File:   c.filePath(),
+		Row:    -1,
+		Field:  common.GetErrorField(err),
+		Reason: err.Error(),
+	})
+}
+
+func (c *badRowCollector) filePath() string {
+	return strings.Join(c.file.GetPaths(), ",")
+}
+
+// rowIndex converts the ordinal of a parsed row to its index in the file, by counting in the skipped rows.
+// The ordinals must be passed in ascending order.
+func (c *badRowCollector) rowIndex(ordinal int64) int64 {
+	for c.cursor < len(c.skipped) && c.skipped[c.cursor] <= ordinal+int64(c.cursor) {
+		c.cursor++
+	}
+	return ordinal + int64(c.cursor)
+}
+
+// CheckPrimaryKeys reports the duplicated primary keys in the parsed rows, offset is the ordinal of the first row.
+func (c *badRowCollector) CheckPrimaryKeys(data *storage.InsertData, offset int64) {
+	if c.pks == nil {
+		return
+	}
+	fieldData, ok := data.Data[c.pkField.GetFieldID()]
+	if !ok {
+		return
+	}
+	for i := 0; i < fieldData.RowNum(); i++ {
+		pk := fieldData.GetRow(i)
+		index := c.rowIndex(offset + int64(i))
+		if first, ok := c.pks[pk]; ok {
+			c.add(&common.BadRow{
+				File:   c.filePath(),
+				Row:    index,
+				Field:  c.pkField.GetName(),
+				Reason: fmt.Sprintf("duplicated primary key '%v', which first appears at row %d", pk, first),
+			})
+			continue
+		}
+		if len(c.pks) >= c.maxPKs {
+			c.pksTruncated = true
+			continue
+		}
+		c.pks[pk] = index
+	}
+}
+
+// WriteReport writes the report to the object storage if any bad row is found, and returns the path.
+func (c *badRowCollector) WriteReport(ctx context.Context, cm storage.ChunkManager, jobID int64) (string, error) {
+	if c.total == 0 {
+		return "", nil
+	}
+	report := &BadRowsReport{
+		JobID:            jobID,
+		FileID:           c.file.GetId(),
+		Paths:            c.file.GetPaths(),
+		TotalBadRows:     c.total,
+		BadRows:          c.badRows,
+		PKCheckTruncated: c.pksTruncated,
+	}
+	bytes, err := json.Marshal(report)
+	if err != nil {
+		return "", err
+	}
+	reportPath := GetBadRowsReportPath(cm.RootPath(), jobID, c.file.GetId())
+	if err = cm.Write(ctx, reportPath, bytes); err != nil {
+		return "", err
+	}
+	return reportPath, nil
+}
@@ -384,6 +384,102 @@ func (s *SchedulerSuite) TestScheduler_ReadFileStat() {
 	s.NoError(err)
 }
 
+func (s *SchedulerSuite) TestScheduler_ReadFileStat_BadRows() {
+	content := &sampleContent{
+		Rows: make([]sampleRow, 0),
+	}
+	for i := 0; i < 10; i++ {
+		row := sampleRow{
+			FieldString:      "No." + strconv.FormatInt(int64(i), 10),
+			FieldInt64:       int64(99999999999999999 + i),
+			FieldFloatVector: []float32{float32(i) + 0.1, float32(i) + 0.2, float32(i) + 0.3, float32(i) + 0.4},
+		}
+		switch i {
+		case 0, 5: // the int64 field is missed
+			row.FieldInt64 = 0
+		case 2: // duplicated primary key
+			row.FieldString = "No.1"
+		}
+		content.Rows = append(content.Rows, row)
+	}
+	bytes, err := json.Marshal(content)
+	s.NoError(err)
+
+	run := func(options []*commonpb.KeyValuePair) (*PreImportTask, []byte, error) {
+		cm := mocks.NewChunkManager(s.T())
+		cm.EXPECT().Size(mock.Anything, mock.Anything).Return(1024, nil)
+		cm.EXPECT().Reader(mock.Anything, mock.Anything).Return(&mockReader{Reader: strings.NewReader(string(bytes))}, nil)
+		cm.EXPECT().RootPath().Return("root").Maybe()
+		var report []byte
+		cm.EXPECT().Write(mock.Anything, "root/import_bad_rows/1/5.json", mock.Anything).RunAndReturn(
+			func(ctx context.Context, path string, content []byte) error {
+				report = content
+				return nil
+			}).Maybe()
+
+		importFile := &internalpb.ImportFile{Id: 5, Paths: []string{"dummy.json"}}
+		preimportReq := &datapb.PreImportRequest{
+			JobID:        1,
+			TaskID:       2,
+			CollectionID: 3,
+			PartitionIDs: []int64{4},
+			Vchannels:    []string{"ch-0"},
+			Schema:       s.schema,
+			ImportFiles:  []*internalpb.ImportFile{importFile},
+			Options:      options,
+		}
+		manager := NewTaskManager()
+		preimportTask := NewPreImportTask(preimportReq, manager, cm).(*PreImportTask)
+		manager.Add(preimportTask)
+		reader, err := importutilv2.NewReader(context.Background(), cm, s.schema, importFile, options, 1024*1024)
+		s.NoError(err)
+		err = preimportTask.readFileStat(reader, 0)
+		return manager.Get(preimportTask.GetTaskID()).(*PreImportTask), report, err
+	}
+
+	// dry-run, all the bad rows and the duplicated primary keys are reported
+	task, bs, err := run([]*commonpb.KeyValuePair{{Key: importutilv2.DryRun, Value: "true"}})
+	s.NoError(err)
+	s.Equal(int64(8), task.GetFileStats()[0].GetTotalRows())
+	s.Equal("found 3 bad rows in file [dummy.json], report=root/import_bad_rows/1/5.json", task.GetReason())
+	report := &BadRowsReport{}
+	s.NoError(json.Unmarshal(bs, report))
+	s.Equal(int64(1), report.JobID)
+	s.Equal(int64(5), report.FileID)
+	s.Equal(int64(3), report.TotalBadRows)
+	s.Equal(3, len(report.BadRows))
+	s.Equal(int64(0), report.BadRows[0].Row)
+	s.Equal("int64", report.BadRows[0].Field)
+	s.Equal(int64(5), report.BadRows[1].Row)
+	s.Equal("int64", report.BadRows[1].Field)
+	s.Equal(int64(2), report.BadRows[2].Row)
+	s.Equal("pk", report.BadRows[2].Field)
+	s.Contains(report.BadRows[2].Reason, "first appears at row 1")
+	s.False(report.PKCheckTruncated)
+
+	// only the first primary key is tracked, the duplicated key of it is still reported
+	paramtable.Get().Save(paramtable.Get().DataNodeCfg.MaxPrimaryKeysInDryRun.Key, "1")
+	defer paramtable.Get().Reset(paramtable.Get().DataNodeCfg.MaxPrimaryKeysInDryRun.Key)
+	_, bs, err = run([]*commonpb.KeyValuePair{{Key: importutilv2.DryRun, Value: "true"}})
+	s.NoError(err)
+	report = &BadRowsReport{}
+	s.NoError(json.Unmarshal(bs, report))
+	s.Equal(int64(3), report.TotalBadRows)
+	s.True(report.PKCheckTruncated)
+
+	// skip the bad rows up to max_bad_rows
+	task, bs, err = run([]*commonpb.KeyValuePair{{Key: importutilv2.MaxBadRows, Value: "2"}})
+	s.NoError(err)
+	s.Equal(int64(8), task.GetFileStats()[0].GetTotalRows())
+	s.NoError(json.Unmarshal(bs, report))
+	s.Equal(int64(2), report.TotalBadRows)
+
+	// the number of bad rows exceeds max_bad_rows
+	_, _, err = run([]*commonpb.KeyValuePair{{Key: importutilv2.MaxBadRows, Value: "1"}})
+	s.ErrorContains(err, "the number of bad rows exceeds the limit max_bad_rows=1")
+	s.ErrorContains(err, "report=root/import_bad_rows/1/5.json")
+}
+
 func (s *SchedulerSuite) TestScheduler_ImportFile() {
 	s.syncMgr.EXPECT().SyncData(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, task syncmgr.Task, callbacks ...func(error) error) (*conc.Future[struct{}], error) {
 		future := conc.Go(func() (struct{}, error) {
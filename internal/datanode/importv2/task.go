@@ -96,6 +96,24 @@ func UpdateReason(reason string) UpdateAction {
 	}
 }
 
+// AppendReason appends the reason to the existing one, used to accumulate the messages of the files.
+func AppendReason(reason string) UpdateAction {
+	return func(t Task) {
+		appendFn := func(current string) string {
+			if current == "" {
+				return reason
+			}
+			return current + "; " + reason
+		}
+		switch t.GetType() {
+		case PreImportTaskType:
+			t.(*PreImportTask).PreImportTask.Reason = appendFn(t.(*PreImportTask).PreImportTask.Reason)
+		case ImportTaskType:
+			t.(*ImportTask).ImportTaskV2.Reason = appendFn(t.(*ImportTask).ImportTaskV2.Reason)
+		}
+	}
+}
+
 func UpdateFileStat(idx int, fileStat *datapb.ImportFileStats) UpdateAction {
 	return func(task Task) {
 		var t *datapb.PreImportTask
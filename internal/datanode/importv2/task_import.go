@@ -18,6 +18,7 @@ package importv2
 
 import (
 	"context"
+	"fmt"
 	"io"
 	"math"
 	"time"
@@ -37,6 +38,7 @@ import (
 	"github.com/milvus-io/milvus/pkg/v2/proto/internalpb"
 	"github.com/milvus-io/milvus/pkg/v2/util/conc"
 	"github.com/milvus-io/milvus/pkg/v2/util/funcutil"
+	"github.com/milvus-io/milvus/pkg/v2/util/merr"
 	"github.com/milvus-io/milvus/pkg/v2/util/paramtable"
 	"github.com/milvus-io/milvus/pkg/v2/util/typeutil"
 )
@@ -156,6 +158,11 @@ func (t *ImportTask) Execute() []*conc.Future[any] {
 			return err
 		}
 		defer reader.Close()
+		err = t.skipBadRows(reader, file)
+		if err != nil {
+			t.manager.Update(t.GetTaskID(), UpdateState(datapb.ImportTaskStateV2_Failed), UpdateReason(err.Error()))
+			return err
+		}
 		start := time.Now()
 		err = t.importFile(reader)
 		if err != nil {
@@ -180,6 +187,23 @@ func (t *ImportTask) Execute() []*conc.Future[any] {
 	return futures
 }
 
+// skipBadRows makes the reader skip the bad rows if max_bad_rows is set, the bad rows have been
+// reported by the preimport, so only the number of skipped rows is checked here.
+func (t *ImportTask) skipBadRows(reader importutilv2.Reader, file *internalpb.ImportFile) error {
+	maxBadRows, err := importutilv2.GetMaxBadRows(t.req.GetOptions())
+	if err != nil || maxBadRows == 0 {
+		return err
+	}
+	skipper, ok := reader.(importutilv2.BadRowSkipper)
+	if !ok {
+		return merr.WrapErrImportFailed(fmt.Sprintf("option '%s' is not supported by the file type of %v",
+			importutilv2.MaxBadRows, file.GetPaths()))
+	}
+	collector := newBadRowCollector(file, t.GetSchema(), false, maxBadRows, 0, 0)
+	skipper.SetBadRowHandler(collector.Handle)
+	return nil
+}
+
 func (t *ImportTask) importFile(reader importutilv2.Reader) error {
 	syncFutures := make([]*conc.Future[struct{}], 0)
 	syncTasks := make([]syncmgr.Task, 0)
@@ -35,6 +35,7 @@ import (
 	"github.com/milvus-io/milvus/pkg/v2/proto/internalpb"
 	"github.com/milvus-io/milvus/pkg/v2/util/conc"
 	"github.com/milvus-io/milvus/pkg/v2/util/funcutil"
+	"github.com/milvus-io/milvus/pkg/v2/util/merr"
 	"github.com/milvus-io/milvus/pkg/v2/util/paramtable"
 	"github.com/milvus-io/milvus/pkg/v2/util/typeutil"
 )
@@ -179,20 +180,35 @@ func (t *PreImportTask) readFileStat(reader importutilv2.Reader, fileIdx int) er
 				"fileSize=%d, maxSize=%d", fileSize, int64(maxSize)))
 	}
 
+	collector, err := t.newBadRowCollector(reader, fileIdx)
+	if err != nil {
+		return err
+	}
+
 	totalRows := 0
 	totalSize := 0
 	hashedStats := make(map[string]*datapb.PartitionImportStats)
 	for {
 		data, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err == nil {
+			err = CheckRowsEqual(t.GetSchema(), data)
+		}
 		if err != nil {
-			if errors.Is(err, io.EOF) {
-				break
+			if collector == nil {
+				return err
 			}
-			return err
+			if !collector.dryRun {
+				return t.wrapBadRowsError(collector, err)
+			}
+			// In dry-run mode, the error which stops reading is reported as a bad row as well.
+			collector.HandleReadError(err)
+			break
 		}
-		err = CheckRowsEqual(t.GetSchema(), data)
-		if err != nil {
-			return err
+		if collector != nil {
+			collector.CheckPrimaryKeys(data, int64(totalRows))
 		}
 		rowsCount, err := GetRowsStats(t, data)
 		if err != nil {
@@ -213,5 +229,59 @@ func (t *PreImportTask) readFileStat(reader importutilv2.Reader, fileIdx int) er
 		HashedStats:     hashedStats,
 	}
 	t.manager.Update(t.GetTaskID(), UpdateFileStat(fileIdx, stat))
+	if collector == nil {
+		return nil
+	}
+	if collector.pksTruncated {
+		log.Warn("too many primary keys to check duplication in dry-run, the later keys are not tracked",
+			WrapLogFields(t, zap.Strings("files", collector.file.GetPaths()), zap.Int("maxPrimaryKeys", collector.maxPKs))...)
+	}
+	reportPath, err := collector.WriteReport(t.ctx, t.cm, t.GetJobID())
+	if err != nil {
+		return err
+	}
+	if reportPath != "" {
+		log.Warn("bad rows found", WrapLogFields(t, zap.Strings("files", collector.file.GetPaths()),
+			zap.Int64("badRows", collector.total), zap.String("report", reportPath))...)
+		t.manager.Update(t.GetTaskID(), AppendReason(fmt.Sprintf("found %d bad rows in file %v, report=%s",
+			collector.total, collector.file.GetPaths(), reportPath)))
+	}
 	return nil
 }
+
+// newBadRowCollector creates the collector of bad rows if the import is in dry-run mode or allowed to skip bad rows,
+// nil is returned if the import fails on the first bad row.
+func (t *PreImportTask) newBadRowCollector(reader importutilv2.Reader, fileIdx int) (*badRowCollector, error) {
+	dryRun := importutilv2.IsDryRun(t.options)
+	maxBadRows, err := importutilv2.GetMaxBadRows(t.options)
+	if err != nil {
+		return nil, err
+	}
+	if !dryRun && maxBadRows == 0 {
+		return nil, nil
+	}
+	reportLimit := paramtable.Get().DataNodeCfg.MaxBadRowsInReport.GetAsInt()
+	maxPKs := paramtable.Get().DataNodeCfg.MaxPrimaryKeysInDryRun.GetAsInt()
+	collector := newBadRowCollector(t.GetFileStats()[fileIdx].GetImportFile(), t.GetSchema(), dryRun, maxBadRows, reportLimit, maxPKs)
+	skipper, ok := reader.(importutilv2.BadRowSkipper)
+	if !ok {
+		// the column-based readers stop at the first error, which is reported as a bad row in dry-run mode
+		if maxBadRows > 0 {
+			return nil, merr.WrapErrImportFailed(fmt.Sprintf("option '%s' is not supported by the file type of %v",
+				importutilv2.MaxBadRows, collector.file.GetPaths()))
+		}
+		return collector, nil
+	}
+	skipper.SetBadRowHandler(collector.Handle)
+	return collector, nil
+}
+
+// wrapBadRowsError writes the report of the bad rows collected before the import fails,
+// and attaches the report path to the error.
+func (t *PreImportTask) wrapBadRowsError(collector *badRowCollector, err error) error {
+	reportPath, writeErr := collector.WriteReport(t.ctx, t.cm, t.GetJobID())
+	if writeErr != nil || reportPath == "" {
+		return err
+	}
+	return fmt.Errorf("%w, report=%s", err, reportPath)
+}
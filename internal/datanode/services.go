@@ -30,6 +30,7 @@ import (
 	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
 	"github.com/milvus-io/milvus/internal/compaction"
 	"github.com/milvus-io/milvus/internal/datanode/compactor"
+	"github.com/milvus-io/milvus/internal/datanode/exportv2"
 	"github.com/milvus-io/milvus/internal/datanode/importv2"
 	"github.com/milvus-io/milvus/internal/flushcommon/io"
 	"github.com/milvus-io/milvus/internal/flushcommon/metacache/pkoracle"
@@ -574,3 +575,64 @@ func (node *DataNode) DropCompactionPlan(ctx context.Context, req *datapb.DropCo
 	log.Ctx(ctx).Info("DropCompactionPlans success", zap.Int64("planID", req.GetPlanID()))
 	return merr.Success(), nil
 }
+
+func (node *DataNode) ExportV2(ctx context.Context, req *datapb.ExportRequest) (*commonpb.Status, error) {
+	log := log.Ctx(ctx).With(zap.Int64("taskID", req.GetTaskID()),
+		zap.Int64("jobID", req.GetJobID()),
+		zap.Int64("collectionID", req.GetCollectionID()),
+		zap.Uint64("ts", req.GetTs()),
+		zap.Int("segments", len(req.GetSegments())),
+		zap.Int("l0Segments", len(req.GetL0Segments())))
+
+	log.Info("datanode receive export request")
+
+	if err := merr.CheckHealthy(node.GetStateCode()); err != nil {
+		return merr.Status(err), nil
+	}
+	node.exportTaskMgr.Add(exportv2.NewTask(req, node.chunkManager))
+
+	log.Info("datanode added export task")
+	return merr.Success(), nil
+}
+
+func (node *DataNode) QueryExport(ctx context.Context, req *datapb.QueryExportRequest) (*datapb.QueryExportResponse, error) {
+	log := log.Ctx(ctx).With(zap.Int64("taskID", req.GetTaskID()),
+		zap.Int64("jobID", req.GetJobID()))
+
+	if err := merr.CheckHealthy(node.GetStateCode()); err != nil {
+		return &datapb.QueryExportResponse{Status: merr.Status(err)}, nil
+	}
+	task := node.exportTaskMgr.Get(req.GetTaskID())
+	if task == nil {
+		return &datapb.QueryExportResponse{
+			Status: merr.Status(merr.WrapErrServiceInternal(fmt.Sprintf("cannot find export task with id %d", req.GetTaskID()))),
+		}, nil
+	}
+	scannedRows, exportedRows, files := task.GetProgress()
+	log.RatedInfo(10, "datanode query export", zap.String("state", task.GetState().String()),
+		zap.String("reason", task.GetReason()), zap.Int64("exportedRows", exportedRows))
+	return &datapb.QueryExportResponse{
+		Status:       merr.Success(),
+		TaskID:       task.GetTaskID(),
+		State:        task.GetState(),
+		Reason:       task.GetReason(),
+		ScannedRows:  scannedRows,
+		ExportedRows: exportedRows,
+		Files:        files,
+	}, nil
+}
+
+func (node *DataNode) DropExport(ctx context.Context, req *datapb.DropExportRequest) (*commonpb.Status, error) {
+	log := log.Ctx(ctx).With(zap.Int64("taskID", req.GetTaskID()),
+		zap.Int64("jobID", req.GetJobID()))
+
+	if err := merr.CheckHealthy(node.GetStateCode()); err != nil {
+		return merr.Status(err), nil
+	}
+
+	node.exportTaskMgr.Remove(req.GetTaskID())
+
+	log.Info("datanode drop export done")
+
+	return merr.Success(), nil
+}
@@ -1189,3 +1189,55 @@ func (s *DataNodeServicesSuite) TestDropCompactionPlan() {
 		s.True(merr.Ok(status))
 	})
 }
+
+func (s *DataNodeServicesSuite) TestExport() {
+	s.Run("node not healthy", func() {
+		s.SetupTest()
+		s.node.UpdateStateCode(commonpb.StateCode_Abnormal)
+
+		ctx := context.Background()
+		status, err := s.node.ExportV2(ctx, &datapb.ExportRequest{})
+		s.NoError(err)
+		s.ErrorIs(merr.Error(status), merr.ErrServiceNotReady)
+		resp, err := s.node.QueryExport(ctx, &datapb.QueryExportRequest{})
+		s.NoError(err)
+		s.ErrorIs(merr.Error(resp.GetStatus()), merr.ErrServiceNotReady)
+		status, err = s.node.DropExport(ctx, &datapb.DropExportRequest{})
+		s.NoError(err)
+		s.ErrorIs(merr.Error(status), merr.ErrServiceNotReady)
+	})
+
+	s.Run("normal case", func() {
+		s.SetupTest()
+		ctx := context.Background()
+		req := &datapb.ExportRequest{
+			JobID:  1,
+			TaskID: 2,
+			Schema: &schemapb.CollectionSchema{
+				Fields: []*schemapb.FieldSchema{
+					{FieldID: 100, Name: "pk", DataType: schemapb.DataType_Int64, IsPrimaryKey: true},
+				},
+			},
+			Ts:          100,
+			OutputPath:  "/tmp/milvus_test/datanode/export/1",
+			MaxFileSize: 1024,
+		}
+		status, err := s.node.ExportV2(ctx, req)
+		s.NoError(err)
+		s.True(merr.Ok(status))
+
+		s.Eventually(func() bool {
+			resp, err := s.node.QueryExport(ctx, &datapb.QueryExportRequest{JobID: 1, TaskID: 2})
+			s.NoError(err)
+			s.True(merr.Ok(resp.GetStatus()))
+			return resp.GetState() == datapb.ImportTaskStateV2_Completed
+		}, 10*time.Second, 10*time.Millisecond)
+
+		status, err = s.node.DropExport(ctx, &datapb.DropExportRequest{JobID: 1, TaskID: 2})
+		s.NoError(err)
+		s.True(merr.Ok(status))
+		resp, err := s.node.QueryExport(ctx, &datapb.QueryExportRequest{JobID: 1, TaskID: 2})
+		s.NoError(err)
+		s.False(merr.Ok(resp.GetStatus()))
+	})
+}
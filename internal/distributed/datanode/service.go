@@ -410,3 +410,15 @@ func (s *Server) QuerySlot(ctx context.Context, req *datapb.QuerySlotRequest) (*
 func (s *Server) DropCompactionPlan(ctx context.Context, req *datapb.DropCompactionPlanRequest) (*commonpb.Status, error) {
 	return s.datanode.DropCompactionPlan(ctx, req)
 }
+
+func (s *Server) ExportV2(ctx context.Context, req *datapb.ExportRequest) (*commonpb.Status, error) {
+	return s.datanode.ExportV2(ctx, req)
+}
+
+func (s *Server) QueryExport(ctx context.Context, req *datapb.QueryExportRequest) (*datapb.QueryExportResponse, error) {
+	return s.datanode.QueryExport(ctx, req)
+}
+
+func (s *Server) DropExport(ctx context.Context, req *datapb.DropExportRequest) (*commonpb.Status, error) {
+	return s.datanode.DropExport(ctx, req)
+}
@@ -185,6 +185,18 @@ func (m *MockDataNode) DropCompactionPlan(ctx context.Context, req *datapb.DropC
 	return m.status, m.err
 }
 
+func (m *MockDataNode) ExportV2(ctx context.Context, req *datapb.ExportRequest) (*commonpb.Status, error) {
+	return m.status, m.err
+}
+
+func (m *MockDataNode) QueryExport(ctx context.Context, req *datapb.QueryExportRequest) (*datapb.QueryExportResponse, error) {
+	return &datapb.QueryExportResponse{}, m.err
+}
+
+func (m *MockDataNode) DropExport(ctx context.Context, req *datapb.DropExportRequest) (*commonpb.Status, error) {
+	return m.status, m.err
+}
+
 // /////////////////////////////////////////////////////////////////////////////////////////////////////////////////////
 func Test_NewServer(t *testing.T) {
 	paramtable.Init()
@@ -332,6 +344,23 @@ func Test_NewServer(t *testing.T) {
 		assert.NotNil(t, resp)
 	})
 
+	t.Run("Export", func(t *testing.T) {
+		server.datanode = &MockDataNode{
+			status: &commonpb.Status{},
+		}
+		status, err := server.ExportV2(ctx, nil)
+		assert.NoError(t, err)
+		assert.NotNil(t, status)
+
+		resp, err := server.QueryExport(ctx, nil)
+		assert.NoError(t, err)
+		assert.NotNil(t, resp)
+
+		status, err = server.DropExport(ctx, nil)
+		assert.NoError(t, err)
+		assert.NotNil(t, status)
+	})
+
 	err = server.Stop()
 	assert.NoError(t, err)
 }
@@ -186,6 +186,8 @@ const (
 	ParamRoundDecimal    = "round_decimal"
 	ParamOffset          = "offset"
 	ParamLimit           = "limit"
+	ParamOrderBy         = "order_by"
+	ParamGroupByFields   = "group_by_fields"
 	ParamRadius          = "radius"
 	ParamRangeFilter     = "range_filter"
 	ParamGroupByField    = "group_by_field"
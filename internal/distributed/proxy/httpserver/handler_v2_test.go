@@ -2025,8 +2025,16 @@ func TestDML(t *testing.T) {
 				}
 			}
 		}
+		for _, pair := range req.QueryParams {
+			if pair.GetKey() == ParamOrderBy && pair.GetValue() != "word_count desc, book_id" {
+				return nil, fmt.Errorf("mock error")
+			}
+			if pair.GetKey() == ParamGroupByFields && pair.GetValue() != "word_count" {
+				return nil, fmt.Errorf("mock error")
+			}
+		}
 		return &milvuspb.QueryResults{Status: commonSuccessStatus, OutputFields: []string{}, FieldsData: []*schemapb.FieldData{}}, nil
-	}).Times(4)
+	}).Times(6)
 	mp.EXPECT().Insert(mock.Anything, mock.Anything).Return(&milvuspb.MutationResult{Status: commonSuccessStatus, InsertCnt: int64(0), IDs: &schemapb.IDs{IdField: &schemapb.IDs_IntId{IntId: &schemapb.LongArray{Data: []int64{}}}}}, nil).Once()
 	mp.EXPECT().Insert(mock.Anything, mock.Anything).Return(&milvuspb.MutationResult{Status: commonSuccessStatus, InsertCnt: int64(0), IDs: &schemapb.IDs{IdField: &schemapb.IDs_StrId{StrId: &schemapb.StringArray{Data: []string{}}}}}, nil).Once()
 	mp.EXPECT().Upsert(mock.Anything, mock.Anything).Return(&milvuspb.MutationResult{Status: commonSuccessStatus, UpsertCnt: int64(0), IDs: &schemapb.IDs{IdField: &schemapb.IDs_IntId{IntId: &schemapb.LongArray{Data: []int64{}}}}}, nil).Once()
@@ -2059,6 +2067,14 @@ func TestDML(t *testing.T) {
 		path:        QueryAction,
 		requestBody: []byte(`{"collectionName": "book", "filter": "", "outputFields": ["count(*)"], "limit": 10}`),
 	})
+	queryTestCases = append(queryTestCases, requestBodyTestCase{
+		path:        QueryAction,
+		requestBody: []byte(`{"collectionName": "book", "filter": "book_id > 0", "outputFields": ["book_id", "word_count"], "limit": 10, "orderBy": "word_count desc, book_id"}`),
+	})
+	queryTestCases = append(queryTestCases, requestBodyTestCase{
+		path:        QueryAction,
+		requestBody: []byte(`{"collectionName": "book", "filter": "book_id > 0", "outputFields": ["word_count", "count(*)"], "groupByFields": ["word_count"]}`),
+	})
 	queryTestCases = append(queryTestCases, requestBodyTestCase{
 		path:        InsertAction,
 		requestBody: []byte(`{"collectionName": "book", "data": [{"book_id": 0, "word_count": 0, "book_intro": [0.11825, 0.6]}]}`),
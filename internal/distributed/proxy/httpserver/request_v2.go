@@ -216,6 +216,8 @@ type QueryReqV2 struct {
 	Filter         string                 `json:"filter"`
 	Limit          int32                  `json:"limit"`
 	Offset         int32                  `json:"offset"`
+	OrderBy        string                 `json:"orderBy"`
+	GroupByFields  []string               `json:"groupByFields"`
 	ExprParams     map[string]interface{} `json:"exprParams"`
 }
 
@@ -1536,7 +1536,14 @@ func CheckLimiter(ctx context.Context, req interface{}, pxy types.ProxyComponent
 	if err != nil {
 		return nil, err
 	}
-	err = limiter.Check(dbID, collectionIDToPartIDs, rt, n)
+	username := proxy.GetCurUserFromContextOrDefault(ctx)
+	err = limiter.CheckUser(username, rt, n)
+	if err == nil {
+		err = limiter.Check(dbID, collectionIDToPartIDs, rt, n)
+		if err != nil {
+			limiter.CancelUser(username, rt, n)
+		}
+	}
 	nodeID := strconv.FormatInt(paramtable.GetNodeID(), 10)
 	metrics.ProxyRateLimitReqCount.WithLabelValues(nodeID, rt.String(), metrics.TotalLabel).Inc()
 	if err != nil {
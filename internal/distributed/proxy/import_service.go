@@ -0,0 +1,73 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package grpcproxy
+
+import (
+	"context"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus/internal/proxy"
+	"github.com/milvus-io/milvus/internal/types"
+	"github.com/milvus-io/milvus/pkg/v2/proto/internalpb"
+	"github.com/milvus-io/milvus/pkg/v2/proto/proxypb"
+	"github.com/milvus-io/milvus/pkg/v2/util/merr"
+)
+
+// importServer exposes the import RPCs of the proxy service to the external grpc server,
+// so that the SDKs could create and watch import jobs without the restful api.
+// The other RPCs of the proxy service are internal and stay unimplemented.
+type importServer struct {
+	proxypb.UnimplementedProxyServer
+
+	proxy types.ProxyComponent
+}
+
+func newImportServer(proxy types.ProxyComponent) *importServer {
+	return &importServer{proxy: proxy}
+}
+
+// The privilege interceptor doesn't recognize the internal requests, check the privileges with the placeholders instead,
+// the same as the restful handlers do.
+func (s *importServer) ImportV2(ctx context.Context, req *internalpb.ImportRequest) (*internalpb.ImportResponse, error) {
+	if _, err := proxy.PrivilegeInterceptor(ctx, &milvuspb.ImportAuthPlaceholder{
+		DbName:         req.GetDbName(),
+		CollectionName: req.GetCollectionName(),
+		PartitionName:  req.GetPartitionName(),
+	}); err != nil {
+		return &internalpb.ImportResponse{Status: merr.Status(err)}, nil
+	}
+	return s.proxy.ImportV2(ctx, req)
+}
+
+func (s *importServer) GetImportProgress(ctx context.Context, req *internalpb.GetImportProgressRequest) (*internalpb.GetImportProgressResponse, error) {
+	if _, err := proxy.PrivilegeInterceptor(ctx, &milvuspb.GetImportProgressAuthPlaceholder{
+		DbName: req.GetDbName(),
+	}); err != nil {
+		return &internalpb.GetImportProgressResponse{Status: merr.Status(err)}, nil
+	}
+	return s.proxy.GetImportProgress(ctx, req)
+}
+
+func (s *importServer) ListImports(ctx context.Context, req *internalpb.ListImportsRequest) (*internalpb.ListImportsResponse, error) {
+	if _, err := proxy.PrivilegeInterceptor(ctx, &milvuspb.ListImportsAuthPlaceholder{
+		DbName:         req.GetDbName(),
+		CollectionName: req.GetCollectionName(),
+	}); err != nil {
+		return &internalpb.ListImportsResponse{Status: merr.Status(err)}, nil
+	}
+	return s.proxy.ListImports(ctx, req)
+}
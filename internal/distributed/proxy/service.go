@@ -316,6 +316,9 @@ func (s *Server) startExternalGrpc(errChan chan error) {
 
 	if enableRegisterProxyServer {
 		proxypb.RegisterProxyServer(s.grpcExternalServer, s)
+	} else {
+		// only the import RPCs of the proxy service are exposed to the SDKs
+		proxypb.RegisterProxyServer(s.grpcExternalServer, newImportServer(s.proxy))
 	}
 
 	milvuspb.RegisterMilvusServiceServer(s.grpcExternalServer, s)
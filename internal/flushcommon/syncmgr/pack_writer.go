@@ -30,6 +30,7 @@ import (
 	"github.com/milvus-io/milvus/pkg/v2/log"
 	"github.com/milvus-io/milvus/pkg/v2/proto/datapb"
 	"github.com/milvus-io/milvus/pkg/v2/util/metautil"
+	"github.com/milvus-io/milvus/pkg/v2/util/paramtable"
 	"github.com/milvus-io/milvus/pkg/v2/util/retry"
 )
 
@@ -232,6 +233,23 @@ func (bw *BulkPackWriter) writeStats(ctx context.Context, pack *SyncPack) (map[i
 		FieldID: pkFieldID,
 		Binlogs: binlogs,
 	}
+
+	// zone maps are written for each sync batch, the zone map of a segment is the merge of all its batches.
+	zoneMapBlobs, err := serializer.serializeZoneMaps(pack, paramtable.Get().DataNodeCfg.ZoneMapEnabled.GetAsBool())
+	if err != nil {
+		return nil, err
+	}
+	for fieldID, blob := range zoneMapBlobs {
+		k := metautil.JoinIDPath(pack.collectionID, pack.partitionID, pack.segmentID, fieldID, bw.nextID())
+		binlog, err := bw.writeLog(ctx, blob, common.SegmentStatslogPath, k, pack)
+		if err != nil {
+			return nil, err
+		}
+		logs[fieldID] = &datapb.FieldBinlog{
+			FieldID: fieldID,
+			Binlogs: []*datapb.Binlog{binlog},
+		}
+	}
 	return logs, nil
 }
 
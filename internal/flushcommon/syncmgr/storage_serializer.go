@@ -130,6 +130,43 @@ func (s *storageV1Serializer) serializeStatslog(pack *SyncPack) (*storage.Primar
 	return stats, blob, nil
 }
 
+// serializeZoneMaps collects the min/max value and null count of the scalar fields except the primary key,
+// which are used by the delegator to prune segments. The bloom filters of the fields enabling them are
+// collected as well, even if the zone maps are disabled. One blob is generated for each field.
+func (s *storageV1Serializer) serializeZoneMaps(pack *SyncPack, zoneMapEnabled bool) (map[int64]*storage.Blob, error) {
+	blobs := make(map[int64]*storage.Blob)
+	if len(pack.insertData) == 0 {
+		return blobs, nil
+	}
+	var batchRows int64
+	for _, chunk := range pack.insertData {
+		batchRows += int64(chunk.GetRowNum())
+	}
+	for _, stats := range storage.NewZoneMapStatsList(s.schema, batchRows, zoneMapEnabled) {
+		var rowNum int64
+		complete := true
+		for _, chunk := range pack.insertData {
+			fieldData, ok := chunk.Data[stats.FieldID]
+			if !ok {
+				// the field may be added after the data is buffered, an incomplete zone map shall not be written.
+				complete = false
+				break
+			}
+			stats.UpdateZoneMap(fieldData)
+			rowNum += int64(fieldData.RowNum())
+		}
+		if !complete {
+			continue
+		}
+		blob, err := storage.SerializeZoneMap(stats, rowNum)
+		if err != nil {
+			return nil, err
+		}
+		blobs[stats.FieldID] = blob
+	}
+	return blobs, nil
+}
+
 func (s *storageV1Serializer) serializeMergedPkStats(pack *SyncPack) (*storage.Blob, error) {
 	segment, ok := s.metacache.GetSegmentByID(pack.segmentID)
 	if !ok {
@@ -251,6 +251,72 @@ func (s *StorageV1SerializerSuite) TestSerializeDelete() {
 	})
 }
 
+func (s *StorageV1SerializerSuite) TestSerializeZoneMaps() {
+	schema := &schemapb.CollectionSchema{
+		Name: "serializer_zone_map_test_col",
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: common.RowIDField, DataType: schemapb.DataType_Int64},
+			{FieldID: common.TimeStampField, DataType: schemapb.DataType_Int64},
+			{FieldID: 100, Name: "pk", DataType: schemapb.DataType_Int64, IsPrimaryKey: true},
+			{FieldID: 101, Name: "age", DataType: schemapb.DataType_Int32},
+			{FieldID: 102, Name: "name", DataType: schemapb.DataType_VarChar, Nullable: true, TypeParams: []*commonpb.KeyValuePair{
+				{Key: common.BloomFilterEnabledKey, Value: "true"},
+			}},
+			{FieldID: 103, Name: "flag", DataType: schemapb.DataType_Bool},
+		},
+	}
+	mockCache := metacache.NewMockMetaCache(s.T())
+	mockCache.EXPECT().Schema().Return(schema).Once()
+	serializer, err := NewStorageSerializer(mockCache)
+	s.Require().NoError(err)
+
+	buf, err := storage.NewInsertData(schema)
+	s.Require().NoError(err)
+	for i := 0; i < 10; i++ {
+		data := map[storage.FieldID]any{
+			common.RowIDField:     int64(i),
+			common.TimeStampField: int64(i),
+			100:                   int64(i),
+			101:                   int32(i * 10),
+			102:                   nil,
+			103:                   i%2 == 0,
+		}
+		if i%3 == 0 {
+			data[102] = fmt.Sprintf("name_%d", i)
+		}
+		s.Require().NoError(buf.Append(data))
+	}
+	pack := s.getBasicPack()
+	pack.WithInsertData([]*storage.InsertData{buf}).WithBatchRows(10)
+
+	blobs, err := serializer.serializeZoneMaps(pack, true)
+	s.Require().NoError(err)
+	// system fields, pk and the unsupported bool field are skipped
+	s.Len(blobs, 2)
+
+	stats, err := storage.DeserializeFieldStats(blobs[101])
+	s.Require().NoError(err)
+	s.Equal(int64(10), blobs[101].RowNum)
+	s.Equal(int32(0), stats[0].Min.GetValue())
+	s.Equal(int32(90), stats[0].Max.GetValue())
+	s.Equal(int64(0), stats[0].NullCount)
+	s.Nil(stats[0].BF)
+
+	stats, err = storage.DeserializeFieldStats(blobs[102])
+	s.Require().NoError(err)
+	s.Equal("name_0", stats[0].Min.GetValue())
+	s.Equal("name_9", stats[0].Max.GetValue())
+	s.Equal(int64(6), stats[0].NullCount)
+	s.Require().NotNil(stats[0].BF)
+	s.True(storage.BloomFilterMayContain(stats[0].BF, storage.NewVarCharFieldValue("name_3")))
+
+	// the bloom filter enabled fields are always collected
+	blobs, err = serializer.serializeZoneMaps(pack, false)
+	s.Require().NoError(err)
+	s.Len(blobs, 1)
+	s.Contains(blobs, int64(102))
+}
+
 func (s *StorageV1SerializerSuite) TestBadSchema() {
 	mockCache := metacache.NewMockMetaCache(s.T())
 	mockCache.EXPECT().Schema().Return(&schemapb.CollectionSchema{}).Once()
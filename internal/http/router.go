@@ -51,6 +51,25 @@ const (
 	RouteGcPause  = "/management/datacoord/garbage_collection/pause"
 	RouteGcResume = "/management/datacoord/garbage_collection/resume"
 
+	RouteCreateExportJob   = "/management/datacoord/export/create"
+	RouteGetExportProgress = "/management/datacoord/export/progress"
+	RouteListExportJobs    = "/management/datacoord/export/list"
+
+	RouteCreateSnapshot = "/management/datacoord/snapshot/create"
+	RouteDropSnapshot   = "/management/datacoord/snapshot/drop"
+	RouteListSnapshots  = "/management/datacoord/snapshot/list"
+	RouteCloneSnapshot  = "/management/datacoord/snapshot/clone"
+
+	RouteListCompactionTasks  = "/management/datacoord/compaction/list"
+	RouteCancelCompactionTask = "/management/datacoord/compaction/cancel"
+	RoutePauseCompaction      = "/management/datacoord/compaction/pause"
+	RouteResumeCompaction     = "/management/datacoord/compaction/resume"
+	RouteListIndexTasks       = "/management/datacoord/index/list"
+	RouteCancelIndexTask      = "/management/datacoord/index/cancel"
+	RouteResumeIndexTask      = "/management/datacoord/index/resume"
+	RouteListImportJobs       = "/management/datacoord/import/list"
+	RouteCancelImportJob      = "/management/datacoord/import/cancel"
+
 	RouteSuspendQueryCoordBalance = "/management/querycoord/balance/suspend"
 	RouteResumeQueryCoordBalance  = "/management/querycoord/balance/resume"
 	RouteQueryCoordBalanceStatus  = "/management/querycoord/balance/status"
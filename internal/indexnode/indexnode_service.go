@@ -524,6 +524,7 @@ func (i *IndexNode) QueryJobsV2(ctx context.Context, req *workerpb.QueryJobsV2Re
 					StatsLogs:     info.statsLogs,
 					TextStatsLogs: info.textStatsLogs,
 					Bm25Logs:      info.bm25Logs,
+					DeltaLogs:     info.deltaLogs,
 					NumRows:       info.numRows,
 				})
 			}
@@ -192,13 +192,27 @@ func (st *statsTask) sort(ctx context.Context) ([]*datapb.FieldBinlog, error) {
 		zap.Int64("segmentID", st.req.GetSegmentID()),
 	)
 
-	deletePKs, err := compaction.ComposeDeleteFromDeltalogs(ctx, st.binlogIO, st.deltaLogs)
+	// the rows deleted in the time travel window are kept along with their deletes
+	deletePKs, retained, err := compaction.ComposeDeleteFromDeltalogsWithTimeTravel(ctx, st.binlogIO, st.deltaLogs, st.req.GetTimetravel())
 	if err != nil {
 		log.Warn("load deletePKs failed", zap.Error(err))
 		return nil, err
 	}
 
 	entityFilter := compaction.NewEntityFilter(deletePKs, st.req.GetCollectionTtl(), st.currentTime)
+	// pk -> the retained deletes later than the kept rows of the pk
+	retainedDeletes := make(map[interface{}][]typeutil.Timestamp)
+	keep := func(pk interface{}, ts int64) bool {
+		if entityFilter.Filtered(pk, uint64(ts)) {
+			return false
+		}
+		for _, deleteTs := range retained[pk] {
+			if deleteTs > uint64(ts) && !lo.Contains(retainedDeletes[pk], deleteTs) {
+				retainedDeletes[pk] = append(retainedDeletes[pk], deleteTs)
+			}
+		}
+		return true
+	}
 
 	var predicate func(r storage.Record, ri, i int) bool
 	switch pkField.DataType {
@@ -206,13 +220,13 @@ func (st *statsTask) sort(ctx context.Context) ([]*datapb.FieldBinlog, error) {
 		predicate = func(r storage.Record, ri, i int) bool {
 			pk := r.Column(pkField.FieldID).(*array.Int64).Value(i)
 			ts := r.Column(common.TimeStampField).(*array.Int64).Value(i)
-			return !entityFilter.Filtered(pk, uint64(ts))
+			return keep(pk, ts)
 		}
 	case schemapb.DataType_VarChar:
 		predicate = func(r storage.Record, ri, i int) bool {
 			pk := r.Column(pkField.FieldID).(*array.String).Value(i)
 			ts := r.Column(common.TimeStampField).(*array.Int64).Value(i)
-			return !entityFilter.Filtered(pk, uint64(ts))
+			return keep(pk, ts)
 		}
 	default:
 		log.Warn("sort task only support int64 and varchar pk field")
@@ -239,7 +253,7 @@ func (st *statsTask) sort(ctx context.Context) ([]*datapb.FieldBinlog, error) {
 		return nil, err
 	}
 
-	statsLogs := []*datapb.FieldBinlog{stats}
+	statsLogs := append([]*datapb.FieldBinlog{stats}, lo.Values(srw.GetZoneMapLogs())...)
 	if err := binlog.CompressFieldBinlogs(statsLogs); err != nil {
 		return nil, err
 	}
@@ -249,13 +263,24 @@ func (st *statsTask) sort(ctx context.Context) ([]*datapb.FieldBinlog, error) {
 		return nil, err
 	}
 
+	var deltaLogs []*datapb.FieldBinlog
+	deltalog, err := compaction.WriteRetainedDeletes(ctx, st.binlogIO, alloc, pkField.GetDataType(),
+		st.req.GetCollectionID(), st.req.GetPartitionID(), st.req.GetTargetSegmentID(), retainedDeletes)
+	if err != nil {
+		log.Warn("write retained deletes failed", zap.Error(err))
+		return nil, err
+	}
+	if deltalog != nil {
+		deltaLogs = []*datapb.FieldBinlog{deltalog}
+	}
+
 	st.node.storePKSortStatsResult(st.req.GetClusterID(),
 		st.req.GetTaskID(),
 		st.req.GetCollectionID(),
 		st.req.GetPartitionID(),
 		st.req.GetTargetSegmentID(),
 		st.req.GetInsertChannel(),
-		int64(numValidRows), insertLogs, statsLogs, bm25StatsLogs)
+		int64(numValidRows), insertLogs, statsLogs, bm25StatsLogs, deltaLogs)
 
 	log.Info("sort segment end",
 		zap.String("clusterID", st.req.GetClusterID()),
@@ -418,6 +443,11 @@ func (st *statsTask) createTextIndex(ctx context.Context,
 		result := make([]string, 0, len(binlogs))
 		for _, binlog := range binlogs {
 			for _, file := range binlog.GetBinlogs() {
+				// the binlogs shared with another segment can't be located by the log ids
+				if file.GetLogPath() != "" {
+					result = append(result, file.GetLogPath())
+					continue
+				}
 				result = append(result, metautil.BuildInsertLogPath(storageConfig.GetRootPath(), collectionID, partitionID, segmentID, fieldID, file.GetLogID()))
 			}
 		}
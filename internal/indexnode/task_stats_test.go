@@ -32,6 +32,7 @@ import (
 	"github.com/milvus-io/milvus/internal/mocks/flushcommon/mock_util"
 	"github.com/milvus-io/milvus/internal/storage"
 	"github.com/milvus-io/milvus/pkg/v2/common"
+	"github.com/milvus-io/milvus/pkg/v2/proto/datapb"
 	"github.com/milvus-io/milvus/pkg/v2/proto/indexpb"
 	"github.com/milvus-io/milvus/pkg/v2/proto/workerpb"
 	"github.com/milvus-io/milvus/pkg/v2/util/paramtable"
@@ -132,6 +133,60 @@ func (s *TaskStatsSuite) TestSortSegmentWithBM25() {
 		}
 	})
 
+	s.Run("keep deletes in time travel window", func() {
+		s.schema = genCollectionSchemaWithBM25()
+		s.GenSegmentWriterWithBM25(0)
+		_, kvs, fBinlogs, err := serializeWrite(context.TODO(), "root_path", 0, s.segWriter)
+		s.NoError(err)
+		deleteTs := tsoutil.ComposeTSByTime(getMilvusBirthday().Add(time.Second), 0)
+		deltaBlob, err := storage.NewDeleteCodec().Serialize(s.collectionID, s.partitionID, 0,
+			storage.NewDeleteData([]storage.PrimaryKey{storage.NewInt64PrimaryKey(0)}, []typeutil.Timestamp{deleteTs}))
+		s.Require().NoError(err)
+		kvs["delta"] = deltaBlob.GetValue()
+		s.mockBinlogIO.EXPECT().Download(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, paths []string) ([][]byte, error) {
+			result := make([][]byte, len(paths))
+			for i, path := range paths {
+				result[i] = kvs[path]
+			}
+			return result, nil
+		})
+		s.mockBinlogIO.EXPECT().Upload(mock.Anything, mock.Anything).Return(nil)
+
+		ctx, cancel := context.WithCancel(context.Background())
+
+		testTaskKey := taskKey{ClusterID: s.clusterID, TaskID: 100}
+		node := &IndexNode{statsTasks: map[taskKey]*statsTaskInfo{testTaskKey: {segID: 1}}}
+		task := newStatsTask(ctx, cancel, &workerpb.CreateStatsRequest{
+			CollectionID:    s.collectionID,
+			PartitionID:     s.partitionID,
+			ClusterID:       s.clusterID,
+			TaskID:          testTaskKey.TaskID,
+			TargetSegmentID: 1,
+			InsertLogs:      lo.Values(fBinlogs),
+			DeltaLogs:       []*datapb.FieldBinlog{{Binlogs: []*datapb.Binlog{{LogPath: "delta"}}}},
+			Schema:          s.schema,
+			NumRows:         1,
+			StartLogID:      0,
+			EndLogID:        8,
+			BinlogMaxSize:   64 * 1024 * 1024,
+			StorageConfig: &indexpb.StorageConfig{
+				RootPath: "root_path",
+			},
+			Timetravel: deleteTs - 1,
+		}, node, s.mockBinlogIO)
+		err = task.PreExecute(ctx)
+		s.Require().NoError(err)
+		_, err = task.sort(ctx)
+		s.Require().NoError(err)
+
+		// the deleted row is kept along with its delete
+		info := node.statsTasks[testTaskKey]
+		s.EqualValues(1, info.numRows)
+		s.Require().Equal(1, len(info.deltaLogs))
+		s.EqualValues(1, info.deltaLogs[0].GetBinlogs()[0].GetEntriesNum())
+		s.Equal(deleteTs, info.deltaLogs[0].GetBinlogs()[0].GetTimestampTo())
+	})
+
 	s.Run("upload bm25 binlog failed", func() {
 		s.schema = genCollectionSchemaWithBM25()
 		s.GenSegmentWriterWithBM25(0)
@@ -310,6 +310,7 @@ type statsTaskInfo struct {
 	statsLogs     []*datapb.FieldBinlog
 	textStatsLogs map[int64]*datapb.TextIndexStats
 	bm25Logs      []*datapb.FieldBinlog
+	deltaLogs     []*datapb.FieldBinlog
 }
 
 func (i *IndexNode) loadOrStoreStatsTask(clusterID string, taskID UniqueID, info *statsTaskInfo) *statsTaskInfo {
@@ -358,6 +359,7 @@ func (i *IndexNode) storePKSortStatsResult(
 	insertLogs []*datapb.FieldBinlog,
 	statsLogs []*datapb.FieldBinlog,
 	bm25Logs []*datapb.FieldBinlog,
+	deltaLogs []*datapb.FieldBinlog,
 ) {
 	key := taskKey{ClusterID: ClusterID, TaskID: taskID}
 	i.stateLock.Lock()
@@ -371,6 +373,7 @@ func (i *IndexNode) storePKSortStatsResult(
 		info.insertLogs = insertLogs
 		info.statsLogs = statsLogs
 		info.bm25Logs = bm25Logs
+		info.deltaLogs = deltaLogs
 		return
 	}
 }
@@ -414,6 +417,7 @@ func (i *IndexNode) getStatsTaskInfo(clusterID string, taskID UniqueID) *statsTa
 			statsLogs:     info.statsLogs,
 			textStatsLogs: info.textStatsLogs,
 			bm25Logs:      info.bm25Logs,
+			deltaLogs:     info.deltaLogs,
 		}
 	}
 	return nil
@@ -80,6 +80,7 @@ func (s *statsTaskInfoSuite) Test_Methods() {
 			[]*datapb.FieldBinlog{{FieldID: 100, Binlogs: []*datapb.Binlog{{LogID: 1}}}},
 			[]*datapb.FieldBinlog{{FieldID: 100, Binlogs: []*datapb.Binlog{{LogID: 2}}}},
 			[]*datapb.FieldBinlog{},
+			[]*datapb.FieldBinlog{{Binlogs: []*datapb.Binlog{{LogID: 3}}}},
 		)
 	})
 
@@ -105,6 +106,7 @@ func (s *statsTaskInfoSuite) Test_Methods() {
 		s.Equal(int64(3), taskInfo.segID)
 		s.Equal("ch1", taskInfo.insertChannel)
 		s.Equal(int64(65535), taskInfo.numRows)
+		s.Equal(1, len(taskInfo.deltaLogs))
 	})
 
 	s.Run("deleteStatsTaskInfos", func() {
@@ -158,6 +158,13 @@ type DataCoordCatalog interface {
 	ListImportTasks(ctx context.Context) ([]*datapb.ImportTaskV2, error)
 	DropImportTask(ctx context.Context, taskID int64) error
 
+	SaveExportJob(ctx context.Context, job *datapb.ExportJob) error
+	ListExportJobs(ctx context.Context) ([]*datapb.ExportJob, error)
+	DropExportJob(ctx context.Context, jobID int64) error
+	SaveExportTask(ctx context.Context, task *datapb.ExportTask) error
+	ListExportTasks(ctx context.Context) ([]*datapb.ExportTask, error)
+	DropExportTask(ctx context.Context, taskID int64) error
+
 	GcConfirm(ctx context.Context, collectionID, partitionID typeutil.UniqueID) bool
 
 	ListCompactionTask(ctx context.Context) ([]*datapb.CompactionTask, error)
@@ -179,6 +186,10 @@ type DataCoordCatalog interface {
 	ListStatsTasks(ctx context.Context) ([]*indexpb.StatsTask, error)
 	SaveStatsTask(ctx context.Context, task *indexpb.StatsTask) error
 	DropStatsTask(ctx context.Context, taskID typeutil.UniqueID) error
+
+	ListSnapshots(ctx context.Context) ([]*model.Snapshot, error)
+	SaveSnapshot(ctx context.Context, snapshot *model.Snapshot) error
+	DropSnapshot(ctx context.Context, collectionID, snapshotID int64) error
 }
 
 type QueryCoordCatalog interface {
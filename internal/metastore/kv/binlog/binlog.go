@@ -98,6 +98,58 @@ func CompressFieldBinlogs(fieldBinlogs []*datapb.FieldBinlog) error {
 	return nil
 }
 
+// CompressSegmentBinLogs compresses the binlogs of the segment like CompressBinLogs, except the
+// foreign log paths, which can't be rebuilt from the log ids and are kept as they are.
+func CompressSegmentBinLogs(s *datapb.SegmentInfo) error {
+	err := CompressBinLog(storage.InsertBinlog, s.GetID(), s.GetBinlogs())
+	if err != nil {
+		return err
+	}
+	err = CompressBinLog(storage.DeleteBinlog, s.GetID(), s.GetDeltalogs())
+	if err != nil {
+		return err
+	}
+	err = CompressBinLog(storage.StatsBinlog, s.GetID(), s.GetStatslogs())
+	if err != nil {
+		return err
+	}
+	return CompressBinLog(storage.BM25Binlog, s.GetID(), s.GetBm25Statslogs())
+}
+
+// CompressBinLog compresses the log paths belonging to the segment to log ids, the foreign log paths are kept.
+func CompressBinLog(binlogType storage.BinlogType, segmentID typeutil.UniqueID, fieldBinlogs []*datapb.FieldBinlog) error {
+	for _, fieldBinlog := range fieldBinlogs {
+		for _, binlog := range fieldBinlog.Binlogs {
+			logPath := binlog.GetLogPath()
+			if len(logPath) == 0 || IsForeignLogPath(binlogType, segmentID, logPath) {
+				continue
+			}
+			logID, err := GetLogIDFromBingLogPath(logPath)
+			if err != nil {
+				return err
+			}
+			binlog.LogID = logID
+			binlog.LogPath = ""
+		}
+	}
+	return nil
+}
+
+// IsForeignLogPath returns whether the log path belongs to another segment, such as the binlogs
+// which a segment cloned from a snapshot shares with the source segment.
+func IsForeignLogPath(binlogType storage.BinlogType, segmentID typeutil.UniqueID, logPath string) bool {
+	ownerID := GetSegmentIDFromLogPath(binlogType, logPath)
+	return ownerID != 0 && ownerID != segmentID
+}
+
+// GetSegmentIDFromLogPath returns the id of the segment which the log path belongs to, 0 if the path is invalid.
+func GetSegmentIDFromLogPath(binlogType storage.BinlogType, logPath string) typeutil.UniqueID {
+	if binlogType == storage.DeleteBinlog {
+		return metautil.GetSegmentIDFromDeltaLogPath(logPath)
+	}
+	return metautil.GetSegmentIDFromInsertLogPath(logPath)
+}
+
 func DecompressMultiBinLogs(infos []*datapb.SegmentInfo) error {
 	for _, info := range infos {
 		err := DecompressBinLogs(info)
@@ -288,3 +288,35 @@ func TestBinlog_Compress(t *testing.T) {
 	err = DecompressBinLog(invaildType, 1, 1, 1, segmentInfo.Binlogs)
 	assert.ErrorIs(t, err, merr.ErrParameterInvalid)
 }
+
+func TestBinlog_CompressSegment(t *testing.T) {
+	segment := &datapb.SegmentInfo{
+		ID:           segmentID,
+		CollectionID: collectionID,
+		PartitionID:  partitionID,
+		Binlogs: []*datapb.FieldBinlog{{
+			FieldID: fieldID,
+			Binlogs: []*datapb.Binlog{{LogPath: binlogPath}, {LogPath: binlogPath2}},
+		}},
+		Deltalogs: []*datapb.FieldBinlog{{
+			Binlogs: []*datapb.Binlog{{LogPath: deltalogPath}, {LogPath: deltalogPath2}},
+		}},
+		Statslogs: []*datapb.FieldBinlog{{
+			FieldID: fieldID,
+			Binlogs: []*datapb.Binlog{{LogPath: statslogPath}, {LogPath: statslogPath2}},
+		}},
+	}
+	assert.False(t, IsForeignLogPath(storage.InsertBinlog, segmentID, binlogPath))
+	assert.True(t, IsForeignLogPath(storage.InsertBinlog, segmentID, binlogPath2))
+	assert.True(t, IsForeignLogPath(storage.DeleteBinlog, segmentID, deltalogPath2))
+	assert.False(t, IsForeignLogPath(storage.InsertBinlog, segmentID, "test"))
+
+	err := CompressSegmentBinLogs(segment)
+	assert.NoError(t, err)
+	for _, fieldBinlogs := range [][]*datapb.FieldBinlog{segment.GetBinlogs(), segment.GetDeltalogs(), segment.GetStatslogs()} {
+		assert.Equal(t, "", fieldBinlogs[0].GetBinlogs()[0].GetLogPath())
+		assert.Equal(t, logID, fieldBinlogs[0].GetBinlogs()[0].GetLogID())
+		// the foreign log paths are kept
+		assert.NotEqual(t, "", fieldBinlogs[0].GetBinlogs()[1].GetLogPath())
+	}
+}
@@ -28,11 +28,17 @@ const (
 	ImportJobPrefix                    = MetaPrefix + "/import-job"
 	ImportTaskPrefix                   = MetaPrefix + "/import-task"
 	PreImportTaskPrefix                = MetaPrefix + "/preimport-task"
+	ExportJobPrefix                    = MetaPrefix + "/export-job"
+	ExportTaskPrefix                   = MetaPrefix + "/export-task"
 	CompactionTaskPrefix               = MetaPrefix + "/compaction-task"
 	AnalyzeTaskPrefix                  = MetaPrefix + "/analyze-task"
 	PartitionStatsInfoPrefix           = MetaPrefix + "/partition-stats"
 	PartitionStatsCurrentVersionPrefix = MetaPrefix + "/current-partition-stats-version"
 	StatsTaskPrefix                    = MetaPrefix + "/stats-task"
+	SnapshotInfoPrefix                 = MetaPrefix + "/snapshot-info"
+	SnapshotSegmentPrefix              = MetaPrefix + "/snapshot-segment"
+	SnapshotSegmentIndexPrefix         = MetaPrefix + "/snapshot-segment-index"
+	SnapshotSchemaPrefix               = MetaPrefix + "/snapshot-schema"
 
 	NonRemoveFlagTomestone = "non-removed"
 	RemoveFlagTomestone    = "removed"
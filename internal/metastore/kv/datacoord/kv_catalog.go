@@ -31,6 +31,8 @@ import (
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/msgpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/json"
 	"github.com/milvus-io/milvus/internal/metastore"
 	"github.com/milvus-io/milvus/internal/metastore/kv/binlog"
 	"github.com/milvus-io/milvus/internal/metastore/model"
@@ -218,28 +220,28 @@ func (kc *Catalog) applyBinlogInfo(segments []*datapb.SegmentInfo, insertLogs, d
 		if len(segmentInfo.Binlogs) == 0 {
 			segmentInfo.Binlogs = insertLogs[segmentInfo.ID]
 		}
-		if err = binlog.CompressFieldBinlogs(segmentInfo.Binlogs); err != nil {
+		if err = binlog.CompressBinLog(storage.InsertBinlog, segmentInfo.GetID(), segmentInfo.Binlogs); err != nil {
 			return err
 		}
 
 		if len(segmentInfo.Deltalogs) == 0 {
 			segmentInfo.Deltalogs = deltaLogs[segmentInfo.ID]
 		}
-		if err = binlog.CompressFieldBinlogs(segmentInfo.Deltalogs); err != nil {
+		if err = binlog.CompressBinLog(storage.DeleteBinlog, segmentInfo.GetID(), segmentInfo.Deltalogs); err != nil {
 			return err
 		}
 
 		if len(segmentInfo.Statslogs) == 0 {
 			segmentInfo.Statslogs = statsLogs[segmentInfo.ID]
 		}
-		if err = binlog.CompressFieldBinlogs(segmentInfo.Statslogs); err != nil {
+		if err = binlog.CompressBinLog(storage.StatsBinlog, segmentInfo.GetID(), segmentInfo.Statslogs); err != nil {
 			return err
 		}
 
 		if len(segmentInfo.Bm25Statslogs) == 0 {
 			segmentInfo.Bm25Statslogs = bm25Logs[segmentInfo.ID]
 		}
-		if err = binlog.CompressFieldBinlogs(segmentInfo.Bm25Statslogs); err != nil {
+		if err = binlog.CompressBinLog(storage.BM25Binlog, segmentInfo.GetID(), segmentInfo.Bm25Statslogs); err != nil {
 			return err
 		}
 	}
@@ -766,6 +768,72 @@ func (kc *Catalog) DropImportTask(ctx context.Context, taskID int64) error {
 	return kc.MetaKv.Remove(ctx, key)
 }
 
+func (kc *Catalog) SaveExportJob(ctx context.Context, job *datapb.ExportJob) error {
+	key := buildExportJobKey(job.GetJobID())
+	value, err := proto.Marshal(job)
+	if err != nil {
+		return err
+	}
+	return kc.MetaKv.Save(ctx, key, string(value))
+}
+
+func (kc *Catalog) ListExportJobs(ctx context.Context) ([]*datapb.ExportJob, error) {
+	jobs := make([]*datapb.ExportJob, 0)
+	applyFn := func(key []byte, value []byte) error {
+		job := &datapb.ExportJob{}
+		err := proto.Unmarshal(value, job)
+		if err != nil {
+			return err
+		}
+		jobs = append(jobs, job)
+		return nil
+	}
+
+	err := kc.MetaKv.WalkWithPrefix(ctx, ExportJobPrefix, kc.paginationSize, applyFn)
+	if err != nil {
+		return nil, err
+	}
+	return jobs, nil
+}
+
+func (kc *Catalog) DropExportJob(ctx context.Context, jobID int64) error {
+	key := buildExportJobKey(jobID)
+	return kc.MetaKv.Remove(ctx, key)
+}
+
+func (kc *Catalog) SaveExportTask(ctx context.Context, task *datapb.ExportTask) error {
+	key := buildExportTaskKey(task.GetTaskID())
+	value, err := proto.Marshal(task)
+	if err != nil {
+		return err
+	}
+	return kc.MetaKv.Save(ctx, key, string(value))
+}
+
+func (kc *Catalog) ListExportTasks(ctx context.Context) ([]*datapb.ExportTask, error) {
+	tasks := make([]*datapb.ExportTask, 0)
+	applyFn := func(key []byte, value []byte) error {
+		task := &datapb.ExportTask{}
+		err := proto.Unmarshal(value, task)
+		if err != nil {
+			return err
+		}
+		tasks = append(tasks, task)
+		return nil
+	}
+
+	err := kc.MetaKv.WalkWithPrefix(ctx, ExportTaskPrefix, kc.paginationSize, applyFn)
+	if err != nil {
+		return nil, err
+	}
+	return tasks, nil
+}
+
+func (kc *Catalog) DropExportTask(ctx context.Context, taskID int64) error {
+	key := buildExportTaskKey(taskID)
+	return kc.MetaKv.Remove(ctx, key)
+}
+
 // GcConfirm returns true if related collection/partition is not found.
 // DataCoord will remove all the meta eventually after GC is finished.
 func (kc *Catalog) GcConfirm(ctx context.Context, collectionID, partitionID typeutil.UniqueID) bool {
@@ -961,3 +1029,135 @@ func (kc *Catalog) DropStatsTask(ctx context.Context, taskID typeutil.UniqueID)
 	key := buildStatsTaskKey(taskID)
 	return kc.MetaKv.Remove(ctx, key)
 }
+
+// ListSnapshots loads the snapshots, the segments and segment indexes left by an interrupted
+// SaveSnapshot or DropSnapshot are ignored since the snapshot info key is missing.
+func (kc *Catalog) ListSnapshots(ctx context.Context) ([]*model.Snapshot, error) {
+	snapshots := make(map[int64]*model.Snapshot)
+	applyInfoFn := func(key []byte, value []byte) error {
+		snapshot := &model.Snapshot{}
+		if err := json.Unmarshal(value, snapshot); err != nil {
+			return err
+		}
+		snapshots[snapshot.ID] = snapshot
+		return nil
+	}
+	if err := kc.MetaKv.WalkWithPrefix(ctx, SnapshotInfoPrefix+"/", kc.paginationSize, applyInfoFn); err != nil {
+		return nil, err
+	}
+
+	applySegmentFn := func(key []byte, value []byte) error {
+		snapshotID, err := parseSnapshotID(string(key))
+		if err != nil {
+			return err
+		}
+		snapshot, ok := snapshots[snapshotID]
+		if !ok {
+			return nil
+		}
+		segment := &datapb.SegmentInfo{}
+		if err := proto.Unmarshal(value, segment); err != nil {
+			return err
+		}
+		snapshot.Segments = append(snapshot.Segments, segment)
+		return nil
+	}
+	if err := kc.MetaKv.WalkWithPrefix(ctx, SnapshotSegmentPrefix+"/", kc.paginationSize, applySegmentFn); err != nil {
+		return nil, err
+	}
+
+	applySegmentIndexFn := func(key []byte, value []byte) error {
+		snapshotID, err := parseSnapshotID(string(key))
+		if err != nil {
+			return err
+		}
+		snapshot, ok := snapshots[snapshotID]
+		if !ok {
+			return nil
+		}
+		segIdx := &indexpb.SegmentIndex{}
+		if err := proto.Unmarshal(value, segIdx); err != nil {
+			return err
+		}
+		snapshot.SegmentIndexes = append(snapshot.SegmentIndexes, model.UnmarshalSegmentIndexModel(segIdx))
+		return nil
+	}
+	if err := kc.MetaKv.WalkWithPrefix(ctx, SnapshotSegmentIndexPrefix+"/", kc.paginationSize, applySegmentIndexFn); err != nil {
+		return nil, err
+	}
+
+	applySchemaFn := func(key []byte, value []byte) error {
+		snapshotID, err := strconv.ParseInt(path.Base(string(key)), 10, 64)
+		if err != nil {
+			return fmt.Errorf("parse snapshot key failed, key:%s, %w", key, err)
+		}
+		snapshot, ok := snapshots[snapshotID]
+		if !ok {
+			return nil
+		}
+		schema := &schemapb.CollectionSchema{}
+		if err := proto.Unmarshal(value, schema); err != nil {
+			return err
+		}
+		snapshot.Schema = schema
+		return nil
+	}
+	if err := kc.MetaKv.WalkWithPrefix(ctx, SnapshotSchemaPrefix+"/", kc.paginationSize, applySchemaFn); err != nil {
+		return nil, err
+	}
+	return maps.Values(snapshots), nil
+}
+
+// SaveSnapshot saves the segments and segment indexes of the snapshot before the snapshot info,
+// so that a snapshot is visible only if it is saved completely.
+func (kc *Catalog) SaveSnapshot(ctx context.Context, snapshot *model.Snapshot) error {
+	kvs := make(map[string]string)
+	for _, segment := range snapshot.Segments {
+		cloned := proto.Clone(segment).(*datapb.SegmentInfo)
+		if err := binlog.CompressSegmentBinLogs(cloned); err != nil {
+			return err
+		}
+		value, err := proto.Marshal(cloned)
+		if err != nil {
+			return err
+		}
+		kvs[buildSnapshotSegmentKey(snapshot.ID, segment.GetID())] = string(value)
+	}
+	for _, segIdx := range snapshot.SegmentIndexes {
+		value, err := proto.Marshal(model.MarshalSegmentIndexModel(segIdx))
+		if err != nil {
+			return err
+		}
+		kvs[buildSnapshotSegmentIndexKey(snapshot.ID, segIdx.BuildID)] = string(value)
+	}
+	if snapshot.Schema != nil {
+		value, err := proto.Marshal(snapshot.Schema)
+		if err != nil {
+			return err
+		}
+		kvs[buildSnapshotSchemaKey(snapshot.ID)] = string(value)
+	}
+	if err := kc.SaveByBatch(ctx, kvs); err != nil {
+		return err
+	}
+	value, err := json.Marshal(snapshot)
+	if err != nil {
+		return err
+	}
+	return kc.MetaKv.Save(ctx, buildSnapshotInfoKey(snapshot.CollectionID, snapshot.ID), string(value))
+}
+
+// DropSnapshot removes the snapshot info first, the snapshot is invisible even if removing
+// the segments and segment indexes fails, these orphan keys are removed by the next try.
+func (kc *Catalog) DropSnapshot(ctx context.Context, collectionID, snapshotID int64) error {
+	if err := kc.MetaKv.Remove(ctx, buildSnapshotInfoKey(collectionID, snapshotID)); err != nil {
+		return err
+	}
+	if err := kc.MetaKv.RemoveWithPrefix(ctx, buildSnapshotSegmentPrefix(snapshotID)+"/"); err != nil {
+		return err
+	}
+	if err := kc.MetaKv.Remove(ctx, buildSnapshotSchemaKey(snapshotID)); err != nil {
+		return err
+	}
+	return kc.MetaKv.RemoveWithPrefix(ctx, buildSnapshotSegmentIndexPrefix(snapshotID)+"/")
+}
@@ -28,6 +28,7 @@ import (
 	"time"
 
 	"github.com/cockroachdb/errors"
+	"github.com/samber/lo"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"golang.org/x/exp/maps"
@@ -358,6 +359,27 @@ func Test_AddSegments(t *testing.T) {
 		err = catalog.AddSegment(context.TODO(), segment)
 		assert.Error(t, err)
 	})
+
+	t.Run("store foreign log path", func(t *testing.T) {
+		metakv := mocks.NewMetaKv(t)
+		metakv.EXPECT().MultiSave(mock.Anything, mock.Anything).Return(nil)
+		catalog := NewCatalog(metakv, rootPath, "")
+
+		// the binlog shared with another segment by a segment cloned from a snapshot
+		segment := &datapb.SegmentInfo{
+			ID:           segmentID,
+			CollectionID: collectionID,
+			PartitionID:  partitionID,
+			NumOfRows:    100,
+			State:        commonpb.SegmentState_Flushed,
+			Binlogs: []*datapb.FieldBinlog{{
+				FieldID: 1,
+				Binlogs: []*datapb.Binlog{{LogID: 1, LogPath: fmt.Sprintf("a/insert_log/%d/%d/%d/1/1", collectionID, partitionID, segmentID+1)}},
+			}},
+		}
+		err := catalog.AddSegment(context.TODO(), segment)
+		assert.NoError(t, err)
+	})
 }
 
 func Test_AlterSegments(t *testing.T) {
@@ -1455,6 +1477,73 @@ func TestCatalog_Import(t *testing.T) {
 	})
 }
 
+func TestCatalog_Export(t *testing.T) {
+	kc := &Catalog{}
+	mockErr := errors.New("mock error")
+
+	job := &datapb.ExportJob{
+		JobID: 1,
+	}
+	task := &datapb.ExportTask{
+		JobID:  1,
+		TaskID: 2,
+	}
+
+	t.Run("ExportJob", func(t *testing.T) {
+		txn := mocks.NewMetaKv(t)
+		txn.EXPECT().Save(mock.Anything, buildExportJobKey(1), mock.Anything).Return(nil).Once()
+		txn.EXPECT().Save(mock.Anything, mock.Anything, mock.Anything).Return(mockErr).Once()
+		kc.MetaKv = txn
+		assert.NoError(t, kc.SaveExportJob(context.TODO(), job))
+		assert.Error(t, kc.SaveExportJob(context.TODO(), job))
+
+		value, err := proto.Marshal(job)
+		assert.NoError(t, err)
+		txn.EXPECT().WalkWithPrefix(mock.Anything, ExportJobPrefix, mock.Anything, mock.Anything).RunAndReturn(func(_ context.Context, _ string, _ int, f func([]byte, []byte) error) error {
+			return f(nil, value)
+		}).Once()
+		jobs, err := kc.ListExportJobs(context.TODO())
+		assert.NoError(t, err)
+		assert.Equal(t, 1, len(jobs))
+		assert.Equal(t, int64(1), jobs[0].GetJobID())
+
+		txn.EXPECT().WalkWithPrefix(mock.Anything, ExportJobPrefix, mock.Anything, mock.Anything).RunAndReturn(func(_ context.Context, _ string, _ int, f func([]byte, []byte) error) error {
+			return f(nil, []byte("@#%#^#"))
+		}).Once()
+		_, err = kc.ListExportJobs(context.TODO())
+		assert.Error(t, err)
+
+		txn.EXPECT().Remove(mock.Anything, buildExportJobKey(1)).Return(nil).Once()
+		assert.NoError(t, kc.DropExportJob(context.TODO(), 1))
+	})
+
+	t.Run("ExportTask", func(t *testing.T) {
+		txn := mocks.NewMetaKv(t)
+		txn.EXPECT().Save(mock.Anything, buildExportTaskKey(2), mock.Anything).Return(nil).Once()
+		txn.EXPECT().Save(mock.Anything, mock.Anything, mock.Anything).Return(mockErr).Once()
+		kc.MetaKv = txn
+		assert.NoError(t, kc.SaveExportTask(context.TODO(), task))
+		assert.Error(t, kc.SaveExportTask(context.TODO(), task))
+
+		value, err := proto.Marshal(task)
+		assert.NoError(t, err)
+		txn.EXPECT().WalkWithPrefix(mock.Anything, ExportTaskPrefix, mock.Anything, mock.Anything).RunAndReturn(func(_ context.Context, _ string, _ int, f func([]byte, []byte) error) error {
+			return f(nil, value)
+		}).Once()
+		tasks, err := kc.ListExportTasks(context.TODO())
+		assert.NoError(t, err)
+		assert.Equal(t, 1, len(tasks))
+		assert.Equal(t, int64(2), tasks[0].GetTaskID())
+
+		txn.EXPECT().WalkWithPrefix(mock.Anything, ExportTaskPrefix, mock.Anything, mock.Anything).Return(mockErr).Once()
+		_, err = kc.ListExportTasks(context.TODO())
+		assert.Error(t, err)
+
+		txn.EXPECT().Remove(mock.Anything, buildExportTaskKey(2)).Return(mockErr).Once()
+		assert.Error(t, kc.DropExportTask(context.TODO(), 2))
+	})
+}
+
 func TestCatalog_AnalyzeTask(t *testing.T) {
 	kc := &Catalog{}
 	mockErr := errors.New("mock error")
@@ -1806,3 +1895,131 @@ func Test_StatsTasks(t *testing.T) {
 		assert.NoError(t, err)
 	})
 }
+
+func Test_Snapshot(t *testing.T) {
+	kvs := make(map[string]string)
+	txn := mocks.NewMetaKv(t)
+	txn.EXPECT().MultiSave(mock.Anything, mock.Anything).RunAndReturn(func(_ context.Context, saves map[string]string) error {
+		maps.Copy(kvs, saves)
+		return nil
+	}).Maybe()
+	txn.EXPECT().Save(mock.Anything, mock.Anything, mock.Anything).RunAndReturn(func(_ context.Context, key string, value string) error {
+		kvs[key] = value
+		return nil
+	}).Maybe()
+	txn.EXPECT().WalkWithPrefix(mock.Anything, mock.Anything, mock.Anything, mock.Anything).RunAndReturn(func(_ context.Context, prefix string, _ int, f func([]byte, []byte) error) error {
+		for key, value := range kvs {
+			if strings.HasPrefix(key, prefix) {
+				if err := f([]byte(key), []byte(value)); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	}).Maybe()
+	txn.EXPECT().Remove(mock.Anything, mock.Anything).RunAndReturn(func(_ context.Context, key string) error {
+		delete(kvs, key)
+		return nil
+	}).Maybe()
+	txn.EXPECT().RemoveWithPrefix(mock.Anything, mock.Anything).RunAndReturn(func(_ context.Context, prefix string) error {
+		for key := range kvs {
+			if strings.HasPrefix(key, prefix) {
+				delete(kvs, key)
+			}
+		}
+		return nil
+	}).Maybe()
+	kc := &Catalog{MetaKv: txn}
+
+	snapshot := &model.Snapshot{
+		ID:           10,
+		Name:         "s1",
+		CollectionID: 1,
+		CreateTs:     100,
+		Segments: []*datapb.SegmentInfo{
+			{
+				ID:           1,
+				CollectionID: 1,
+				PartitionID:  2,
+				Binlogs: []*datapb.FieldBinlog{
+					{FieldID: 100, Binlogs: []*datapb.Binlog{{LogID: 5, LogPath: "a/insert_log/1/2/1/100/5"}}},
+				},
+			},
+			{ID: 2, CollectionID: 1, PartitionID: 2, Level: datapb.SegmentLevel_L0},
+			{
+				// a segment cloned from another snapshot shares the binlogs of segment 1
+				ID:           3,
+				CollectionID: 1,
+				PartitionID:  2,
+				Binlogs: []*datapb.FieldBinlog{
+					{FieldID: 100, Binlogs: []*datapb.Binlog{{LogID: 5, LogPath: "a/insert_log/1/2/1/100/5"}}},
+				},
+			},
+		},
+		SegmentIndexes: []*model.SegmentIndex{{SegmentID: 1, CollectionID: 1, BuildID: 7, IndexFileKeys: []string{"f1"}}},
+		Schema:         &schemapb.CollectionSchema{Name: "coll"},
+	}
+	err := kc.SaveSnapshot(context.TODO(), snapshot)
+	assert.NoError(t, err)
+	// the snapshot is not mutated
+	assert.Equal(t, "a/insert_log/1/2/1/100/5", snapshot.Segments[0].GetBinlogs()[0].GetBinlogs()[0].GetLogPath())
+	// orphan segments of an interrupted save
+	kvs[buildSnapshotSegmentKey(11, 3)] = kvs[buildSnapshotSegmentKey(10, 1)]
+
+	snapshots, err := kc.ListSnapshots(context.TODO())
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(snapshots))
+	assert.Equal(t, "s1", snapshots[0].Name)
+	assert.Equal(t, uint64(100), snapshots[0].CreateTs)
+	assert.Equal(t, "coll", snapshots[0].Schema.GetName())
+	segments := lo.SliceToMap(snapshots[0].Segments, func(segment *datapb.SegmentInfo) (int64, *datapb.SegmentInfo) {
+		return segment.GetID(), segment
+	})
+	assert.ElementsMatch(t, []int64{1, 2, 3}, lo.Keys(segments))
+	assert.Equal(t, int64(5), segments[1].GetBinlogs()[0].GetBinlogs()[0].GetLogID())
+	assert.Equal(t, "", segments[1].GetBinlogs()[0].GetBinlogs()[0].GetLogPath())
+	// the foreign log path is kept
+	assert.Equal(t, "a/insert_log/1/2/1/100/5", segments[3].GetBinlogs()[0].GetBinlogs()[0].GetLogPath())
+	assert.Equal(t, 1, len(snapshots[0].SegmentIndexes))
+	assert.Equal(t, int64(7), snapshots[0].SegmentIndexes[0].BuildID)
+
+	err = kc.DropSnapshot(context.TODO(), 1, 10)
+	assert.NoError(t, err)
+	snapshots, err = kc.ListSnapshots(context.TODO())
+	assert.NoError(t, err)
+	assert.Empty(t, snapshots)
+	assert.Equal(t, 1, len(kvs))
+}
+
+func TestValidateSegment_ZoneMapStatslogs(t *testing.T) {
+	fieldBinlog := func(fieldID int64, logIDs ...int64) *datapb.FieldBinlog {
+		binlogs := make([]*datapb.Binlog, 0, len(logIDs))
+		for _, logID := range logIDs {
+			binlogs = append(binlogs, &datapb.Binlog{LogID: logID})
+		}
+		return &datapb.FieldBinlog{FieldID: fieldID, Binlogs: binlogs}
+	}
+	segment := &datapb.SegmentInfo{
+		ID:      1,
+		Level:   datapb.SegmentLevel_L1,
+		Binlogs: []*datapb.FieldBinlog{fieldBinlog(100, 10, 11, 12)},
+		// the zone map of field 101 is skipped in a sync and listed before the pk statslogs.
+		Statslogs: []*datapb.FieldBinlog{fieldBinlog(101, 20, 21), fieldBinlog(100, 30, 31, 32)},
+	}
+	assert.NoError(t, ValidateSegment(segment, 100))
+
+	segment.Statslogs = []*datapb.FieldBinlog{fieldBinlog(101, 20, 21), fieldBinlog(100, 30, 31)}
+	assert.Error(t, ValidateSegment(segment, 100))
+
+	// the zone map of another field has more statslogs than the pk field.
+	segment.Statslogs = []*datapb.FieldBinlog{fieldBinlog(101, 20, 21, 22), fieldBinlog(100, 30, 31)}
+	assert.Error(t, ValidateSegment(segment, 100))
+
+	// the pk statslogs are missing.
+	segment.Statslogs = []*datapb.FieldBinlog{fieldBinlog(101, 20, 21, 22)}
+	assert.Error(t, ValidateSegment(segment, 100))
+
+	// the merged pk statslog of flushed segment
+	segment.Statslogs = []*datapb.FieldBinlog{fieldBinlog(101, 20, 21), fieldBinlog(100, 30, 1)}
+	assert.NoError(t, ValidateSegment(segment, 100))
+}
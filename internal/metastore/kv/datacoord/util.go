@@ -18,10 +18,14 @@ package datacoord
 
 import (
 	"fmt"
+	"strconv"
+	"strings"
 
+	"github.com/samber/lo"
 	"go.uber.org/zap"
 	"google.golang.org/protobuf/proto"
 
+	"github.com/milvus-io/milvus/internal/metastore/kv/binlog"
 	"github.com/milvus-io/milvus/internal/storage"
 	"github.com/milvus-io/milvus/internal/util/segmentutil"
 	"github.com/milvus-io/milvus/pkg/v2/log"
@@ -30,7 +34,8 @@ import (
 	"github.com/milvus-io/milvus/pkg/v2/util/typeutil"
 )
 
-func ValidateSegment(segment *datapb.SegmentInfo) error {
+// ValidateSegment checks the binlogs and statslogs of the segment, pkFieldID is the primary key field of the collection.
+func ValidateSegment(segment *datapb.SegmentInfo, pkFieldID int64) error {
 	log := log.With(
 		zap.Int64("collection", segment.GetCollectionID()),
 		zap.Int64("partition", segment.GetPartitionID()),
@@ -66,11 +71,15 @@ func ValidateSegment(segment *datapb.SegmentInfo) error {
 	}
 
 	// if segment not merge status log(growing or new flushed by old version)
-	// segment num of binlog should same with statslogs.
+	// segment num of binlog should same with pk statslogs.
+	// the zone map statslogs of other fields may be skipped in a sync, so only the pk statslogs are checked.
 	binlogNum := len(segment.GetBinlogs()[0].GetBinlogs())
-	statslogNum := len(segment.GetStatslogs()[0].GetBinlogs())
+	pkStatslog, _ := lo.Find(segment.GetStatslogs(), func(fieldBinlog *datapb.FieldBinlog) bool {
+		return fieldBinlog.GetFieldID() == pkFieldID
+	})
+	statslogNum := len(pkStatslog.GetBinlogs())
 
-	if len(segment.GetCompactionFrom()) == 0 && statslogNum != binlogNum && !hasSpecialStatslog(segment) {
+	if len(segment.GetCompactionFrom()) == 0 && statslogNum != binlogNum && !hasSpecialStatslog(pkStatslog) {
 		log.Warn("find invalid segment while bin log size didn't match stat log size",
 			zap.Any("binlogs", segment.GetBinlogs()),
 			zap.Any("stats", segment.GetStatslogs()),
@@ -82,8 +91,8 @@ func ValidateSegment(segment *datapb.SegmentInfo) error {
 	return nil
 }
 
-func hasSpecialStatslog(segment *datapb.SegmentInfo) bool {
-	for _, statslog := range segment.GetStatslogs()[0].GetBinlogs() {
+func hasSpecialStatslog(fieldBinlog *datapb.FieldBinlog) bool {
+	for _, statslog := range fieldBinlog.GetBinlogs() {
 		logidx := fmt.Sprint(statslog.LogID)
 		if logidx == storage.CompoundStatsType.LogIdx() {
 			return true
@@ -143,13 +152,14 @@ func cloneLogs(binlogs []*datapb.FieldBinlog) []*datapb.FieldBinlog {
 func buildBinlogKvs(collectionID, partitionID, segmentID typeutil.UniqueID, binlogs, deltalogs, statslogs, bm25logs []*datapb.FieldBinlog) (map[string]string, error) {
 	kv := make(map[string]string)
 
-	checkLogID := func(fieldBinlog *datapb.FieldBinlog) error {
-		for _, binlog := range fieldBinlog.GetBinlogs() {
-			if binlog.GetLogID() == 0 {
-				return fmt.Errorf("invalid log id, binlog:%v", binlog)
+	// only the foreign log paths, which can't be rebuilt from the log ids, are stored
+	checkLogID := func(binlogType storage.BinlogType, fieldBinlog *datapb.FieldBinlog) error {
+		for _, l := range fieldBinlog.GetBinlogs() {
+			if l.GetLogID() == 0 {
+				return fmt.Errorf("invalid log id, binlog:%v", l)
 			}
-			if binlog.GetLogPath() != "" {
-				return fmt.Errorf("fieldBinlog no need to store logpath, binlog:%v", binlog)
+			if l.GetLogPath() != "" && !binlog.IsForeignLogPath(binlogType, segmentID, l.GetLogPath()) {
+				return fmt.Errorf("fieldBinlog no need to store logpath, binlog:%v", l)
 			}
 		}
 		return nil
@@ -157,7 +167,7 @@ func buildBinlogKvs(collectionID, partitionID, segmentID typeutil.UniqueID, binl
 
 	// binlog kv
 	for _, binlog := range binlogs {
-		if err := checkLogID(binlog); err != nil {
+		if err := checkLogID(storage.InsertBinlog, binlog); err != nil {
 			return nil, err
 		}
 		binlogBytes, err := proto.Marshal(binlog)
@@ -170,7 +180,7 @@ func buildBinlogKvs(collectionID, partitionID, segmentID typeutil.UniqueID, binl
 
 	// deltalog
 	for _, deltalog := range deltalogs {
-		if err := checkLogID(deltalog); err != nil {
+		if err := checkLogID(storage.DeleteBinlog, deltalog); err != nil {
 			return nil, err
 		}
 		binlogBytes, err := proto.Marshal(deltalog)
@@ -183,7 +193,7 @@ func buildBinlogKvs(collectionID, partitionID, segmentID typeutil.UniqueID, binl
 
 	// statslog
 	for _, statslog := range statslogs {
-		if err := checkLogID(statslog); err != nil {
+		if err := checkLogID(storage.StatsBinlog, statslog); err != nil {
 			return nil, err
 		}
 		binlogBytes, err := proto.Marshal(statslog)
@@ -196,7 +206,7 @@ func buildBinlogKvs(collectionID, partitionID, segmentID typeutil.UniqueID, binl
 
 	// bm25log
 	for _, bm25log := range bm25logs {
-		if err := checkLogID(bm25log); err != nil {
+		if err := checkLogID(storage.BM25Binlog, bm25log); err != nil {
 			return nil, err
 		}
 		binlogBytes, err := proto.Marshal(bm25log)
@@ -345,6 +355,14 @@ func buildPreImportTaskKey(taskID int64) string {
 	return fmt.Sprintf("%s/%d", PreImportTaskPrefix, taskID)
 }
 
+func buildExportJobKey(jobID int64) string {
+	return fmt.Sprintf("%s/%d", ExportJobPrefix, jobID)
+}
+
+func buildExportTaskKey(taskID int64) string {
+	return fmt.Sprintf("%s/%d", ExportTaskPrefix, taskID)
+}
+
 func buildAnalyzeTaskKey(taskID int64) string {
 	return fmt.Sprintf("%s/%d", AnalyzeTaskPrefix, taskID)
 }
@@ -352,3 +370,41 @@ func buildAnalyzeTaskKey(taskID int64) string {
 func buildStatsTaskKey(taskID int64) string {
 	return fmt.Sprintf("%s/%d", StatsTaskPrefix, taskID)
 }
+
+func buildSnapshotInfoKey(collectionID, snapshotID int64) string {
+	return fmt.Sprintf("%s/%d/%d", SnapshotInfoPrefix, collectionID, snapshotID)
+}
+
+func buildSnapshotSegmentPrefix(snapshotID int64) string {
+	return fmt.Sprintf("%s/%d", SnapshotSegmentPrefix, snapshotID)
+}
+
+func buildSnapshotSegmentKey(snapshotID, segmentID int64) string {
+	return fmt.Sprintf("%s/%d/%d", SnapshotSegmentPrefix, snapshotID, segmentID)
+}
+
+func buildSnapshotSegmentIndexPrefix(snapshotID int64) string {
+	return fmt.Sprintf("%s/%d", SnapshotSegmentIndexPrefix, snapshotID)
+}
+
+func buildSnapshotSegmentIndexKey(snapshotID, buildID int64) string {
+	return fmt.Sprintf("%s/%d/%d", SnapshotSegmentIndexPrefix, snapshotID, buildID)
+}
+
+func buildSnapshotSchemaKey(snapshotID int64) string {
+	return fmt.Sprintf("%s/%d", SnapshotSchemaPrefix, snapshotID)
+}
+
+// parseSnapshotID parses the snapshot id from the key of a snapshot segment or segment index,
+// e.g. by-dev/meta/datacoord-meta/snapshot-segment/{snapshotID}/{segmentID}.
+func parseSnapshotID(key string) (int64, error) {
+	keyWordGroup := strings.Split(key, "/")
+	if len(keyWordGroup) < 2 {
+		return 0, fmt.Errorf("parse snapshot key failed, key:%s", key)
+	}
+	snapshotID, err := strconv.ParseInt(keyWordGroup[len(keyWordGroup)-2], 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("parse snapshot key failed, key:%s, %w", key, err)
+	}
+	return snapshotID, nil
+}
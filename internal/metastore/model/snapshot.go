@@ -0,0 +1,48 @@
+package model
+
+import (
+	"maps"
+	"slices"
+
+	"github.com/samber/lo"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/pkg/v2/proto/datapb"
+)
+
+// Snapshot pins the sealed segments, the L0 segments and the segment indexes of a collection
+// at the time it is created, the files referenced by a snapshot are kept by the garbage collector
+// until the snapshot is dropped.
+type Snapshot struct {
+	ID           int64  `json:"id,string"`
+	Name         string `json:"name"`
+	CollectionID int64  `json:"collection_id,string"`
+	CreateTs     uint64 `json:"create_ts,string"`
+	// VChannels and PartitionNames are used to map the segments to a collection cloned from the snapshot.
+	VChannels      []string         `json:"vchannels"`
+	PartitionNames map[int64]string `json:"partition_names"`
+
+	Schema *schemapb.CollectionSchema `json:"-"`
+	// Segments holds the segment infos with the binlogs compressed to log ids, except the foreign log paths.
+	Segments       []*datapb.SegmentInfo `json:"-"`
+	SegmentIndexes []*SegmentIndex       `json:"-"`
+}
+
+func (s *Snapshot) Clone() *Snapshot {
+	return &Snapshot{
+		ID:             s.ID,
+		Name:           s.Name,
+		CollectionID:   s.CollectionID,
+		CreateTs:       s.CreateTs,
+		VChannels:      slices.Clone(s.VChannels),
+		PartitionNames: maps.Clone(s.PartitionNames),
+		Schema:         proto.Clone(s.Schema).(*schemapb.CollectionSchema),
+		Segments: lo.Map(s.Segments, func(segment *datapb.SegmentInfo, _ int) *datapb.SegmentInfo {
+			return proto.Clone(segment).(*datapb.SegmentInfo)
+		}),
+		SegmentIndexes: lo.Map(s.SegmentIndexes, func(segIdx *SegmentIndex, _ int) *SegmentIndex {
+			return CloneSegmentIndex(segIdx)
+		}),
+	}
+}
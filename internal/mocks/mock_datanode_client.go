@@ -298,6 +298,80 @@ func (_c *MockDataNodeClient_DropCompactionPlan_Call) RunAndReturn(run func(cont
 	return _c
 }
 
+// DropExport provides a mock function with given fields: ctx, in, opts
+func (_m *MockDataNodeClient) DropExport(ctx context.Context, in *datapb.DropExportRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DropExport")
+	}
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.DropExportRequest, ...grpc.CallOption) (*commonpb.Status, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.DropExportRequest, ...grpc.CallOption) *commonpb.Status); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.DropExportRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDataNodeClient_DropExport_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DropExport'
+type MockDataNodeClient_DropExport_Call struct {
+	*mock.Call
+}
+
+// DropExport is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *datapb.DropExportRequest
+//   - opts ...grpc.CallOption
+func (_e *MockDataNodeClient_Expecter) DropExport(ctx interface{}, in interface{}, opts ...interface{}) *MockDataNodeClient_DropExport_Call {
+	return &MockDataNodeClient_DropExport_Call{Call: _e.mock.On("DropExport",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockDataNodeClient_DropExport_Call) Run(run func(ctx context.Context, in *datapb.DropExportRequest, opts ...grpc.CallOption)) *MockDataNodeClient_DropExport_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*datapb.DropExportRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockDataNodeClient_DropExport_Call) Return(_a0 *commonpb.Status, _a1 error) *MockDataNodeClient_DropExport_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDataNodeClient_DropExport_Call) RunAndReturn(run func(context.Context, *datapb.DropExportRequest, ...grpc.CallOption) (*commonpb.Status, error)) *MockDataNodeClient_DropExport_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // DropImport provides a mock function with given fields: ctx, in, opts
 func (_m *MockDataNodeClient) DropImport(ctx context.Context, in *datapb.DropImportRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
 	_va := make([]interface{}, len(opts))
@@ -372,6 +446,80 @@ func (_c *MockDataNodeClient_DropImport_Call) RunAndReturn(run func(context.Cont
 	return _c
 }
 
+// ExportV2 provides a mock function with given fields: ctx, in, opts
+func (_m *MockDataNodeClient) ExportV2(ctx context.Context, in *datapb.ExportRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExportV2")
+	}
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.ExportRequest, ...grpc.CallOption) (*commonpb.Status, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.ExportRequest, ...grpc.CallOption) *commonpb.Status); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.ExportRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDataNodeClient_ExportV2_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExportV2'
+type MockDataNodeClient_ExportV2_Call struct {
+	*mock.Call
+}
+
+// ExportV2 is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *datapb.ExportRequest
+//   - opts ...grpc.CallOption
+func (_e *MockDataNodeClient_Expecter) ExportV2(ctx interface{}, in interface{}, opts ...interface{}) *MockDataNodeClient_ExportV2_Call {
+	return &MockDataNodeClient_ExportV2_Call{Call: _e.mock.On("ExportV2",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockDataNodeClient_ExportV2_Call) Run(run func(ctx context.Context, in *datapb.ExportRequest, opts ...grpc.CallOption)) *MockDataNodeClient_ExportV2_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*datapb.ExportRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockDataNodeClient_ExportV2_Call) Return(_a0 *commonpb.Status, _a1 error) *MockDataNodeClient_ExportV2_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDataNodeClient_ExportV2_Call) RunAndReturn(run func(context.Context, *datapb.ExportRequest, ...grpc.CallOption) (*commonpb.Status, error)) *MockDataNodeClient_ExportV2_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // FlushChannels provides a mock function with given fields: ctx, in, opts
 func (_m *MockDataNodeClient) FlushChannels(ctx context.Context, in *datapb.FlushChannelsRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
 	_va := make([]interface{}, len(opts))
@@ -1038,6 +1186,80 @@ func (_c *MockDataNodeClient_PreImport_Call) RunAndReturn(run func(context.Conte
 	return _c
 }
 
+// QueryExport provides a mock function with given fields: ctx, in, opts
+func (_m *MockDataNodeClient) QueryExport(ctx context.Context, in *datapb.QueryExportRequest, opts ...grpc.CallOption) (*datapb.QueryExportResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for QueryExport")
+	}
+
+	var r0 *datapb.QueryExportResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.QueryExportRequest, ...grpc.CallOption) (*datapb.QueryExportResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *datapb.QueryExportRequest, ...grpc.CallOption) *datapb.QueryExportResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*datapb.QueryExportResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *datapb.QueryExportRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockDataNodeClient_QueryExport_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'QueryExport'
+type MockDataNodeClient_QueryExport_Call struct {
+	*mock.Call
+}
+
+// QueryExport is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *datapb.QueryExportRequest
+//   - opts ...grpc.CallOption
+func (_e *MockDataNodeClient_Expecter) QueryExport(ctx interface{}, in interface{}, opts ...interface{}) *MockDataNodeClient_QueryExport_Call {
+	return &MockDataNodeClient_QueryExport_Call{Call: _e.mock.On("QueryExport",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockDataNodeClient_QueryExport_Call) Run(run func(ctx context.Context, in *datapb.QueryExportRequest, opts ...grpc.CallOption)) *MockDataNodeClient_QueryExport_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*datapb.QueryExportRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockDataNodeClient_QueryExport_Call) Return(_a0 *datapb.QueryExportResponse, _a1 error) *MockDataNodeClient_QueryExport_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockDataNodeClient_QueryExport_Call) RunAndReturn(run func(context.Context, *datapb.QueryExportRequest, ...grpc.CallOption) (*datapb.QueryExportResponse, error)) *MockDataNodeClient_QueryExport_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // QueryImport provides a mock function with given fields: ctx, in, opts
 func (_m *MockDataNodeClient) QueryImport(ctx context.Context, in *datapb.QueryImportRequest, opts ...grpc.CallOption) (*datapb.QueryImportResponse, error) {
 	_va := make([]interface{}, len(opts))
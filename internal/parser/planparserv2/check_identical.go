@@ -1,8 +1,12 @@
 package planparserv2
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"reflect"
 
+	"google.golang.org/protobuf/proto"
+
 	"github.com/milvus-io/milvus/pkg/v2/proto/planpb"
 	"github.com/milvus-io/milvus/pkg/v2/util/funcutil"
 )
@@ -19,6 +23,21 @@ func CheckPredicatesIdentical(expr, other *planpb.Expr) bool {
 	return reflect.DeepEqual(js1, js2)
 }
 
+// HashPredicates returns the digest of the deterministic serialization of the expr,
+// the exprs with the same digest are of the same fields, json paths and values.
+func HashPredicates(expr *planpb.Expr) (string, error) {
+	if expr == nil {
+		return "", nil
+	}
+
+	bs, err := proto.MarshalOptions{Deterministic: true}.Marshal(expr)
+	if err != nil {
+		return "", err
+	}
+	digest := sha256.Sum256(bs)
+	return hex.EncodeToString(digest[:]), nil
+}
+
 func CheckQueryInfoIdentical(info1, info2 *planpb.QueryInfo) bool {
 	if info1.GetTopk() != info2.GetTopk() {
 		return false
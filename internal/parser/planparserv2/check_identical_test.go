@@ -37,6 +37,50 @@ func TestCheckIdentical(t *testing.T) {
 	}
 }
 
+func TestHashPredicates(t *testing.T) {
+	schema := newTestSchema(true)
+	helper, err := typeutil.CreateSchemaHelper(schema)
+	assert.NoError(t, err)
+
+	expr1, err := ParseExpr(helper, `Int64Field > 0 and VarCharField in ["a", "b"]`, nil)
+	assert.NoError(t, err)
+	expr2, err := ParseExpr(helper, `(Int64Field > 0) and (VarCharField in ["a", "b"])`, nil)
+	assert.NoError(t, err)
+	expr3, err := ParseExpr(helper, `Int64Field > 1 and VarCharField in ["a", "b"]`, nil)
+	assert.NoError(t, err)
+
+	h1, err := HashPredicates(expr1)
+	assert.NoError(t, err)
+	h2, err := HashPredicates(expr2)
+	assert.NoError(t, err)
+	h3, err := HashPredicates(expr3)
+	assert.NoError(t, err)
+	assert.Equal(t, h1, h2)
+	assert.NotEqual(t, h1, h3)
+
+	// the json paths and the array values are part of the digest
+	for _, pair := range [][2]string{
+		{`JSONField["a"] > 1`, `JSONField["b"] > 1`},
+		{`json_contains(JSONField["a"], 1)`, `json_contains(JSONField["a"], 2)`},
+		{`exists JSONField["a"]`, `exists JSONField["b"]`},
+		{`array_contains_any(ArrayField, [1, 2])`, `array_contains_any(ArrayField, [1, 3])`},
+	} {
+		expr1, err = ParseExpr(helper, pair[0], nil)
+		assert.NoError(t, err)
+		expr2, err = ParseExpr(helper, pair[1], nil)
+		assert.NoError(t, err)
+		h1, err = HashPredicates(expr1)
+		assert.NoError(t, err)
+		h2, err = HashPredicates(expr2)
+		assert.NoError(t, err)
+		assert.NotEqual(t, h1, h2, pair)
+	}
+
+	h, err := HashPredicates(nil)
+	assert.NoError(t, err)
+	assert.Empty(t, h)
+}
+
 func TestCheckQueryInfoIdentical(t *testing.T) {
 	type args struct {
 		info1 *planpb.QueryInfo
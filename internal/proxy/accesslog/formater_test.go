@@ -31,6 +31,7 @@ import (
 	"google.golang.org/grpc/status"
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus/internal/json"
 	"github.com/milvus-io/milvus/internal/proxy/accesslog/info"
 	"github.com/milvus-io/milvus/pkg/v2/tracer"
 	"github.com/milvus-io/milvus/pkg/v2/util"
@@ -181,6 +182,41 @@ func (s *LogFormatterSuite) TestFormatMethodResult() {
 	}
 }
 
+func (s *LogFormatterSuite) TestFormatJSON() {
+	fmt := "$method_name: $method_status $response_size $error_code $time_cost $user_name $database_name"
+	formatter := NewJSONFormatter(fmt)
+
+	for id, req := range s.reqs {
+		i := info.NewGrpcAccessInfo(s.ctx, s.serverinfo, req)
+		entry := make(map[string]any)
+		s.NoError(json.Unmarshal([]byte(formatter.Format(i)), &entry))
+		s.Nil(entry["response_size"])
+		s.Nil(entry["time_cost"])
+
+		i.SetResult(s.resps[id], s.errs[id])
+		fs := formatter.Format(i)
+		s.True(strings.HasSuffix(fs, "\n"))
+		entry = make(map[string]any)
+		s.NoError(json.Unmarshal([]byte(fs), &entry))
+		s.Equal(7, len(entry))
+		s.Equal("test", entry["method_name"])
+		s.Equal("mockUser", entry["user_name"])
+		s.Equal("test-db", entry["database_name"])
+		s.IsType(float64(0), entry["response_size"])
+		s.IsType(float64(0), entry["error_code"])
+		s.IsType(float64(0), entry["time_cost"])
+	}
+}
+
+func (s *LogFormatterSuite) TestJSONValue() {
+	s.Equal(int64(10), jsonValue("$error_code", "10"))
+	s.Nil(jsonValue("$response_size", "invalid"))
+	s.Equal(1.5, jsonValue("$time_cost", "1.5ms"))
+	s.Nil(jsonValue("$time_cost", "invalid"))
+	s.Nil(jsonValue("$user_name", info.Unknown))
+	s.Equal("Query", jsonValue("$method_name", "Query"))
+}
+
 func (s *LogFormatterSuite) TestParseConfigKeyFailed() {
 	configKey := ".testf.invalidSub"
 	_, _, err := parseConfigKey(configKey)
@@ -18,8 +18,11 @@ package accesslog
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
+	"time"
 
+	"github.com/milvus-io/milvus/internal/json"
 	"github.com/milvus-io/milvus/internal/proxy/accesslog/info"
 	"github.com/milvus-io/milvus/pkg/v2/util/merr"
 )
@@ -29,6 +32,12 @@ const (
 	methodKey  = "methods"
 )
 
+// output formats of access logs
+const (
+	TextFormat = "text"
+	JSONFormat = "json"
+)
+
 var BaseFormatterKey = "base"
 
 // Formaater manager not concurrent safe
@@ -49,6 +58,10 @@ func (m *FormatterManger) Add(name, fmt string) {
 	m.formatters[name] = NewFormatter(fmt)
 }
 
+func (m *FormatterManger) AddJSON(name, fmt string) {
+	m.formatters[name] = NewJSONFormatter(fmt)
+}
+
 func (m *FormatterManger) SetMethod(name string, methods ...string) {
 	for _, method := range methods {
 		m.methodMap[method] = name
@@ -72,6 +85,8 @@ type Formatter struct {
 	base   string
 	fmt    string
 	fields []string
+	// output the metrics as a json object instead of the format
+	json bool
 }
 
 func NewFormatter(base string) *Formatter {
@@ -82,6 +97,16 @@ func NewFormatter(base string) *Formatter {
 	return formatter
 }
 
+// NewJSONFormatter returns a formatter outputs the metrics in base as a json object.
+func NewJSONFormatter(base string) *Formatter {
+	formatter := &Formatter{
+		base: base,
+		json: true,
+	}
+	formatter.build()
+	return formatter
+}
+
 func (f *Formatter) buildMetric(metric string, prefixs []string) ([]string, []string) {
 	newFields := []string{}
 	newPrefixs := []string{}
@@ -121,9 +146,43 @@ func (f *Formatter) build() {
 
 func (f *Formatter) Format(i info.AccessInfo) string {
 	fieldValues := info.Get(i, f.fields...)
+	if f.json {
+		return f.formatJSON(fieldValues)
+	}
 	return fmt.Sprintf(f.fmt, fieldValues...)
 }
 
+func (f *Formatter) formatJSON(fieldValues []any) string {
+	entry := make(map[string]any, len(f.fields))
+	for id, field := range f.fields {
+		entry[strings.TrimPrefix(field, "$")] = jsonValue(field, fieldValues[id].(string))
+	}
+	// json of a map with primitive values never fails
+	bytes, _ := json.Marshal(entry)
+	return string(bytes) + "\n"
+}
+
+// jsonValue converts the metric value to the typed json value, unknown values are null.
+func jsonValue(metric string, value string) any {
+	if value == info.Unknown {
+		return nil
+	}
+	switch metric {
+	case "$response_size", "$error_code":
+		if v, err := strconv.ParseInt(value, 10, 64); err == nil {
+			return v
+		}
+		return nil
+	case "$time_cost":
+		if d, err := time.ParseDuration(value); err == nil {
+			return float64(d) / float64(time.Millisecond)
+		}
+		return nil
+	default:
+		return value
+	}
+}
+
 func parseConfigKey(k string) (string, string, error) {
 	fields := strings.Split(k, ".")
 	if len(fields) != 2 || (fields[1] != fomaterkey && fields[1] != methodKey) {
@@ -28,6 +28,7 @@ import (
 	"github.com/milvus-io/milvus/internal/proxy/accesslog/info"
 	configEvent "github.com/milvus-io/milvus/pkg/v2/config"
 	"github.com/milvus-io/milvus/pkg/v2/log"
+	"github.com/milvus-io/milvus/pkg/v2/util/merr"
 	"github.com/milvus-io/milvus/pkg/v2/util/paramtable"
 )
 
@@ -54,7 +55,7 @@ func (l *AccessLogger) init(params *paramtable.ComponentParam) error {
 	}
 	l.formatters = formatters
 
-	writer, err := initWriter(&params.ProxyCfg.AccessLog, &params.MinioCfg)
+	writer, err := initWriter(&params.ProxyCfg.AccessLog, &params.MinioCfg, &params.KafkaCfg)
 	if err != nil {
 		return err
 	}
@@ -94,9 +95,7 @@ func (l *AccessLogger) SetEnable(enable bool) error {
 		}
 	} else {
 		log.Info("start close access log")
-		if write, ok := l.writer.(*RotateWriter); ok {
-			write.Close()
-		}
+		closeWriter(l.writer)
 	}
 
 	l.enable.Store(enable)
@@ -148,6 +147,11 @@ func InitAccessLogger(params *paramtable.ComponentParam) {
 }
 
 func initFormatter(logCfg *paramtable.AccessLogConfig) (*FormatterManger, error) {
+	outputFormat := logCfg.OutputFormat.GetValue()
+	if outputFormat != TextFormat && outputFormat != JSONFormat {
+		return nil, merr.WrapErrParameterInvalid("text|json", outputFormat, "invalid access log output format")
+	}
+
 	formatterManger := NewFormatterManger()
 	formatMap := make(map[string]string)   // fommatter name -> formatter format
 	methodMap := make(map[string][]string) // fommatter name -> formatter owner method
@@ -165,7 +169,11 @@ func initFormatter(logCfg *paramtable.AccessLogConfig) (*FormatterManger, error)
 	}
 
 	for name, format := range formatMap {
-		formatterManger.Add(name, format)
+		if outputFormat == JSONFormat {
+			formatterManger.AddJSON(name, format)
+		} else {
+			formatterManger.Add(name, format)
+		}
 		if methods, ok := methodMap[name]; ok {
 			formatterManger.SetMethod(name, methods...)
 		}
@@ -174,8 +182,30 @@ func initFormatter(logCfg *paramtable.AccessLogConfig) (*FormatterManger, error)
 	return formatterManger, nil
 }
 
-// initAccessLogger initializes a zap access logger for proxy
-func initWriter(logCfg *paramtable.AccessLogConfig, minioCfg *paramtable.MinioConfig) (io.Writer, error) {
+// initWriter initializes the writers of access logs, the local file or stdout, and kafka if enabled.
+func initWriter(logCfg *paramtable.AccessLogConfig, minioCfg *paramtable.MinioConfig, kafkaCfg *paramtable.KafkaConfig) (io.Writer, error) {
+	if !logCfg.KafkaEnable.GetAsBool() {
+		return initLocalWriter(logCfg, minioCfg)
+	}
+
+	kafkaWriter, err := NewKafkaWriter(logCfg, kafkaCfg)
+	if err != nil {
+		return nil, err
+	}
+	if len(logCfg.Filename.GetValue()) == 0 {
+		return kafkaWriter, nil
+	}
+
+	localWriter, err := initLocalWriter(logCfg, minioCfg)
+	if err != nil {
+		kafkaWriter.Close()
+		return nil, err
+	}
+	return NewMultiWriter(localWriter, kafkaWriter), nil
+}
+
+// initLocalWriter initializes a rotated file writer, or stdout writer if filename is empty.
+func initLocalWriter(logCfg *paramtable.AccessLogConfig, minioCfg *paramtable.MinioConfig) (io.Writer, error) {
 	if len(logCfg.Filename.GetValue()) > 0 {
 		lg, err := NewRotateWriter(logCfg, minioCfg)
 		if err != nil {
@@ -81,6 +81,20 @@ func TestAccessLogger_InitFailed(t *testing.T) {
 	accessInfo = info.NewGrpcAccessInfo(context.Background(), rpcInfo, nil)
 	ok = _globalL.Write(accessInfo)
 	assert.False(t, ok)
+
+	// invalid output format
+	logger := NewAccessLogger()
+	Params.Init(paramtable.NewBaseTable(paramtable.SkipRemote(true)))
+	Params.Save(Params.ProxyCfg.AccessLog.Enable.Key, "true")
+	Params.Save(Params.ProxyCfg.AccessLog.OutputFormat.Key, "xml")
+	assert.Error(t, logger.Init(&Params))
+
+	// empty kafka topic
+	Params.Init(paramtable.NewBaseTable(paramtable.SkipRemote(true)))
+	Params.Save(Params.ProxyCfg.AccessLog.Enable.Key, "true")
+	Params.Save(Params.ProxyCfg.AccessLog.KafkaEnable.Key, "true")
+	Params.Save(Params.ProxyCfg.AccessLog.KafkaTopic.Key, "")
+	assert.Error(t, logger.Init(&Params))
 }
 
 func TestAccessLogger_DynamicEnable(t *testing.T) {
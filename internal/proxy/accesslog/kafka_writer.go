@@ -0,0 +1,128 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package accesslog
+
+import (
+	"bytes"
+	"fmt"
+	"sync"
+
+	"github.com/confluentinc/confluent-kafka-go/kafka"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/pkg/v2/log"
+	kafkawrapper "github.com/milvus-io/milvus/pkg/v2/mq/msgstream/mqwrapper/kafka"
+	"github.com/milvus-io/milvus/pkg/v2/util/merr"
+	"github.com/milvus-io/milvus/pkg/v2/util/paramtable"
+)
+
+// timeout of flushing the remaining access logs when closing the kafka writer, in milliseconds
+const kafkaFlushTimeout = 3000
+
+// kafkaProducer is the part of kafka.Producer used by KafkaWriter.
+type kafkaProducer interface {
+	Produce(msg *kafka.Message, deliveryChan chan kafka.Event) error
+	Events() chan kafka.Event
+	Flush(timeoutMs int) int
+	Close()
+}
+
+// KafkaWriter sends each access log as a message to the kafka topic.
+type KafkaWriter struct {
+	mu       sync.RWMutex
+	producer kafkaProducer
+	topic    string
+	closed   bool
+}
+
+func NewKafkaWriter(logCfg *paramtable.AccessLogConfig, kafkaCfg *paramtable.KafkaConfig) (*KafkaWriter, error) {
+	topic := logCfg.KafkaTopic.GetValue()
+	if topic == "" {
+		return nil, merr.WrapErrParameterInvalidMsg("kafka topic of access log is empty")
+	}
+
+	config := kafkawrapper.GetBasicConfig(kafkaCfg)
+	// access logs are sent without waiting the delivery reports,
+	// only the failed deliveries are reported to the events channel
+	config.SetKey("delivery.report.only.error", true)
+	for k, v := range kafkaCfg.ProducerExtraConfig.GetValue() {
+		config.SetKey(k, v)
+	}
+	producer, err := kafka.NewProducer(&config)
+	if err != nil {
+		return nil, err
+	}
+	return newKafkaWriter(producer, topic), nil
+}
+
+func newKafkaWriter(producer kafkaProducer, topic string) *KafkaWriter {
+	w := &KafkaWriter{
+		producer: producer,
+		topic:    topic,
+	}
+	go w.handleEvents()
+	return w
+}
+
+// handleEvents drains the events channel of the producer until it's closed,
+// otherwise the producer gets blocked once the channel is full.
+func (w *KafkaWriter) handleEvents() {
+	for e := range w.producer.Events() {
+		switch ev := e.(type) {
+		case *kafka.Message:
+			if ev.TopicPartition.Error != nil {
+				log.RatedWarn(10, "failed to send access log to kafka", zap.String("topic", w.topic), zap.Error(ev.TopicPartition.Error))
+			}
+		case kafka.Error:
+			log.RatedWarn(10, "kafka error of access log writer", zap.String("topic", w.topic), zap.Error(ev))
+		}
+	}
+}
+
+func (w *KafkaWriter) Write(p []byte) (n int, err error) {
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+	if w.closed {
+		return 0, fmt.Errorf("write to closed kafka writer")
+	}
+
+	// copy the log since the buffer may be reused by the caller
+	value := bytes.Clone(bytes.TrimSuffix(p, []byte("\n")))
+	err = w.producer.Produce(&kafka.Message{
+		TopicPartition: kafka.TopicPartition{Topic: &w.topic, Partition: kafka.PartitionAny},
+		Value:          value,
+	}, nil)
+	if err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
+
+func (w *KafkaWriter) Close() error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if w.closed {
+		return nil
+	}
+	w.closed = true
+
+	if remain := w.producer.Flush(kafkaFlushTimeout); remain > 0 {
+		log.Warn("access logs are not sent to kafka before close", zap.String("topic", w.topic), zap.Int("remain", remain))
+	}
+	w.producer.Close()
+	return nil
+}
@@ -0,0 +1,103 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package accesslog
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/confluentinc/confluent-kafka-go/kafka"
+	"github.com/stretchr/testify/assert"
+)
+
+// testProducer is an in-process stand-in of kafka producer.
+type testProducer struct {
+	mu       sync.Mutex
+	messages []*kafka.Message
+	err      error
+	remain   int
+	closed   bool
+	events   chan kafka.Event
+}
+
+func newTestProducer() *testProducer {
+	return &testProducer{events: make(chan kafka.Event, 1)}
+}
+
+func (p *testProducer) Produce(msg *kafka.Message, deliveryChan chan kafka.Event) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.err != nil {
+		return p.err
+	}
+	p.messages = append(p.messages, msg)
+	return nil
+}
+
+func (p *testProducer) Events() chan kafka.Event {
+	return p.events
+}
+
+func (p *testProducer) Flush(timeoutMs int) int {
+	return p.remain
+}
+
+func (p *testProducer) Close() {
+	p.closed = true
+	close(p.events)
+}
+
+func TestKafkaWriter(t *testing.T) {
+	producer := newTestProducer()
+	writer := newKafkaWriter(producer, "access-log")
+
+	buf := []byte("log1\n")
+	n, err := writer.Write(buf)
+	assert.NoError(t, err)
+	assert.Equal(t, 5, n)
+	// the buffer may be reused after written
+	copy(buf, "log2\n")
+	_, err = writer.Write(buf)
+	assert.NoError(t, err)
+
+	assert.Equal(t, 2, len(producer.messages))
+	assert.Equal(t, "access-log", *producer.messages[0].TopicPartition.Topic)
+	assert.Equal(t, kafka.PartitionAny, producer.messages[0].TopicPartition.Partition)
+	assert.Equal(t, "log1", string(producer.messages[0].Value))
+	assert.Equal(t, "log2", string(producer.messages[1].Value))
+
+	// the delivery reports are drained
+	topic := "access-log"
+	for i := 0; i < 3; i++ {
+		producer.events <- &kafka.Message{TopicPartition: kafka.TopicPartition{
+			Topic: &topic,
+			Error: kafka.NewError(kafka.ErrMsgTimedOut, "timed out", false),
+		}}
+	}
+	producer.events <- kafka.NewError(kafka.ErrAllBrokersDown, "all brokers down", false)
+
+	producer.err = kafka.NewError(kafka.ErrQueueFull, "queue full", false)
+	_, err = writer.Write([]byte("log3\n"))
+	assert.Error(t, err)
+
+	producer.remain = 1
+	assert.NoError(t, writer.Close())
+	assert.True(t, producer.closed)
+	assert.NoError(t, writer.Close())
+	_, err = writer.Write([]byte("log4\n"))
+	assert.Error(t, err)
+}
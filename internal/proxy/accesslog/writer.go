@@ -29,6 +29,7 @@ import (
 	"go.uber.org/zap"
 
 	"github.com/milvus-io/milvus/pkg/v2/log"
+	"github.com/milvus-io/milvus/pkg/v2/util/merr"
 	"github.com/milvus-io/milvus/pkg/v2/util/paramtable"
 )
 
@@ -131,6 +132,45 @@ func (l *CacheWriter) Close() {
 	})
 }
 
+// MultiWriter duplicates the access logs to all the writers,
+// the logs are still written to the other writers if some writer fails.
+type MultiWriter struct {
+	writers []io.Writer
+}
+
+func NewMultiWriter(writers ...io.Writer) *MultiWriter {
+	return &MultiWriter{writers: writers}
+}
+
+func (w *MultiWriter) Write(p []byte) (n int, err error) {
+	errs := make([]error, 0)
+	for _, writer := range w.writers {
+		if _, err := writer.Write(p); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	if len(errs) > 0 {
+		return 0, merr.Combine(errs...)
+	}
+	return len(p), nil
+}
+
+// closeWriter closes the writer and the writers wrapped by it.
+func closeWriter(writer io.Writer) {
+	switch w := writer.(type) {
+	case *RotateWriter:
+		w.Close()
+	case *CacheWriter:
+		w.Close()
+	case *KafkaWriter:
+		w.Close()
+	case *MultiWriter:
+		for _, writer := range w.writers {
+			closeWriter(writer)
+		}
+	}
+}
+
 // a rotated file writer
 type RotateWriter struct {
 	// local path is the path to save log before update to minIO
@@ -314,3 +314,28 @@ func TestCacheWriter_WithAutoFlush(t *testing.T) {
 	writer.Close()
 	assert.True(t, buffer.closed)
 }
+
+func TestMultiWriter(t *testing.T) {
+	first := &TestWriter{buffer: bytes.NewBuffer(make([]byte, 0))}
+	second := NewCacheWriter(bytes.NewBuffer(make([]byte, 0)), 512, 0)
+	writer := NewMultiWriter(first, second)
+
+	n, err := writer.Write([]byte("111\n"))
+	assert.NoError(t, err)
+	assert.Equal(t, 4, n)
+	b, err := first.ReadBytes('\n')
+	assert.NoError(t, err)
+	assert.Equal(t, "111\n", string(b))
+
+	// still write to the others if some writer fails
+	second.Close()
+	_, err = writer.Write([]byte("222\n"))
+	assert.Error(t, err)
+	b, err = first.ReadBytes('\n')
+	assert.NoError(t, err)
+	assert.Equal(t, "222\n", string(b))
+
+	closeWriter(writer)
+	_, err = second.Write([]byte("333\n"))
+	assert.Error(t, err)
+}
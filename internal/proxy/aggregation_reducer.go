@@ -0,0 +1,49 @@
+package proxy
+
+import (
+	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/util/reduce"
+	"github.com/milvus-io/milvus/pkg/v2/proto/internalpb"
+	"github.com/milvus-io/milvus/pkg/v2/util/merr"
+	"github.com/milvus-io/milvus/pkg/v2/util/typeutil"
+)
+
+// aggregationReducer merges the partial aggregation states from shards and computes the final results.
+type aggregationReducer struct {
+	req            *internalpb.RetrieveRequest
+	params         *queryParams
+	schema         *schemapb.CollectionSchema
+	collectionName string
+}
+
+func (r *aggregationReducer) Reduce(results []*internalpb.RetrieveResults) (*milvuspb.QueryResults, error) {
+	aggregator, err := reduce.NewGroupAggregator(r.schema, r.req.GetGroupByFieldIds(), r.req.GetAggregates())
+	if err != nil {
+		return nil, err
+	}
+	for _, result := range results {
+		if err := aggregator.AddPartials(result.GetFieldsData()); err != nil {
+			return nil, err
+		}
+	}
+
+	offset, limit := int64(0), typeutil.Unlimited
+	if r.params != nil {
+		offset, limit = r.params.offset, r.params.limit
+	}
+	return &milvuspb.QueryResults{
+		Status:         merr.Success(),
+		CollectionName: r.collectionName,
+		FieldsData:     aggregator.Finalize(offset, limit),
+	}, nil
+}
+
+func newAggregationReducer(params *queryParams, req *internalpb.RetrieveRequest, schema *schemapb.CollectionSchema, collectionName string) *aggregationReducer {
+	return &aggregationReducer{
+		req:            req,
+		params:         params,
+		schema:         schema,
+		collectionName: collectionName,
+	}
+}
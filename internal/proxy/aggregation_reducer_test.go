@@ -0,0 +1,54 @@
+package proxy
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/pkg/v2/proto/internalpb"
+)
+
+func Test_aggregationReducer_Reduce(t *testing.T) {
+	schema := &schemapb.CollectionSchema{
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: 100, Name: "pk", DataType: schemapb.DataType_Int64, IsPrimaryKey: true},
+			{FieldID: 101, Name: "category", DataType: schemapb.DataType_Int64},
+		},
+	}
+	req := &internalpb.RetrieveRequest{
+		GroupByFieldIds: []int64{101},
+		Aggregates:      []*internalpb.Aggregate{{Op: internalpb.AggregateOp_Count}},
+	}
+	// partial states of category and count(*)
+	genPartials := func(categories []int64, counts []int64) *internalpb.RetrieveResults {
+		return &internalpb.RetrieveResults{
+			FieldsData: []*schemapb.FieldData{
+				getFieldData("category", 101, schemapb.DataType_Int64, categories, 1),
+				getFieldData("count(*)", 0, schemapb.DataType_Int64, counts, 1),
+			},
+		}
+	}
+
+	r := newAggregationReducer(&queryParams{limit: 2, offset: 1}, req, schema, "test")
+	ret, err := r.Reduce([]*internalpb.RetrieveResults{
+		genPartials([]int64{3, 1}, []int64{5, 2}),
+		genPartials([]int64{2, 1}, []int64{1, 1}),
+		{},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "test", ret.GetCollectionName())
+	assert.Equal(t, 2, len(ret.GetFieldsData()))
+	assert.Equal(t, []int64{2, 3}, ret.GetFieldsData()[0].GetScalars().GetLongData().GetData())
+	assert.Equal(t, []int64{1, 5}, ret.GetFieldsData()[1].GetScalars().GetLongData().GetData())
+
+	// mismatched partial states
+	_, err = r.Reduce([]*internalpb.RetrieveResults{
+		{FieldsData: []*schemapb.FieldData{getFieldData("category", 101, schemapb.DataType_Int64, []int64{1}, 1)}},
+	})
+	assert.Error(t, err)
+
+	r = newAggregationReducer(nil, &internalpb.RetrieveRequest{}, schema, "test")
+	_, err = r.Reduce(nil)
+	assert.Error(t, err)
+}
@@ -11,6 +11,7 @@ import (
 	"google.golang.org/protobuf/proto"
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus/pkg/v2/proto/internalpb"
 	"github.com/milvus-io/milvus/pkg/v2/util"
 )
 
@@ -93,6 +94,9 @@ func TestDatabaseInterceptor(t *testing.T) {
 			&milvuspb.RenameCollectionRequest{},
 			&milvuspb.TransferReplicaRequest{},
 			&milvuspb.ListImportTasksRequest{},
+			&internalpb.ImportRequest{},
+			&internalpb.GetImportProgressRequest{},
+			&internalpb.ListImportsRequest{},
 			&milvuspb.OperatePrivilegeRequest{Entity: &milvuspb.GrantEntity{}},
 			&milvuspb.SelectGrantRequest{Entity: &milvuspb.GrantEntity{}},
 			&milvuspb.ManualCompactionRequest{},
@@ -0,0 +1,486 @@
+package proxy
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"reflect"
+	"sort"
+
+	"github.com/cockroachdb/errors"
+	"github.com/expr-lang/expr"
+	"github.com/expr-lang/expr/ast"
+	"github.com/expr-lang/expr/vm"
+	"github.com/samber/lo"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/json"
+	"github.com/milvus-io/milvus/internal/util/function"
+	"github.com/milvus-io/milvus/pkg/v2/util/funcutil"
+	"github.com/milvus-io/milvus/pkg/v2/util/merr"
+	"github.com/milvus-io/milvus/pkg/v2/util/typeutil"
+)
+
+const (
+	gaussDecayFunction  = "gauss"
+	expDecayFunction    = "exp"
+	linearDecayFunction = "linear"
+
+	defaultDecay = 0.5
+
+	// exprScoreVariable is the variable which refers to the fused score in the score expression.
+	exprScoreVariable = "score"
+)
+
+// functionScorer adjusts the fused scores of hybrid search by the values of some scalar fields,
+// it is applied after the requery so that the input fields are available.
+type functionScorer interface {
+	name() string
+	scorerType() rankType
+	inputFieldNames() []string
+	score(ctx context.Context, input *schemapb.SearchResultData) error
+}
+
+// NewFunctionScorer returns the function scorer declared by the rank params, nil if there is none.
+func NewFunctionScorer(rankParams []*commonpb.KeyValuePair, schema *schemapb.CollectionSchema) (functionScorer, error) {
+	// the hits are fused by rrf if the rank type is not specified
+	rankTypeStr, _ := funcutil.GetAttrByKeyFromRepeatedKV(RankTypeKey, rankParams)
+	rt := rankTypeMap[rankTypeStr]
+	if queriesStr, err := funcutil.GetAttrByKeyFromRepeatedKV(RerankQueriesKey, rankParams); err == nil {
+		if rt == decayRankType || rt == udfExprRankType {
+			return nil, merr.WrapErrParameterInvalidMsg("%s could not be used with rank type %s", RerankQueriesKey, rankTypeStr)
+		}
+		return newModelScorer(queriesStr, schema)
+	}
+	if rt != decayRankType && rt != udfExprRankType {
+		return nil, nil
+	}
+
+	paramStr, err := funcutil.GetAttrByKeyFromRepeatedKV(RankParamsKey, rankParams)
+	if err != nil {
+		return nil, errors.New(RankParamsKey + " not found in rank_params")
+	}
+	var params map[string]interface{}
+	if err := json.Unmarshal([]byte(paramStr), &params); err != nil {
+		return nil, err
+	}
+
+	switch rt {
+	case decayRankType:
+		return newDecayScorer(params, schema)
+	default:
+		return newExprScorer(params, schema)
+	}
+}
+
+func getStringSliceParam(params map[string]interface{}, key string) ([]string, error) {
+	rs := reflect.ValueOf(params[key])
+	if rs.Kind() != reflect.Slice {
+		return nil, errors.Errorf("The %s param should be an array", key)
+	}
+	values := make([]string, 0, rs.Len())
+	for i := 0; i < rs.Len(); i++ {
+		value, ok := rs.Index(i).Interface().(string)
+		if !ok {
+			return nil, errors.Errorf("The type of %s param element should be string", key)
+		}
+		values = append(values, value)
+	}
+	return values, nil
+}
+
+func checkScorerInputField(schema *schemapb.CollectionSchema, name string) error {
+	for _, field := range schema.GetFields() {
+		if field.GetName() != name {
+			continue
+		}
+		if !typeutil.IsIntegerType(field.GetDataType()) && !typeutil.IsFloatingType(field.GetDataType()) {
+			return merr.WrapErrParameterInvalidMsg("the input field %s of rank function should be numeric, but got %s", name, field.GetDataType().String())
+		}
+		return nil
+	}
+	return merr.WrapErrFieldNotFound(name, "input field of rank function not found")
+}
+
+func getFloatParam(params map[string]interface{}, key string) (float64, bool, error) {
+	value, ok := params[key]
+	if !ok {
+		return 0, false, nil
+	}
+	if !reflect.ValueOf(value).CanFloat() {
+		return 0, true, errors.Errorf("The type of rank param %s should be float", key)
+	}
+	return reflect.ValueOf(value).Float(), true, nil
+}
+
+func getInputFieldData(fieldsData []*schemapb.FieldData, name string) (*schemapb.FieldData, error) {
+	for _, fieldData := range fieldsData {
+		if fieldData.GetFieldName() == name {
+			return fieldData, nil
+		}
+	}
+	return nil, merr.WrapErrServiceInternal(fmt.Sprintf("input field %s of rank function not found in search results", name))
+}
+
+func getNumericValue(fieldData *schemapb.FieldData, idx int) (float64, error) {
+	switch v := typeutil.GetData(fieldData, idx).(type) {
+	case int32:
+		return float64(v), nil
+	case int64:
+		return float64(v), nil
+	case float32:
+		return float64(v), nil
+	case float64:
+		return v, nil
+	default:
+		return 0, merr.WrapErrServiceInternal(fmt.Sprintf("unexpected value type %T of field %s", v, fieldData.GetFieldName()))
+	}
+}
+
+// sortByScores reorders the hits of each query by the descending order of new scores.
+func sortByScores(input *schemapb.SearchResultData, scores []float32) {
+	indices := make([]int, 0, len(scores))
+	var start int64
+	for _, topk := range input.GetTopks() {
+		offsets := make([]int, topk)
+		for i := range offsets {
+			offsets[i] = int(start) + i
+		}
+		sort.SliceStable(offsets, func(i, j int) bool {
+			return scores[offsets[i]] > scores[offsets[j]]
+		})
+		indices = append(indices, offsets...)
+		start += topk
+	}
+
+	ids := &schemapb.IDs{}
+	sortedScores := make([]float32, 0, len(indices))
+	fieldsData := typeutil.PrepareResultFieldData(input.GetFieldsData(), int64(len(indices)))
+	for _, idx := range indices {
+		typeutil.AppendPKs(ids, typeutil.GetPK(input.GetIds(), int64(idx)))
+		sortedScores = append(sortedScores, scores[idx])
+		typeutil.AppendFieldData(fieldsData, input.GetFieldsData(), int64(idx))
+	}
+	input.Ids = ids
+	input.Scores = sortedScores
+	input.FieldsData = fieldsData
+}
+
+// pageSearchResults keeps the hits within [offset, offset+limit) of each query, the function scorers rerank
+// the top offset+limit candidates of the fused results, so the page is only cut after the scoring.
+func pageSearchResults(input *schemapb.SearchResultData, offset int64, limit int64) {
+	topks := make([]int64, 0, len(input.GetTopks()))
+	ids := &schemapb.IDs{}
+	scores := make([]float32, 0, len(input.GetScores()))
+	fieldsData := typeutil.PrepareResultFieldData(input.GetFieldsData(), limit*int64(len(input.GetTopks())))
+	var start int64
+	for _, topk := range input.GetTopks() {
+		end := start + topk
+		from, to := min(start+offset, end), min(start+offset+limit, end)
+		for idx := from; idx < to; idx++ {
+			typeutil.AppendPKs(ids, typeutil.GetPK(input.GetIds(), idx))
+			scores = append(scores, input.GetScores()[idx])
+			typeutil.AppendFieldData(fieldsData, input.GetFieldsData(), idx)
+		}
+		topks = append(topks, to-from)
+		start = end
+	}
+	input.Ids = ids
+	input.Scores = scores
+	input.FieldsData = fieldsData
+	input.Topks = topks
+	input.TopK = limit
+}
+
+type decayFunc func(distance float64) float64
+
+// decayScorer multiplies the fused score by a decay factor computed from the distance between
+// the field value and the origin, the factor equals to decay once the distance reaches offset + scale.
+type decayScorer struct {
+	baseScorer
+	field string
+	// origin is the point where the factor is 1
+	origin float64
+	// offset is the distance within which the factor stays 1
+	offset float64
+	decay  decayFunc
+}
+
+func newDecayScorer(params map[string]interface{}, schema *schemapb.CollectionSchema) (*decayScorer, error) {
+	function, ok := params[DecayFunctionParamsKey].(string)
+	if !ok {
+		return nil, errors.New(DecayFunctionParamsKey + " not found in rank_params")
+	}
+	field, ok := params[DecayFieldParamsKey].(string)
+	if !ok {
+		return nil, errors.New(DecayFieldParamsKey + " not found in rank_params")
+	}
+	if err := checkScorerInputField(schema, field); err != nil {
+		return nil, err
+	}
+
+	origin, ok, err := getFloatParam(params, DecayOriginParamsKey)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, errors.New(DecayOriginParamsKey + " not found in rank_params")
+	}
+	scale, ok, err := getFloatParam(params, DecayScaleParamsKey)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, errors.New(DecayScaleParamsKey + " not found in rank_params")
+	}
+	if scale <= 0 {
+		return nil, errors.New("rank param scale should be greater than 0")
+	}
+	offset, _, err := getFloatParam(params, DecayOffsetParamsKey)
+	if err != nil {
+		return nil, err
+	}
+	if offset < 0 {
+		return nil, errors.New("rank param offset should not be less than 0")
+	}
+	decay, ok, err := getFloatParam(params, DecayParamsKey)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		decay = defaultDecay
+	}
+	if decay <= 0 || decay >= 1 {
+		return nil, errors.New("rank param decay should be in range (0, 1)")
+	}
+
+	var f decayFunc
+	switch function {
+	case gaussDecayFunction:
+		sigmaSquare := -math.Pow(scale, 2) / (2 * math.Log(decay))
+		f = func(distance float64) float64 {
+			return math.Exp(-math.Pow(distance, 2) / (2 * sigmaSquare))
+		}
+	case expDecayFunction:
+		lambda := math.Log(decay) / scale
+		f = func(distance float64) float64 {
+			return math.Exp(lambda * distance)
+		}
+	case linearDecayFunction:
+		s := scale / (1 - decay)
+		f = func(distance float64) float64 {
+			return math.Max(0, (s-distance)/s)
+		}
+	default:
+		return nil, errors.Errorf("unsupported decay function %s", function)
+	}
+
+	return &decayScorer{
+		baseScorer: baseScorer{
+			scorerName: function,
+		},
+		field:  field,
+		origin: origin,
+		offset: offset,
+		decay:  f,
+	}, nil
+}
+
+func (ds *decayScorer) scorerType() rankType {
+	return decayRankType
+}
+
+func (ds *decayScorer) inputFieldNames() []string {
+	return []string{ds.field}
+}
+
+func (ds *decayScorer) score(ctx context.Context, input *schemapb.SearchResultData) error {
+	fieldData, err := getInputFieldData(input.GetFieldsData(), ds.field)
+	if err != nil {
+		return err
+	}
+	scores := make([]float32, len(input.GetScores()))
+	for i, s := range input.GetScores() {
+		value, err := getNumericValue(fieldData, i)
+		if err != nil {
+			return err
+		}
+		distance := math.Max(0, math.Abs(value-ds.origin)-ds.offset)
+		scores[i] = s * float32(ds.decay(distance))
+	}
+	sortByScores(input, scores)
+	return nil
+}
+
+// exprScorer replaces the fused score by the result of a user defined expression,
+// the expression could refer to the fused score by `score` and to the input fields by their names.
+type exprScorer struct {
+	baseScorer
+	fields  []string
+	program *vm.Program
+}
+
+func newExprScorer(params map[string]interface{}, schema *schemapb.CollectionSchema) (*exprScorer, error) {
+	code, ok := params[ExprCodeParamsKey].(string)
+	if !ok || code == "" {
+		return nil, errors.New(ExprCodeParamsKey + " not found in rank_params")
+	}
+
+	fields := make([]string, 0)
+	if _, ok := params[InputFieldsParamsKey]; ok {
+		names, err := getStringSliceParam(params, InputFieldsParamsKey)
+		if err != nil {
+			return nil, err
+		}
+		for _, name := range names {
+			if name == exprScoreVariable {
+				return nil, errors.Errorf("input field %s conflicts with the score variable", name)
+			}
+			if err := checkScorerInputField(schema, name); err != nil {
+				return nil, err
+			}
+			fields = append(fields, name)
+		}
+	}
+
+	env := map[string]any{exprScoreVariable: float64(0)}
+	for _, name := range fields {
+		env[name] = float64(0)
+	}
+	program, err := expr.Compile(code, expr.Env(env), expr.AsFloat64())
+	if err != nil {
+		return nil, merr.WrapErrParameterInvalidMsg("invalid rank expr %s: %s", code, err.Error())
+	}
+	// map env accepts any variable, so the variables are checked against the input fields here
+	visitor := &exprVariableVisitor{callees: make(map[string]struct{})}
+	node := program.Node()
+	ast.Walk(&node, visitor)
+	for _, name := range visitor.identifiers {
+		if _, ok := visitor.callees[name]; ok {
+			continue
+		}
+		if _, ok := env[name]; !ok {
+			return nil, merr.WrapErrParameterInvalidMsg("variable %s of rank expr is not declared in input_fields", name)
+		}
+	}
+
+	return &exprScorer{
+		baseScorer: baseScorer{
+			scorerName: "expr",
+		},
+		fields:  fields,
+		program: program,
+	}, nil
+}
+
+type exprVariableVisitor struct {
+	identifiers []string
+	callees     map[string]struct{}
+}
+
+func (v *exprVariableVisitor) Visit(node *ast.Node) {
+	switch n := (*node).(type) {
+	case *ast.IdentifierNode:
+		v.identifiers = append(v.identifiers, n.Value)
+	case *ast.CallNode:
+		if callee, ok := n.Callee.(*ast.IdentifierNode); ok {
+			v.callees[callee.Value] = struct{}{}
+		}
+	}
+}
+
+func (es *exprScorer) scorerType() rankType {
+	return udfExprRankType
+}
+
+func (es *exprScorer) inputFieldNames() []string {
+	return es.fields
+}
+
+func (es *exprScorer) score(ctx context.Context, input *schemapb.SearchResultData) error {
+	fieldsData := make([]*schemapb.FieldData, len(es.fields))
+	for i, name := range es.fields {
+		fieldData, err := getInputFieldData(input.GetFieldsData(), name)
+		if err != nil {
+			return err
+		}
+		fieldsData[i] = fieldData
+	}
+
+	machine := &vm.VM{}
+	env := make(map[string]any, len(es.fields)+1)
+	scores := make([]float32, len(input.GetScores()))
+	for i, s := range input.GetScores() {
+		env[exprScoreVariable] = float64(s)
+		for j, name := range es.fields {
+			value, err := getNumericValue(fieldsData[j], i)
+			if err != nil {
+				return err
+			}
+			env[name] = value
+		}
+		result, err := machine.Run(es.program, env)
+		if err != nil {
+			return merr.WrapErrServiceInternal("failed to evaluate rank expr", err.Error())
+		}
+		scores[i] = float32(result.(float64))
+	}
+	sortByScores(input, scores)
+	return nil
+}
+
+// modelScorer replaces the fused score by the relevance between the queries and the text field
+// of the hits, the relevance is computed by the rerank function declared in the collection schema.
+type modelScorer struct {
+	baseScorer
+	queries []string
+	runner  *function.RerankFunction
+}
+
+func newModelScorer(queriesStr string, schema *schemapb.CollectionSchema) (*modelScorer, error) {
+	var queries []string
+	if err := json.Unmarshal([]byte(queriesStr), &queries); err != nil || len(queries) == 0 {
+		return nil, merr.WrapErrParameterInvalidMsg("%s should be a non-empty string array, but got %s", RerankQueriesKey, queriesStr)
+	}
+
+	fSchema, ok := lo.Find(schema.GetFunctions(), func(fSchema *schemapb.FunctionSchema) bool {
+		return fSchema.GetType() == function.FunctionTypeRerank
+	})
+	if !ok {
+		return nil, merr.WrapErrParameterInvalidMsg("collection %s has no rerank function", schema.GetName())
+	}
+	runner, err := function.NewRerankFunction(schema, fSchema)
+	if err != nil {
+		return nil, merr.WrapErrServiceInternal("failed to create rerank function", err.Error())
+	}
+	return &modelScorer{
+		baseScorer: baseScorer{
+			scorerName: fSchema.GetName(),
+		},
+		queries: queries,
+		runner:  runner,
+	}, nil
+}
+
+func (ms *modelScorer) scorerType() rankType {
+	return modelRankType
+}
+
+func (ms *modelScorer) inputFieldNames() []string {
+	return []string{ms.runner.GetInputFieldName()}
+}
+
+func (ms *modelScorer) score(ctx context.Context, input *schemapb.SearchResultData) error {
+	fieldData, err := getInputFieldData(input.GetFieldsData(), ms.runner.GetInputFieldName())
+	if err != nil {
+		return err
+	}
+	texts := fieldData.GetScalars().GetStringData().GetData()
+	scores, err := ms.runner.Rerank(ctx, ms.queries, input.GetTopks(), texts)
+	if err != nil {
+		return merr.WrapErrServiceInternal("failed to rerank by model", err.Error())
+	}
+	sortByScores(input, scores)
+	return nil
+}
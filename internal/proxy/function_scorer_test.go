@@ -0,0 +1,321 @@
+package proxy
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/json"
+	"github.com/milvus-io/milvus/internal/util/function"
+)
+
+func TestFunctionScorer(t *testing.T) {
+	schema := &schemapb.CollectionSchema{
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: 100, Name: "pk", DataType: schemapb.DataType_Int64, IsPrimaryKey: true},
+			{FieldID: 101, Name: "ts", DataType: schemapb.DataType_Int64},
+			{FieldID: 102, Name: "popularity", DataType: schemapb.DataType_Float},
+			{FieldID: 103, Name: "title", DataType: schemapb.DataType_VarChar},
+		},
+	}
+
+	genRankParams := func(rankType string, params map[string]interface{}) []*commonpb.KeyValuePair {
+		b, err := json.Marshal(params)
+		assert.NoError(t, err)
+		return []*commonpb.KeyValuePair{
+			{Key: RankTypeKey, Value: rankType},
+			{Key: RankParamsKey, Value: string(b)},
+		}
+	}
+
+	// two queries, each with 3 hits ordered by the fused scores
+	genResult := func() *schemapb.SearchResultData {
+		return &schemapb.SearchResultData{
+			NumQueries: 2,
+			TopK:       3,
+			Topks:      []int64{3, 3},
+			Scores:     []float32{0.9, 0.8, 0.7, 0.9, 0.8, 0.7},
+			Ids: &schemapb.IDs{
+				IdField: &schemapb.IDs_IntId{IntId: &schemapb.LongArray{Data: []int64{1, 2, 3, 4, 5, 6}}},
+			},
+			FieldsData: []*schemapb.FieldData{
+				{
+					Type:      schemapb.DataType_Int64,
+					FieldName: "ts",
+					FieldId:   101,
+					Field: &schemapb.FieldData_Scalars{Scalars: &schemapb.ScalarField{
+						Data: &schemapb.ScalarField_LongData{LongData: &schemapb.LongArray{Data: []int64{0, 50, 100, 100, 50, 0}}},
+					}},
+				},
+				{
+					Type:      schemapb.DataType_Float,
+					FieldName: "popularity",
+					FieldId:   102,
+					Field: &schemapb.FieldData_Scalars{Scalars: &schemapb.ScalarField{
+						Data: &schemapb.ScalarField_FloatData{FloatData: &schemapb.FloatArray{Data: []float32{1, 2, 3, 1, 1, 1}}},
+					}},
+				},
+			},
+		}
+	}
+
+	t.Run("no function scorer", func(t *testing.T) {
+		scorer, err := NewFunctionScorer(nil, schema)
+		assert.NoError(t, err)
+		assert.Nil(t, scorer)
+
+		scorer, err = NewFunctionScorer(genRankParams("rrf", map[string]interface{}{RRFParamsKey: 60}), schema)
+		assert.NoError(t, err)
+		assert.Nil(t, scorer)
+	})
+
+	t.Run("decay rescorers", func(t *testing.T) {
+		params := map[string]interface{}{
+			DecayFunctionParamsKey: "gauss",
+			DecayFieldParamsKey:    "ts",
+			DecayOriginParamsKey:   100,
+			DecayScaleParamsKey:    10,
+		}
+		rescorers, err := NewReScorers(context.TODO(), 2, genRankParams("decay", params))
+		assert.NoError(t, err)
+		assert.Equal(t, rrfRankType, rescorers[0].scorerType())
+
+		params[WeightsParamsKey] = []float64{0.5, 0.5}
+		rescorers, err = NewReScorers(context.TODO(), 2, genRankParams("decay", params))
+		assert.NoError(t, err)
+		assert.Equal(t, weightedRankType, rescorers[0].scorerType())
+	})
+
+	t.Run("invalid decay params", func(t *testing.T) {
+		valid := func() map[string]interface{} {
+			return map[string]interface{}{
+				DecayFunctionParamsKey: "gauss",
+				DecayFieldParamsKey:    "ts",
+				DecayOriginParamsKey:   100,
+				DecayScaleParamsKey:    10,
+			}
+		}
+		cases := []func(map[string]interface{}){
+			func(p map[string]interface{}) { delete(p, DecayFunctionParamsKey) },
+			func(p map[string]interface{}) { p[DecayFunctionParamsKey] = "sigmoid" },
+			func(p map[string]interface{}) { delete(p, DecayFieldParamsKey) },
+			func(p map[string]interface{}) { p[DecayFieldParamsKey] = "title" },
+			func(p map[string]interface{}) { p[DecayFieldParamsKey] = "not_exist" },
+			func(p map[string]interface{}) { delete(p, DecayOriginParamsKey) },
+			func(p map[string]interface{}) { p[DecayOriginParamsKey] = "now" },
+			func(p map[string]interface{}) { delete(p, DecayScaleParamsKey) },
+			func(p map[string]interface{}) { p[DecayScaleParamsKey] = 0 },
+			func(p map[string]interface{}) { p[DecayOffsetParamsKey] = -1 },
+			func(p map[string]interface{}) { p[DecayParamsKey] = 1 },
+		}
+		for _, c := range cases {
+			params := valid()
+			c(params)
+			_, err := NewFunctionScorer(genRankParams("decay", params), schema)
+			assert.Error(t, err)
+		}
+	})
+
+	t.Run("decay", func(t *testing.T) {
+		for _, function := range []string{gaussDecayFunction, expDecayFunction, linearDecayFunction} {
+			scorer, err := NewFunctionScorer(genRankParams("decay", map[string]interface{}{
+				DecayFunctionParamsKey: function,
+				DecayFieldParamsKey:    "ts",
+				DecayOriginParamsKey:   100,
+				DecayScaleParamsKey:    50,
+			}), schema)
+			assert.NoError(t, err)
+			assert.Equal(t, decayRankType, scorer.scorerType())
+			assert.Equal(t, []string{"ts"}, scorer.inputFieldNames())
+
+			result := genResult()
+			assert.NoError(t, scorer.score(context.TODO(), result))
+			// the fresher hits are ranked first
+			assert.Equal(t, []int64{3, 2, 1, 4, 5, 6}, result.GetIds().GetIntId().GetData())
+			assert.Equal(t, []int64{100, 50, 0, 100, 50, 0}, result.GetFieldsData()[0].GetScalars().GetLongData().GetData())
+			assert.InDelta(t, 0.7, result.GetScores()[0], 1e-6)
+			// the factor equals to decay at origin - scale
+			assert.InDelta(t, 0.8*defaultDecay, result.GetScores()[1], 1e-6)
+		}
+	})
+
+	t.Run("decay with offset", func(t *testing.T) {
+		scorer, err := NewFunctionScorer(genRankParams("decay", map[string]interface{}{
+			DecayFunctionParamsKey: "exp",
+			DecayFieldParamsKey:    "ts",
+			DecayOriginParamsKey:   100,
+			DecayScaleParamsKey:    50,
+			DecayOffsetParamsKey:   50,
+		}), schema)
+		assert.NoError(t, err)
+
+		result := genResult()
+		assert.NoError(t, scorer.score(context.TODO(), result))
+		assert.Equal(t, []int64{2, 3, 1, 4, 5, 6}, result.GetIds().GetIntId().GetData())
+	})
+
+	t.Run("page after rerank", func(t *testing.T) {
+		scorer, err := NewFunctionScorer(genRankParams("decay", map[string]interface{}{
+			DecayFunctionParamsKey: "gauss",
+			DecayFieldParamsKey:    "ts",
+			DecayOriginParamsKey:   100,
+			DecayScaleParamsKey:    50,
+		}), schema)
+		assert.NoError(t, err)
+
+		// the last fused candidate of the first query is promoted to the top
+		result := genResult()
+		assert.NoError(t, scorer.score(context.TODO(), result))
+		pageSearchResults(result, 0, 1)
+		assert.Equal(t, []int64{1, 1}, result.GetTopks())
+		assert.Equal(t, []int64{3, 4}, result.GetIds().GetIntId().GetData())
+		assert.Equal(t, []int64{100, 100}, result.GetFieldsData()[0].GetScalars().GetLongData().GetData())
+
+		result = genResult()
+		assert.NoError(t, scorer.score(context.TODO(), result))
+		pageSearchResults(result, 2, 2)
+		assert.Equal(t, []int64{1, 1}, result.GetTopks())
+		assert.Equal(t, []int64{1, 6}, result.GetIds().GetIntId().GetData())
+		assert.Len(t, result.GetScores(), 2)
+	})
+
+	t.Run("expr", func(t *testing.T) {
+		scorer, err := NewFunctionScorer(genRankParams("expr", map[string]interface{}{
+			ExprCodeParamsKey:    "score * popularity",
+			InputFieldsParamsKey: []string{"popularity"},
+		}), schema)
+		assert.NoError(t, err)
+		assert.Equal(t, udfExprRankType, scorer.scorerType())
+
+		result := genResult()
+		assert.NoError(t, scorer.score(context.TODO(), result))
+		assert.Equal(t, []int64{3, 2, 1, 4, 5, 6}, result.GetIds().GetIntId().GetData())
+		assert.InDelta(t, 2.1, result.GetScores()[0], 1e-6)
+	})
+
+	t.Run("invalid expr", func(t *testing.T) {
+		_, err := NewFunctionScorer(genRankParams("expr", map[string]interface{}{}), schema)
+		assert.Error(t, err)
+
+		_, err = NewFunctionScorer(genRankParams("expr", map[string]interface{}{
+			ExprCodeParamsKey:    "score * popularity",
+			InputFieldsParamsKey: "popularity",
+		}), schema)
+		assert.Error(t, err)
+
+		_, err = NewFunctionScorer(genRankParams("expr", map[string]interface{}{
+			ExprCodeParamsKey: "score * popularity",
+		}), schema)
+		assert.Error(t, err)
+
+		_, err = NewFunctionScorer(genRankParams("expr", map[string]interface{}{
+			ExprCodeParamsKey:    "score",
+			InputFieldsParamsKey: []string{"score"},
+		}), schema)
+		assert.Error(t, err)
+	})
+
+	t.Run("missing input field", func(t *testing.T) {
+		scorer, err := NewFunctionScorer(genRankParams("expr", map[string]interface{}{
+			ExprCodeParamsKey:    "score * popularity",
+			InputFieldsParamsKey: []string{"popularity"},
+		}), schema)
+		assert.NoError(t, err)
+
+		result := genResult()
+		result.FieldsData = result.FieldsData[:1]
+		assert.Error(t, scorer.score(context.TODO(), result))
+	})
+
+	t.Run("model", func(t *testing.T) {
+		// mock tei rerank service, the longer the title the higher the score
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			var req struct {
+				Query string   `json:"query"`
+				Texts []string `json:"texts"`
+			}
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+			results := make([]map[string]interface{}, 0, len(req.Texts))
+			for i, text := range req.Texts {
+				results = append(results, map[string]interface{}{"index": i, "score": len(text)})
+			}
+			data, _ := json.Marshal(results)
+			w.Write(data)
+		}))
+		defer ts.Close()
+
+		t.Setenv("MILVUSAI_TEI_RERANK_ENDPOINT", ts.URL)
+		rerankSchema := proto.Clone(schema).(*schemapb.CollectionSchema)
+		rerankSchema.Functions = []*schemapb.FunctionSchema{{
+			Name:            "title_rerank",
+			Type:            function.FunctionTypeRerank,
+			InputFieldNames: []string{"title"},
+			Params:          []*commonpb.KeyValuePair{{Key: "provider", Value: "tei"}},
+		}}
+		rankParams := append(genRankParams("rrf", map[string]interface{}{RRFParamsKey: 60}),
+			&commonpb.KeyValuePair{Key: RerankQueriesKey, Value: `["q1", "q2"]`})
+		rescorers, err := NewReScorers(context.TODO(), 2, rankParams)
+		assert.NoError(t, err)
+		assert.Equal(t, rrfRankType, rescorers[0].scorerType())
+
+		scorer, err := NewFunctionScorer(rankParams, rerankSchema)
+		assert.NoError(t, err)
+		assert.Equal(t, modelRankType, scorer.scorerType())
+		assert.Equal(t, "title_rerank", scorer.name())
+		assert.Equal(t, []string{"title"}, scorer.inputFieldNames())
+
+		// the rank type is optional
+		scorer, err = NewFunctionScorer(rankParams[2:], rerankSchema)
+		assert.NoError(t, err)
+		assert.Equal(t, modelRankType, scorer.scorerType())
+
+		result := genResult()
+		result.FieldsData = append(result.FieldsData, &schemapb.FieldData{
+			Type:      schemapb.DataType_VarChar,
+			FieldName: "title",
+			FieldId:   103,
+			Field: &schemapb.FieldData_Scalars{Scalars: &schemapb.ScalarField{
+				Data: &schemapb.ScalarField_StringData{StringData: &schemapb.StringArray{Data: []string{"a", "aaa", "aa", "aa", "a", "aaa"}}},
+			}},
+		})
+		assert.NoError(t, scorer.score(context.TODO(), result))
+		assert.Equal(t, []int64{2, 3, 1, 6, 4, 5}, result.GetIds().GetIntId().GetData())
+		assert.Equal(t, []float32{3, 2, 1, 3, 2, 1}, result.GetScores())
+	})
+
+	t.Run("invalid model params", func(t *testing.T) {
+		t.Setenv("MILVUSAI_TEI_RERANK_ENDPOINT", "http://localhost:8080")
+		rerankSchema := proto.Clone(schema).(*schemapb.CollectionSchema)
+		rerankSchema.Functions = []*schemapb.FunctionSchema{{
+			Name:            "title_rerank",
+			Type:            function.FunctionTypeRerank,
+			InputFieldNames: []string{"title"},
+			Params:          []*commonpb.KeyValuePair{{Key: "provider", Value: "tei"}},
+		}}
+		genParams := func(queries string) []*commonpb.KeyValuePair {
+			return []*commonpb.KeyValuePair{{Key: RerankQueriesKey, Value: queries}}
+		}
+
+		_, err := NewFunctionScorer(genParams(`[]`), rerankSchema)
+		assert.Error(t, err)
+		_, err = NewFunctionScorer(genParams(`q1`), rerankSchema)
+		assert.Error(t, err)
+		// no rerank function in the collection schema
+		_, err = NewFunctionScorer(genParams(`["q1"]`), schema)
+		assert.Error(t, err)
+		// could not be used with the other function scorers
+		rankParams := append(genRankParams("decay", map[string]interface{}{InputFieldsParamsKey: []string{"ts"}}), genParams(`["q1"]`)...)
+		_, err = NewFunctionScorer(rankParams, rerankSchema)
+		assert.Error(t, err)
+
+		// model is not a rank type
+		_, err = NewReScorers(context.TODO(), 2, genRankParams("model", map[string]interface{}{}))
+		assert.Error(t, err)
+	})
+}
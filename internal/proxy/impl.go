@@ -5706,6 +5706,14 @@ func (node *Proxy) RefreshPolicyInfoCache(ctx context.Context, req *proxypb.Refr
 			return merr.Status(err), nil
 		}
 	}
+	if node.simpleLimiter != nil {
+		switch typeutil.CacheOpType(req.OpType) {
+		case typeutil.CacheDeleteUser:
+			node.simpleLimiter.RemoveUserLimiter(internalpb.RateScope_User, req.OpKey)
+		case typeutil.CacheDropRole:
+			node.simpleLimiter.RemoveUserLimiter(internalpb.RateScope_Role, req.OpKey)
+		}
+	}
 	log.Debug("RefreshPrivilegeInfoCache success")
 
 	return merr.Success(), nil
@@ -23,6 +23,7 @@ import (
 	"strconv"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/cockroachdb/errors"
 	"github.com/samber/lo"
@@ -104,6 +105,8 @@ type collectionInfo struct {
 	partitionKeyIsolation bool
 	replicateID           string
 	updateTimestamp       uint64
+	// timeTravel is the window in which the collection could be queried as of an earlier timestamp
+	timeTravel time.Duration
 }
 
 type databaseInfo struct {
@@ -455,6 +458,8 @@ func (m *MetaCache) update(ctx context.Context, database, collectionName string,
 	if err != nil {
 		return nil, err
 	}
+	// the property is validated when it's set, an unexpected value just disables the time travel
+	timeTravel, _ := common.GetCollectionTimeTravel(collection.Properties...)
 
 	schemaInfo := newSchemaInfoWithLoadFields(collection.Schema, loadFields)
 
@@ -475,6 +480,7 @@ func (m *MetaCache) update(ctx context.Context, database, collectionName string,
 			consistencyLevel:      collection.ConsistencyLevel,
 			partitionKeyIsolation: isolation,
 			updateTimestamp:       collection.UpdateTimestamp,
+			timeTravel:            timeTravel,
 		}, nil
 	}
 	_, dbOk := m.collInfo[database]
@@ -493,6 +499,7 @@ func (m *MetaCache) update(ctx context.Context, database, collectionName string,
 		partitionKeyIsolation: isolation,
 		replicateID:           replicateID,
 		updateTimestamp:       collection.UpdateTimestamp,
+		timeTravel:            timeTravel,
 	}
 
 	log.Ctx(ctx).Info("meta update success", zap.String("database", database), zap.String("collectionName", collectionName),
@@ -0,0 +1,83 @@
+package proxy
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/util/reduce"
+	typeutil2 "github.com/milvus-io/milvus/internal/util/typeutil"
+	"github.com/milvus-io/milvus/pkg/v2/proto/internalpb"
+	"github.com/milvus-io/milvus/pkg/v2/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/v2/util/typeutil"
+)
+
+// orderByReducer merges the per-shard results which are already sorted and limited by the order by fields.
+type orderByReducer struct {
+	*defaultLimitReducer
+}
+
+func (r *orderByReducer) Reduce(results []*internalpb.RetrieveResults) (*milvuspb.QueryResults, error) {
+	res, err := reduceRetrieveResultsByOrder(results, r.req, r.params)
+	if err != nil {
+		return nil, err
+	}
+
+	// filter system fields.
+	filtered := filterSystemFields(r.req.GetOutputFieldsId())
+	if err := typeutil2.FillRetrieveResultIfEmpty(typeutil2.NewMilvusResult(res), filtered, r.schema); err != nil {
+		return nil, fmt.Errorf("failed to fill retrieve results: %s", err.Error())
+	}
+
+	if err := r.afterReduce(res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func reduceRetrieveResultsByOrder(retrieveResults []*internalpb.RetrieveResults, req *internalpb.RetrieveRequest, queryParams *queryParams) (*milvuspb.QueryResults, error) {
+	ret := &milvuspb.QueryResults{}
+
+	validRetrieveResults := []*internalpb.RetrieveResults{}
+	for _, r := range retrieveResults {
+		if r == nil || len(r.GetFieldsData()) == 0 || typeutil.GetSizeOfIDs(r.GetIds()) == 0 {
+			continue
+		}
+		validRetrieveResults = append(validRetrieveResults, r)
+	}
+	if len(validRetrieveResults) == 0 {
+		return ret, nil
+	}
+
+	selections, err := reduce.SelectByOrder(validRetrieveResults, req.GetOrderByFields(), req.GetLimit())
+	if err != nil {
+		return nil, err
+	}
+	// handle offset
+	if queryParams != nil && queryParams.offset > 0 {
+		if queryParams.offset >= int64(len(selections)) {
+			return ret, nil
+		}
+		selections = selections[queryParams.offset:]
+	}
+
+	ret.FieldsData = typeutil.PrepareResultFieldData(validRetrieveResults[0].GetFieldsData(), int64(len(selections)))
+	var retSize int64
+	maxOutputSize := paramtable.Get().QuotaConfig.MaxOutputSize.GetAsInt64()
+	for _, sel := range selections {
+		retSize += typeutil.AppendFieldData(ret.FieldsData, validRetrieveResults[sel.BatchIndex].GetFieldsData(), sel.ResultIndex)
+
+		// limit retrieve result to avoid oom
+		if retSize > maxOutputSize {
+			return nil, fmt.Errorf("query results exceed the maxOutputSize Limit %d", maxOutputSize)
+		}
+	}
+	return ret, nil
+}
+
+func newOrderByReducer(ctx context.Context, params *queryParams, req *internalpb.RetrieveRequest, schema *schemapb.CollectionSchema, collectionName string) *orderByReducer {
+	return &orderByReducer{
+		defaultLimitReducer: newDefaultLimitReducer(ctx, params, req, schema, collectionName),
+	}
+}
@@ -0,0 +1,63 @@
+package proxy
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/pkg/v2/common"
+	"github.com/milvus-io/milvus/pkg/v2/proto/internalpb"
+)
+
+func Test_orderByReducer_Reduce(t *testing.T) {
+	const ageFieldID = common.StartOfUserFieldID + 1
+	schema := &schemapb.CollectionSchema{
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: common.StartOfUserFieldID, Name: "pk", DataType: schemapb.DataType_Int64, IsPrimaryKey: true},
+			{FieldID: ageFieldID, Name: "age", DataType: schemapb.DataType_Int64},
+		},
+	}
+	genResult := func(pks []int64, ages []int64) *internalpb.RetrieveResults {
+		return &internalpb.RetrieveResults{
+			Ids: &schemapb.IDs{IdField: &schemapb.IDs_IntId{IntId: &schemapb.LongArray{Data: pks}}},
+			FieldsData: []*schemapb.FieldData{
+				getFieldData("pk", common.StartOfUserFieldID, schemapb.DataType_Int64, pks, 1),
+				getFieldData("age", ageFieldID, schemapb.DataType_Int64, ages, 1),
+			},
+		}
+	}
+	req := &internalpb.RetrieveRequest{
+		OutputFieldsId: []int64{common.StartOfUserFieldID, ageFieldID},
+		OrderByFields:  []*internalpb.OrderByField{{FieldID: ageFieldID, Ascending: false}},
+		Limit:          3,
+	}
+
+	t.Run("limit and offset", func(t *testing.T) {
+		r := newOrderByReducer(context.Background(), &queryParams{limit: 2, offset: 1}, req, schema, "test")
+		ret, err := r.Reduce([]*internalpb.RetrieveResults{
+			genResult([]int64{1, 3}, []int64{40, 20}),
+			genResult([]int64{2, 4}, []int64{30, 10}),
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, "test", ret.GetCollectionName())
+		assert.Equal(t, []int64{2, 3}, ret.GetFieldsData()[0].GetScalars().GetLongData().GetData())
+		assert.Equal(t, []int64{30, 20}, ret.GetFieldsData()[1].GetScalars().GetLongData().GetData())
+	})
+
+	t.Run("offset exceeds results", func(t *testing.T) {
+		r := newOrderByReducer(context.Background(), &queryParams{limit: 2, offset: 5}, req, schema, "test")
+		ret, err := r.Reduce([]*internalpb.RetrieveResults{genResult([]int64{1, 3}, []int64{40, 20})})
+		assert.NoError(t, err)
+		assert.Equal(t, 2, len(ret.GetFieldsData()))
+		assert.Empty(t, ret.GetFieldsData()[0].GetScalars().GetLongData().GetData())
+	})
+
+	t.Run("empty results", func(t *testing.T) {
+		r := newOrderByReducer(context.Background(), &queryParams{limit: 2}, req, schema, "test")
+		ret, err := r.Reduce(nil)
+		assert.NoError(t, err)
+		assert.Equal(t, 2, len(ret.GetFieldsData()))
+	})
+}
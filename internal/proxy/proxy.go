@@ -273,14 +273,19 @@ func (node *Proxy) Init() error {
 
 	replicateMsgChannel := Params.CommonCfg.ReplicateMsgChannel.GetValue()
 	node.replicateMsgStream, err = node.factory.NewMsgStream(node.ctx)
-	if err != nil {
+	if errors.Is(err, dependency.ErrMsgStreamUnsupported) && streamingutil.IsStreamingServiceEnabled() {
+		// the wal can only be accessed through the streaming service, the replicate messages are not sent.
+		log.Info("skip creating replicate msg stream", zap.String("role", typeutil.ProxyRole), zap.Error(err))
+		node.replicateMsgStream = nil
+	} else if err != nil {
 		log.Warn("failed to create replicate msg stream",
 			zap.String("role", typeutil.ProxyRole), zap.Int64("ProxyID", paramtable.GetNodeID()),
 			zap.Error(err))
 		return err
+	} else {
+		node.replicateMsgStream.ForceEnableProduce(true)
+		node.replicateMsgStream.AsProducer(node.ctx, []string{replicateMsgChannel})
 	}
-	node.replicateMsgStream.ForceEnableProduce(true)
-	node.replicateMsgStream.AsProducer(node.ctx, []string{replicateMsgChannel})
 
 	node.sched, err = newTaskScheduler(node.ctx, node.tsoAllocator, node.factory)
 	if err != nil {
@@ -55,7 +55,14 @@ func RateLimitInterceptor(limiter types.Limiter) grpc.UnaryServerInterceptor {
 				}
 			}
 		}
-		err = limiter.Check(dbID, collectionIDToPartIDs, rt, n)
+		username := GetCurUserFromContextOrDefault(ctx)
+		err = limiter.CheckUser(username, rt, n)
+		if err == nil {
+			err = limiter.Check(dbID, collectionIDToPartIDs, rt, n)
+			if err != nil {
+				limiter.CancelUser(username, rt, n)
+			}
+		}
 		nodeID := strconv.FormatInt(paramtable.GetNodeID(), 10)
 		metrics.ProxyRateLimitReqCount.WithLabelValues(nodeID, rt.String(), metrics.TotalLabel).Inc()
 		if err != nil {
@@ -50,6 +50,12 @@ func (l *limiterMock) Check(dbID int64, collectionIDToPartIDs map[int64][]int64,
 	return nil
 }
 
+func (l *limiterMock) CheckUser(username string, rt internalpb.RateType, n int) error {
+	return nil
+}
+
+func (l *limiterMock) CancelUser(username string, rt internalpb.RateType, n int) {}
+
 func (l *limiterMock) Alloc(ctx context.Context, dbID int64, collectionIDToPartIDs map[int64][]int64, rt internalpb.RateType, n int) error {
 	return l.Check(dbID, collectionIDToPartIDs, rt, n)
 }
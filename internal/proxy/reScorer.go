@@ -26,6 +26,8 @@ const (
 	rrfRankType                      // rrfRankType = 1
 	weightedRankType                 // weightedRankType = 2
 	udfExprRankType                  // udfExprRankType = 3
+	decayRankType                    // decayRankType = 4
+	modelRankType                    // modelRankType = 5
 )
 
 var rankTypeMap = map[string]rankType{
@@ -33,6 +35,7 @@ var rankTypeMap = map[string]rankType{
 	"rrf":      rrfRankType,
 	"weighted": weightedRankType,
 	"expr":     udfExprRankType,
+	"decay":    decayRankType,
 }
 
 type reScorer interface {
@@ -164,67 +167,89 @@ func NewReScorers(ctx context.Context, reqCnt int, rankParams []*commonpb.KeyVal
 
 	switch rankTypeMap[rankTypeStr] {
 	case rrfRankType:
-		_, ok := params[RRFParamsKey]
-		if !ok {
+		if _, ok := params[RRFParamsKey]; !ok {
 			return nil, errors.New(RRFParamsKey + " not found in rank_params")
 		}
-		var k float64
-		if reflect.ValueOf(params[RRFParamsKey]).CanFloat() {
-			k = reflect.ValueOf(params[RRFParamsKey]).Float()
-		} else {
-			return nil, errors.New("The type of rank param k should be float")
-		}
-		if k <= 0 || k >= maxRRFParamsValue {
-			return nil, errors.New(fmt.Sprintf("The rank params k should be in range (0, %d)", maxRRFParamsValue))
-		}
-		log.Debug("rrf params", zap.Float64("k", k))
-		for i := 0; i < reqCnt; i++ {
-			res[i] = &rrfScorer{
-				baseScorer: baseScorer{
-					scorerName: "rrf",
-				},
-				k: float32(k),
-			}
-		}
+		return newRRFScorers(ctx, reqCnt, params)
 	case weightedRankType:
 		if _, ok := params[WeightsParamsKey]; !ok {
 			return nil, errors.New(WeightsParamsKey + " not found in rank_params")
 		}
-		weights := make([]float32, 0)
-		switch reflect.TypeOf(params[WeightsParamsKey]).Kind() {
-		case reflect.Slice:
-			rs := reflect.ValueOf(params[WeightsParamsKey])
-			for i := 0; i < rs.Len(); i++ {
-				v := rs.Index(i).Elem()
-				if v.CanFloat() {
-					weight := v.Float()
-					if weight < 0 || weight > 1 {
-						return nil, errors.New("rank param weight should be in range [0, 1]")
-					}
-					weights = append(weights, float32(weight))
-				} else {
-					return nil, errors.New("The type of rank param weight should be float")
-				}
-			}
-		default:
-			return nil, errors.New("The weights param should be an array")
+		return newWeightedScorers(ctx, reqCnt, params)
+	case decayRankType, udfExprRankType:
+		// the sub results are fused by weights if provided, otherwise by rrf,
+		// then the fused scores are adjusted by the function scorer.
+		if _, ok := params[WeightsParamsKey]; ok {
+			return newWeightedScorers(ctx, reqCnt, params)
+		}
+		if params == nil {
+			params = make(map[string]interface{})
+		}
+		if _, ok := params[RRFParamsKey]; !ok {
+			params[RRFParamsKey] = float64(defaultRRFParamsValue)
 		}
+		return newRRFScorers(ctx, reqCnt, params)
+	default:
+		return nil, errors.Errorf("unsupported rank type %s", rankTypeStr)
+	}
+}
 
-		log.Debug("weights params", zap.Any("weights", weights))
-		if reqCnt != len(weights) {
-			return nil, merr.WrapErrParameterInvalid(fmt.Sprint(reqCnt), fmt.Sprint(len(weights)), "the length of weights param mismatch with ann search requests")
+func newRRFScorers(ctx context.Context, reqCnt int, params map[string]interface{}) ([]reScorer, error) {
+	var k float64
+	if reflect.ValueOf(params[RRFParamsKey]).CanFloat() {
+		k = reflect.ValueOf(params[RRFParamsKey]).Float()
+	} else {
+		return nil, errors.New("The type of rank param k should be float")
+	}
+	if k <= 0 || k >= maxRRFParamsValue {
+		return nil, errors.New(fmt.Sprintf("The rank params k should be in range (0, %d)", maxRRFParamsValue))
+	}
+	log.Ctx(ctx).Debug("rrf params", zap.Float64("k", k))
+	res := make([]reScorer, reqCnt)
+	for i := 0; i < reqCnt; i++ {
+		res[i] = &rrfScorer{
+			baseScorer: baseScorer{
+				scorerName: "rrf",
+			},
+			k: float32(k),
 		}
-		for i := 0; i < reqCnt; i++ {
-			res[i] = &weightedScorer{
-				baseScorer: baseScorer{
-					scorerName: "weighted",
-				},
-				weight: weights[i],
+	}
+	return res, nil
+}
+
+func newWeightedScorers(ctx context.Context, reqCnt int, params map[string]interface{}) ([]reScorer, error) {
+	weights := make([]float32, 0)
+	switch reflect.TypeOf(params[WeightsParamsKey]).Kind() {
+	case reflect.Slice:
+		rs := reflect.ValueOf(params[WeightsParamsKey])
+		for i := 0; i < rs.Len(); i++ {
+			v := rs.Index(i).Elem()
+			if v.CanFloat() {
+				weight := v.Float()
+				if weight < 0 || weight > 1 {
+					return nil, errors.New("rank param weight should be in range [0, 1]")
+				}
+				weights = append(weights, float32(weight))
+			} else {
+				return nil, errors.New("The type of rank param weight should be float")
 			}
 		}
 	default:
-		return nil, errors.Errorf("unsupported rank type %s", rankTypeStr)
+		return nil, errors.New("The weights param should be an array")
 	}
 
+	log.Ctx(ctx).Debug("weights params", zap.Any("weights", weights))
+	if reqCnt != len(weights) {
+		return nil, merr.WrapErrParameterInvalid(fmt.Sprint(reqCnt), fmt.Sprint(len(weights)), "the length of weights param mismatch with ann search requests")
+	}
+	res := make([]reScorer, reqCnt)
+	for i := 0; i < reqCnt; i++ {
+		res[i] = &weightedScorer{
+			baseScorer: baseScorer{
+				scorerName: "weighted",
+			},
+			weight: weights[i],
+		}
+	}
 	return res, nil
 }
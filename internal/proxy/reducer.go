@@ -19,5 +19,11 @@ func createMilvusReducer(ctx context.Context, params *queryParams, req *internal
 			collectionName: collectionName,
 		}
 	}
+	if len(req.GetAggregates()) > 0 {
+		return newAggregationReducer(params, req, schema, collectionName)
+	}
+	if len(req.GetOrderByFields()) > 0 {
+		return newOrderByReducer(ctx, params, req, schema, collectionName)
+	}
 	return newDefaultLimitReducer(ctx, params, req, schema, collectionName)
 }
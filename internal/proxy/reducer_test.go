@@ -6,6 +6,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 
+	"github.com/milvus-io/milvus/pkg/v2/proto/internalpb"
 	"github.com/milvus-io/milvus/pkg/v2/proto/planpb"
 )
 
@@ -28,4 +29,15 @@ func Test_createMilvusReducer(t *testing.T) {
 	r = createMilvusReducer(ctx, nil, nil, nil, n, "")
 	_, ok = r.(*cntReducer)
 	assert.True(t, ok)
+
+	n.Node.(*planpb.PlanNode_Query).Query.IsCount = false
+	req := &internalpb.RetrieveRequest{OrderByFields: []*internalpb.OrderByField{{FieldID: 100}}}
+	r = createMilvusReducer(ctx, nil, req, nil, n, "")
+	_, ok = r.(*orderByReducer)
+	assert.True(t, ok)
+
+	req.Aggregates = []*internalpb.Aggregate{{Op: internalpb.AggregateOp_Count}}
+	r = createMilvusReducer(ctx, nil, req, nil, n, "")
+	_, ok = r.(*aggregationReducer)
+	assert.True(t, ok)
 }
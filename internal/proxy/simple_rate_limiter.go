@@ -44,6 +44,7 @@ import (
 type SimpleLimiter struct {
 	quotaStatesMu sync.RWMutex
 	rateLimiter   *rlinternal.RateLimiterTree
+	userLimiter   *userRateLimiter
 
 	// for alloc
 	allocWaitInterval time.Duration
@@ -54,9 +55,20 @@ type SimpleLimiter struct {
 func NewSimpleLimiter(allocWaitInterval time.Duration, allocRetryTimes uint) *SimpleLimiter {
 	rootRateLimiter := newClusterLimiter()
 	m := &SimpleLimiter{rateLimiter: rlinternal.NewRateLimiterTree(rootRateLimiter), allocWaitInterval: allocWaitInterval, allocRetryTimes: allocRetryTimes}
+	m.userLimiter = newUserRateLimiter(newUserLimitPolicy(), newRoleLimitPolicy(getUserRolesFromCache))
 	return m
 }
 
+// RegisterUserLimitPolicy adds a policy deciding the token buckets which the requests of users are charged to.
+func (m *SimpleLimiter) RegisterUserLimitPolicy(policy UserLimitPolicy) {
+	m.userLimiter.register(policy)
+}
+
+// RemoveUserLimiter drops the token bucket of the user or role which no longer exists.
+func (m *SimpleLimiter) RemoveUserLimiter(scope internalpb.RateScope, key string) {
+	m.userLimiter.remove(scope, key)
+}
+
 // Alloc will retry till check pass or out of times.
 func (m *SimpleLimiter) Alloc(ctx context.Context, dbID int64, collectionIDToPartIDs map[int64][]int64, rt internalpb.RateType, n int) error {
 	return retry.Do(ctx, func() error {
@@ -142,6 +154,22 @@ func (m *SimpleLimiter) Check(dbID int64, collectionIDToPartIDs map[int64][]int6
 	return ret
 }
 
+// CheckUser checks if the request of the user would be limited by the rate limits of users and roles.
+func (m *SimpleLimiter) CheckUser(username string, rt internalpb.RateType, n int) error {
+	if !Params.QuotaConfig.QuotaAndLimitsEnabled.GetAsBool() || !Params.QuotaConfig.UserLimitEnabled.GetAsBool() || username == "" {
+		return nil
+	}
+	return m.userLimiter.Check(username, rt, n)
+}
+
+// CancelUser refunds the tokens taken by CheckUser.
+func (m *SimpleLimiter) CancelUser(username string, rt internalpb.RateType, n int) {
+	if !Params.QuotaConfig.QuotaAndLimitsEnabled.GetAsBool() || !Params.QuotaConfig.UserLimitEnabled.GetAsBool() || username == "" {
+		return
+	}
+	m.userLimiter.Cancel(username, rt, n)
+}
+
 func isNotCollectionLevelLimitRequest(rt internalpb.RateType) bool {
 	// Most ddl is global level, only DDLFlush will be applied at collection
 	switch rt {
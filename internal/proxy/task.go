@@ -55,6 +55,7 @@ const (
 	IgnoreGrowingKey     = "ignore_growing"
 	ReduceStopForBestKey = "reduce_stop_for_best"
 	IteratorField        = "iterator"
+	TravelTimestampKey   = "travel_timestamp"
 	CollectionID         = "collection_id"
 	GroupByFieldKey      = "group_by_field"
 	GroupSizeKey         = "group_size"
@@ -69,6 +70,8 @@ const (
 	RoundDecimalKey      = "round_decimal"
 	OffsetKey            = "offset"
 	LimitKey             = "limit"
+	OrderByKey           = "order_by"
+	GroupByFieldsKey     = "group_by_fields"
 
 	SearchIterV2Key        = "search_iter_v2"
 	SearchIterBatchSizeKey = "search_iter_batch_size"
@@ -120,6 +123,16 @@ const (
 	RankParamsKey    = "params"
 	RRFParamsKey     = "k"
 	WeightsParamsKey = "weights"
+
+	DecayFunctionParamsKey = "function"
+	DecayFieldParamsKey    = "field"
+	DecayOriginParamsKey   = "origin"
+	DecayScaleParamsKey    = "scale"
+	DecayOffsetParamsKey   = "offset"
+	DecayParamsKey         = "decay"
+	ExprCodeParamsKey      = "expr"
+	InputFieldsParamsKey   = "input_fields"
+	RerankQueriesKey       = "rerank_queries"
 )
 
 type task interface {
@@ -381,6 +394,10 @@ func (t *createCollectionTask) PreExecute(ctx context.Context) error {
 		return err
 	}
 
+	if _, err := common.GetCollectionTimeTravel(t.GetProperties()...); err != nil {
+		return merr.WrapErrParameterInvalidMsg(err.Error())
+	}
+
 	// validate clustering key
 	if err := t.validateClusteringKey(ctx); err != nil {
 		return err
@@ -416,6 +433,9 @@ func (t *createCollectionTask) PreExecute(ctx context.Context) error {
 				return err
 			}
 		}
+		if err := validateBloomFilterParams(field); err != nil {
+			return err
+		}
 		// TODO should remove the index params in the field schema
 		indexParams := funcutil.KeyValuePair2Map(field.GetIndexParams())
 		if err = ValidateAutoIndexMmapConfig(isVectorType, indexParams); err != nil {
@@ -1039,6 +1059,11 @@ func (t *alterCollectionTask) PreExecute(ctx context.Context) error {
 		}
 	}
 
+	// an invalid time travel window would fail every compaction of the collection
+	if _, err := common.GetCollectionTimeTravel(t.GetProperties()...); err != nil {
+		return merr.WrapErrParameterInvalidMsg(err.Error())
+	}
+
 	isPartitionKeyMode, err := isPartitionKeyMode(ctx, t.GetDbName(), t.CollectionName)
 	if err != nil {
 		return err
@@ -1186,6 +1211,7 @@ const (
 var allowedProps = []string{
 	common.MaxLengthKey,
 	common.MmapEnabledKey,
+	common.BloomFilterEnabledKey,
 }
 
 func IsKeyAllowed(key string) bool {
@@ -1268,6 +1294,26 @@ func (t *alterCollectionFieldTask) PreExecute(ctx context.Context) error {
 			if int64(value) > defaultMaxVarCharLength {
 				return merr.WrapErrParameterInvalidMsg("%s exceeds the maximum allowed value %s", prop.Value, strconv.FormatInt(defaultMaxVarCharLength, 10))
 			}
+
+		case common.BloomFilterEnabledKey:
+			// the bloom filters are built for the data written after the property is altered
+			var field *schemapb.FieldSchema
+			for _, f := range collSchema.Fields {
+				if f.GetName() == t.FieldName {
+					field = f
+				}
+			}
+			if field == nil {
+				return merr.WrapErrFieldNotFound(t.FieldName)
+			}
+			if err := validateBloomFilterParams(&schemapb.FieldSchema{
+				Name:         field.GetName(),
+				DataType:     field.GetDataType(),
+				IsPrimaryKey: field.GetIsPrimaryKey(),
+				TypeParams:   []*commonpb.KeyValuePair{prop},
+			}); err != nil {
+				return err
+			}
 		}
 	}
 
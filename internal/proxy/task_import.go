@@ -185,15 +185,28 @@ func (it *importTask) PreExecute(ctx context.Context) error {
 		return merr.WrapErrImportFailed(fmt.Sprintf("The max number of import files should not exceed %d, but got %d",
 			Params.DataCoordCfg.MaxFilesPerImportReq.GetAsInt(), len(req.Files)))
 	}
+	maxBadRows, err := importutilv2.GetMaxBadRows(req.GetOptions())
+	if err != nil {
+		return err
+	}
 	if !isBackup && !isL0Import {
 		// check file type
 		for _, file := range req.GetFiles() {
-			_, err = importutilv2.GetFileType(file)
+			fileType, err := importutilv2.GetFileType(file)
 			if err != nil {
 				return err
 			}
+			// the column-based files could not skip a single row
+			if maxBadRows > 0 && (fileType == importutilv2.Parquet || fileType == importutilv2.Numpy) {
+				return merr.WrapErrImportFailed(fmt.Sprintf("option '%s' is not supported by %s files",
+					importutilv2.MaxBadRows, fileType.String()))
+			}
 		}
 	}
+	if (isBackup || isL0Import) && (importutilv2.IsDryRun(req.GetOptions()) || maxBadRows > 0) {
+		return merr.WrapErrImportFailed(fmt.Sprintf("options '%s' and '%s' are not supported in backup or l0 import",
+			importutilv2.DryRun, importutilv2.MaxBadRows))
+	}
 	it.partitionIDs = partitionIDs
 	return nil
 }
@@ -481,6 +481,9 @@ func checkTrain(ctx context.Context, field *schemapb.FieldSchema, indexParams ma
 			indexParams[common.BitmapCardinalityLimitKey] = paramtable.Get().AutoIndexConfig.BitmapCardinalityLimit.GetValue()
 		}
 	}
+	if indexparamcheck.IsBitmapIndex(indexType) {
+		indexparams.FillBitmapIndexParams(paramtable.Get(), indexParams)
+	}
 	checker, err := indexparamcheck.GetIndexCheckerMgrInstance().GetChecker(indexType)
 	if err != nil {
 		log.Ctx(ctx).Warn("Failed to get index checker", zap.String(common.IndexTypeKey, indexType))
@@ -3,6 +3,7 @@ package proxy
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"strconv"
 	"strings"
 	"time"
@@ -74,14 +75,90 @@ type queryTask struct {
 	allQueryCnt          int64
 	totalRelatedDataSize int64
 	mustUsePartitionKey  bool
+
+	// order by fields which are retrieved for sorting only, dropped after reducing
+	orderByOnlyFieldIDs []UniqueID
 }
 
 type queryParams struct {
-	limit        int64
-	offset       int64
-	reduceType   reduce.IReduceType
-	isIterator   bool
-	collectionID int64
+	limit         int64
+	offset        int64
+	reduceType    reduce.IReduceType
+	isIterator    bool
+	collectionID  int64
+	orderByFields []*orderByField
+	groupByFields []string
+	travelTs      uint64
+}
+
+type orderByField struct {
+	fieldName  string
+	ascending  bool
+	nullsFirst bool
+}
+
+// parseOrderByFields parses order by clause like "age desc, name asc nulls first",
+// fields are sorted in ascending order with nulls last by default.
+func parseOrderByFields(orderBy string) ([]*orderByField, error) {
+	fields := make([]*orderByField, 0)
+	for _, item := range strings.Split(orderBy, ",") {
+		tokens := strings.Fields(item)
+		if len(tokens) == 0 {
+			return nil, merr.WrapErrParameterInvalidMsg("empty field in %s [%s]", OrderByKey, orderBy)
+		}
+		field := &orderByField{fieldName: tokens[0], ascending: true}
+		for i := 1; i < len(tokens); i++ {
+			switch strings.ToLower(tokens[i]) {
+			case "asc":
+				field.ascending = true
+			case "desc":
+				field.ascending = false
+			case "nulls":
+				if i+1 >= len(tokens) {
+					return nil, merr.WrapErrParameterInvalidMsg("nulls should be followed by first or last in %s [%s]", OrderByKey, orderBy)
+				}
+				i++
+				switch strings.ToLower(tokens[i]) {
+				case "first":
+					field.nullsFirst = true
+				case "last":
+					field.nullsFirst = false
+				default:
+					return nil, merr.WrapErrParameterInvalidMsg("nulls should be followed by first or last in %s [%s]", OrderByKey, orderBy)
+				}
+			default:
+				return nil, merr.WrapErrParameterInvalidMsg("unknown keyword %s in %s [%s]", tokens[i], OrderByKey, orderBy)
+			}
+		}
+		fields = append(fields, field)
+	}
+	return fields, nil
+}
+
+// translateOrderByFields translates order by field names to field ids, only scalar fields of
+// comparable types are allowed.
+func translateOrderByFields(fields []*orderByField, schema *schemapb.CollectionSchema) ([]*internalpb.OrderByField, error) {
+	orderByFields := make([]*internalpb.OrderByField, 0, len(fields))
+	for _, f := range fields {
+		field, ok := lo.Find(schema.GetFields(), func(field *schemapb.FieldSchema) bool {
+			return field.GetName() == f.fieldName
+		})
+		if !ok {
+			return nil, merr.WrapErrFieldNotFound(f.fieldName)
+		}
+		if !reduce.IsOrderByType(field.GetDataType()) {
+			return nil, merr.WrapErrParameterInvalidMsg("order by field %s of type %s is not supported", f.fieldName, field.GetDataType().String())
+		}
+		if lo.ContainsBy(orderByFields, func(o *internalpb.OrderByField) bool { return o.GetFieldID() == field.GetFieldID() }) {
+			return nil, merr.WrapErrParameterInvalidMsg("duplicate order by field %s", f.fieldName)
+		}
+		orderByFields = append(orderByFields, &internalpb.OrderByField{
+			FieldID:    field.GetFieldID(),
+			Ascending:  f.ascending,
+			NullsFirst: f.nullsFirst,
+		})
+	}
+	return orderByFields, nil
 }
 
 // translateToOutputFieldIDs translates output fields name to output fields id.
@@ -149,6 +226,9 @@ func parseQueryParams(queryParamsPair []*commonpb.KeyValuePair) (*queryParams, e
 		isIterator        bool
 		err               error
 		collectionID      int64
+		orderByFields     []*orderByField
+		groupByFields     []string
+		travelTs          uint64
 	)
 	reduceStopForBestStr, err := funcutil.GetAttrByKeyFromRepeatedKV(ReduceStopForBestKey, queryParamsPair)
 	// if reduce_stop_for_best is provided
@@ -179,6 +259,42 @@ func parseQueryParams(queryParamsPair []*commonpb.KeyValuePair) (*queryParams, e
 		}
 	}
 
+	orderByStr, err := funcutil.GetAttrByKeyFromRepeatedKV(OrderByKey, queryParamsPair)
+	// if order_by is provided
+	if err == nil {
+		if isIterator {
+			return nil, merr.WrapErrParameterInvalidMsg("%s is not supported for query iterator", OrderByKey)
+		}
+		orderByFields, err = parseOrderByFields(orderByStr)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	groupByFieldsStr, err := funcutil.GetAttrByKeyFromRepeatedKV(GroupByFieldsKey, queryParamsPair)
+	// if group_by_fields is provided
+	if err == nil {
+		if isIterator {
+			return nil, merr.WrapErrParameterInvalidMsg("%s is not supported for query iterator", GroupByFieldsKey)
+		}
+		for _, field := range strings.Split(groupByFieldsStr, ",") {
+			field = strings.TrimSpace(field)
+			if field == "" {
+				return nil, merr.WrapErrParameterInvalidMsg("empty field in %s [%s]", GroupByFieldsKey, groupByFieldsStr)
+			}
+			groupByFields = append(groupByFields, field)
+		}
+	}
+
+	travelTs, err = parseTravelTs(queryParamsPair)
+	if err != nil {
+		return nil, err
+	}
+	if travelTs > 0 && isIterator {
+		// the iterator reads as of its session ts
+		return nil, merr.WrapErrParameterInvalidMsg("%s is not supported for query iterator", TravelTimestampKey)
+	}
+
 	reduceType := reduce.IReduceNoOrder
 	if isIterator {
 		if reduceStopForBest {
@@ -191,7 +307,18 @@ func parseQueryParams(queryParamsPair []*commonpb.KeyValuePair) (*queryParams, e
 	limitStr, err := funcutil.GetAttrByKeyFromRepeatedKV(LimitKey, queryParamsPair)
 	// if limit is not provided
 	if err != nil {
-		return &queryParams{limit: typeutil.Unlimited, reduceType: reduceType, isIterator: isIterator}, nil
+		if len(orderByFields) > 0 {
+			// workers keep only the top offset+limit sorted rows, unlimited order by would ship every matched row
+			return nil, merr.WrapErrParameterInvalidMsg("%s should be used with %s", OrderByKey, LimitKey)
+		}
+		return &queryParams{
+			limit:         typeutil.Unlimited,
+			reduceType:    reduceType,
+			isIterator:    isIterator,
+			orderByFields: orderByFields,
+			groupByFields: groupByFields,
+			travelTs:      travelTs,
+		}, nil
 	}
 	limit, err = strconv.ParseInt(limitStr, 0, 64)
 	if err != nil {
@@ -213,11 +340,14 @@ func parseQueryParams(queryParamsPair []*commonpb.KeyValuePair) (*queryParams, e
 	}
 
 	return &queryParams{
-		limit:        limit,
-		offset:       offset,
-		reduceType:   reduceType,
-		isIterator:   isIterator,
-		collectionID: collectionID,
+		limit:         limit,
+		offset:        offset,
+		reduceType:    reduceType,
+		isIterator:    isIterator,
+		collectionID:  collectionID,
+		orderByFields: orderByFields,
+		groupByFields: groupByFields,
+		travelTs:      travelTs,
 	}, nil
 }
 
@@ -225,6 +355,29 @@ func matchCountRule(outputs []string) bool {
 	return len(outputs) == 1 && strings.ToLower(strings.TrimSpace(outputs[0])) == "count(*)"
 }
 
+var aggregateRegex = regexp.MustCompile(`^\s*(\w+)\s*\(\s*(\*|[^\s()]+)\s*\)\s*$`)
+
+// parseAggregate parses output field like sum(price) into the aggregate op and the field name.
+func parseAggregate(output string) (internalpb.AggregateOp, string, bool) {
+	matches := aggregateRegex.FindStringSubmatch(output)
+	if matches == nil {
+		return 0, "", false
+	}
+	for name, value := range internalpb.AggregateOp_value {
+		if strings.EqualFold(name, matches[1]) {
+			return internalpb.AggregateOp(value), matches[2], true
+		}
+	}
+	return 0, "", false
+}
+
+func isAggregation(outputs []string, groupByFields []string) bool {
+	return len(groupByFields) > 0 || lo.ContainsBy(outputs, func(output string) bool {
+		_, _, ok := parseAggregate(output)
+		return ok
+	})
+}
+
 func createCntPlan(expr string, schemaHelper *typeutil.SchemaHelper, exprTemplateValues map[string]*schemapb.TemplateValue) (*planpb.PlanNode, error) {
 	if expr == "" {
 		return &planpb.PlanNode{
@@ -251,8 +404,13 @@ func createCntPlan(expr string, schemaHelper *typeutil.SchemaHelper, exprTemplat
 func (t *queryTask) createPlan(ctx context.Context) error {
 	schema := t.schema
 
+	var groupByFields []string
+	if t.queryParams != nil {
+		groupByFields = t.queryParams.groupByFields
+	}
+
 	cntMatch := matchCountRule(t.request.GetOutputFields())
-	if cntMatch {
+	if cntMatch && len(groupByFields) == 0 {
 		var err error
 		t.plan, err = createCntPlan(t.request.GetExpr(), schema.schemaHelper, t.request.GetExprTemplateValues())
 		t.userOutputFields = []string{"count(*)"}
@@ -270,6 +428,10 @@ func (t *queryTask) createPlan(ctx context.Context) error {
 		metrics.ProxyParseExpressionLatency.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), "query", metrics.SuccessLabel).Observe(float64(time.Since(start).Milliseconds()))
 	}
 
+	if isAggregation(t.request.GetOutputFields(), groupByFields) {
+		return t.translateAggregation(ctx, groupByFields)
+	}
+
 	t.request.OutputFields, t.userOutputFields, t.userDynamicFields, err = translateOutputFields(t.request.OutputFields, t.schema, true)
 	if err != nil {
 		return err
@@ -279,6 +441,14 @@ func (t *queryTask) createPlan(ctx context.Context) error {
 	if err != nil {
 		return err
 	}
+	// order by fields are required for sorting even if they are not in the output fields
+	t.orderByOnlyFieldIDs = nil
+	for _, orderBy := range t.RetrieveRequest.GetOrderByFields() {
+		if !lo.Contains(outputFieldIDs, orderBy.GetFieldID()) {
+			outputFieldIDs = append(outputFieldIDs, orderBy.GetFieldID())
+			t.orderByOnlyFieldIDs = append(t.orderByOnlyFieldIDs, orderBy.GetFieldID())
+		}
+	}
 	outputFieldIDs = append(outputFieldIDs, common.TimeStampField)
 	t.RetrieveRequest.OutputFieldsId = outputFieldIDs
 	t.plan.OutputFieldIds = outputFieldIDs
@@ -290,6 +460,85 @@ func (t *queryTask) createPlan(ctx context.Context) error {
 	return nil
 }
 
+// translateAggregation translates the group by fields and the aggregates in output fields,
+// output fields other than aggregates must be group by fields.
+func (t *queryTask) translateAggregation(ctx context.Context, groupByFields []string) error {
+	schema := t.schema.CollectionSchema
+
+	groupByFieldIDs := make([]UniqueID, 0, len(groupByFields))
+	for _, name := range groupByFields {
+		field := typeutil.GetFieldByName(schema, name)
+		if field == nil {
+			return merr.WrapErrFieldNotFound(name)
+		}
+		if !reduce.IsOrderByType(field.GetDataType()) {
+			return merr.WrapErrParameterInvalidMsg("group by field %s of type %s is not supported", name, field.GetDataType().String())
+		}
+		if lo.Contains(groupByFieldIDs, field.GetFieldID()) {
+			return merr.WrapErrParameterInvalidMsg("duplicate group by field %s", name)
+		}
+		groupByFieldIDs = append(groupByFieldIDs, field.GetFieldID())
+	}
+
+	outputFieldIDs := append([]UniqueID{}, groupByFieldIDs...)
+	aggregates := make([]*internalpb.Aggregate, 0)
+	userOutputFields := make([]string, 0, len(t.request.GetOutputFields()))
+	for _, output := range t.request.GetOutputFields() {
+		op, fieldName, ok := parseAggregate(output)
+		if !ok {
+			if !lo.Contains(groupByFields, output) {
+				return merr.WrapErrParameterInvalidMsg("output field %s should be either group by field or aggregate", output)
+			}
+			userOutputFields = append(userOutputFields, output)
+			continue
+		}
+
+		aggregate := &internalpb.Aggregate{Op: op}
+		if fieldName == "*" {
+			if op != internalpb.AggregateOp_Count {
+				return merr.WrapErrParameterInvalidMsg("%s(*) is not supported", strings.ToLower(op.String()))
+			}
+			fieldName = ""
+		} else {
+			field := typeutil.GetFieldByName(schema, fieldName)
+			if field == nil {
+				return merr.WrapErrFieldNotFound(fieldName)
+			}
+			if err := reduce.CheckAggregate(op, field.GetDataType()); err != nil {
+				return err
+			}
+			aggregate.FieldID = field.GetFieldID()
+			if !lo.Contains(outputFieldIDs, field.GetFieldID()) {
+				outputFieldIDs = append(outputFieldIDs, field.GetFieldID())
+			}
+		}
+		aggregates = append(aggregates, aggregate)
+		userOutputFields = append(userOutputFields, reduce.AggregateName(op, fieldName))
+	}
+	if len(aggregates) == 0 {
+		return merr.WrapErrParameterInvalidMsg("no aggregate found in output fields")
+	}
+
+	pkField, err := typeutil.GetPrimaryFieldSchema(schema)
+	if err != nil {
+		return err
+	}
+	if !lo.Contains(outputFieldIDs, pkField.GetFieldID()) {
+		outputFieldIDs = append(outputFieldIDs, pkField.GetFieldID())
+	}
+
+	t.userOutputFields = userOutputFields
+	t.RetrieveRequest.GroupByFieldIds = groupByFieldIDs
+	t.RetrieveRequest.Aggregates = aggregates
+	t.RetrieveRequest.OutputFieldsId = outputFieldIDs
+	t.plan.OutputFieldIds = outputFieldIDs
+	log.Ctx(ctx).Debug("translate aggregation",
+		zap.Int64s("groupByFieldIDs", groupByFieldIDs),
+		zap.Strings("outputFields", userOutputFields),
+		zap.String("requestType", "query"))
+	return nil
+}
+
 func (t *queryTask) CanSkipAllocTimestamp() bool {
 	var consistencyLevel commonpb.ConsistencyLevel
 	useDefaultConsistency := t.request.GetUseDefaultConsistency()
@@ -392,6 +641,11 @@ func (t *queryTask) PreExecute(ctx context.Context) error {
 	}
 	t.schema = schema
 
+	t.RetrieveRequest.OrderByFields, err = translateOrderByFields(queryParams.orderByFields, schema.CollectionSchema)
+	if err != nil {
+		return merr.WrapErrAsInputError(err)
+	}
+
 	if t.ids != nil {
 		pkField := ""
 		for _, field := range schema.Fields {
@@ -405,9 +659,24 @@ func (t *queryTask) PreExecute(ctx context.Context) error {
 	if err := t.createPlan(ctx); err != nil {
 		return err
 	}
+	if len(t.RetrieveRequest.GetAggregates()) > 0 {
+		if len(t.RetrieveRequest.GetOrderByFields()) > 0 || queryParams.isIterator {
+			return merr.WrapErrAsInputError(merr.WrapErrParameterInvalidMsg("aggregation with order by or iterator is not supported"))
+		}
+		// the matched rows are aggregated into partial states on each segment by querynodes,
+		// offset and limit are applied on groups by proxy
+		t.RetrieveRequest.Limit = typeutil.Unlimited
+	}
 	t.plan.Node.(*planpb.PlanNode_Query).Query.Limit = t.RetrieveRequest.Limit
+	if len(t.RetrieveRequest.GetOrderByFields()) > 0 {
+		// segcore does not sort the rows by the order by fields, so every matched row is retrieved with the
+		// order by fields only, querynodes keep the top offset+limit rows of each segment and then fetch
+		// their output fields by offsets, so each worker ships at most that many
+		t.plan.Node.(*planpb.PlanNode_Query).Query.Limit = typeutil.Unlimited
+	}
 
-	if planparserv2.IsAlwaysTruePlan(t.plan) && t.RetrieveRequest.Limit == typeutil.Unlimited {
+	// the limit of aggregation is applied on groups, which are unbounded as well
+	if planparserv2.IsAlwaysTruePlan(t.plan) && t.queryParams.limit == typeutil.Unlimited {
 		return merr.WrapErrAsInputError(merr.WrapErrParameterInvalidMsg("empty expression should be used with limit"))
 	}
 
@@ -437,6 +706,9 @@ func (t *queryTask) PreExecute(ctx context.Context) error {
 	if t.plan.GetQuery().GetIsCount() && t.queryParams.limit != typeutil.Unlimited {
 		return merr.WrapErrAsInputError(merr.WrapErrParameterInvalidMsg("count entities with pagination is not allowed"))
 	}
+	if t.plan.GetQuery().GetIsCount() && len(t.RetrieveRequest.GetOrderByFields()) > 0 {
+		return merr.WrapErrAsInputError(merr.WrapErrParameterInvalidMsg("count entities with order by is not allowed"))
+	}
 
 	t.RetrieveRequest.IsCount = t.plan.GetQuery().GetIsCount()
 	t.RetrieveRequest.SerializedExprPlan, err = proto.Marshal(t.plan)
@@ -480,6 +752,14 @@ func (t *queryTask) PreExecute(ctx context.Context) error {
 		t.MvccTimestamp = t.request.GetGuaranteeTimestamp()
 		t.GuaranteeTimestamp = t.request.GetGuaranteeTimestamp()
 	}
+	// query as of the travel ts, the guarantee ts is kept to see all the data before it
+	if t.queryParams.travelTs > 0 {
+		if err := checkTravelTs(t.queryParams.travelTs, t.BeginTs(), collectionInfo.timeTravel); err != nil {
+			return err
+		}
+		t.MvccTimestamp = t.queryParams.travelTs
+		t.GuaranteeTimestamp = max(t.GuaranteeTimestamp, t.queryParams.travelTs)
+	}
 	t.RetrieveRequest.IsIterator = queryParams.isIterator
 
 	deadline, ok := t.TraceCtx().Deadline()
@@ -559,6 +839,20 @@ func (t *queryTask) PostExecute(ctx context.Context) error {
 		log.Warn("fail to reduce query result", zap.Error(err))
 		return err
 	}
+	if len(t.RetrieveRequest.GetAggregates()) > 0 {
+		// return the columns in the order of output fields
+		columns := lo.SliceToMap(t.result.GetFieldsData(), func(fieldData *schemapb.FieldData) (string, *schemapb.FieldData) {
+			return fieldData.GetFieldName(), fieldData
+		})
+		t.result.FieldsData = lo.Map(t.userOutputFields, func(name string, _ int) *schemapb.FieldData {
+			return columns[name]
+		})
+	}
+	if len(t.orderByOnlyFieldIDs) > 0 {
+		t.result.FieldsData = lo.Filter(t.result.GetFieldsData(), func(fieldData *schemapb.FieldData, _ int) bool {
+			return !lo.Contains(t.orderByOnlyFieldIDs, fieldData.GetFieldId())
+		})
+	}
 	t.result.OutputFields = t.userOutputFields
 	metrics.ProxyReduceResultLatency.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), metrics.QueryLabel).Observe(float64(tr.RecordSpan().Milliseconds()))
 
@@ -657,6 +657,75 @@ func TestTaskQuery_functions(t *testing.T) {
 		}
 	})
 
+	t.Run("test parseQueryParams for order by", func(t *testing.T) {
+		{
+			inParams := []*commonpb.KeyValuePair{
+				{Key: OrderByKey, Value: "age desc nulls first, name, score ASC NULLS LAST"},
+				{Key: LimitKey, Value: "10"},
+			}
+			ret, err := parseQueryParams(inParams)
+			assert.NoError(t, err)
+			assert.Equal(t, []*orderByField{
+				{fieldName: "age", ascending: false, nullsFirst: true},
+				{fieldName: "name", ascending: true},
+				{fieldName: "score", ascending: true},
+			}, ret.orderByFields)
+		}
+		{
+			ret, err := parseQueryParams([]*commonpb.KeyValuePair{{Key: OrderByKey, Value: "age"}, {Key: LimitKey, Value: "10"}})
+			assert.NoError(t, err)
+			assert.Equal(t, []*orderByField{{fieldName: "age", ascending: true}}, ret.orderByFields)
+		}
+		{
+			// order by requires limit
+			ret, err := parseQueryParams([]*commonpb.KeyValuePair{{Key: OrderByKey, Value: "age"}})
+			assert.Error(t, err)
+			assert.Nil(t, ret)
+		}
+		for _, orderBy := range []string{"", "age,", "age descending", "age nulls", "age nulls middle"} {
+			ret, err := parseQueryParams([]*commonpb.KeyValuePair{{Key: OrderByKey, Value: orderBy}})
+			assert.Error(t, err)
+			assert.Nil(t, ret)
+		}
+		{
+			ret, err := parseQueryParams([]*commonpb.KeyValuePair{{Key: GroupByFieldsKey, Value: "category, brand"}})
+			assert.NoError(t, err)
+			assert.Equal(t, []string{"category", "brand"}, ret.groupByFields)
+
+			_, err = parseQueryParams([]*commonpb.KeyValuePair{{Key: GroupByFieldsKey, Value: "category,"}})
+			assert.Error(t, err)
+		}
+		{
+			// order by is not supported by iterator
+			ret, err := parseQueryParams([]*commonpb.KeyValuePair{
+				{Key: OrderByKey, Value: "age"},
+				{Key: IteratorField, Value: "True"},
+			})
+			assert.Error(t, err)
+			assert.Nil(t, ret)
+		}
+	})
+
+	t.Run("test parseQueryParams for travel timestamp", func(t *testing.T) {
+		ret, err := parseQueryParams([]*commonpb.KeyValuePair{{Key: TravelTimestampKey, Value: "100"}})
+		assert.NoError(t, err)
+		assert.EqualValues(t, 100, ret.travelTs)
+
+		ret, err = parseQueryParams([]*commonpb.KeyValuePair{{Key: TravelTimestampKey, Value: "100"}, {Key: LimitKey, Value: "10"}})
+		assert.NoError(t, err)
+		assert.EqualValues(t, 100, ret.travelTs)
+
+		_, err = parseQueryParams([]*commonpb.KeyValuePair{{Key: TravelTimestampKey, Value: "invalid"}})
+		assert.Error(t, err)
+
+		// travel timestamp is not supported by iterator
+		_, err = parseQueryParams([]*commonpb.KeyValuePair{
+			{Key: TravelTimestampKey, Value: "100"},
+			{Key: IteratorField, Value: "True"},
+		})
+		assert.Error(t, err)
+	})
+
 	t.Run("test reduceRetrieveResults", func(t *testing.T) {
 		const (
 			Dim                  = 8
@@ -1148,6 +1217,90 @@ func Test_queryTask_createPlan(t *testing.T) {
 	})
 }
 
+func Test_queryTask_createAggregationPlan(t *testing.T) {
+	schema := newSchemaInfo(&schemapb.CollectionSchema{
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: 100, Name: "pk", DataType: schemapb.DataType_Int64, IsPrimaryKey: true},
+			{FieldID: 101, Name: "category", DataType: schemapb.DataType_VarChar},
+			{FieldID: 102, Name: "price", DataType: schemapb.DataType_Double},
+			{FieldID: 103, Name: "vec", DataType: schemapb.DataType_FloatVector},
+		},
+	})
+	newTask := func(outputFields []string, groupByFields []string) *queryTask {
+		return &queryTask{
+			RetrieveRequest: &internalpb.RetrieveRequest{},
+			request: &milvuspb.QueryRequest{
+				OutputFields: outputFields,
+				Expr:         "price > 0",
+			},
+			queryParams: &queryParams{groupByFields: groupByFields},
+			schema:      schema,
+		}
+	}
+
+	t.Run("group by", func(t *testing.T) {
+		tsk := newTask([]string{"Sum(price)", "category", "count(*)"}, []string{"category"})
+		assert.NoError(t, tsk.createPlan(context.TODO()))
+		assert.False(t, tsk.plan.GetQuery().GetIsCount())
+		assert.Equal(t, []int64{101}, tsk.RetrieveRequest.GetGroupByFieldIds())
+		assert.Equal(t, 2, len(tsk.RetrieveRequest.GetAggregates()))
+		assert.Equal(t, internalpb.AggregateOp_Sum, tsk.RetrieveRequest.GetAggregates()[0].GetOp())
+		assert.Equal(t, int64(102), tsk.RetrieveRequest.GetAggregates()[0].GetFieldID())
+		assert.Equal(t, internalpb.AggregateOp_Count, tsk.RetrieveRequest.GetAggregates()[1].GetOp())
+		assert.Equal(t, []int64{101, 102, 100}, tsk.RetrieveRequest.GetOutputFieldsId())
+		assert.Equal(t, []string{"sum(price)", "category", "count(*)"}, tsk.userOutputFields)
+	})
+
+	t.Run("count without group by", func(t *testing.T) {
+		tsk := newTask([]string{"count(*)"}, nil)
+		assert.NoError(t, tsk.createPlan(context.TODO()))
+		assert.True(t, tsk.plan.GetQuery().GetIsCount())
+
+		tsk = newTask([]string{"count(*)", "avg(price)"}, nil)
+		assert.NoError(t, tsk.createPlan(context.TODO()))
+		assert.False(t, tsk.plan.GetQuery().GetIsCount())
+		assert.Empty(t, tsk.RetrieveRequest.GetGroupByFieldIds())
+		assert.Equal(t, 2, len(tsk.RetrieveRequest.GetAggregates()))
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		cases := []struct {
+			outputFields  []string
+			groupByFields []string
+		}{
+			{[]string{"category"}, []string{"category"}},
+			{[]string{"pk", "count(*)"}, []string{"category"}},
+			{[]string{"count(*)"}, []string{"not_exist"}},
+			{[]string{"count(*)"}, []string{"vec"}},
+			{[]string{"count(*)"}, []string{"category", "category"}},
+			{[]string{"sum(*)"}, []string{"category"}},
+			{[]string{"sum(category)"}, []string{"category"}},
+			{[]string{"max(not_exist)"}, nil},
+		}
+		for _, c := range cases {
+			tsk := newTask(c.outputFields, c.groupByFields)
+			assert.Error(t, tsk.createPlan(context.TODO()), c.outputFields)
+		}
+	})
+}
+
+func Test_parseAggregate(t *testing.T) {
+	op, field, ok := parseAggregate(" MAX( price ) ")
+	assert.True(t, ok)
+	assert.Equal(t, internalpb.AggregateOp_Max, op)
+	assert.Equal(t, "price", field)
+
+	op, field, ok = parseAggregate("count(*)")
+	assert.True(t, ok)
+	assert.Equal(t, internalpb.AggregateOp_Count, op)
+	assert.Equal(t, "*", field)
+
+	for _, output := range []string{"price", "median(price)", "sum(a, b)", "sum()"} {
+		_, _, ok = parseAggregate(output)
+		assert.False(t, ok, output)
+	}
+}
+
 func TestQueryTask_IDs2Expr(t *testing.T) {
 	fieldName := "pk"
 	intIDs := &schemapb.IDs{
@@ -1312,3 +1465,35 @@ func TestQueryTask_CanSkipAllocTimestamp(t *testing.T) {
 		assert.True(t, skip)
 	})
 }
+
+func Test_translateOrderByFields(t *testing.T) {
+	schema := &schemapb.CollectionSchema{
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: 100, Name: "pk", DataType: schemapb.DataType_Int64, IsPrimaryKey: true},
+			{FieldID: 101, Name: "age", DataType: schemapb.DataType_Int32},
+			{FieldID: 102, Name: "name", DataType: schemapb.DataType_VarChar},
+			{FieldID: 103, Name: "meta", DataType: schemapb.DataType_JSON},
+		},
+	}
+
+	orderByFields, err := translateOrderByFields([]*orderByField{
+		{fieldName: "age", nullsFirst: true},
+		{fieldName: "name", ascending: true},
+	}, schema)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(orderByFields))
+	assert.Equal(t, int64(101), orderByFields[0].GetFieldID())
+	assert.False(t, orderByFields[0].GetAscending())
+	assert.True(t, orderByFields[0].GetNullsFirst())
+	assert.Equal(t, int64(102), orderByFields[1].GetFieldID())
+	assert.True(t, orderByFields[1].GetAscending())
+
+	_, err = translateOrderByFields([]*orderByField{{fieldName: "not_exist"}}, schema)
+	assert.Error(t, err)
+
+	_, err = translateOrderByFields([]*orderByField{{fieldName: "meta"}}, schema)
+	assert.Error(t, err)
+
+	_, err = translateOrderByFields([]*orderByField{{fieldName: "age"}, {fieldName: "age", ascending: true}}, schema)
+	assert.Error(t, err)
+}
@@ -84,9 +84,10 @@ type searchTask struct {
 	queryInfos      []*planpb.QueryInfo
 	relatedDataSize int64
 
-	reScorers   []reScorer
-	rankParams  *rankParams
-	groupScorer func(group *Group) error
+	reScorers      []reScorer
+	functionScorer functionScorer
+	rankParams     *rankParams
+	groupScorer    func(group *Group) error
 
 	isIterator bool
 }
@@ -212,7 +213,17 @@ func (t *searchTask) PreExecute(ctx context.Context) error {
 	})
 
 	if t.SearchRequest.GetIsAdvanced() {
-		t.requery = len(t.request.OutputFields) > 0
+		t.functionScorer, err = NewFunctionScorer(t.request.GetSearchParams(), t.schema.CollectionSchema)
+		if err != nil {
+			// the rank params are not logged, as they may carry the credentials by mistake
+			log.Info("generate function scorer failed", zap.Error(err))
+			return err
+		}
+		if t.functionScorer != nil && t.rankParams.GetGroupByFieldId() > 0 {
+			return merr.WrapErrParameterInvalidMsg("rank function %s is not supported with group by", t.functionScorer.name())
+		}
+		// the input fields of function scorer are fetched by requery
+		t.requery = len(t.request.OutputFields) > 0 || t.functionScorer != nil
 		err = t.initAdvancedSearchRequest(ctx)
 	} else {
 		t.requery = len(vectorOutputFields) > 0
@@ -251,6 +262,21 @@ func (t *searchTask) PreExecute(ctx context.Context) error {
 		t.MvccTimestamp = t.request.GetGuaranteeTimestamp()
 		t.GuaranteeTimestamp = t.request.GetGuaranteeTimestamp()
 	}
+	// search as of the travel ts, the guarantee ts is kept to see all the data before it
+	travelTs, err := parseTravelTs(t.request.GetSearchParams())
+	if err != nil {
+		return err
+	}
+	if travelTs > 0 {
+		if t.isIterator {
+			return merr.WrapErrParameterInvalidMsg("%s is not supported for search iterator", TravelTimestampKey)
+		}
+		if err := checkTravelTs(travelTs, t.BeginTs(), collectionInfo.timeTravel); err != nil {
+			return err
+		}
+		t.MvccTimestamp = travelTs
+		t.SearchRequest.GuaranteeTimestamp = max(t.SearchRequest.GuaranteeTimestamp, travelTs)
+	}
 	t.SearchRequest.IsIterator = t.isIterator
 
 	if deadline, ok := t.TraceCtx().Deadline(); ok {
@@ -771,8 +797,20 @@ func (t *searchTask) PostExecute(ctx context.Context) error {
 			t.reScorers[index].reScore(result)
 			multipleMilvusResults[index] = result
 		}
+		params := t.rankParams
+		if t.functionScorer != nil {
+			// the function scorer reranks the top offset+limit fused candidates before the page is cut
+			params = &rankParams{
+				limit:           t.rankParams.GetLimit() + t.rankParams.GetOffset(),
+				offset:          0,
+				roundDecimal:    t.rankParams.GetRoundDecimal(),
+				groupByFieldId:  t.rankParams.GetGroupByFieldId(),
+				groupSize:       t.rankParams.GetGroupSize(),
+				strictGroupSize: t.rankParams.GetStrictGroupSize(),
+			}
+		}
 		t.result, err = rankSearchResultData(ctx, t.SearchRequest.GetNq(),
-			t.rankParams,
+			params,
 			primaryFieldSchema.GetDataType(),
 			multipleMilvusResults,
 			t.SearchRequest.GetGroupByFieldId(),
@@ -790,15 +828,6 @@ func (t *searchTask) PostExecute(ctx context.Context) error {
 	}
 
 	// reduce done, get final result
-	limit := t.SearchRequest.GetTopk() - t.SearchRequest.GetOffset()
-	resultSizeInsufficient := false
-	for _, topk := range t.result.Results.Topks {
-		if topk < limit {
-			resultSizeInsufficient = true
-			break
-		}
-	}
-	t.resultSizeInsufficient = resultSizeInsufficient
 	t.isTopkReduce = isTopkReduce
 	t.isRecallEvaluation = isRecallEvaluation
 	t.result.CollectionName = t.collectionName
@@ -811,6 +840,16 @@ func (t *searchTask) PostExecute(ctx context.Context) error {
 			return err
 		}
 	}
+	// the page is cut after the function scorer reranks the candidates in requery
+	limit := t.SearchRequest.GetTopk() - t.SearchRequest.GetOffset()
+	resultSizeInsufficient := false
+	for _, topk := range t.result.Results.Topks {
+		if topk < limit {
+			resultSizeInsufficient = true
+			break
+		}
+	}
+	t.resultSizeInsufficient = resultSizeInsufficient
 	t.result.Results.OutputFields = t.userOutputFields
 	t.result.CollectionName = t.request.GetCollectionName()
 	if t.isIterator && len(t.queryInfos) == 1 && t.queryInfos[0] != nil {
@@ -899,6 +938,10 @@ func (t *searchTask) estimateResultSize(nq int64, topK int64) (int64, error) {
 }
 
 func (t *searchTask) Requery(span trace.Span) error {
+	outputFields := t.request.GetOutputFields()
+	if t.functionScorer != nil {
+		outputFields = lo.Union(outputFields, t.functionScorer.inputFieldNames())
+	}
 	queryReq := &milvuspb.QueryRequest{
 		Base: &commonpb.MsgBase{
 			MsgType:   commonpb.MsgType_Retrieve,
@@ -909,7 +952,7 @@ func (t *searchTask) Requery(span trace.Span) error {
 		ConsistencyLevel:      t.SearchRequest.GetConsistencyLevel(),
 		NotReturnAllMeta:      t.request.GetNotReturnAllMeta(),
 		Expr:                  "",
-		OutputFields:          t.request.GetOutputFields(),
+		OutputFields:          outputFields,
 		PartitionNames:        t.request.GetPartitionNames(),
 		UseDefaultConsistency: false,
 		GuaranteeTimestamp:    t.SearchRequest.GuaranteeTimestamp,
@@ -987,6 +1030,13 @@ func (t *searchTask) Requery(span trace.Span) error {
 		typeutil.AppendFieldData(t.result.Results.FieldsData, queryResult.GetFieldsData(), int64(offsets[id]))
 	}
 
+	if t.functionScorer != nil {
+		if err := t.functionScorer.score(t.ctx, t.result.Results); err != nil {
+			return err
+		}
+		pageSearchResults(t.result.Results, t.rankParams.GetOffset(), t.rankParams.GetLimit())
+	}
+
 	t.result.Results.FieldsData = lo.Filter(t.result.Results.FieldsData, func(fieldData *schemapb.FieldData, i int) bool {
 		return lo.Contains(t.request.GetOutputFields(), fieldData.GetFieldName())
 	})
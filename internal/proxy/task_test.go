@@ -2463,6 +2463,32 @@ func Test_checkTrain(t *testing.T) {
 		}
 		assert.NoError(t, checkTrain(context.TODO(), f, m))
 	})
+
+	t.Run("bitmap params", func(t *testing.T) {
+		f := &schemapb.FieldSchema{
+			DataType: schemapb.DataType_Int64,
+		}
+		m := map[string]string{
+			common.IndexTypeKey: "HYBRID",
+		}
+		assert.NoError(t, checkTrain(context.TODO(), f, m))
+		assert.Equal(t, paramtable.Get().AutoIndexConfig.BitmapCardinalityLimit.GetValue(), m[common.BitmapCardinalityLimitKey])
+		assert.Equal(t, paramtable.Get().AutoIndexConfig.BitmapRunOptimize.GetValue(), m[common.BitmapRunOptimizeKey])
+		assert.Equal(t, paramtable.Get().AutoIndexConfig.BitmapOffsetBits.GetValue(), m[common.BitmapOffsetBitsKey])
+
+		m = map[string]string{
+			common.IndexTypeKey:        "BITMAP",
+			common.BitmapOffsetBitsKey: "64",
+		}
+		assert.NoError(t, checkTrain(context.TODO(), f, m))
+		assert.Equal(t, "64", m[common.BitmapOffsetBitsKey])
+
+		m = map[string]string{
+			common.IndexTypeKey:         "BITMAP",
+			common.BitmapRunOptimizeKey: "maybe",
+		}
+		assert.Error(t, checkTrain(context.TODO(), f, m))
+	})
 }
 
 func Test_createIndexTask_PreExecute(t *testing.T) {
@@ -4208,6 +4234,26 @@ func TestTaskPartitionKeyIsolation(t *testing.T) {
 		assert.NoError(t, err)
 	})
 
+	t.Run("create collection with invalid time travel", func(t *testing.T) {
+		colName := collectionName + "_timetravel"
+		schema := getSchema(colName, false)
+		marshaledSchema, err := proto.Marshal(schema)
+		assert.NoError(t, err)
+
+		createCollectionTask := getCollectionTask(colName, false, marshaledSchema)
+		createCollectionTask.Properties = append(createCollectionTask.Properties,
+			&commonpb.KeyValuePair{Key: common.CollectionTimeTravelKey, Value: "-1"})
+		err = createCollectionTask.PreExecute(ctx)
+		assert.ErrorIs(t, err, merr.ErrParameterInvalid)
+	})
+
+	t.Run("alter collection with invalid time travel", func(t *testing.T) {
+		alterTask := getAlterCollectionTask(collectionName, false)
+		alterTask.Properties = []*commonpb.KeyValuePair{{Key: common.CollectionTimeTravelKey, Value: "invalid"}}
+		err := alterTask.PreExecute(ctx)
+		assert.ErrorIs(t, err, merr.ErrParameterInvalid)
+	})
+
 	t.Run("create collection without isolation", func(t *testing.T) {
 		schema := getSchema(collectionName, true)
 		marshaledSchema, err := proto.Marshal(schema)
@@ -0,0 +1,257 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"fmt"
+	"strconv"
+	"sync"
+
+	"github.com/cockroachdb/errors"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/json"
+	rlinternal "github.com/milvus-io/milvus/internal/util/ratelimitutil"
+	"github.com/milvus-io/milvus/pkg/v2/log"
+	"github.com/milvus-io/milvus/pkg/v2/metrics"
+	"github.com/milvus-io/milvus/pkg/v2/proto/internalpb"
+	"github.com/milvus-io/milvus/pkg/v2/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/v2/util/ratelimitutil"
+	"github.com/milvus-io/milvus/pkg/v2/util/typeutil"
+)
+
+// defaultUserLimitKey is the key of the rate limits applied to the users without their own limits.
+const defaultUserLimitKey = "*"
+
+// UserLimitPolicy decides the token buckets which the requests of a user are charged to.
+type UserLimitPolicy interface {
+	// Scope returns the rate scope of the token buckets.
+	Scope() internalpb.RateScope
+	// Keys returns the keys of the token buckets which the requests of the user are charged to.
+	Keys(username string) []string
+	// Rates returns the rate limits of the token bucket, the rate types absent are not limited.
+	Rates(key string) map[internalpb.RateType]float64
+}
+
+// rateLimitsConfig caches the rate limits parsed from the json config, and reparses them once the config is changed.
+type rateLimitsConfig struct {
+	param *paramtable.ParamItem
+
+	mu     sync.Mutex
+	value  string
+	limits map[string]map[internalpb.RateType]float64
+}
+
+func (c *rateLimitsConfig) get() map[string]map[internalpb.RateType]float64 {
+	value := c.param.GetValue()
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.limits != nil && c.value == value {
+		return c.limits
+	}
+	limits, err := parseRateLimits(value)
+	if err != nil {
+		log.Warn("invalid rate limits config, ignore it", zap.String("key", c.param.Key), zap.String("value", value), zap.Error(err))
+		limits = make(map[string]map[internalpb.RateType]float64)
+	}
+	c.value, c.limits = value, limits
+	return limits
+}
+
+func parseRateLimits(value string) (map[string]map[internalpb.RateType]float64, error) {
+	configs := make(map[string]map[string]float64)
+	if err := json.Unmarshal([]byte(value), &configs); err != nil {
+		return nil, err
+	}
+	limits := make(map[string]map[internalpb.RateType]float64, len(configs))
+	for key, config := range configs {
+		rates := make(map[internalpb.RateType]float64, len(config))
+		for name, rate := range config {
+			rt, ok := internalpb.RateType_value[name]
+			if !ok {
+				return nil, fmt.Errorf("unknown rate type %s of %s", name, key)
+			}
+			if rate < 0 {
+				return nil, fmt.Errorf("negative rate %v of %s for %s", rate, name, key)
+			}
+			switch internalpb.RateType(rt) {
+			case internalpb.RateType_DMLInsert, internalpb.RateType_DMLUpsert, internalpb.RateType_DMLDelete, internalpb.RateType_DMLBulkLoad:
+				rate *= paramtable.MBSize
+			}
+			rates[internalpb.RateType(rt)] = rate
+		}
+		limits[key] = rates
+	}
+	return limits, nil
+}
+
+// userLimitPolicy charges the requests to the token bucket of the user itself.
+type userLimitPolicy struct {
+	config *rateLimitsConfig
+}
+
+func newUserLimitPolicy() *userLimitPolicy {
+	return &userLimitPolicy{config: &rateLimitsConfig{param: &Params.QuotaConfig.UserRateLimits}}
+}
+
+func (p *userLimitPolicy) Scope() internalpb.RateScope {
+	return internalpb.RateScope_User
+}
+
+func (p *userLimitPolicy) Keys(username string) []string {
+	return []string{username}
+}
+
+func (p *userLimitPolicy) Rates(key string) map[internalpb.RateType]float64 {
+	limits := p.config.get()
+	if rates, ok := limits[key]; ok {
+		return rates
+	}
+	return limits[defaultUserLimitKey]
+}
+
+// roleLimitPolicy charges the requests to the token buckets of the roles granted to the user,
+// which are shared by all the users of the role.
+type roleLimitPolicy struct {
+	config   *rateLimitsConfig
+	getRoles func(username string) []string
+}
+
+func newRoleLimitPolicy(getRoles func(username string) []string) *roleLimitPolicy {
+	return &roleLimitPolicy{
+		config:   &rateLimitsConfig{param: &Params.QuotaConfig.RoleRateLimits},
+		getRoles: getRoles,
+	}
+}
+
+func (p *roleLimitPolicy) Scope() internalpb.RateScope {
+	return internalpb.RateScope_Role
+}
+
+func (p *roleLimitPolicy) Keys(username string) []string {
+	return p.getRoles(username)
+}
+
+func (p *roleLimitPolicy) Rates(key string) map[internalpb.RateType]float64 {
+	return p.config.get()[key]
+}
+
+func getUserRolesFromCache(username string) []string {
+	if globalMetaCache == nil {
+		return nil
+	}
+	return globalMetaCache.GetUserRole(username)
+}
+
+// userRateLimiter enforces the rate limits decided by the user limit policies.
+type userRateLimiter struct {
+	mu       sync.RWMutex
+	policies []UserLimitPolicy
+
+	// limiter nodes keyed by the scope and the key of the token bucket
+	nodes *typeutil.ConcurrentMap[string, *rlinternal.RateLimiterNode]
+}
+
+func newUserRateLimiter(policies ...UserLimitPolicy) *userRateLimiter {
+	return &userRateLimiter{
+		policies: policies,
+		nodes:    typeutil.NewConcurrentMap[string, *rlinternal.RateLimiterNode](),
+	}
+}
+
+func (l *userRateLimiter) register(policy UserLimitPolicy) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.policies = append(l.policies, policy)
+}
+
+// Check checks if the request of the user would be limited by any token bucket of the policies.
+func (l *userRateLimiter) Check(username string, rt internalpb.RateType, n int) error {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+
+	nodeID := strconv.FormatInt(paramtable.GetNodeID(), 10)
+	metrics.ProxyUserRateLimitReqCount.WithLabelValues(nodeID, rt.String(), metrics.TotalLabel).Inc()
+
+	// store done limiters to cancel them when error occurs.
+	doneLimiters := make([]*rlinternal.RateLimiterNode, 0)
+	for _, policy := range l.policies {
+		for _, key := range policy.Keys(username) {
+			rate, ok := policy.Rates(key)[rt]
+			if !ok {
+				continue
+			}
+			limiter := l.getOrCreateLimiter(policy.Scope(), key, rt, rate)
+			if err := limiter.Check(rt, n); err != nil {
+				for _, done := range doneLimiters {
+					done.Cancel(rt, n)
+				}
+				metrics.ProxyUserRateLimitReqCount.WithLabelValues(nodeID, rt.String(), metrics.FailLabel).Inc()
+				return errors.Wrapf(err, "limited by the rate of %s %s", policy.Scope().String(), key)
+			}
+			doneLimiters = append(doneLimiters, limiter)
+		}
+	}
+	metrics.ProxyUserRateLimitReqCount.WithLabelValues(nodeID, rt.String(), metrics.SuccessLabel).Inc()
+	return nil
+}
+
+// Cancel refunds the tokens taken by Check from the token buckets of the user.
+func (l *userRateLimiter) Cancel(username string, rt internalpb.RateType, n int) {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+
+	for _, policy := range l.policies {
+		for _, key := range policy.Keys(username) {
+			if _, ok := policy.Rates(key)[rt]; !ok {
+				continue
+			}
+			if node, ok := l.nodes.Get(limiterNodeKey(policy.Scope(), key)); ok {
+				node.Cancel(rt, n)
+			}
+		}
+	}
+}
+
+func limiterNodeKey(scope internalpb.RateScope, key string) string {
+	return fmt.Sprintf("%s.%s", scope.String(), key)
+}
+
+func (l *userRateLimiter) getOrCreateLimiter(scope internalpb.RateScope, key string, rt internalpb.RateType, rate float64) *rlinternal.RateLimiterNode {
+	nodeKey := limiterNodeKey(scope, key)
+	node, ok := l.nodes.Get(nodeKey)
+	if !ok {
+		node, _ = l.nodes.GetOrInsert(nodeKey, rlinternal.NewRateLimiterNode(scope))
+	}
+	newLimit := ratelimitutil.Limit(rate)
+	limiter, ok := node.GetLimiters().Get(rt)
+	if !ok {
+		// use rate as burst, which is the same as the quota limiters.
+		limiter, _ = node.GetLimiters().GetOrInsert(rt, ratelimitutil.NewLimiter(newLimit, rate))
+	}
+	if limiter.Limit() != newLimit {
+		// SetLimit resets the burst to the new rate as well
+		limiter.SetLimit(newLimit)
+	}
+	return node
+}
+
+// remove drops the token bucket of the key, e.g. the user or role is dropped.
+func (l *userRateLimiter) remove(scope internalpb.RateScope, key string) {
+	l.nodes.Remove(limiterNodeKey(scope, key))
+}
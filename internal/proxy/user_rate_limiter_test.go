@@ -0,0 +1,145 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/pkg/v2/proto/internalpb"
+	"github.com/milvus-io/milvus/pkg/v2/util/merr"
+	"github.com/milvus-io/milvus/pkg/v2/util/paramtable"
+)
+
+func TestParseRateLimits(t *testing.T) {
+	limits, err := parseRateLimits(`{"alice": {"DMLInsert": 2, "DQLSearch": 10}, "*": {"DQLQuery": 0}}`)
+	assert.NoError(t, err)
+	assert.Equal(t, 2*paramtable.MBSize, limits["alice"][internalpb.RateType_DMLInsert])
+	assert.Equal(t, float64(10), limits["alice"][internalpb.RateType_DQLSearch])
+	assert.Equal(t, float64(0), limits["*"][internalpb.RateType_DQLQuery])
+
+	_, err = parseRateLimits(`{"alice": {"unknown": 2}}`)
+	assert.Error(t, err)
+	_, err = parseRateLimits(`{"alice": {"DQLSearch": -1}}`)
+	assert.Error(t, err)
+	_, err = parseRateLimits(`invalid`)
+	assert.Error(t, err)
+}
+
+func TestUserRateLimiter(t *testing.T) {
+	paramtable.Init()
+	pt := paramtable.Get()
+	pt.Save(pt.QuotaConfig.QuotaAndLimitsEnabled.Key, "true")
+	pt.Save(pt.QuotaConfig.UserLimitEnabled.Key, "true")
+	defer pt.Reset(pt.QuotaConfig.QuotaAndLimitsEnabled.Key)
+	defer pt.Reset(pt.QuotaConfig.UserLimitEnabled.Key)
+	defer pt.Reset(pt.QuotaConfig.UserRateLimits.Key)
+	defer pt.Reset(pt.QuotaConfig.RoleRateLimits.Key)
+
+	roles := map[string][]string{"alice": {"reader"}, "bob": {"reader"}}
+	simpleLimiter := NewSimpleLimiter(0, 0)
+	simpleLimiter.userLimiter = newUserRateLimiter(newUserLimitPolicy(), newRoleLimitPolicy(func(username string) []string {
+		return roles[username]
+	}))
+
+	t.Run("user limits", func(t *testing.T) {
+		pt.Save(pt.QuotaConfig.UserRateLimits.Key, `{"alice": {"DQLSearch": 5}, "*": {"DQLSearch": 10}}`)
+		pt.Save(pt.QuotaConfig.RoleRateLimits.Key, `{}`)
+
+		assert.NoError(t, simpleLimiter.CheckUser("alice", internalpb.RateType_DQLSearch, 6))
+		err := simpleLimiter.CheckUser("alice", internalpb.RateType_DQLSearch, 1)
+		assert.ErrorIs(t, err, merr.ErrServiceRateLimit)
+		// other users have their own token buckets
+		assert.NoError(t, simpleLimiter.CheckUser("bob", internalpb.RateType_DQLSearch, 10))
+		assert.NoError(t, simpleLimiter.CheckUser("carol", internalpb.RateType_DQLSearch, 10))
+		// rate types absent are not limited
+		assert.NoError(t, simpleLimiter.CheckUser("alice", internalpb.RateType_DQLQuery, 100))
+		// anonymous requests are not limited
+		assert.NoError(t, simpleLimiter.CheckUser("", internalpb.RateType_DQLSearch, 100))
+
+		// deny the user by zero rate
+		pt.Save(pt.QuotaConfig.UserRateLimits.Key, `{"alice": {"DQLQuery": 0}}`)
+		err = simpleLimiter.CheckUser("alice", internalpb.RateType_DQLQuery, 1)
+		assert.ErrorIs(t, err, merr.ErrServiceQuotaExceeded)
+	})
+
+	t.Run("role limits", func(t *testing.T) {
+		pt.Save(pt.QuotaConfig.UserRateLimits.Key, `{"bob": {"DMLDelete": 1}}`)
+		pt.Save(pt.QuotaConfig.RoleRateLimits.Key, `{"reader": {"DMLDelete": 2}}`)
+
+		// users of the role share the token bucket
+		assert.NoError(t, simpleLimiter.CheckUser("alice", internalpb.RateType_DMLDelete, 2*int(paramtable.MBSize)))
+		assert.NoError(t, simpleLimiter.CheckUser("alice", internalpb.RateType_DMLDelete, int(paramtable.MBSize)))
+		err := simpleLimiter.CheckUser("bob", internalpb.RateType_DMLDelete, 2*int(paramtable.MBSize))
+		assert.ErrorIs(t, err, merr.ErrServiceRateLimit)
+		// the tokens of the user bucket are refunded once the role bucket rejects the request
+		pt.Save(pt.QuotaConfig.RoleRateLimits.Key, `{}`)
+		assert.NoError(t, simpleLimiter.CheckUser("bob", internalpb.RateType_DMLDelete, 1))
+	})
+
+	t.Run("cancel", func(t *testing.T) {
+		pt.Save(pt.QuotaConfig.UserRateLimits.Key, `{"carol": {"DQLQuery": 5}}`)
+		pt.Save(pt.QuotaConfig.RoleRateLimits.Key, `{}`)
+
+		assert.NoError(t, simpleLimiter.CheckUser("carol", internalpb.RateType_DQLQuery, 5))
+		assert.Error(t, simpleLimiter.CheckUser("carol", internalpb.RateType_DQLQuery, 5))
+		// the tokens are refunded once the request is rejected by the quota limits
+		simpleLimiter.CancelUser("carol", internalpb.RateType_DQLQuery, 5)
+		assert.NoError(t, simpleLimiter.CheckUser("carol", internalpb.RateType_DQLQuery, 5))
+	})
+
+	t.Run("rate updated", func(t *testing.T) {
+		pt.Save(pt.QuotaConfig.UserRateLimits.Key, `{"dave": {"DQLSearch": 100}}`)
+		pt.Save(pt.QuotaConfig.RoleRateLimits.Key, `{}`)
+		assert.NoError(t, simpleLimiter.CheckUser("dave", internalpb.RateType_DQLSearch, 1))
+
+		// the burst shrinks along with the rate
+		pt.Save(pt.QuotaConfig.UserRateLimits.Key, `{"dave": {"DQLSearch": 5}}`)
+		assert.NoError(t, simpleLimiter.CheckUser("dave", internalpb.RateType_DQLSearch, 6))
+		assert.Error(t, simpleLimiter.CheckUser("dave", internalpb.RateType_DQLSearch, 1))
+	})
+
+	t.Run("remove", func(t *testing.T) {
+		pt.Save(pt.QuotaConfig.UserRateLimits.Key, `{"erin": {"DQLQuery": 5}}`)
+		pt.Save(pt.QuotaConfig.RoleRateLimits.Key, `{"reader": {"DQLQuery": 5}}`)
+		assert.NoError(t, simpleLimiter.CheckUser("erin", internalpb.RateType_DQLQuery, 6))
+		assert.NoError(t, simpleLimiter.CheckUser("alice", internalpb.RateType_DQLQuery, 1))
+		assert.True(t, simpleLimiter.userLimiter.nodes.Contain(limiterNodeKey(internalpb.RateScope_User, "erin")))
+		assert.True(t, simpleLimiter.userLimiter.nodes.Contain(limiterNodeKey(internalpb.RateScope_Role, "reader")))
+
+		simpleLimiter.RemoveUserLimiter(internalpb.RateScope_User, "erin")
+		simpleLimiter.RemoveUserLimiter(internalpb.RateScope_Role, "reader")
+		assert.False(t, simpleLimiter.userLimiter.nodes.Contain(limiterNodeKey(internalpb.RateScope_User, "erin")))
+		assert.False(t, simpleLimiter.userLimiter.nodes.Contain(limiterNodeKey(internalpb.RateScope_Role, "reader")))
+		// the user starts with a full token bucket if created again
+		assert.NoError(t, simpleLimiter.CheckUser("erin", internalpb.RateType_DQLQuery, 5))
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		pt.Save(pt.QuotaConfig.UserRateLimits.Key, `{"*": {"DQLQuery": 0}}`)
+		pt.Save(pt.QuotaConfig.UserLimitEnabled.Key, "false")
+		assert.NoError(t, simpleLimiter.CheckUser("alice", internalpb.RateType_DQLQuery, 1))
+		pt.Save(pt.QuotaConfig.UserLimitEnabled.Key, "true")
+		assert.Error(t, simpleLimiter.CheckUser("alice", internalpb.RateType_DQLQuery, 1))
+	})
+
+	t.Run("invalid config", func(t *testing.T) {
+		pt.Save(pt.QuotaConfig.UserRateLimits.Key, `invalid`)
+		assert.NoError(t, simpleLimiter.CheckUser("alice", internalpb.RateType_DQLQuery, 1))
+	})
+}
@@ -52,6 +52,7 @@ import (
 	"github.com/milvus-io/milvus/pkg/v2/util/commonpbutil"
 	"github.com/milvus-io/milvus/pkg/v2/util/contextutil"
 	"github.com/milvus-io/milvus/pkg/v2/util/crypto"
+	"github.com/milvus-io/milvus/pkg/v2/util/funcutil"
 	"github.com/milvus-io/milvus/pkg/v2/util/merr"
 	"github.com/milvus-io/milvus/pkg/v2/util/metric"
 	"github.com/milvus-io/milvus/pkg/v2/util/paramtable"
@@ -409,6 +410,28 @@ func validateMaxCapacityPerRow(collectionName string, field *schemapb.FieldSchem
 	return nil
 }
 
+// validateBloomFilterParams checks the secondary bloom filter is only enabled on the integer or varchar fields except the primary key,
+// the primary key always has its own bloom filter.
+func validateBloomFilterParams(field *schemapb.FieldSchema) error {
+	for _, param := range field.GetTypeParams() {
+		if param.GetKey() != common.BloomFilterEnabledKey {
+			continue
+		}
+		enabled, err := strconv.ParseBool(param.GetValue())
+		if err != nil {
+			return merr.WrapErrParameterInvalidMsg("the value for %s of field %s must be a boolean", common.BloomFilterEnabledKey, field.GetName())
+		}
+		if !enabled {
+			continue
+		}
+		dataType := field.GetDataType()
+		if field.GetIsPrimaryKey() || !(typeutil.IsIntegerType(dataType) || dataType == schemapb.DataType_VarChar || dataType == schemapb.DataType_String) {
+			return merr.WrapErrParameterInvalidMsg("bloom filter is not supported on field %s of type %s", field.GetName(), dataType.String())
+		}
+	}
+	return nil
+}
+
 func validateVectorFieldMetricType(field *schemapb.FieldSchema) error {
 	if !typeutil.IsVectorType(field.DataType) {
 		return nil
@@ -734,6 +757,10 @@ func checkFunctionOutputField(fSchema *schemapb.FunctionSchema, fields []*schema
 		if err := function.TextEmbeddingOutputsCheck(fields); err != nil {
 			return err
 		}
+	case function.FunctionTypeRerank:
+		if len(fields) != 0 {
+			return fmt.Errorf("Rerank function accepts no output field, but got %d", len(fields))
+		}
 	default:
 		return fmt.Errorf("check output field for unknown function type")
 	}
@@ -749,6 +776,11 @@ func wasBm25FunctionInputField(coll *schemapb.CollectionSchema, field *schemapb.
 	return false
 }
 
+// isRerankFunction checks whether the function reranks the search results, which has no output field.
+func isRerankFunction(fSchema *schemapb.FunctionSchema) bool {
+	return fSchema.GetType() == function.FunctionTypeRerank
+}
+
 func checkFunctionInputField(function *schemapb.FunctionSchema, fields []*schemapb.FieldSchema) error {
 	switch function.GetType() {
 	case schemapb.FunctionType_BM25:
@@ -765,7 +797,12 @@ func checkFunctionInputField(function *schemapb.FunctionSchema, fields []*schema
 			return fmt.Errorf("TextEmbedding function input field must be a VARCHAR/TEXT field")
 		}
 	default:
-		return fmt.Errorf("check input field with unknown function type")
+		if !isRerankFunction(function) {
+			return fmt.Errorf("check input field with unknown function type")
+		}
+		if len(fields) != 1 || (fields[0].DataType != schemapb.DataType_VarChar && fields[0].DataType != schemapb.DataType_Text) {
+			return fmt.Errorf("Rerank function input field must be a VARCHAR/TEXT field")
+		}
 	}
 	return nil
 }
@@ -777,7 +814,7 @@ func checkFunctionBasicParams(function *schemapb.FunctionSchema) error {
 	if len(function.GetInputFieldNames()) == 0 {
 		return fmt.Errorf("function input field names cannot be empty, function: %s", function.GetName())
 	}
-	if len(function.GetOutputFieldNames()) == 0 {
+	if len(function.GetOutputFieldNames()) == 0 && !isRerankFunction(function) {
 		return fmt.Errorf("function output field names cannot be empty, function: %s", function.GetName())
 	}
 	for _, input := range function.GetInputFieldNames() {
@@ -810,7 +847,12 @@ func checkFunctionBasicParams(function *schemapb.FunctionSchema) error {
 			return fmt.Errorf("TextEmbedding function accepts no params")
 		}
 	default:
-		return fmt.Errorf("check function params with unknown function type")
+		if !isRerankFunction(function) {
+			return fmt.Errorf("check function params with unknown function type")
+		}
+		if len(function.GetParams()) == 0 {
+			return fmt.Errorf("Rerank function requires params")
+		}
 	}
 	return nil
 }
@@ -1060,6 +1102,35 @@ func parseGuaranteeTs(ts, tMax typeutil.Timestamp) typeutil.Timestamp {
 	return ts
 }
 
+// parseTravelTs parses the travel timestamp of search/query, 0 if it's not specified.
+func parseTravelTs(params []*commonpb.KeyValuePair) (typeutil.Timestamp, error) {
+	travelTsStr, err := funcutil.GetAttrByKeyFromRepeatedKV(TravelTimestampKey, params)
+	if err != nil {
+		return 0, nil
+	}
+	travelTs, err := strconv.ParseUint(travelTsStr, 0, 64)
+	if err != nil {
+		return 0, merr.WrapErrParameterInvalid("uint64 timestamp", travelTsStr, "value for travel timestamp is invalid")
+	}
+	return travelTs, nil
+}
+
+// checkTravelTs checks the travel timestamp is within the time travel window of the collection,
+// the deletes before the window might have been applied by compaction, so the results as of
+// an earlier timestamp could not be reproduced.
+func checkTravelTs(travelTs, tMax typeutil.Timestamp, window time.Duration) error {
+	if travelTs > tMax {
+		return merr.WrapErrParameterInvalidMsg("travel timestamp %d is later than the current timestamp %d", travelTs, tMax)
+	}
+	if window == 0 {
+		return merr.WrapErrParameterInvalidMsg("time travel is not enabled for the collection, please set %s first", common.CollectionTimeTravelKey)
+	}
+	if bound := tsoutil.AddPhysicalDurationOnTs(tMax, -window); travelTs < bound {
+		return merr.WrapErrParameterInvalidMsg("travel timestamp %d is out of the time travel window %v of the collection", travelTs, window)
+	}
+	return nil
+}
+
 func getMaxMvccTsFromChannels(channelsTs map[string]uint64, beginTs typeutil.Timestamp) typeutil.Timestamp {
 	maxTs := typeutil.Timestamp(0)
 	for _, ts := range channelsTs {
@@ -2358,6 +2358,32 @@ func Test_CheckDynamicFieldData(t *testing.T) {
 	})
 }
 
+func Test_validateBloomFilterParams(t *testing.T) {
+	newField := func(dataType schemapb.DataType, isPrimaryKey bool, value string) *schemapb.FieldSchema {
+		return &schemapb.FieldSchema{
+			Name:         "field",
+			DataType:     dataType,
+			IsPrimaryKey: isPrimaryKey,
+			TypeParams: []*commonpb.KeyValuePair{
+				{
+					Key:   common.BloomFilterEnabledKey,
+					Value: value,
+				},
+			},
+		}
+	}
+
+	assert.NoError(t, validateBloomFilterParams(&schemapb.FieldSchema{DataType: schemapb.DataType_Float}))
+	assert.NoError(t, validateBloomFilterParams(newField(schemapb.DataType_Int32, false, "true")))
+	assert.NoError(t, validateBloomFilterParams(newField(schemapb.DataType_VarChar, false, "true")))
+	assert.NoError(t, validateBloomFilterParams(newField(schemapb.DataType_JSON, false, "false")))
+	assert.Error(t, validateBloomFilterParams(newField(schemapb.DataType_VarChar, false, "yes please")))
+	assert.Error(t, validateBloomFilterParams(newField(schemapb.DataType_Int64, true, "true")))
+	assert.Error(t, validateBloomFilterParams(newField(schemapb.DataType_Double, false, "true")))
+	assert.Error(t, validateBloomFilterParams(newField(schemapb.DataType_Text, false, "true")))
+	assert.Error(t, validateBloomFilterParams(newField(schemapb.DataType_FloatVector, false, "true")))
+}
+
 func Test_validateMaxCapacityPerRow(t *testing.T) {
 	t.Run("normal case", func(t *testing.T) {
 		arrayField := &schemapb.FieldSchema{
@@ -2847,6 +2873,32 @@ func TestValidateFunction(t *testing.T) {
 		assert.Error(t, err)
 		assert.Contains(t, err.Error(), "function output field cannot be nullable")
 	})
+
+	t.Run("Rerank function schema", func(t *testing.T) {
+		t.Setenv("MILVUSAI_COHERE_API_KEY", "mock")
+		schema := &schemapb.CollectionSchema{
+			Fields: []*schemapb.FieldSchema{
+				{Name: "text", DataType: schemapb.DataType_VarChar},
+				{Name: "id", DataType: schemapb.DataType_Int64},
+			},
+			Functions: []*schemapb.FunctionSchema{
+				{
+					Name:            "rerank_func",
+					Type:            function.FunctionTypeRerank,
+					InputFieldNames: []string{"text"},
+					Params:          []*commonpb.KeyValuePair{{Key: "provider", Value: "cohere"}, {Key: "model_name", Value: "rerank-v3.5"}},
+				},
+			},
+		}
+		assert.NoError(t, validateFunction(schema))
+
+		schema.Functions[0].InputFieldNames = []string{"id"}
+		assert.Error(t, validateFunction(schema))
+
+		schema.Functions[0].InputFieldNames = []string{"text"}
+		schema.Functions[0].Params = nil
+		assert.Error(t, validateFunction(schema))
+	})
 }
 
 func TestValidateModelFunction(t *testing.T) {
@@ -3496,3 +3548,27 @@ func BenchmarkCheckVarcharFormat(b *testing.B) {
 		checkInputUtf8Compatiable(schema, data)
 	}
 }
+
+func TestTravelTs(t *testing.T) {
+	travelTs, err := parseTravelTs(nil)
+	assert.NoError(t, err)
+	assert.EqualValues(t, 0, travelTs)
+
+	travelTs, err = parseTravelTs([]*commonpb.KeyValuePair{{Key: TravelTimestampKey, Value: "100"}})
+	assert.NoError(t, err)
+	assert.EqualValues(t, 100, travelTs)
+
+	_, err = parseTravelTs([]*commonpb.KeyValuePair{{Key: TravelTimestampKey, Value: "invalid"}})
+	assert.ErrorIs(t, err, merr.ErrParameterInvalid)
+
+	now := time.Now()
+	tMax := tsoutil.ComposeTSByTime(now, 0)
+	assert.NoError(t, checkTravelTs(tsoutil.ComposeTSByTime(now.Add(-time.Minute), 0), tMax, time.Hour))
+	assert.NoError(t, checkTravelTs(tMax, tMax, time.Hour))
+	// out of the time travel window
+	assert.ErrorIs(t, checkTravelTs(tsoutil.ComposeTSByTime(now.Add(-2*time.Hour), 0), tMax, time.Hour), merr.ErrParameterInvalid)
+	// time travel is not enabled
+	assert.ErrorIs(t, checkTravelTs(tsoutil.ComposeTSByTime(now.Add(-time.Minute), 0), tMax, 0), merr.ErrParameterInvalid)
+	// travel to the future
+	assert.ErrorIs(t, checkTravelTs(tMax+1, tMax, time.Hour), merr.ErrParameterInvalid)
+}
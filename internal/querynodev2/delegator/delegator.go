@@ -145,6 +145,10 @@ type shardDelegator struct {
 	growingSegmentLock sync.RWMutex
 	partitionStatsMut  sync.RWMutex
 
+	// segmentID -> zone maps of the scalar fields of sealed segment
+	zoneMaps    map[UniqueID]*SegmentZoneMap
+	zoneMapsMut sync.RWMutex
+
 	// fieldId -> functionRunner map for search function field
 	functionRunners map[UniqueID]function.FunctionRunner
 	isBM25Field     map[UniqueID]bool
@@ -287,6 +291,11 @@ func (sd *shardDelegator) search(ctx context.Context, req *querypb.SearchRequest
 			PruneSegments(ctx, sd.partitionStats, req.GetReq(), nil, sd.collection.Schema(), sealed,
 				PruneInfo{filterRatio: paramtable.Get().QueryNodeCfg.DefaultSegmentFilterRatio.GetAsFloat()})
 		}()
+		func() {
+			sd.zoneMapsMut.RLock()
+			defer sd.zoneMapsMut.RUnlock()
+			PruneSegmentsByZoneMap(ctx, sd.zoneMaps, req.GetReq(), nil, sd.collection.Schema(), sealed)
+		}()
 	}
 
 	searchAgainstBM25Field := sd.isBM25Field[req.GetReq().GetFieldId()]
@@ -579,6 +588,11 @@ func (sd *shardDelegator) Query(ctx context.Context, req *querypb.QueryRequest)
 			defer sd.partitionStatsMut.RUnlock()
 			PruneSegments(ctx, sd.partitionStats, nil, req.GetReq(), sd.collection.Schema(), sealed, PruneInfo{paramtable.Get().QueryNodeCfg.DefaultSegmentFilterRatio.GetAsFloat()})
 		}()
+		func() {
+			sd.zoneMapsMut.RLock()
+			defer sd.zoneMapsMut.RUnlock()
+			PruneSegmentsByZoneMap(ctx, sd.zoneMaps, nil, req.GetReq(), sd.collection.Schema(), sealed)
+		}()
 	}
 
 	sealedNum := lo.SumBy(sealed, func(item SnapshotItem) int { return len(item.Segments) })
@@ -964,6 +978,7 @@ func NewShardDelegator(ctx context.Context, collectionID UniqueID, replicaID Uni
 		queryHook:        queryHook,
 		chunkManager:     chunkManager,
 		partitionStats:   make(map[UniqueID]*storage.PartitionStatsSnapshot),
+		zoneMaps:         make(map[UniqueID]*SegmentZoneMap),
 		excludedSegments: excludedSegments,
 		functionRunners:  make(map[int64]function.FunctionRunner),
 		isBM25Field:      make(map[int64]bool),
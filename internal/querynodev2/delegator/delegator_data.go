@@ -501,6 +501,8 @@ func (sd *shardDelegator) LoadSegments(ctx context.Context, req *querypb.LoadSeg
 		return err
 	}
 
+	sd.loadZoneMaps(ctx, infos)
+
 	// alter distribution
 	sd.distribution.AddDistributions(entries...)
 
@@ -908,6 +910,9 @@ func (sd *shardDelegator) ReleaseSegments(ctx context.Context, req *querypb.Rele
 	signal := sd.distribution.RemoveDistributions(sealed, growing)
 	// wait cleared signal
 	<-signal
+	if len(sealed) > 0 {
+		sd.removeZoneMaps(lo.Map(sealed, func(entry SegmentEntry, _ int) int64 { return entry.SegmentID })...)
+	}
 
 	if len(growing) > 0 {
 		sd.growingSegmentLock.Lock()
@@ -1,21 +1,72 @@
 package delegator
 
 import (
+	"sort"
+
 	"github.com/bits-and-blooms/bitset"
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
 	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/bloomfilter"
 	"github.com/milvus-io/milvus/pkg/v2/proto/planpb"
 )
 
 type EvalCtx struct {
-	segmentStats  []storage.SegmentStats
+	segmentStats []storage.SegmentStats
+	// bloomFilters are the bloom filters of the fields of each segment, aligned with segmentStats if not nil
+	bloomFilters  []map[FieldID][]bloomfilter.BloomFilterInterface
 	size          uint
 	allTrueBitSet *bitset.BitSet
 }
 
 func NewEvalCtx(segStats []storage.SegmentStats, size uint, allTrueBst *bitset.BitSet) *EvalCtx {
-	return &EvalCtx{segStats, size, allTrueBst}
+	return &EvalCtx{segmentStats: segStats, size: size, allTrueBitSet: allTrueBst}
+}
+
+// mayContain tests the value against the bloom filters of the field of the idx-th segment,
+// the segment without bloom filters of the field may always contain the value.
+func (evalCtx *EvalCtx) mayContain(idx int, fieldID FieldID, val storage.ScalarFieldValue) bool {
+	if idx >= len(evalCtx.bloomFilters) {
+		return true
+	}
+	bfs, ok := evalCtx.bloomFilters[idx][fieldID]
+	if !ok {
+		return true
+	}
+	for _, bf := range bfs {
+		if storage.BloomFilterMayContain(bf, val) {
+			return true
+		}
+	}
+	return false
+}
+
+// evalByField sets the bit of the segments which may contain the rows matching the function.
+// The segments without the stats of the field are always kept,
+// and the segments whose values of the field are all null are always skipped.
+func (evalCtx *EvalCtx) evalByField(fieldID FieldID, match func(idx int, fieldStat *storage.FieldStats) bool) *bitset.BitSet {
+	localBst := bitset.New(evalCtx.size)
+	for i := range evalCtx.segmentStats {
+		segStat := &evalCtx.segmentStats[i]
+		var fieldStat *storage.FieldStats
+		for j := range segStat.FieldStats {
+			if segStat.FieldStats[j].FieldID == fieldID {
+				fieldStat = &segStat.FieldStats[j]
+				break
+			}
+		}
+		switch {
+		case fieldStat == nil:
+			localBst.Set(uint(i))
+		case segStat.NumRows > 0 && fieldStat.NullCount >= int64(segStat.NumRows):
+			// null never matches range/equal/in filters
+		case fieldStat.Min == nil || fieldStat.Max == nil:
+			localBst.Set(uint(i))
+		case match(i, fieldStat):
+			localBst.Set(uint(i))
+		}
+	}
+	return localBst
 }
 
 type Expr interface {
@@ -24,12 +75,22 @@ type Expr interface {
 }
 
 func PruneByScalarField(expr Expr, segmentStats []storage.SegmentStats, segmentIDs []UniqueID, filteredSegments map[UniqueID]struct{}) {
+	PruneByScalarFieldWithBloomFilters(expr, segmentStats, nil, segmentIDs, filteredSegments)
+}
+
+// PruneByScalarFieldWithBloomFilters prunes the segments like PruneByScalarField, while the values of equal/in filters
+// are also tested against the bloom filters of the segments, which are aligned with the segment stats.
+func PruneByScalarFieldWithBloomFilters(expr Expr, segmentStats []storage.SegmentStats,
+	bloomFilters []map[FieldID][]bloomfilter.BloomFilterInterface, segmentIDs []UniqueID, filteredSegments map[UniqueID]struct{},
+) {
 	if expr != nil {
 		size := uint(len(segmentIDs))
 		allTrueBst := bitset.New(size)
 		allTrueBst.FlipRange(0, size)
 
-		resBst := expr.Eval(NewEvalCtx(segmentStats, size, allTrueBst))
+		evalCtx := NewEvalCtx(segmentStats, size, allTrueBst)
+		evalCtx.bloomFilters = bloomFilters
+		resBst := expr.Eval(evalCtx)
 		resBst.FlipRange(0, resBst.Len())
 		for i, e := resBst.NextSet(0); e; i, e = resBst.NextSet(i + 1) {
 			filteredSegments[segmentIDs[i]] = struct{}{}
@@ -63,9 +124,9 @@ func (lbe *LogicalBinaryExpr) Eval(evalCtx *EvalCtx) *bitset.BitSet {
 		rightRes = rightExpr.Eval(evalCtx)
 	}
 
-	// 3. set true for possible nil expr
+	// 3. set true for possible nil expr, the all true bitset is shared so it must not be modified in place
 	if leftRes == nil {
-		leftRes = evalCtx.allTrueBitSet
+		leftRes = evalCtx.allTrueBitSet.Clone()
 	}
 	if rightRes == nil {
 		rightRes = evalCtx.allTrueBitSet
@@ -94,113 +155,117 @@ func (lbe *PhysicalExpr) Inputs() []Expr {
 
 type BinaryRangeExpr struct {
 	PhysicalExpr
+	fieldID      FieldID
 	lowerVal     storage.ScalarFieldValue
 	upperVal     storage.ScalarFieldValue
 	includeLower bool
 	includeUpper bool
 }
 
-func NewBinaryRangeExpr(lower storage.ScalarFieldValue,
+func NewBinaryRangeExpr(fieldID FieldID, lower storage.ScalarFieldValue,
 	upper storage.ScalarFieldValue, inLower bool, inUpper bool,
 ) *BinaryRangeExpr {
-	return &BinaryRangeExpr{lowerVal: lower, upperVal: upper, includeLower: inLower, includeUpper: inUpper}
+	return &BinaryRangeExpr{fieldID: fieldID, lowerVal: lower, upperVal: upper, includeLower: inLower, includeUpper: inUpper}
 }
 
 func (bre *BinaryRangeExpr) Eval(evalCtx *EvalCtx) *bitset.BitSet {
-	localBst := bitset.New(evalCtx.size)
-	for i, segStat := range evalCtx.segmentStats {
-		fieldStat := &(segStat.FieldStats[0])
-		idx := uint(i)
+	return evalCtx.evalByField(bre.fieldID, func(_ int, fieldStat *storage.FieldStats) bool {
 		commonMin := storage.MaxScalar(fieldStat.Min, bre.lowerVal)
 		commonMax := storage.MinScalar(fieldStat.Max, bre.upperVal)
-		if !((commonMin).GT(commonMax)) {
-			localBst.Set(idx)
-		}
-	}
-	return localBst
+		return !((commonMin).GT(commonMax))
+	})
 }
 
 type UnaryRangeExpr struct {
 	PhysicalExpr
-	op  planpb.OpType
-	val storage.ScalarFieldValue
+	fieldID FieldID
+	op      planpb.OpType
+	val     storage.ScalarFieldValue
 }
 
-func NewUnaryRangeExpr(value storage.ScalarFieldValue, op planpb.OpType) *UnaryRangeExpr {
-	return &UnaryRangeExpr{op: op, val: value}
+func NewUnaryRangeExpr(fieldID FieldID, value storage.ScalarFieldValue, op planpb.OpType) *UnaryRangeExpr {
+	return &UnaryRangeExpr{fieldID: fieldID, op: op, val: value}
 }
 
 func (ure *UnaryRangeExpr) Eval(
 	evalCtx *EvalCtx,
 ) *bitset.BitSet {
-	localBst := bitset.New(evalCtx.size)
-	for i, segStat := range evalCtx.segmentStats {
-		fieldStat := &(segStat.FieldStats[0])
-		idx := uint(i)
-		val := ure.val
+	val := ure.val
+	switch ure.op {
+	case planpb.OpType_Equal, planpb.OpType_LessEqual, planpb.OpType_LessThan,
+		planpb.OpType_GreaterEqual, planpb.OpType_GreaterThan:
+	default:
+		return evalCtx.allTrueBitSet.Clone()
+	}
+	return evalCtx.evalByField(ure.fieldID, func(idx int, fieldStat *storage.FieldStats) bool {
 		switch ure.op {
 		case planpb.OpType_Equal:
-			if val.GE(fieldStat.Min) && val.LE(fieldStat.Max) {
-				localBst.Set(idx)
-			}
+			return val.GE(fieldStat.Min) && val.LE(fieldStat.Max) && evalCtx.mayContain(idx, ure.fieldID, val)
 		case planpb.OpType_LessEqual:
-			if !(val.LT(fieldStat.Min)) {
-				localBst.Set(idx)
-			}
+			return !(val.LT(fieldStat.Min))
 		case planpb.OpType_LessThan:
-			if !(val.LE(fieldStat.Min)) {
-				localBst.Set(idx)
-			}
+			return !(val.LE(fieldStat.Min))
 		case planpb.OpType_GreaterEqual:
-			if !(val.GT(fieldStat.Max)) {
-				localBst.Set(idx)
-			}
-		case planpb.OpType_GreaterThan:
-			if !(val.GE(fieldStat.Max)) {
-				localBst.Set(idx)
-			}
+			return !(val.GT(fieldStat.Max))
 		default:
-			return evalCtx.allTrueBitSet
+			return !(val.GE(fieldStat.Max))
 		}
-	}
-	return localBst
+	})
 }
 
 type TermExpr struct {
 	PhysicalExpr
-	vals []storage.ScalarFieldValue
+	fieldID FieldID
+	vals    []storage.ScalarFieldValue
 }
 
-func NewTermExpr(values []storage.ScalarFieldValue) *TermExpr {
-	return &TermExpr{vals: values}
+// NewTermExpr creates the term expr, the values are sorted for early stop in evaluation.
+func NewTermExpr(fieldID FieldID, values []storage.ScalarFieldValue) *TermExpr {
+	sort.Slice(values, func(i, j int) bool {
+		return values[i].LT(values[j])
+	})
+	return &TermExpr{fieldID: fieldID, vals: values}
 }
 
 func (te *TermExpr) Eval(evalCtx *EvalCtx) *bitset.BitSet {
-	localBst := bitset.New(evalCtx.size)
-	for i, segStat := range evalCtx.segmentStats {
-		fieldStat := &(segStat.FieldStats[0])
+	return evalCtx.evalByField(te.fieldID, func(idx int, fieldStat *storage.FieldStats) bool {
 		for _, val := range te.vals {
 			if val.GT(fieldStat.Max) {
 				// as the vals inside expr has been sorted before executed, if current val has exceeded the max, then
 				// no need to iterate over other values
-				break
+				return false
 			}
-			if fieldStat.Min.LE(val) && (val).LE(fieldStat.Max) {
-				localBst.Set(uint(i))
-				break
+			if fieldStat.Min.LE(val) && (val).LE(fieldStat.Max) && evalCtx.mayContain(idx, te.fieldID, val) {
+				return true
 			}
 		}
-	}
-	return localBst
+		return false
+	})
 }
 
+// ParseContext holds the fields which could be used to prune segments and their data types.
 type ParseContext struct {
-	keyFieldIDToPrune FieldID
-	dataType          schemapb.DataType
+	fieldTypes map[FieldID]schemapb.DataType
 }
 
+// NewParseContext creates the parse context for pruning by the single key field, like the clustering key.
 func NewParseContext(keyField FieldID, dType schemapb.DataType) *ParseContext {
-	return &ParseContext{keyField, dType}
+	return NewMultiFieldParseContext(map[FieldID]schemapb.DataType{keyField: dType})
+}
+
+// NewMultiFieldParseContext creates the parse context for pruning by any of the fields, like the zone maps.
+func NewMultiFieldParseContext(fieldTypes map[FieldID]schemapb.DataType) *ParseContext {
+	return &ParseContext{fieldTypes: fieldTypes}
+}
+
+// dataTypeOf returns the data type of the column if it could be used to prune segments.
+// Nested paths of json fields are never pruned.
+func (parseCtx *ParseContext) dataTypeOf(column *planpb.ColumnInfo) (schemapb.DataType, bool) {
+	if len(column.GetNestedPath()) > 0 {
+		return schemapb.DataType_None, false
+	}
+	dataType, ok := parseCtx.fieldTypes[column.GetFieldId()]
+	return dataType, ok
 }
 
 func ParseExpr(exprPb *planpb.Expr, parseCtx *ParseContext) (Expr, error) {
@@ -239,45 +304,48 @@ func ParseLogicalUnaryExpr(exprPb *planpb.UnaryExpr, parseCtx *ParseContext) (Ex
 }
 
 func ParseBinaryRangeExpr(exprPb *planpb.BinaryRangeExpr, parseCtx *ParseContext) (Expr, error) {
-	if exprPb.GetColumnInfo().GetFieldId() != parseCtx.keyFieldIDToPrune {
+	dataType, ok := parseCtx.dataTypeOf(exprPb.GetColumnInfo())
+	if !ok {
 		return nil, nil
 	}
-	lower, err := storage.NewScalarFieldValueFromGenericValue(parseCtx.dataType, exprPb.GetLowerValue())
+	lower, err := storage.NewScalarFieldValueFromGenericValue(dataType, exprPb.GetLowerValue())
 	if err != nil {
 		return nil, err
 	}
-	upper, err := storage.NewScalarFieldValueFromGenericValue(parseCtx.dataType, exprPb.GetUpperValue())
+	upper, err := storage.NewScalarFieldValueFromGenericValue(dataType, exprPb.GetUpperValue())
 	if err != nil {
 		return nil, err
 	}
-	return NewBinaryRangeExpr(lower, upper, exprPb.LowerInclusive, exprPb.UpperInclusive), nil
+	return NewBinaryRangeExpr(exprPb.GetColumnInfo().GetFieldId(), lower, upper, exprPb.LowerInclusive, exprPb.UpperInclusive), nil
 }
 
 func ParseUnaryRangeExpr(exprPb *planpb.UnaryRangeExpr, parseCtx *ParseContext) (Expr, error) {
-	if exprPb.GetColumnInfo().GetFieldId() != parseCtx.keyFieldIDToPrune {
+	dataType, ok := parseCtx.dataTypeOf(exprPb.GetColumnInfo())
+	if !ok {
 		return nil, nil
 	}
 	if exprPb.GetOp() == planpb.OpType_NotEqual {
 		return nil, nil
 		// segment-prune based on min-max cannot support not equal semantic
 	}
-	innerVal, err := storage.NewScalarFieldValueFromGenericValue(parseCtx.dataType, exprPb.GetValue())
+	innerVal, err := storage.NewScalarFieldValueFromGenericValue(dataType, exprPb.GetValue())
 	if err != nil {
 		return nil, err
 	}
-	return NewUnaryRangeExpr(innerVal, exprPb.GetOp()), nil
+	return NewUnaryRangeExpr(exprPb.GetColumnInfo().GetFieldId(), innerVal, exprPb.GetOp()), nil
 }
 
 func ParseTermExpr(exprPb *planpb.TermExpr, parseCtx *ParseContext) (Expr, error) {
-	if exprPb.GetColumnInfo().GetFieldId() != parseCtx.keyFieldIDToPrune {
+	dataType, ok := parseCtx.dataTypeOf(exprPb.GetColumnInfo())
+	if !ok {
 		return nil, nil
 	}
 	scalarVals := make([]storage.ScalarFieldValue, 0)
 	for _, val := range exprPb.GetValues() {
-		innerVal, err := storage.NewScalarFieldValueFromGenericValue(parseCtx.dataType, val)
+		innerVal, err := storage.NewScalarFieldValueFromGenericValue(dataType, val)
 		if err == nil {
 			scalarVals = append(scalarVals, innerVal)
 		}
 	}
-	return NewTermExpr(scalarVals), nil
+	return NewTermExpr(exprPb.GetColumnInfo().GetFieldId(), scalarVals), nil
 }
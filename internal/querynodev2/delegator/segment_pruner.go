@@ -14,6 +14,7 @@ import (
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
 	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/bloomfilter"
 	"github.com/milvus-io/milvus/internal/util/clustering"
 	"github.com/milvus-io/milvus/internal/util/exprutil"
 	"github.com/milvus-io/milvus/pkg/v2/common"
@@ -139,63 +140,152 @@ func PruneSegments(ctx context.Context,
 	}
 
 	// 2. remove filtered segments from sealed segment list
-	if len(filteredSegments) > 0 {
-		realFilteredSegments := 0
-		totalSegNum := 0
-		minSegmentCount := math.MaxInt
-		maxSegmentCount := 0
-		for idx, item := range sealedSegments {
-			newSegments := make([]SegmentEntry, 0)
-			totalSegNum += len(item.Segments)
-			for _, segment := range item.Segments {
-				_, exist := filteredSegments[segment.SegmentID]
-				if exist {
-					realFilteredSegments++
-				} else {
-					newSegments = append(newSegments, segment)
-				}
-			}
-			item.Segments = newSegments
-			sealedSegments[idx] = item
-			segmentCount := len(item.Segments)
-			if segmentCount > maxSegmentCount {
-				maxSegmentCount = segmentCount
-			}
-			if segmentCount < minSegmentCount {
-				minSegmentCount = segmentCount
+	removeFilteredSegments(ctx, collectionID, pruneType, sealedSegments, filteredSegments)
+
+	metrics.QueryNodeSegmentPruneLatency.WithLabelValues(
+		fmt.Sprint(paramtable.GetNodeID()),
+		fmt.Sprint(collectionID),
+		pruneType).
+		Observe(float64(tr.ElapseSpan().Milliseconds()))
+	log.Ctx(ctx).Debug("Pruned segment for search/query",
+		zap.Duration("duration", tr.ElapseSpan()))
+}
+
+// removeFilteredSegments removes the filtered segments from the sealed segment list and reports the prune metrics.
+func removeFilteredSegments(ctx context.Context,
+	collectionID int64,
+	pruneType string,
+	sealedSegments []SnapshotItem,
+	filteredSegments map[UniqueID]struct{},
+) {
+	if len(filteredSegments) == 0 {
+		return
+	}
+	realFilteredSegments := 0
+	totalSegNum := 0
+	minSegmentCount := math.MaxInt
+	maxSegmentCount := 0
+	for idx, item := range sealedSegments {
+		newSegments := make([]SegmentEntry, 0)
+		totalSegNum += len(item.Segments)
+		for _, segment := range item.Segments {
+			_, exist := filteredSegments[segment.SegmentID]
+			if exist {
+				realFilteredSegments++
+			} else {
+				newSegments = append(newSegments, segment)
 			}
 		}
-		bias := 1.0
-		if maxSegmentCount != 0 && minSegmentCount != math.MaxInt {
-			bias = float64(maxSegmentCount) / float64(minSegmentCount)
+		item.Segments = newSegments
+		sealedSegments[idx] = item
+		segmentCount := len(item.Segments)
+		if segmentCount > maxSegmentCount {
+			maxSegmentCount = segmentCount
+		}
+		if segmentCount < minSegmentCount {
+			minSegmentCount = segmentCount
+		}
+	}
+	bias := 1.0
+	if maxSegmentCount != 0 && minSegmentCount != math.MaxInt {
+		bias = float64(maxSegmentCount) / float64(minSegmentCount)
+	}
+	metrics.QueryNodeSegmentPruneBias.
+		WithLabelValues(fmt.Sprint(paramtable.GetNodeID()),
+			fmt.Sprint(collectionID),
+			pruneType,
+		).Set(bias)
+
+	filterRatio := float32(realFilteredSegments) / float32(totalSegNum)
+	metrics.QueryNodeSegmentPruneRatio.
+		WithLabelValues(fmt.Sprint(paramtable.GetNodeID()),
+			fmt.Sprint(collectionID),
+			pruneType,
+		).Set(float64(filterRatio))
+	log.Ctx(ctx).Debug("Pruned segment for search/query",
+		zap.Int("filtered_segment_num[stats]", len(filteredSegments)),
+		zap.Int("filtered_segment_num[excluded]", realFilteredSegments),
+		zap.Int("total_segment_num", totalSegNum),
+		zap.Float32("filtered_ratio", filterRatio),
+	)
+}
+
+// PruneSegmentsByZoneMap skips the sealed segments whose zone maps show that they have no rows matching
+// the range/equal/in filters of the request. Unlike PruneSegments, the zone maps are collected on every
+// scalar field at flush time, so it works for the collections without clustering key.
+// The equal/in filters on the fields enabling bloom filter are also tested against their bloom filters.
+func PruneSegmentsByZoneMap(ctx context.Context,
+	zoneMaps map[UniqueID]*SegmentZoneMap,
+	searchReq *internalpb.SearchRequest,
+	queryReq *internalpb.RetrieveRequest,
+	schema *schemapb.CollectionSchema,
+	sealedSegments []SnapshotItem,
+) {
+	if len(zoneMaps) == 0 || schema == nil {
+		return
+	}
+	_, span := otel.Tracer(typeutil.QueryNodeRole).Start(ctx, "segmentPruneByZoneMap")
+	defer span.End()
+
+	var collectionID int64
+	var exprBytes []byte
+	if searchReq != nil {
+		collectionID = searchReq.GetCollectionID()
+		exprBytes = searchReq.GetSerializedExprPlan()
+	} else {
+		collectionID = queryReq.GetCollectionID()
+		exprBytes = queryReq.GetSerializedExprPlan()
+	}
+	plan := planpb.PlanNode{}
+	if err := proto.Unmarshal(exprBytes, &plan); err != nil {
+		log.Ctx(ctx).Error("failed to unmarshall serialized expr from bytes, failed the operation")
+		return
+	}
+	exprPb, err := exprutil.ParseExprFromPlan(&plan)
+	if err != nil || exprPb == nil {
+		return
+	}
+
+	fieldTypes := make(map[FieldID]schemapb.DataType)
+	for _, field := range schema.GetFields() {
+		if storage.SupportZoneMap(field.GetDataType()) {
+			fieldTypes[field.GetFieldID()] = field.GetDataType()
 		}
-		metrics.QueryNodeSegmentPruneBias.
-			WithLabelValues(fmt.Sprint(paramtable.GetNodeID()),
-				fmt.Sprint(collectionID),
-				pruneType,
-			).Set(bias)
+	}
+	expr, err := ParseExpr(exprPb, NewMultiFieldParseContext(fieldTypes))
+	if err != nil {
+		log.Ctx(ctx).RatedWarn(10, "failed to parse expr for zone map prune, fallback to common search/query", zap.Error(err))
+		return
+	}
+	if expr == nil {
+		return
+	}
 
-		filterRatio := float32(realFilteredSegments) / float32(totalSegNum)
-		metrics.QueryNodeSegmentPruneRatio.
-			WithLabelValues(fmt.Sprint(paramtable.GetNodeID()),
-				fmt.Sprint(collectionID),
-				pruneType,
-			).Set(float64(filterRatio))
-		log.Ctx(ctx).Debug("Pruned segment for search/query",
-			zap.Int("filtered_segment_num[stats]", len(filteredSegments)),
-			zap.Int("filtered_segment_num[excluded]", realFilteredSegments),
-			zap.Int("total_segment_num", totalSegNum),
-			zap.Float32("filtered_ratio", filterRatio),
-		)
+	tr := timerecord.NewTimeRecorder("PruneSegmentsByZoneMap")
+	segmentStats := make([]storage.SegmentStats, 0, len(zoneMaps))
+	bloomFilters := make([]map[FieldID][]bloomfilter.BloomFilterInterface, 0, len(zoneMaps))
+	segmentIDs := make([]int64, 0, len(zoneMaps))
+	for _, item := range sealedSegments {
+		for _, segment := range item.Segments {
+			if zoneMap, ok := zoneMaps[segment.SegmentID]; ok {
+				segmentIDs = append(segmentIDs, segment.SegmentID)
+				segmentStats = append(segmentStats, *zoneMap.Stats)
+				bloomFilters = append(bloomFilters, zoneMap.BloomFilters)
+			}
+		}
+	}
+	if len(segmentIDs) == 0 {
+		return
 	}
+	filteredSegments := make(map[UniqueID]struct{})
+	PruneByScalarFieldWithBloomFilters(expr, segmentStats, bloomFilters, segmentIDs, filteredSegments)
+	removeFilteredSegments(ctx, collectionID, "zonemap", sealedSegments, filteredSegments)
 
 	metrics.QueryNodeSegmentPruneLatency.WithLabelValues(
 		fmt.Sprint(paramtable.GetNodeID()),
 		fmt.Sprint(collectionID),
-		pruneType).
+		"zonemap").
 		Observe(float64(tr.ElapseSpan().Milliseconds()))
-	log.Ctx(ctx).Debug("Pruned segment for search/query",
-		zap.Duration("duration", tr.ElapseSpan()))
 }
 
 type segmentDisStruct struct {
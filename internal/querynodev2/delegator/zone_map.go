@@ -0,0 +1,134 @@
+package delegator
+
+import (
+	"context"
+
+	"github.com/cockroachdb/errors"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/bloomfilter"
+	"github.com/milvus-io/milvus/pkg/v2/common"
+	"github.com/milvus-io/milvus/pkg/v2/log"
+	"github.com/milvus-io/milvus/pkg/v2/proto/querypb"
+	"github.com/milvus-io/milvus/pkg/v2/util/paramtable"
+)
+
+// SegmentZoneMap is the zone map of a sealed segment used to prune it,
+// along with the bloom filters of the fields enabling them, one for each statslog of the field.
+type SegmentZoneMap struct {
+	Stats        *storage.SegmentStats
+	BloomFilters map[FieldID][]bloomfilter.BloomFilterInterface
+}
+
+// loadZoneMaps loads the zone maps of the sealed segments from their statslogs.
+// As zone maps are an optimization like partition stats, loading them is a try-best process,
+// the segments whose zone maps cannot be loaded are just never pruned.
+func (sd *shardDelegator) loadZoneMaps(ctx context.Context, infos []*querypb.SegmentLoadInfo) {
+	if !paramtable.Get().QueryNodeCfg.EnableSegmentPrune.GetAsBool() {
+		return
+	}
+	log := sd.getLogger(ctx)
+	schema := sd.collection.Schema()
+	for _, info := range infos {
+		zoneMap, err := loadSegmentZoneMap(ctx, sd.chunkManager, schema, info)
+		if err != nil {
+			log.Warn("failed to load zone map of segment, skip it", zap.Int64("segmentID", info.GetSegmentID()), zap.Error(err))
+			continue
+		}
+		if zoneMap == nil {
+			continue
+		}
+		func() {
+			sd.zoneMapsMut.Lock()
+			defer sd.zoneMapsMut.Unlock()
+			sd.zoneMaps[info.GetSegmentID()] = zoneMap
+		}()
+	}
+}
+
+// removeZoneMaps removes the zone maps of the segments which are not in the sealed distribution anymore.
+func (sd *shardDelegator) removeZoneMaps(segmentIDs ...int64) {
+	sealed, _ := sd.distribution.PeekSegments(false)
+	serving := make(map[int64]struct{})
+	for _, item := range sealed {
+		for _, segment := range item.Segments {
+			serving[segment.SegmentID] = struct{}{}
+		}
+	}
+
+	sd.zoneMapsMut.Lock()
+	defer sd.zoneMapsMut.Unlock()
+	for _, segmentID := range segmentIDs {
+		if _, ok := serving[segmentID]; !ok {
+			delete(sd.zoneMaps, segmentID)
+		}
+	}
+}
+
+// loadSegmentZoneMap merges the zone map statslogs of each field written at every sync of the segment,
+// or the single statslog written by compaction.
+// The fields whose zone map statslogs don't cover all rows of the segment are skipped,
+// e.g. the fields added after the segment is created. The bloom filters of a field are only kept
+// if every statslog of the field has one, since the field property may be altered after some data is written.
+// Nil is returned if no field of the segment has a complete zone map.
+func loadSegmentZoneMap(ctx context.Context, chunkManager storage.ChunkManager, schema *schemapb.CollectionSchema, info *querypb.SegmentLoadInfo) (*SegmentZoneMap, error) {
+	fields := make(map[int64]*schemapb.FieldSchema)
+	for _, field := range schema.GetFields() {
+		if !common.IsSystemField(field.GetFieldID()) && !field.GetIsPrimaryKey() && storage.SupportZoneMap(field.GetDataType()) {
+			fields[field.GetFieldID()] = field
+		}
+	}
+
+	fieldStats := make([]storage.FieldStats, 0)
+	bloomFilters := make(map[FieldID][]bloomfilter.BloomFilterInterface)
+	for _, fieldBinlog := range info.GetStatslogs() {
+		field, ok := fields[fieldBinlog.GetFieldID()]
+		if !ok || len(fieldBinlog.GetBinlogs()) == 0 {
+			continue
+		}
+		var rowNum int64
+		paths := make([]string, 0, len(fieldBinlog.GetBinlogs()))
+		for _, binlog := range fieldBinlog.GetBinlogs() {
+			rowNum += binlog.GetEntriesNum()
+			paths = append(paths, binlog.GetLogPath())
+		}
+		if rowNum != info.GetNumOfRows() {
+			continue
+		}
+
+		values, err := chunkManager.MultiRead(ctx, paths)
+		if err != nil {
+			return nil, err
+		}
+		merged := storage.NewZoneMapStats(field.GetFieldID(), field.GetDataType())
+		bfs := make([]bloomfilter.BloomFilterInterface, 0, len(values))
+		for i, value := range values {
+			stats, err := storage.DeserializeFieldStats(&storage.Blob{Value: value})
+			if err != nil {
+				return nil, err
+			}
+			if len(stats) != 1 || stats[0].FieldID != field.GetFieldID() {
+				return nil, errors.Newf("invalid zone map statslog %s", paths[i])
+			}
+			merged.MergeZoneMap(stats[0], fieldBinlog.GetBinlogs()[i].GetEntriesNum())
+			if stats[0].BF != nil {
+				bfs = append(bfs, stats[0].BF)
+			}
+		}
+		fieldStats = append(fieldStats, *merged)
+		if len(bfs) == len(values) {
+			bloomFilters[field.GetFieldID()] = bfs
+		}
+	}
+	if len(fieldStats) == 0 {
+		return nil, nil
+	}
+	log.Ctx(ctx).Debug("zone map loaded", zap.Int64("segmentID", info.GetSegmentID()),
+		zap.Int("fieldNum", len(fieldStats)), zap.Int("bloomFilterFieldNum", len(bloomFilters)))
+	return &SegmentZoneMap{
+		Stats:        storage.NewSegmentStats(fieldStats, int(info.GetNumOfRows())),
+		BloomFilters: bloomFilters,
+	}, nil
+}
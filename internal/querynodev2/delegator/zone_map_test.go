@@ -0,0 +1,201 @@
+package delegator
+
+import (
+	"context"
+	"path"
+	"testing"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/parser/planparserv2"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/bloomfilter"
+	"github.com/milvus-io/milvus/internal/util/testutil"
+	"github.com/milvus-io/milvus/pkg/v2/proto/datapb"
+	"github.com/milvus-io/milvus/pkg/v2/proto/internalpb"
+	"github.com/milvus-io/milvus/pkg/v2/proto/querypb"
+	"github.com/milvus-io/milvus/pkg/v2/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/v2/util/typeutil"
+)
+
+func newZoneMapTestSchema() (*schemapb.CollectionSchema, map[string]int64) {
+	fieldName2DataType := map[string]schemapb.DataType{
+		"pk":   schemapb.DataType_Int64,
+		"age":  schemapb.DataType_Int64,
+		"info": schemapb.DataType_VarChar,
+		"vec":  schemapb.DataType_FloatVector,
+	}
+	schema := testutil.ConstructCollectionSchemaWithKeys("test_zone_map", fieldName2DataType, "pk", "", "", false, 8)
+	fieldIDs := lo.SliceToMap(schema.GetFields(), func(field *schemapb.FieldSchema) (string, int64) {
+		return field.GetName(), field.GetFieldID()
+	})
+	return schema, fieldIDs
+}
+
+func TestPruneSegmentsByZoneMap(t *testing.T) {
+	paramtable.Init()
+	schema, fieldIDs := newZoneMapTestSchema()
+	ageStats := func(min, max int64) storage.FieldStats {
+		return storage.FieldStats{
+			FieldID: fieldIDs["age"],
+			Type:    schemapb.DataType_Int64,
+			Min:     storage.NewInt64FieldValue(min),
+			Max:     storage.NewInt64FieldValue(max),
+		}
+	}
+	infoStats := func(min, max string) storage.FieldStats {
+		return storage.FieldStats{
+			FieldID: fieldIDs["info"],
+			Type:    schemapb.DataType_VarChar,
+			Min:     storage.NewVarCharFieldValue(min),
+			Max:     storage.NewVarCharFieldValue(max),
+		}
+	}
+	// segment 3 has the bloom filters of info built by two syncs
+	infoBFs := make([]bloomfilter.BloomFilterInterface, 0)
+	for _, values := range [][]string{{"a", "k"}, {"m", "z"}} {
+		bf := bloomfilter.NewBloomFilterWithType(100, 0.001, bloomfilter.BlockBFName)
+		for _, value := range values {
+			bf.AddString(value)
+		}
+		infoBFs = append(infoBFs, bf)
+	}
+	zoneMaps := map[UniqueID]*SegmentZoneMap{
+		1: {Stats: storage.NewSegmentStats([]storage.FieldStats{ageStats(0, 10), infoStats("a", "c")}, 100)},
+		2: {Stats: storage.NewSegmentStats([]storage.FieldStats{ageStats(20, 30), infoStats("d", "f")}, 100)},
+		3: {
+			Stats: storage.NewSegmentStats([]storage.FieldStats{
+				// all values of age are null
+				{FieldID: fieldIDs["age"], Type: schemapb.DataType_Int64, NullCount: 100},
+				infoStats("a", "z"),
+			}, 100),
+			BloomFilters: map[FieldID][]bloomfilter.BloomFilterInterface{fieldIDs["info"]: infoBFs},
+		},
+		// segment 4 has no zone map
+	}
+	sealedSegments := []SnapshotItem{
+		{NodeID: 1, Segments: []SegmentEntry{{NodeID: 1, SegmentID: 1}, {NodeID: 1, SegmentID: 2}}},
+		{NodeID: 2, Segments: []SegmentEntry{{NodeID: 2, SegmentID: 3}, {NodeID: 2, SegmentID: 4}}},
+	}
+
+	prune := func(exprStr string) []int64 {
+		schemaHelper, err := typeutil.CreateSchemaHelper(schema)
+		require.NoError(t, err)
+		planNode, err := planparserv2.CreateRetrievePlan(schemaHelper, exprStr, nil)
+		require.NoError(t, err)
+		serializedPlan, err := proto.Marshal(planNode)
+		require.NoError(t, err)
+		testSegments := make([]SnapshotItem, len(sealedSegments))
+		copy(testSegments, sealedSegments)
+		PruneSegmentsByZoneMap(context.TODO(), zoneMaps, nil, &internalpb.RetrieveRequest{SerializedExprPlan: serializedPlan}, schema, testSegments)
+		return lo.FlatMap(testSegments, func(item SnapshotItem, _ int) []int64 {
+			return lo.Map(item.Segments, func(segment SegmentEntry, _ int) int64 { return segment.SegmentID })
+		})
+	}
+
+	assert.ElementsMatch(t, []int64{1, 4}, prune("age < 15"))
+	assert.ElementsMatch(t, []int64{2, 4}, prune("age >= 15 and age <= 25"))
+	assert.ElementsMatch(t, []int64{2, 4}, prune("age == 25"))
+	assert.ElementsMatch(t, []int64{1, 4}, prune("age in [100, 5]"))
+	assert.ElementsMatch(t, []int64{1, 4}, prune(`age > 5 and info < "b"`))
+	// the bloom filters of segment 3 show that it doesn't contain "e"
+	assert.ElementsMatch(t, []int64{2, 4}, prune(`info == "e"`))
+	assert.ElementsMatch(t, []int64{2, 3, 4}, prune(`info in ["e", "m"]`))
+	assert.ElementsMatch(t, []int64{3, 4}, prune(`info == "z"`))
+	// the bloom filters are not used for range filters
+	assert.ElementsMatch(t, []int64{3, 4}, prune(`info > "g"`))
+	assert.ElementsMatch(t, []int64{1, 2, 4}, prune(`age == 5 or info == "e"`))
+	// unsupported filters never prune
+	assert.ElementsMatch(t, []int64{1, 2, 3, 4}, prune("age != 5"))
+	assert.ElementsMatch(t, []int64{1, 2, 3, 4}, prune("not (age < 15)"))
+	assert.ElementsMatch(t, []int64{1, 2, 3, 4}, prune("pk < 0"))
+	assert.ElementsMatch(t, []int64{1, 2, 3, 4}, prune(`age > 100 or info like "x%"`))
+}
+
+func TestLoadSegmentZoneMap(t *testing.T) {
+	paramtable.Init()
+	ctx := context.Background()
+	schema, fieldIDs := newZoneMapTestSchema()
+	rootPath := t.TempDir()
+	cm := storage.NewLocalChunkManager(storage.RootPath(rootPath))
+
+	writeBatch := func(name string, data storage.FieldData) *datapb.Binlog {
+		stats := storage.NewZoneMapStats(fieldIDs["age"], schemapb.DataType_Int64)
+		stats.UpdateZoneMap(data)
+		blob, err := storage.SerializeZoneMap(stats, int64(data.RowNum()))
+		require.NoError(t, err)
+		p := path.Join(rootPath, name)
+		require.NoError(t, cm.Write(ctx, p, blob.GetValue()))
+		return &datapb.Binlog{EntriesNum: blob.RowNum, LogPath: p}
+	}
+	binlogs := []*datapb.Binlog{
+		writeBatch("batch1", &storage.Int64FieldData{Data: []int64{5, 7}, ValidData: []bool{true, true}, Nullable: true}),
+		writeBatch("batch2", &storage.Int64FieldData{Data: []int64{0, 0}, ValidData: []bool{false, false}, Nullable: true}),
+		writeBatch("batch3", &storage.Int64FieldData{Data: []int64{9, 0}, ValidData: []bool{true, false}, Nullable: true}),
+	}
+	info := &querypb.SegmentLoadInfo{
+		SegmentID: 1,
+		NumOfRows: 6,
+		Statslogs: []*datapb.FieldBinlog{
+			{FieldID: fieldIDs["pk"], Binlogs: []*datapb.Binlog{{EntriesNum: 6, LogPath: "pk_stats_not_read"}}},
+			{FieldID: fieldIDs["age"], Binlogs: binlogs},
+		},
+	}
+
+	zoneMap, err := loadSegmentZoneMap(ctx, cm, schema, info)
+	require.NoError(t, err)
+	require.NotNil(t, zoneMap)
+	assert.Equal(t, 6, zoneMap.Stats.NumRows)
+	require.Len(t, zoneMap.Stats.FieldStats, 1)
+	assert.Equal(t, fieldIDs["age"], zoneMap.Stats.FieldStats[0].FieldID)
+	assert.Equal(t, int64(5), zoneMap.Stats.FieldStats[0].Min.GetValue())
+	assert.Equal(t, int64(9), zoneMap.Stats.FieldStats[0].Max.GetValue())
+	assert.Equal(t, int64(3), zoneMap.Stats.FieldStats[0].NullCount)
+	assert.Empty(t, zoneMap.BloomFilters)
+
+	writeInfoBatch := func(name string, withBF bool, values ...string) *datapb.Binlog {
+		stats := storage.NewZoneMapStats(fieldIDs["info"], schemapb.DataType_VarChar)
+		if withBF {
+			stats.BF = bloomfilter.NewBloomFilterWithType(uint(len(values)), 0.001, bloomfilter.BlockBFName)
+		}
+		stats.UpdateZoneMap(&storage.StringFieldData{Data: values})
+		blob, err := storage.SerializeZoneMap(stats, int64(len(values)))
+		require.NoError(t, err)
+		p := path.Join(rootPath, name)
+		require.NoError(t, cm.Write(ctx, p, blob.GetValue()))
+		return &datapb.Binlog{EntriesNum: blob.RowNum, LogPath: p}
+	}
+	infoBinlogs := &datapb.FieldBinlog{FieldID: fieldIDs["info"], Binlogs: []*datapb.Binlog{
+		writeInfoBatch("info1", true, "a", "b", "c"),
+		writeInfoBatch("info2", true, "x", "y", "z"),
+	}}
+	info.Statslogs = append(info.Statslogs, infoBinlogs)
+	zoneMap, err = loadSegmentZoneMap(ctx, cm, schema, info)
+	require.NoError(t, err)
+	require.Len(t, zoneMap.Stats.FieldStats, 2)
+	require.Len(t, zoneMap.BloomFilters[fieldIDs["info"]], 2)
+	assert.True(t, zoneMap.BloomFilters[fieldIDs["info"]][1].TestString("y"))
+
+	// the bloom filter is enabled after some data is written
+	infoBinlogs.Binlogs[0] = writeInfoBatch("info1", false, "a", "b", "c")
+	zoneMap, err = loadSegmentZoneMap(ctx, cm, schema, info)
+	require.NoError(t, err)
+	require.Len(t, zoneMap.Stats.FieldStats, 2)
+	assert.Empty(t, zoneMap.BloomFilters)
+
+	// the zone map doesn't cover all rows of the segment, e.g. written by compaction
+	info.NumOfRows = 10
+	zoneMap, err = loadSegmentZoneMap(ctx, cm, schema, info)
+	assert.NoError(t, err)
+	assert.Nil(t, zoneMap)
+
+	// broken statslog
+	info.NumOfRows = 6
+	require.NoError(t, cm.Write(ctx, binlogs[1].GetLogPath(), []byte("invalid")))
+	_, err = loadSegmentZoneMap(ctx, cm, schema, info)
+	assert.Error(t, err)
+}
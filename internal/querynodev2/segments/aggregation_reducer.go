@@ -0,0 +1,103 @@
+package segments
+
+import (
+	"context"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/util/reduce"
+	"github.com/milvus-io/milvus/pkg/v2/proto/internalpb"
+	"github.com/milvus-io/milvus/pkg/v2/proto/querypb"
+	"github.com/milvus-io/milvus/pkg/v2/proto/segcorepb"
+	"github.com/milvus-io/milvus/pkg/v2/util/merr"
+	"github.com/milvus-io/milvus/pkg/v2/util/typeutil"
+)
+
+// aggregationReducer merges the partial aggregation states of the groups.
+type aggregationReducer struct {
+	req    *querypb.QueryRequest
+	schema *schemapb.CollectionSchema
+}
+
+func (r *aggregationReducer) Reduce(ctx context.Context, results []*internalpb.RetrieveResults) (*internalpb.RetrieveResults, error) {
+	aggregator, err := reduce.NewGroupAggregator(r.schema, r.req.GetReq().GetGroupByFieldIds(), r.req.GetReq().GetAggregates())
+	if err != nil {
+		return nil, err
+	}
+
+	ret := &internalpb.RetrieveResults{
+		Status: merr.Success(),
+		Ids:    &schemapb.IDs{},
+	}
+	relatedDataSize := int64(0)
+	for _, result := range results {
+		ret.AllRetrieveCount += result.GetAllRetrieveCount()
+		relatedDataSize += result.GetCostAggregation().GetTotalRelatedDataSize()
+		if err := aggregator.AddPartials(result.GetFieldsData()); err != nil {
+			return nil, err
+		}
+	}
+	ret.FieldsData = aggregator.Partials()
+	ret.CostAggregation = mergeRetrieveCost(results, relatedDataSize)
+	return ret, nil
+}
+
+func newAggregationReducer(req *querypb.QueryRequest, schema *schemapb.CollectionSchema) *aggregationReducer {
+	return &aggregationReducer{
+		req:    req,
+		schema: schema,
+	}
+}
+
+// aggregationReducerSegcore merges the partial states aggregated on each segment by aggregateSegmentResult.
+type aggregationReducerSegcore struct {
+	req    *querypb.QueryRequest
+	schema *schemapb.CollectionSchema
+}
+
+func (r *aggregationReducerSegcore) Reduce(ctx context.Context, results []*segcorepb.RetrieveResults, _ []Segment, _ *RetrievePlan) (*segcorepb.RetrieveResults, error) {
+	aggregator, err := reduce.NewGroupAggregator(r.schema, r.req.GetReq().GetGroupByFieldIds(), r.req.GetReq().GetAggregates())
+	if err != nil {
+		return nil, err
+	}
+
+	ret := &segcorepb.RetrieveResults{
+		Ids: &schemapb.IDs{},
+	}
+	for _, result := range results {
+		ret.AllRetrieveCount += result.GetAllRetrieveCount()
+		if err := aggregator.AddPartials(result.GetFieldsData()); err != nil {
+			return nil, err
+		}
+	}
+	ret.FieldsData = aggregator.Partials()
+	return ret, nil
+}
+
+func newAggregationReducerSegcore(req *querypb.QueryRequest, schema *schemapb.CollectionSchema) *aggregationReducerSegcore {
+	return &aggregationReducerSegcore{
+		req:    req,
+		schema: schema,
+	}
+}
+
+// aggregateSegmentResult aggregates the rows retrieved from a segment into partial states,
+// so that the matched rows of all the segments are not held until reducing.
+// Rows are not deduplicated by primary key across segments, see reduce.GroupAggregator.
+func aggregateSegmentResult(result *segcorepb.RetrieveResults, schema *schemapb.CollectionSchema, req *querypb.QueryRequest) (*segcorepb.RetrieveResults, error) {
+	aggregator, err := reduce.NewGroupAggregator(schema, req.GetReq().GetGroupByFieldIds(), req.GetReq().GetAggregates())
+	if err != nil {
+		return nil, err
+	}
+	if size := typeutil.GetSizeOfIDs(result.GetIds()); size > 0 {
+		if err := aggregator.AddRows(result.GetFieldsData(), size); err != nil {
+			return nil, err
+		}
+	}
+	return &segcorepb.RetrieveResults{
+		Ids:              &schemapb.IDs{},
+		Offset:           result.GetOffset(),
+		FieldsData:       aggregator.Partials(),
+		AllRetrieveCount: result.GetAllRetrieveCount(),
+		HasMoreResult:    result.GetHasMoreResult(),
+	}, nil
+}
@@ -0,0 +1,110 @@
+package segments
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/pkg/v2/proto/internalpb"
+	"github.com/milvus-io/milvus/pkg/v2/proto/querypb"
+	"github.com/milvus-io/milvus/pkg/v2/proto/segcorepb"
+	"github.com/milvus-io/milvus/pkg/v2/util/paramtable"
+)
+
+type AggregationReducerSuite struct {
+	suite.Suite
+	schema *schemapb.CollectionSchema
+	req    *querypb.QueryRequest
+}
+
+func TestAggregationReducerSuite(t *testing.T) {
+	suite.Run(t, new(AggregationReducerSuite))
+}
+
+func (suite *AggregationReducerSuite) SetupSuite() {
+	paramtable.Init()
+}
+
+func (suite *AggregationReducerSuite) SetupTest() {
+	suite.schema = &schemapb.CollectionSchema{
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: 100, Name: "pk", DataType: schemapb.DataType_Int64, IsPrimaryKey: true},
+			{FieldID: 101, Name: "category", DataType: schemapb.DataType_Int64},
+		},
+	}
+	suite.req = &querypb.QueryRequest{
+		Req: &internalpb.RetrieveRequest{
+			OutputFieldsId:  []int64{100, 101},
+			GroupByFieldIds: []int64{101},
+			Aggregates:      []*internalpb.Aggregate{{Op: internalpb.AggregateOp_Count}, {Op: internalpb.AggregateOp_Max, FieldID: 100}},
+		},
+	}
+}
+
+func (suite *AggregationReducerSuite) genSegcoreResult(pks []int64, categories []int64) *segcorepb.RetrieveResults {
+	return &segcorepb.RetrieveResults{
+		Ids:    &schemapb.IDs{IdField: &schemapb.IDs_IntId{IntId: &schemapb.LongArray{Data: pks}}},
+		Offset: pks,
+		FieldsData: []*schemapb.FieldData{
+			{
+				Type:    schemapb.DataType_Int64,
+				FieldId: 100,
+				Field: &schemapb.FieldData_Scalars{Scalars: &schemapb.ScalarField{
+					Data: &schemapb.ScalarField_LongData{LongData: &schemapb.LongArray{Data: pks}},
+				}},
+			},
+			{
+				Type:    schemapb.DataType_Int64,
+				FieldId: 101,
+				Field: &schemapb.FieldData_Scalars{Scalars: &schemapb.ScalarField{
+					Data: &schemapb.ScalarField_LongData{LongData: &schemapb.LongArray{Data: categories}},
+				}},
+			},
+		},
+		AllRetrieveCount: int64(len(pks)),
+	}
+}
+
+func (suite *AggregationReducerSuite) TestReduce() {
+	segcoreReducer := newAggregationReducerSegcore(suite.req, suite.schema)
+	partials := make([]*internalpb.RetrieveResults, 0)
+	for _, results := range [][]*segcorepb.RetrieveResults{
+		{suite.genSegcoreResult([]int64{1, 2, 3}, []int64{1, 2, 1}), {}},
+		{suite.genSegcoreResult([]int64{4, 5}, []int64{2, 3})},
+	} {
+		segmentPartials := make([]*segcorepb.RetrieveResults, 0, len(results))
+		for _, result := range results {
+			partial, err := aggregateSegmentResult(result, suite.schema, suite.req)
+			suite.NoError(err)
+			suite.Equal(result.GetOffset(), partial.GetOffset())
+			segmentPartials = append(segmentPartials, partial)
+		}
+		ret, err := segcoreReducer.Reduce(context.TODO(), segmentPartials, nil, nil)
+		suite.NoError(err)
+		partials = append(partials, &internalpb.RetrieveResults{
+			FieldsData:       ret.GetFieldsData(),
+			AllRetrieveCount: ret.GetAllRetrieveCount(),
+		})
+	}
+
+	ret, err := newAggregationReducer(suite.req, suite.schema).Reduce(context.TODO(), partials)
+	suite.NoError(err)
+	suite.Equal(int64(5), ret.GetAllRetrieveCount())
+	// category, count(*), max(pk) and its count
+	suite.Equal(4, len(ret.GetFieldsData()))
+	suite.Equal([]int64{1, 2, 3}, ret.GetFieldsData()[0].GetScalars().GetLongData().GetData())
+	suite.Equal([]int64{2, 2, 1}, ret.GetFieldsData()[1].GetScalars().GetLongData().GetData())
+	suite.Equal([]int64{3, 4, 5}, ret.GetFieldsData()[2].GetScalars().GetLongData().GetData())
+}
+
+func (suite *AggregationReducerSuite) TestReduceInvalid() {
+	suite.req.Req.GroupByFieldIds = []int64{102}
+	_, err := newAggregationReducerSegcore(suite.req, suite.schema).Reduce(context.TODO(), nil, nil, nil)
+	suite.Error(err)
+	_, err = aggregateSegmentResult(suite.genSegcoreResult([]int64{1}, []int64{1}), suite.schema, suite.req)
+	suite.Error(err)
+	_, err = newAggregationReducer(suite.req, suite.schema).Reduce(context.TODO(), nil)
+	suite.Error(err)
+}
@@ -0,0 +1,168 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package segments
+
+import (
+	"container/list"
+	"fmt"
+	"sync"
+
+	"github.com/RoaringBitmap/roaring"
+
+	"github.com/milvus-io/milvus/pkg/v2/metrics"
+	"github.com/milvus-io/milvus/pkg/v2/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/v2/util/typeutil"
+)
+
+var (
+	filterCache     *FilterCache
+	filterCacheOnce sync.Once
+)
+
+// GetFilterCache returns the singleton filter cache of the querynode.
+func GetFilterCache() *FilterCache {
+	filterCacheOnce.Do(func() {
+		filterCache = NewFilterCache(paramtable.Get().QueryNodeCfg.FilterCacheSize.GetAsInt64())
+	})
+	return filterCache
+}
+
+type filterCacheKey struct {
+	segmentID int64
+	exprHash  string
+}
+
+type filterCacheEntry struct {
+	key    filterCacheKey
+	bitmap *roaring.Bitmap
+	// ts is the mvcc timestamp the filter result is computed at
+	ts   uint64
+	size int64
+}
+
+// FilterCache caches the matched row offsets of sealed segments keyed by (segment, expression hash),
+// along with the mvcc timestamp they are computed at.
+// Entries are evicted in LRU order once the memory used exceeds the capacity.
+type FilterCache struct {
+	mu       sync.Mutex
+	capacity int64
+	size     int64
+	entries  map[filterCacheKey]*list.Element
+	segments map[int64]typeutil.Set[string]
+	lru      *list.List
+}
+
+func NewFilterCache(capacity int64) *FilterCache {
+	return &FilterCache{
+		capacity: capacity,
+		entries:  make(map[filterCacheKey]*list.Element),
+		segments: make(map[int64]typeutil.Set[string]),
+		lru:      list.New(),
+	}
+}
+
+// Get returns the cached filter result of the segment for the read at ts, the returned bitmap must not be modified.
+// The result is only served if it's computed no later than ts, and not earlier than deltaTs,
+// the timestamp of the last delete applied to the segment, otherwise the rows visible at ts may differ.
+func (c *FilterCache) Get(segmentID int64, exprHash string, ts uint64, deltaTs uint64) (*roaring.Bitmap, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	nodeID := fmt.Sprint(paramtable.GetNodeID())
+	elem, ok := c.entries[filterCacheKey{segmentID: segmentID, exprHash: exprHash}]
+	if !ok || elem.Value.(*filterCacheEntry).ts > ts || elem.Value.(*filterCacheEntry).ts < deltaTs {
+		metrics.QueryNodeFilterCacheMissTotal.WithLabelValues(nodeID).Inc()
+		return nil, false
+	}
+	c.lru.MoveToFront(elem)
+	metrics.QueryNodeFilterCacheHitTotal.WithLabelValues(nodeID).Inc()
+	return elem.Value.(*filterCacheEntry).bitmap, true
+}
+
+// Put caches the filter result of the segment computed at the mvcc timestamp ts,
+// results larger than the capacity are ignored.
+func (c *FilterCache) Put(segmentID int64, exprHash string, ts uint64, bitmap *roaring.Bitmap) {
+	size := int64(bitmap.GetSizeInBytes())
+	if size > c.capacity {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	key := filterCacheKey{segmentID: segmentID, exprHash: exprHash}
+	if elem, ok := c.entries[key]; ok {
+		c.remove(elem)
+	}
+	for c.size+size > c.capacity {
+		c.remove(c.lru.Back())
+	}
+
+	c.entries[key] = c.lru.PushFront(&filterCacheEntry{
+		key:    key,
+		bitmap: bitmap,
+		ts:     ts,
+		size:   size,
+	})
+	if _, ok := c.segments[segmentID]; !ok {
+		c.segments[segmentID] = typeutil.NewSet[string]()
+	}
+	c.segments[segmentID].Insert(exprHash)
+	c.size += size
+	c.updateMetrics()
+}
+
+// Invalidate drops all the filter results of the segment,
+// it shall be called once the segment is released or its deleted rows changed.
+func (c *FilterCache) Invalidate(segmentID int64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	hashes, ok := c.segments[segmentID]
+	if !ok {
+		return
+	}
+	for exprHash := range hashes {
+		if elem, ok := c.entries[filterCacheKey{segmentID: segmentID, exprHash: exprHash}]; ok {
+			c.remove(elem)
+		}
+	}
+	c.updateMetrics()
+}
+
+// Size returns the memory used by the cached filter results.
+func (c *FilterCache) Size() int64 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.size
+}
+
+func (c *FilterCache) remove(elem *list.Element) {
+	entry := c.lru.Remove(elem).(*filterCacheEntry)
+	delete(c.entries, entry.key)
+	if hashes, ok := c.segments[entry.key.segmentID]; ok {
+		hashes.Remove(entry.key.exprHash)
+		if hashes.Len() == 0 {
+			delete(c.segments, entry.key.segmentID)
+		}
+	}
+	c.size -= entry.size
+}
+
+func (c *FilterCache) updateMetrics() {
+	metrics.QueryNodeFilterCacheSize.WithLabelValues(fmt.Sprint(paramtable.GetNodeID())).Set(float64(c.size))
+}
@@ -0,0 +1,124 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package segments
+
+import (
+	"testing"
+
+	"github.com/RoaringBitmap/roaring"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/milvus-io/milvus/pkg/v2/util/paramtable"
+)
+
+type FilterCacheSuite struct {
+	suite.Suite
+}
+
+func TestFilterCacheSuite(t *testing.T) {
+	suite.Run(t, new(FilterCacheSuite))
+}
+
+func (suite *FilterCacheSuite) SetupSuite() {
+	paramtable.Init()
+}
+
+func (suite *FilterCacheSuite) TestGetPut() {
+	cache := NewFilterCache(1024)
+
+	_, ok := cache.Get(100, "expr", 10, 0)
+	suite.False(ok)
+
+	bitmap := roaring.BitmapOf(1, 2, 3)
+	cache.Put(100, "expr", 10, bitmap)
+	got, ok := cache.Get(100, "expr", 10, 0)
+	suite.True(ok)
+	suite.Equal(uint64(3), got.GetCardinality())
+	suite.Equal(int64(bitmap.GetSizeInBytes()), cache.Size())
+
+	// overwrite the same key
+	cache.Put(100, "expr", 10, roaring.BitmapOf(1))
+	got, ok = cache.Get(100, "expr", 10, 0)
+	suite.True(ok)
+	suite.Equal(uint64(1), got.GetCardinality())
+
+	_, ok = cache.Get(101, "expr", 10, 0)
+	suite.False(ok)
+	_, ok = cache.Get(100, "other", 10, 0)
+	suite.False(ok)
+}
+
+func (suite *FilterCacheSuite) TestEvict() {
+	bitmap := roaring.BitmapOf(1, 2, 3)
+	size := int64(bitmap.GetSizeInBytes())
+	cache := NewFilterCache(size * 2)
+
+	cache.Put(100, "expr", 10, bitmap)
+	cache.Put(101, "expr", 10, roaring.BitmapOf(1, 2, 3))
+	// touch segment 100 so that segment 101 is the least recently used
+	_, ok := cache.Get(100, "expr", 10, 0)
+	suite.True(ok)
+	cache.Put(102, "expr", 10, roaring.BitmapOf(1, 2, 3))
+
+	_, ok = cache.Get(100, "expr", 10, 0)
+	suite.True(ok)
+	_, ok = cache.Get(101, "expr", 10, 0)
+	suite.False(ok)
+	_, ok = cache.Get(102, "expr", 10, 0)
+	suite.True(ok)
+	suite.Equal(size*2, cache.Size())
+
+	// too large to cache
+	cache = NewFilterCache(1)
+	cache.Put(100, "expr", 10, bitmap)
+	_, ok = cache.Get(100, "expr", 10, 0)
+	suite.False(ok)
+}
+
+func (suite *FilterCacheSuite) TestInvalidate() {
+	cache := NewFilterCache(1024)
+	cache.Put(100, "expr1", 10, roaring.BitmapOf(1))
+	cache.Put(100, "expr2", 10, roaring.BitmapOf(2))
+	cache.Put(101, "expr1", 10, roaring.BitmapOf(3))
+
+	cache.Invalidate(100)
+	_, ok := cache.Get(100, "expr1", 10, 0)
+	suite.False(ok)
+	_, ok = cache.Get(100, "expr2", 10, 0)
+	suite.False(ok)
+	_, ok = cache.Get(101, "expr1", 10, 0)
+	suite.True(ok)
+
+	cache.Invalidate(101)
+	suite.Equal(int64(0), cache.Size())
+	cache.Invalidate(102)
+}
+
+func (suite *FilterCacheSuite) TestTimestamp() {
+	cache := NewFilterCache(1024)
+	cache.Put(100, "expr", 10, roaring.BitmapOf(1, 2, 3))
+
+	// reads at later timestamps are served
+	_, ok := cache.Get(100, "expr", 20, 5)
+	suite.True(ok)
+	// reads at earlier timestamps may see different rows
+	_, ok = cache.Get(100, "expr", 9, 0)
+	suite.False(ok)
+	// rows are deleted after the result is computed
+	_, ok = cache.Get(100, "expr", 20, 15)
+	suite.False(ok)
+}
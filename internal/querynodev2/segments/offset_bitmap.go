@@ -0,0 +1,39 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package segments
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/RoaringBitmap/roaring"
+
+	"github.com/milvus-io/milvus/pkg/v2/util/merr"
+)
+
+// NewOffsetBitmap builds a roaring bitmap from the matched row offsets of a segment.
+func NewOffsetBitmap(offsets []int64) (*roaring.Bitmap, error) {
+	bitmap := roaring.New()
+	for _, offset := range offsets {
+		if offset < 0 || offset > math.MaxUint32 {
+			return nil, merr.WrapErrServiceInternal(fmt.Sprintf("segment offset %d out of range of offset bitmap", offset))
+		}
+		bitmap.Add(uint32(offset))
+	}
+	bitmap.RunOptimize()
+	return bitmap, nil
+}
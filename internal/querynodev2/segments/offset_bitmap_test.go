@@ -0,0 +1,45 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package segments
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type OffsetBitmapSuite struct {
+	suite.Suite
+}
+
+func TestOffsetBitmapSuite(t *testing.T) {
+	suite.Run(t, new(OffsetBitmapSuite))
+}
+
+func (suite *OffsetBitmapSuite) TestNewOffsetBitmap() {
+	bitmap, err := NewOffsetBitmap([]int64{1, 3, 5, 3})
+	suite.NoError(err)
+	suite.Equal(uint64(3), bitmap.GetCardinality())
+	suite.True(bitmap.Contains(5))
+
+	_, err = NewOffsetBitmap([]int64{-1})
+	suite.Error(err)
+
+	_, err = NewOffsetBitmap([]int64{math.MaxUint32 + 1})
+	suite.Error(err)
+}
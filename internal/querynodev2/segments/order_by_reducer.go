@@ -0,0 +1,188 @@
+package segments
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/samber/lo"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/util/reduce"
+	"github.com/milvus-io/milvus/internal/util/segcore"
+	typeutil2 "github.com/milvus-io/milvus/internal/util/typeutil"
+	"github.com/milvus-io/milvus/pkg/v2/common"
+	"github.com/milvus-io/milvus/pkg/v2/proto/internalpb"
+	"github.com/milvus-io/milvus/pkg/v2/proto/planpb"
+	"github.com/milvus-io/milvus/pkg/v2/proto/querypb"
+	"github.com/milvus-io/milvus/pkg/v2/proto/segcorepb"
+	"github.com/milvus-io/milvus/pkg/v2/util/merr"
+	"github.com/milvus-io/milvus/pkg/v2/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/v2/util/typeutil"
+)
+
+// newOrderBySortPlan creates the plan retrieving only the order by fields and timestamps of the matched rows,
+// so that the output fields are materialized for the top rows of each segment only.
+func newOrderBySortPlan(collection *Collection, req *querypb.QueryRequest) (*RetrievePlan, error) {
+	plan := &planpb.PlanNode{}
+	if err := proto.Unmarshal(req.GetReq().GetSerializedExprPlan(), plan); err != nil {
+		return nil, err
+	}
+	plan.OutputFieldIds = lo.Map(req.GetReq().GetOrderByFields(), func(orderBy *internalpb.OrderByField, _ int) int64 {
+		return orderBy.GetFieldID()
+	})
+	plan.OutputFieldIds = append(plan.OutputFieldIds, common.TimeStampField)
+	plan.DynamicFields = nil
+	serializedPlan, err := proto.Marshal(plan)
+	if err != nil {
+		return nil, err
+	}
+	return segcore.NewRetrievePlan(collection.GetCCollection(), serializedPlan, req.GetReq().GetMvccTimestamp(), req.GetReq().GetBase().GetMsgID())
+}
+
+// retrieveTopByOrder sorts the matched rows of the segment by the order by fields retrieved with sortPlan,
+// then retrieves the output fields of the top limit rows by their offsets with plan.
+func retrieveTopByOrder(ctx context.Context, s Segment, plan *RetrievePlan, sortPlan *RetrievePlan, req *querypb.QueryRequest) (*segcorepb.RetrieveResults, error) {
+	sorted, err := s.Retrieve(ctx, sortPlan)
+	if err != nil {
+		return nil, err
+	}
+	if len(sorted.GetOffset()) == 0 || typeutil.GetSizeOfIDs(sorted.GetIds()) == 0 {
+		return sorted, nil
+	}
+
+	selections, err := reduce.SelectByOrder([]*segcorepb.RetrieveResults{sorted}, req.GetReq().GetOrderByFields(), req.GetReq().GetLimit())
+	if err != nil {
+		return nil, err
+	}
+	ret := &segcorepb.RetrieveResults{
+		Ids:              &schemapb.IDs{},
+		Offset:           make([]int64, 0, len(selections)),
+		AllRetrieveCount: sorted.GetAllRetrieveCount(),
+		HasMoreResult:    sorted.GetHasMoreResult(),
+	}
+	for _, sel := range selections {
+		typeutil.AppendPKs(ret.Ids, typeutil.GetPK(sorted.GetIds(), sel.ResultIndex))
+		ret.Offset = append(ret.Offset, sorted.GetOffset()[sel.ResultIndex])
+	}
+
+	// the output fields are returned in the order of offsets
+	result, err := s.RetrieveByOffsets(ctx, &segcore.RetrievePlanWithOffsets{
+		RetrievePlan: plan,
+		Offsets:      ret.Offset,
+	})
+	if err != nil {
+		return nil, err
+	}
+	ret.FieldsData = result.GetFieldsData()
+	return ret, nil
+}
+
+// orderByReducer keeps the top limit rows sorted by the order by fields,
+// each segment has returned its own top limit rows.
+type orderByReducer struct {
+	req    *querypb.QueryRequest
+	schema *schemapb.CollectionSchema
+}
+
+func (r *orderByReducer) Reduce(ctx context.Context, results []*internalpb.RetrieveResults) (*internalpb.RetrieveResults, error) {
+	ret := &internalpb.RetrieveResults{
+		Status: merr.Success(),
+		Ids:    &schemapb.IDs{},
+	}
+
+	validResults := make([]*internalpb.RetrieveResults, 0, len(results))
+	relatedDataSize := int64(0)
+	for _, result := range results {
+		ret.AllRetrieveCount += result.GetAllRetrieveCount()
+		relatedDataSize += result.GetCostAggregation().GetTotalRelatedDataSize()
+		ret.HasMoreResult = ret.HasMoreResult || result.GetHasMoreResult()
+		if result == nil || len(result.GetFieldsData()) == 0 || typeutil.GetSizeOfIDs(result.GetIds()) == 0 {
+			continue
+		}
+		validResults = append(validResults, result)
+	}
+	ret.CostAggregation = mergeRetrieveCost(results, relatedDataSize)
+
+	if len(validResults) > 0 {
+		fieldsData, err := selectByOrder(ret.Ids, validResults, r.req.GetReq().GetOrderByFields(), r.req.GetReq().GetLimit())
+		if err != nil {
+			return nil, err
+		}
+		ret.FieldsData = fieldsData
+	}
+
+	if err := typeutil2.FillRetrieveResultIfEmpty(typeutil2.NewInternalResult(ret), r.req.GetReq().GetOutputFieldsId(), r.schema); err != nil {
+		return nil, fmt.Errorf("failed to fill internal retrieve results: %s", err.Error())
+	}
+	return ret, nil
+}
+
+func newOrderByReducer(req *querypb.QueryRequest, schema *schemapb.CollectionSchema) *orderByReducer {
+	return &orderByReducer{
+		req:    req,
+		schema: schema,
+	}
+}
+
+type orderByReducerSegcore struct {
+	req    *querypb.QueryRequest
+	schema *schemapb.CollectionSchema
+}
+
+func (r *orderByReducerSegcore) Reduce(ctx context.Context, results []*segcorepb.RetrieveResults, segments []Segment, plan *RetrievePlan) (*segcorepb.RetrieveResults, error) {
+	ret := &segcorepb.RetrieveResults{
+		Ids: &schemapb.IDs{},
+	}
+
+	validResults := make([]*segcorepb.RetrieveResults, 0, len(results))
+	for _, result := range results {
+		ret.AllRetrieveCount += result.GetAllRetrieveCount()
+		ret.HasMoreResult = ret.HasMoreResult || result.GetHasMoreResult()
+		if result == nil || len(result.GetOffset()) == 0 || typeutil.GetSizeOfIDs(result.GetIds()) == 0 {
+			continue
+		}
+		validResults = append(validResults, result)
+	}
+
+	if len(validResults) > 0 {
+		fieldsData, err := selectByOrder(ret.Ids, validResults, r.req.GetReq().GetOrderByFields(), r.req.GetReq().GetLimit())
+		if err != nil {
+			return nil, err
+		}
+		ret.FieldsData = fieldsData
+	}
+
+	if err := typeutil2.FillRetrieveResultIfEmpty(typeutil2.NewSegcoreResults(ret), r.req.GetReq().GetOutputFieldsId(), r.schema); err != nil {
+		return nil, fmt.Errorf("failed to fill segcore retrieve results: %s", err.Error())
+	}
+	return ret, nil
+}
+
+func newOrderByReducerSegcore(req *querypb.QueryRequest, schema *schemapb.CollectionSchema) *orderByReducerSegcore {
+	return &orderByReducerSegcore{
+		req:    req,
+		schema: schema,
+	}
+}
+
+// selectByOrder appends the primary keys of the selected rows to ids and returns their field data.
+func selectByOrder[T reduce.OrderByResult](ids *schemapb.IDs, results []T, orderByFields []*internalpb.OrderByField, limit int64) ([]*schemapb.FieldData, error) {
+	selections, err := reduce.SelectByOrder(results, orderByFields, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	var retSize int64
+	maxOutputSize := paramtable.Get().QuotaConfig.MaxOutputSize.GetAsInt64()
+	fieldsData := typeutil.PrepareResultFieldData(results[0].GetFieldsData(), int64(len(selections)))
+	for _, sel := range selections {
+		typeutil.AppendPKs(ids, typeutil.GetPK(results[sel.BatchIndex].GetIds(), sel.ResultIndex))
+		retSize += typeutil.AppendFieldData(fieldsData, results[sel.BatchIndex].GetFieldsData(), sel.ResultIndex)
+		// limit retrieve result to avoid oom
+		if retSize > maxOutputSize {
+			return nil, fmt.Errorf("query results exceed the maxOutputSize Limit %d", maxOutputSize)
+		}
+	}
+	return fieldsData, nil
+}
@@ -0,0 +1,169 @@
+package segments
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/util/segcore"
+	"github.com/milvus-io/milvus/pkg/v2/common"
+	"github.com/milvus-io/milvus/pkg/v2/proto/internalpb"
+	"github.com/milvus-io/milvus/pkg/v2/proto/querypb"
+	"github.com/milvus-io/milvus/pkg/v2/proto/segcorepb"
+	"github.com/milvus-io/milvus/pkg/v2/util/paramtable"
+)
+
+type OrderByReducerSuite struct {
+	suite.Suite
+	schema *schemapb.CollectionSchema
+	req    *querypb.QueryRequest
+}
+
+func TestOrderByReducerSuite(t *testing.T) {
+	suite.Run(t, new(OrderByReducerSuite))
+}
+
+func (suite *OrderByReducerSuite) SetupSuite() {
+	paramtable.Init()
+}
+
+func (suite *OrderByReducerSuite) SetupTest() {
+	suite.schema = &schemapb.CollectionSchema{
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: 100, Name: "pk", DataType: schemapb.DataType_Int64, IsPrimaryKey: true},
+			{FieldID: 101, Name: "score", DataType: schemapb.DataType_Float},
+		},
+	}
+	suite.req = &querypb.QueryRequest{
+		Req: &internalpb.RetrieveRequest{
+			OutputFieldsId: []int64{100, 101},
+			OrderByFields:  []*internalpb.OrderByField{{FieldID: 101, Ascending: false}},
+			Limit:          3,
+		},
+	}
+}
+
+func (suite *OrderByReducerSuite) genFieldsData(pks []int64, scores []float32) []*schemapb.FieldData {
+	return []*schemapb.FieldData{
+		{
+			Type:    schemapb.DataType_Int64,
+			FieldId: 100,
+			Field: &schemapb.FieldData_Scalars{Scalars: &schemapb.ScalarField{
+				Data: &schemapb.ScalarField_LongData{LongData: &schemapb.LongArray{Data: pks}},
+			}},
+		},
+		{
+			Type:    schemapb.DataType_Float,
+			FieldId: 101,
+			Field: &schemapb.FieldData_Scalars{Scalars: &schemapb.ScalarField{
+				Data: &schemapb.ScalarField_FloatData{FloatData: &schemapb.FloatArray{Data: scores}},
+			}},
+		},
+	}
+}
+
+func (suite *OrderByReducerSuite) genIDs(pks []int64) *schemapb.IDs {
+	return &schemapb.IDs{IdField: &schemapb.IDs_IntId{IntId: &schemapb.LongArray{Data: pks}}}
+}
+
+func (suite *OrderByReducerSuite) TestInternalReduce() {
+	r := newOrderByReducer(suite.req, suite.schema)
+	ret, err := r.Reduce(context.TODO(), []*internalpb.RetrieveResults{
+		{
+			Ids:              suite.genIDs([]int64{1, 2, 3}),
+			FieldsData:       suite.genFieldsData([]int64{1, 2, 3}, []float32{0.9, 0.5, 0.1}),
+			AllRetrieveCount: 3,
+		},
+		{
+			Ids:              suite.genIDs([]int64{4, 5}),
+			FieldsData:       suite.genFieldsData([]int64{4, 5}, []float32{0.7, 0.6}),
+			AllRetrieveCount: 2,
+		},
+		{},
+	})
+	suite.NoError(err)
+	suite.Equal([]int64{1, 4, 5}, ret.GetIds().GetIntId().GetData())
+	suite.Equal([]float32{0.9, 0.7, 0.6}, ret.GetFieldsData()[1].GetScalars().GetFloatData().GetData())
+	suite.Equal(int64(5), ret.GetAllRetrieveCount())
+	suite.NotNil(ret.GetCostAggregation())
+}
+
+func (suite *OrderByReducerSuite) TestInternalReduceEmpty() {
+	r := newOrderByReducer(suite.req, suite.schema)
+	ret, err := r.Reduce(context.TODO(), []*internalpb.RetrieveResults{{}})
+	suite.NoError(err)
+	suite.Equal(2, len(ret.GetFieldsData()))
+}
+
+func (suite *OrderByReducerSuite) TestInternalReduceFieldNotFound() {
+	suite.req.Req.OrderByFields = []*internalpb.OrderByField{{FieldID: common.StartOfUserFieldID + 10}}
+	r := newOrderByReducer(suite.req, suite.schema)
+	_, err := r.Reduce(context.TODO(), []*internalpb.RetrieveResults{
+		{
+			Ids:        suite.genIDs([]int64{1}),
+			FieldsData: suite.genFieldsData([]int64{1}, []float32{0.9}),
+		},
+	})
+	suite.Error(err)
+}
+
+func (suite *OrderByReducerSuite) TestSegcoreReduce() {
+	r := newOrderByReducerSegcore(suite.req, suite.schema)
+	ret, err := r.Reduce(context.TODO(), []*segcorepb.RetrieveResults{
+		{
+			Ids:              suite.genIDs([]int64{1, 2}),
+			Offset:           []int64{0, 1},
+			FieldsData:       suite.genFieldsData([]int64{1, 2}, []float32{0.2, 0.8}),
+			AllRetrieveCount: 2,
+		},
+		{
+			Ids:              suite.genIDs([]int64{3, 4}),
+			Offset:           []int64{0, 1},
+			FieldsData:       suite.genFieldsData([]int64{3, 4}, []float32{0.4, 0.6}),
+			AllRetrieveCount: 2,
+		},
+	}, nil, nil)
+	suite.NoError(err)
+	suite.Equal([]int64{2, 4, 3}, ret.GetIds().GetIntId().GetData())
+	suite.Equal(int64(4), ret.GetAllRetrieveCount())
+}
+
+func (suite *OrderByReducerSuite) TestRetrieveTopByOrder() {
+	plan, sortPlan := &RetrievePlan{}, &RetrievePlan{}
+	segment := NewMockSegment(suite.T())
+	segment.EXPECT().Retrieve(mock.Anything, sortPlan).Return(&segcorepb.RetrieveResults{
+		Ids:              suite.genIDs([]int64{1, 2, 3, 4, 5}),
+		Offset:           []int64{10, 11, 12, 13, 14},
+		FieldsData:       suite.genFieldsData([]int64{1, 2, 3, 4, 5}, []float32{0.2, 0.8, 0.4, 0.1, 0.6}),
+		AllRetrieveCount: 5,
+	}, nil)
+	// only the output fields of the top 3 rows are retrieved
+	segment.EXPECT().RetrieveByOffsets(mock.Anything, mock.Anything).RunAndReturn(
+		func(ctx context.Context, p *segcore.RetrievePlanWithOffsets) (*segcorepb.RetrieveResults, error) {
+			suite.Same(plan, p.RetrievePlan)
+			suite.Equal([]int64{11, 14, 12}, p.Offsets)
+			return &segcorepb.RetrieveResults{
+				FieldsData: suite.genFieldsData([]int64{2, 5, 3}, []float32{0.8, 0.6, 0.4}),
+			}, nil
+		})
+
+	ret, err := retrieveTopByOrder(context.TODO(), segment, plan, sortPlan, suite.req)
+	suite.NoError(err)
+	suite.Equal([]int64{2, 5, 3}, ret.GetIds().GetIntId().GetData())
+	suite.Equal([]int64{11, 14, 12}, ret.GetOffset())
+	suite.Equal([]float32{0.8, 0.6, 0.4}, ret.GetFieldsData()[1].GetScalars().GetFloatData().GetData())
+	suite.Equal(int64(5), ret.GetAllRetrieveCount())
+}
+
+func (suite *OrderByReducerSuite) TestRetrieveTopByOrderEmpty() {
+	sortPlan := &RetrievePlan{}
+	segment := NewMockSegment(suite.T())
+	segment.EXPECT().Retrieve(mock.Anything, sortPlan).Return(&segcorepb.RetrieveResults{Ids: &schemapb.IDs{}}, nil)
+
+	ret, err := retrieveTopByOrder(context.TODO(), segment, &RetrievePlan{}, sortPlan, suite.req)
+	suite.NoError(err)
+	suite.Empty(ret.GetOffset())
+}
@@ -355,6 +355,11 @@ func MergeInternalRetrieveResult(ctx context.Context, retrieveResults []*interna
 		log.Debug("skip duplicated query result while reducing internal.RetrieveResults", zap.Int64("dupCount", skipDupCnt))
 	}
 
+	ret.CostAggregation = mergeRetrieveCost(retrieveResults, relatedDataSize)
+	return ret, nil
+}
+
+func mergeRetrieveCost(retrieveResults []*internalpb.RetrieveResults, relatedDataSize int64) *internalpb.CostAggregation {
 	requestCosts := lo.FilterMap(retrieveResults, func(result *internalpb.RetrieveResults, _ int) (*internalpb.CostAggregation, bool) {
 		if paramtable.Get().QueryNodeCfg.EnableWorkerSQCostMetrics.GetAsBool() {
 			return result.GetCostAggregation(), true
@@ -366,12 +371,12 @@ func MergeInternalRetrieveResult(ctx context.Context, retrieveResults []*interna
 
 		return nil, false
 	})
-	ret.CostAggregation = mergeRequestCost(requestCosts)
-	if ret.CostAggregation == nil {
-		ret.CostAggregation = &internalpb.CostAggregation{}
+	cost := mergeRequestCost(requestCosts)
+	if cost == nil {
+		cost = &internalpb.CostAggregation{}
 	}
-	ret.CostAggregation.TotalRelatedDataSize = relatedDataSize
-	return ret, nil
+	cost.TotalRelatedDataSize = relatedDataSize
+	return cost
 }
 
 func getTS(i *internalpb.RetrieveResults, idx int64) uint64 {
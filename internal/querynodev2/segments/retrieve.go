@@ -24,6 +24,7 @@ import (
 	"go.uber.org/zap"
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
 	"github.com/milvus-io/milvus/internal/util/streamrpc"
 	"github.com/milvus-io/milvus/pkg/v2/log"
 	"github.com/milvus-io/milvus/pkg/v2/metrics"
@@ -54,7 +55,32 @@ func retrieveOnSegments(ctx context.Context, mgr *Manager, segments []Segment, s
 		}
 		return false
 	}()
-	plan.SetIgnoreNonPk(!anySegIsLazyLoad && len(segments) > 1 && req.GetReq().GetLimit() != typeutil.Unlimited && plan.ShouldIgnoreNonPk())
+	// order by requests retrieve the output fields of the top rows by offsets on each segment
+	plan.SetIgnoreNonPk(!anySegIsLazyLoad && len(segments) > 1 && req.GetReq().GetLimit() != typeutil.Unlimited &&
+		len(req.GetReq().GetOrderByFields()) == 0 && plan.ShouldIgnoreNonPk())
+
+	var aggSchema *schemapb.CollectionSchema
+	if len(req.GetReq().GetAggregates()) > 0 {
+		collection := mgr.Collection.Get(req.GetReq().GetCollectionID())
+		if collection == nil {
+			return nil, merr.WrapErrCollectionNotLoaded(req.GetReq().GetCollectionID())
+		}
+		aggSchema = collection.Schema()
+	}
+
+	var sortPlan *RetrievePlan
+	if len(req.GetReq().GetOrderByFields()) > 0 {
+		collection := mgr.Collection.Get(req.GetReq().GetCollectionID())
+		if collection == nil {
+			return nil, merr.WrapErrCollectionNotLoaded(req.GetReq().GetCollectionID())
+		}
+		var err error
+		sortPlan, err = newOrderBySortPlan(collection, req)
+		if err != nil {
+			return nil, err
+		}
+		defer sortPlan.Delete()
+	}
 
 	label := metrics.SealedSegmentLabel
 	if segType == commonpb.SegmentState_Growing {
@@ -63,10 +89,22 @@ func retrieveOnSegments(ctx context.Context, mgr *Manager, segments []Segment, s
 
 	retriever := func(ctx context.Context, s Segment) error {
 		tr := timerecord.NewTimeRecorder("retrieveOnSegments")
-		result, err := s.Retrieve(ctx, plan)
+		var result *segcorepb.RetrieveResults
+		var err error
+		if sortPlan != nil {
+			result, err = retrieveTopByOrder(ctx, s, plan, sortPlan, req)
+		} else {
+			result, err = s.Retrieve(ctx, plan)
+		}
 		if err != nil {
 			return err
 		}
+		if aggSchema != nil {
+			result, err = aggregateSegmentResult(result, aggSchema, req)
+			if err != nil {
+				return err
+			}
+		}
 		resultCh <- RetrieveSegmentResult{
 			result,
 			s,
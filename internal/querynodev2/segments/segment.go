@@ -738,6 +738,7 @@ func (s *LocalSegment) Delete(ctx context.Context, primaryKeys storage.PrimaryKe
 
 	s.rowNum.Store(-1)
 	s.lastDeltaTimestamp.Store(timestamps[len(timestamps)-1])
+	GetFilterCache().Invalidate(s.ID())
 	return nil
 }
 
@@ -941,6 +942,7 @@ func (s *LocalSegment) LoadDeltaData(ctx context.Context, deltaData *storage.Del
 
 	s.rowNum.Store(-1)
 	s.lastDeltaTimestamp.Store(tss[len(tss)-1])
+	GetFilterCache().Invalidate(s.ID())
 
 	log.Info("load deleted record done",
 		zap.Int64("rowNum", rowNum),
@@ -1321,6 +1323,7 @@ func (s *LocalSegment) Release(ctx context.Context, opts ...releaseOption) {
 	}
 	// release will never fail
 	defer stateLockGuard.Done(nil)
+	GetFilterCache().Invalidate(s.ID())
 
 	log := log.Ctx(ctx).With(zap.Int64("collectionID", s.Collection()),
 		zap.Int64("partitionID", s.Partition()),
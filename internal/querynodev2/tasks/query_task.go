@@ -3,19 +3,24 @@ package tasks
 import (
 	"context"
 	"fmt"
+	"math"
 	"strconv"
 	"time"
 
 	"github.com/samber/lo"
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/protobuf/proto"
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus/internal/parser/planparserv2"
 	"github.com/milvus-io/milvus/internal/querynodev2/segments"
+	"github.com/milvus-io/milvus/internal/util/funcutil"
 	"github.com/milvus-io/milvus/internal/util/searchutil/scheduler"
 	"github.com/milvus-io/milvus/internal/util/segcore"
 	"github.com/milvus-io/milvus/pkg/v2/metrics"
 	"github.com/milvus-io/milvus/pkg/v2/proto/internalpb"
+	"github.com/milvus-io/milvus/pkg/v2/proto/planpb"
 	"github.com/milvus-io/milvus/pkg/v2/proto/querypb"
 	"github.com/milvus-io/milvus/pkg/v2/proto/segcorepb"
 	"github.com/milvus-io/milvus/pkg/v2/util/merr"
@@ -101,6 +106,10 @@ func (t *QueryTask) Execute() error {
 	}
 	tr := timerecord.NewTimeRecorderWithTrace(t.ctx, "QueryTask")
 
+	if exprHash, ok := t.filterCacheKey(); ok {
+		return t.countWithFilterCache(exprHash, tr)
+	}
+
 	retrievePlan, err := segcore.NewRetrievePlan(
 		t.collection.GetCCollection(),
 		t.req.Req.GetSerializedExprPlan(),
@@ -162,6 +171,155 @@ func (t *QueryTask) Execute() error {
 	return nil
 }
 
+// filterCacheKey returns the hash of the filter expression if the request is a count with filter served by
+// the filter cache, only the filter results of sealed segments are cached.
+func (t *QueryTask) filterCacheKey() (string, bool) {
+	if !paramtable.Get().QueryNodeCfg.EnableFilterCache.GetAsBool() ||
+		t.req.GetScope() != querypb.DataScope_Historical ||
+		len(t.req.GetSegmentIDs()) == 0 ||
+		t.req.GetReq().GetMvccTimestamp() == 0 ||
+		t.req.GetReq().GetIsIterator() {
+		return "", false
+	}
+
+	plan := &planpb.PlanNode{}
+	if err := proto.Unmarshal(t.req.GetReq().GetSerializedExprPlan(), plan); err != nil {
+		return "", false
+	}
+	query := plan.GetQuery()
+	if query.GetPredicates() == nil || !query.GetIsCount() {
+		return "", false
+	}
+	exprHash, err := planparserv2.HashPredicates(query.GetPredicates())
+	if err != nil {
+		return "", false
+	}
+	return exprHash, true
+}
+
+// countWithFilterCache serves the count request with the cached filter results of the segments,
+// the filter results of the missed segments are retrieved as offsets, cached and counted.
+func (t *QueryTask) countWithFilterCache(exprHash string, tr *timerecord.TimeRecorder) error {
+	cache := segments.GetFilterCache()
+	cnt := int64(0)
+	missed := make([]int64, 0)
+	for _, segmentID := range t.req.GetSegmentIDs() {
+		if segment := t.segmentManager.Segment.GetSealed(segmentID); segment != nil {
+			if bitmap, ok := cache.Get(segmentID, exprHash, t.req.GetReq().GetMvccTimestamp(), segment.LastDeltaTimestamp()); ok {
+				cnt += int64(bitmap.GetCardinality())
+				continue
+			}
+		}
+		missed = append(missed, segmentID)
+	}
+
+	relatedDataSize := int64(0)
+	if len(missed) > 0 {
+		missedCnt, size, err := t.retrieveFilterResults(exprHash, missed)
+		if err != nil {
+			return err
+		}
+		cnt += missedCnt
+		relatedDataSize = size
+	}
+
+	t.result = funcutil.WrapCntToInternalResult(cnt)
+	t.result.Base = &commonpb.MsgBase{
+		SourceID: paramtable.GetNodeID(),
+	}
+	t.result.CostAggregation = &internalpb.CostAggregation{
+		ServiceTime:          tr.ElapseSpan().Milliseconds(),
+		TotalRelatedDataSize: relatedDataSize,
+	}
+	return nil
+}
+
+// retrieveFilterResults retrieves the matched offsets of the segments instead of counting them in segcore,
+// and caches them once all the segments succeed. It returns the number of the matched rows and the related data size.
+func (t *QueryTask) retrieveFilterResults(exprHash string, segmentIDs []int64) (int64, int64, error) {
+	plan := &planpb.PlanNode{}
+	if err := proto.Unmarshal(t.req.GetReq().GetSerializedExprPlan(), plan); err != nil {
+		return 0, 0, err
+	}
+	pkField, err := typeutil.GetPrimaryFieldSchema(t.collection.Schema())
+	if err != nil {
+		return 0, 0, err
+	}
+	plan.GetQuery().IsCount = false
+	plan.GetQuery().Limit = typeutil.Unlimited
+	plan.OutputFieldIds = []int64{pkField.GetFieldID()}
+	serializedPlan, err := proto.Marshal(plan)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	req := typeutil.Clone(t.req)
+	req.SegmentIDs = segmentIDs
+	req.Req.IsCount = false
+	req.Req.Limit = typeutil.Unlimited
+	req.Req.SerializedExprPlan = serializedPlan
+	retrievePlan, err := segcore.NewRetrievePlan(
+		t.collection.GetCCollection(),
+		serializedPlan,
+		req.Req.GetMvccTimestamp(),
+		req.Req.Base.GetMsgID(),
+	)
+	if err != nil {
+		return 0, 0, err
+	}
+	defer retrievePlan.Delete()
+	results, pinnedSegments, err := segments.Retrieve(t.ctx, t.segmentManager, retrievePlan, req)
+	defer t.segmentManager.Segment.Unpin(pinnedSegments)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	cnt := int64(0)
+	relatedDataSize := int64(0)
+	for _, result := range results {
+		cnt += int64(len(result.Result.GetOffset()))
+		relatedDataSize += segments.GetSegmentRelatedDataSize(result.Segment)
+	}
+	t.fillFilterCache(exprHash, results)
+	return cnt, relatedDataSize, nil
+}
+
+// fillFilterCache caches the filter results of the segments which are complete at the mvcc timestamp,
+// i.e. no rows inserted or deleted after it, so that they could be served to the reads at any later timestamp.
+func (t *QueryTask) fillFilterCache(exprHash string, results []segments.RetrieveSegmentResult) {
+	cache := segments.GetFilterCache()
+	mvccTs := t.req.GetReq().GetMvccTimestamp()
+	for _, result := range results {
+		if result.Result.GetHasMoreResult() ||
+			mvccTs < result.Segment.LastDeltaTimestamp() ||
+			mvccTs < getSegmentMaxInsertTs(result.Segment) {
+			continue
+		}
+		bitmap, err := segments.NewOffsetBitmap(result.Result.GetOffset())
+		if err != nil {
+			continue
+		}
+		cache.Put(result.Segment.ID(), exprHash, mvccTs, bitmap)
+	}
+}
+
+// getSegmentMaxInsertTs returns the max insert timestamp of the sealed segment by its binlogs,
+// math.MaxUint64 if it's unknown.
+func getSegmentMaxInsertTs(segment segments.Segment) uint64 {
+	binlogs := segment.LoadInfo().GetBinlogPaths()
+	if len(binlogs) == 0 {
+		return math.MaxUint64
+	}
+	maxTs := uint64(0)
+	for _, binlog := range binlogs[0].GetBinlogs() {
+		if binlog.GetTimestampTo() == 0 {
+			return math.MaxUint64
+		}
+		maxTs = max(maxTs, binlog.GetTimestampTo())
+	}
+	return maxTs
+}
+
 func (t *QueryTask) Done(err error) {
 	t.notifier <- err
 }
@@ -27,6 +27,8 @@ import (
 	"github.com/cockroachdb/errors"
 	"go.uber.org/zap"
 
+	"github.com/milvus-io/milvus/internal/util/dependency"
+	"github.com/milvus-io/milvus/internal/util/streamingutil"
 	"github.com/milvus-io/milvus/pkg/v2/log"
 	"github.com/milvus-io/milvus/pkg/v2/metrics"
 	"github.com/milvus-io/milvus/pkg/v2/mq/common"
@@ -180,18 +182,23 @@ func newDmlChannels(initCtx context.Context, factory msgstream.Factory, chanName
 
 	for i, name := range names {
 		ms, err := factory.NewMsgStream(initCtx)
-		if err != nil {
-			log.Ctx(initCtx).Error("Failed to add msgstream",
-				zap.String("name", name),
-				zap.Error(err))
-			panic("Failed to add msgstream")
-		}
+		if errors.Is(err, dependency.ErrMsgStreamUnsupported) && streamingutil.IsStreamingServiceEnabled() {
+			// the wal can only be accessed through the streaming service, keep the channel without msgstream.
+			log.Ctx(initCtx).Info("skip msgstream of dml channel", zap.String("name", name), zap.Error(err))
+		} else {
+			if err != nil {
+				log.Ctx(initCtx).Error("Failed to add msgstream",
+					zap.String("name", name),
+					zap.Error(err))
+				panic("Failed to add msgstream")
+			}
 
-		if params.PreCreatedTopicEnabled.GetAsBool() {
-			d.checkPreCreatedTopic(initCtx, factory, name)
-		}
+			if params.PreCreatedTopicEnabled.GetAsBool() {
+				d.checkPreCreatedTopic(initCtx, factory, name)
+			}
 
-		ms.AsProducer(initCtx, []string{name})
+			ms.AsProducer(initCtx, []string{name})
+		}
 		dms := &dmlMsgStream{
 			ms:     ms,
 			refcnt: 0,
@@ -292,6 +299,10 @@ func (d *dmlChannels) broadcast(chanNames []string, pack *msgstream.MsgPack) err
 			return err
 		}
 
+		if dms.ms == nil {
+			return errors.Wrapf(dependency.ErrMsgStreamUnsupported, "channel %s", chanName)
+		}
+
 		dms.mutex.RLock()
 		if dms.refcnt > 0 {
 			if _, err := dms.ms.Broadcast(d.ctx, pack); err != nil {
@@ -312,6 +323,9 @@ func (d *dmlChannels) broadcastMark(chanNames []string, pack *msgstream.MsgPack)
 		if err != nil {
 			return result, err
 		}
+		if dms.ms == nil {
+			return result, errors.Wrapf(dependency.ErrMsgStreamUnsupported, "channel %s", chanName)
+		}
 
 		dms.mutex.RLock()
 		if dms.refcnt > 0 {
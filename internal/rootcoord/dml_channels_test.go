@@ -28,6 +28,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/milvus-io/milvus/internal/util/dependency"
+	"github.com/milvus-io/milvus/internal/util/streamingutil"
 	"github.com/milvus-io/milvus/pkg/v2/mq/common"
 	"github.com/milvus-io/milvus/pkg/v2/mq/msgstream"
 	"github.com/milvus-io/milvus/pkg/v2/util/funcutil"
@@ -196,6 +197,28 @@ func TestDmChannelsFailure(t *testing.T) {
 	wg.Wait()
 }
 
+func TestDmlChannelsWithoutMsgStream(t *testing.T) {
+	mockFactory := &FailMessageStreamFactory{errUnsupported: true}
+	assert.Panics(t, func() { newDmlChannels(context.TODO(), mockFactory, "test-newdmlchannel-root", 1) })
+
+	streamingutil.SetStreamingServiceEnabled()
+	defer streamingutil.UnsetStreamingServiceEnabled()
+
+	dml := newDmlChannels(context.TODO(), mockFactory, "test-newdmlchannel-root", 2)
+	chanName0 := dml.getChannelNames(1)[0]
+	dml.addChannels(chanName0)
+	require.Equal(t, 1, dml.getChannelNum())
+
+	err := dml.broadcast([]string{chanName0}, nil)
+	assert.ErrorIs(t, err, dependency.ErrMsgStreamUnsupported)
+
+	_, err = dml.broadcastMark([]string{chanName0}, nil)
+	assert.ErrorIs(t, err, dependency.ErrMsgStreamUnsupported)
+
+	dml.removeChannels(chanName0)
+	assert.Equal(t, 0, dml.getChannelNum())
+}
+
 func TestGetNeedChanNum(t *testing.T) {
 	paramtable.Get().Save(Params.CommonCfg.PreCreatedTopicEnabled.Key, "true")
 	defer paramtable.Get().Reset(Params.CommonCfg.PreCreatedTopicEnabled.Key)
@@ -258,10 +281,14 @@ func TestGetNeedChanNum(t *testing.T) {
 // FailMessageStreamFactory mock MessageStreamFactory failure
 type FailMessageStreamFactory struct {
 	msgstream.Factory
-	errBroadcast bool
+	errBroadcast   bool
+	errUnsupported bool
 }
 
 func (f *FailMessageStreamFactory) NewMsgStream(ctx context.Context) (msgstream.MsgStream, error) {
+	if f.errUnsupported {
+		return nil, dependency.ErrMsgStreamUnsupported
+	}
 	if f.errBroadcast {
 		return &FailMsgStream{errBroadcast: true}, nil
 	}
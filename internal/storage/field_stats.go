@@ -35,11 +35,12 @@ import (
 type FieldStats struct {
 	FieldID   int64                            `json:"fieldID"`
 	Type      schemapb.DataType                `json:"type"`
-	Max       ScalarFieldValue                 `json:"max"`       // for scalar field
-	Min       ScalarFieldValue                 `json:"min"`       // for scalar field
-	BFType    bloomfilter.BFType               `json:"bfType"`    // for scalar field
-	BF        bloomfilter.BloomFilterInterface `json:"bf"`        // for scalar field
-	Centroids []VectorFieldValue               `json:"centroids"` // for vector field
+	Max       ScalarFieldValue                 `json:"max"`                 // for scalar field
+	Min       ScalarFieldValue                 `json:"min"`                 // for scalar field
+	BFType    bloomfilter.BFType               `json:"bfType"`              // for scalar field
+	BF        bloomfilter.BloomFilterInterface `json:"bf"`                  // for scalar field
+	Centroids []VectorFieldValue               `json:"centroids"`           // for vector field
+	NullCount int64                            `json:"nullCount,omitempty"` // for scalar field zone map
 }
 
 func (stats *FieldStats) Clone() FieldStats {
@@ -51,6 +52,7 @@ func (stats *FieldStats) Clone() FieldStats {
 		BFType:    stats.BFType,
 		BF:        stats.BF,
 		Centroids: stats.Centroids,
+		NullCount: stats.NullCount,
 	}
 }
 
@@ -87,6 +89,13 @@ func (stats *FieldStats) UnmarshalJSON(data []byte) error {
 		}
 	}
 
+	if value, ok := messageMap["nullCount"]; ok && value != nil {
+		err = json.Unmarshal(*value, &stats.NullCount)
+		if err != nil {
+			return err
+		}
+	}
+
 	isScalarField := false
 	switch stats.Type {
 	case schemapb.DataType_Int8:
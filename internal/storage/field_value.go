@@ -1034,39 +1034,63 @@ func (ifv *FloatVectorFieldValue) Size() int64 {
 func NewScalarFieldValueFromGenericValue(dtype schemapb.DataType, gVal *planpb.GenericValue) (ScalarFieldValue, error) {
 	switch dtype {
 	case schemapb.DataType_Int8:
-		i64Val := gVal.Val.(*planpb.GenericValue_Int64Val)
+		i64Val, ok := gVal.GetVal().(*planpb.GenericValue_Int64Val)
+		if !ok {
+			return nil, merr.WrapErrParameterInvalid(dtype.String(), gVal.String(), "expr value type mismatch")
+		}
 		if i64Val.Int64Val > math.MaxInt8 || i64Val.Int64Val < math.MinInt8 {
 			return nil, merr.WrapErrParameterInvalidRange(math.MinInt8, math.MaxInt8, i64Val.Int64Val, "expr value out of bound")
 		}
 		return NewInt8FieldValue(int8(i64Val.Int64Val)), nil
 
 	case schemapb.DataType_Int16:
-		i64Val := gVal.Val.(*planpb.GenericValue_Int64Val)
+		i64Val, ok := gVal.GetVal().(*planpb.GenericValue_Int64Val)
+		if !ok {
+			return nil, merr.WrapErrParameterInvalid(dtype.String(), gVal.String(), "expr value type mismatch")
+		}
 		if i64Val.Int64Val > math.MaxInt16 || i64Val.Int64Val < math.MinInt16 {
 			return nil, merr.WrapErrParameterInvalidRange(math.MinInt16, math.MaxInt16, i64Val.Int64Val, "expr value out of bound")
 		}
 		return NewInt16FieldValue(int16(i64Val.Int64Val)), nil
 
 	case schemapb.DataType_Int32:
-		i64Val := gVal.Val.(*planpb.GenericValue_Int64Val)
+		i64Val, ok := gVal.GetVal().(*planpb.GenericValue_Int64Val)
+		if !ok {
+			return nil, merr.WrapErrParameterInvalid(dtype.String(), gVal.String(), "expr value type mismatch")
+		}
 		if i64Val.Int64Val > math.MaxInt32 || i64Val.Int64Val < math.MinInt32 {
 			return nil, merr.WrapErrParameterInvalidRange(math.MinInt32, math.MaxInt32, i64Val.Int64Val, "expr value out of bound")
 		}
 		return NewInt32FieldValue(int32(i64Val.Int64Val)), nil
 	case schemapb.DataType_Int64:
-		i64Val := gVal.Val.(*planpb.GenericValue_Int64Val)
+		i64Val, ok := gVal.GetVal().(*planpb.GenericValue_Int64Val)
+		if !ok {
+			return nil, merr.WrapErrParameterInvalid(dtype.String(), gVal.String(), "expr value type mismatch")
+		}
 		return NewInt64FieldValue(i64Val.Int64Val), nil
 	case schemapb.DataType_Float:
-		floatVal := gVal.Val.(*planpb.GenericValue_FloatVal)
+		floatVal, ok := gVal.GetVal().(*planpb.GenericValue_FloatVal)
+		if !ok {
+			return nil, merr.WrapErrParameterInvalid(dtype.String(), gVal.String(), "expr value type mismatch")
+		}
 		return NewFloatFieldValue(float32(floatVal.FloatVal)), nil
 	case schemapb.DataType_Double:
-		floatVal := gVal.Val.(*planpb.GenericValue_FloatVal)
+		floatVal, ok := gVal.GetVal().(*planpb.GenericValue_FloatVal)
+		if !ok {
+			return nil, merr.WrapErrParameterInvalid(dtype.String(), gVal.String(), "expr value type mismatch")
+		}
 		return NewDoubleFieldValue(floatVal.FloatVal), nil
 	case schemapb.DataType_String:
-		strVal := gVal.Val.(*planpb.GenericValue_StringVal)
+		strVal, ok := gVal.GetVal().(*planpb.GenericValue_StringVal)
+		if !ok {
+			return nil, merr.WrapErrParameterInvalid(dtype.String(), gVal.String(), "expr value type mismatch")
+		}
 		return NewStringFieldValue(strVal.StringVal), nil
 	case schemapb.DataType_VarChar:
-		strVal := gVal.Val.(*planpb.GenericValue_StringVal)
+		strVal, ok := gVal.GetVal().(*planpb.GenericValue_StringVal)
+		if !ok {
+			return nil, merr.WrapErrParameterInvalid(dtype.String(), gVal.String(), "expr value type mismatch")
+		}
 		return NewVarCharFieldValue(strVal.StringVal), nil
 	default:
 		// should not be reach
@@ -168,6 +168,14 @@ func (s *PackedBinlogRecordSuite) TestPackedBinlogRecordIntegration() {
 
 	s.Equal(len(bm25StatsLog), 0)
 
+	zoneMapLogs := w.GetZoneMapLogs()
+	s.NotEmpty(zoneMapLogs)
+	for fieldID, zoneMapLog := range zoneMapLogs {
+		s.Equal(fieldID, zoneMapLog.FieldID)
+		s.Equal(len(zoneMapLog.Binlogs), 1)
+		s.Equal(zoneMapLog.Binlogs[0].EntriesNum, int64(rows))
+	}
+
 	binlogs := lo.Values(fieldBinlogs)
 	rOption := []RwOption{
 		WithVersion(StorageV2),
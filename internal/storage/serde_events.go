@@ -444,6 +444,8 @@ type BinlogRecordWriter interface {
 		statsLog *datapb.FieldBinlog,
 		bm25StatsLog map[FieldID]*datapb.FieldBinlog,
 	)
+	// GetZoneMapLogs returns the zone map statslogs of the scalar fields except the primary key.
+	GetZoneMapLogs() map[FieldID]*datapb.FieldBinlog
 	GetRowNum() int64
 }
 
@@ -462,6 +464,7 @@ type CompositeBinlogRecordWriter struct {
 	maxRowNum    int64
 	pkstats      *PrimaryKeyStats
 	bm25Stats    map[int64]*BM25Stats
+	zoneMaps     *zoneMapCollector
 
 	// writers and stats generated at runtime
 	fieldWriters map[FieldID]*BinlogStreamWriter
@@ -474,6 +477,7 @@ type CompositeBinlogRecordWriter struct {
 	fieldBinlogs map[FieldID]*datapb.FieldBinlog
 	statsLog     *datapb.FieldBinlog
 	bm25StatsLog map[FieldID]*datapb.FieldBinlog
+	zoneMapLogs  map[FieldID]*datapb.FieldBinlog
 }
 
 var _ BinlogRecordWriter = (*CompositeBinlogRecordWriter)(nil)
@@ -519,6 +523,9 @@ func (c *CompositeBinlogRecordWriter) Write(r Record) error {
 			stats.AppendBytes(field.Value(i))
 		}
 	}
+	if err := c.zoneMaps.update(r); err != nil {
+		return err
+	}
 
 	if err := c.rw.Write(r); err != nil {
 		return err
@@ -563,6 +570,9 @@ func (c *CompositeBinlogRecordWriter) Close() error {
 	if err := c.writeBm25Stats(); err != nil {
 		return err
 	}
+	if err := c.writeZoneMaps(); err != nil {
+		return err
+	}
 	if c.rw != nil {
 		// if rw is not nil, it means there is data to be flushed
 		if err := c.flushChunk(); err != nil {
@@ -714,6 +724,16 @@ func (c *CompositeBinlogRecordWriter) writeBm25Stats() error {
 	return nil
 }
 
+func (c *CompositeBinlogRecordWriter) writeZoneMaps() error {
+	logs, err := c.zoneMaps.write(c.allocator, c.BlobsWriter, c.rootPath,
+		c.collectionID, c.partitionID, c.segmentID, c.rowNum)
+	if err != nil {
+		return err
+	}
+	c.zoneMapLogs = logs
+	return nil
+}
+
 func (c *CompositeBinlogRecordWriter) GetLogs() (
 	fieldBinlogs map[FieldID]*datapb.FieldBinlog,
 	statsLog *datapb.FieldBinlog,
@@ -722,6 +742,10 @@ func (c *CompositeBinlogRecordWriter) GetLogs() (
 	return c.fieldBinlogs, c.statsLog, c.bm25StatsLog
 }
 
+func (c *CompositeBinlogRecordWriter) GetZoneMapLogs() map[FieldID]*datapb.FieldBinlog {
+	return c.zoneMapLogs
+}
+
 func (c *CompositeBinlogRecordWriter) GetRowNum() int64 {
 	return c.rowNum
 }
@@ -761,6 +785,7 @@ func newCompositeBinlogRecordWriter(collectionID, partitionID, segmentID UniqueI
 		maxRowNum:    maxRowNum,
 		pkstats:      stats,
 		bm25Stats:    bm25Stats,
+		zoneMaps:     newZoneMapCollector(schema, maxRowNum),
 	}, nil
 }
 
@@ -298,6 +298,7 @@ type PackedBinlogRecordWriter struct {
 	writer              *packedRecordWriter
 	pkstats             *PrimaryKeyStats
 	bm25Stats           map[int64]*BM25Stats
+	zoneMaps            *zoneMapCollector
 	tsFrom              typeutil.Timestamp
 	tsTo                typeutil.Timestamp
 	rowNum              int64
@@ -307,6 +308,7 @@ type PackedBinlogRecordWriter struct {
 	fieldBinlogs map[FieldID]*datapb.FieldBinlog
 	statsLog     *datapb.FieldBinlog
 	bm25StatsLog map[FieldID]*datapb.FieldBinlog
+	zoneMapLogs  map[FieldID]*datapb.FieldBinlog
 }
 
 func (pw *PackedBinlogRecordWriter) Write(r Record) error {
@@ -350,6 +352,9 @@ func (pw *PackedBinlogRecordWriter) Write(r Record) error {
 			stats.AppendBytes(field.Value(i))
 		}
 	}
+	if err := pw.zoneMaps.update(r); err != nil {
+		return err
+	}
 
 	err := pw.writer.Write(r)
 	if err != nil {
@@ -390,6 +395,9 @@ func (pw *PackedBinlogRecordWriter) Close() error {
 	if err := pw.writeBm25Stats(); err != nil {
 		return err
 	}
+	if err := pw.writeZoneMaps(); err != nil {
+		return err
+	}
 	if err := pw.writer.Close(); err != nil {
 		return err
 	}
@@ -511,6 +519,16 @@ func (pw *PackedBinlogRecordWriter) writeBm25Stats() error {
 	return nil
 }
 
+func (pw *PackedBinlogRecordWriter) writeZoneMaps() error {
+	logs, err := pw.zoneMaps.write(pw.allocator, pw.BlobsWriter, pw.rootPath,
+		pw.collectionID, pw.partitionID, pw.segmentID, pw.rowNum)
+	if err != nil {
+		return err
+	}
+	pw.zoneMapLogs = logs
+	return nil
+}
+
 func (pw *PackedBinlogRecordWriter) GetLogs() (
 	fieldBinlogs map[FieldID]*datapb.FieldBinlog,
 	statsLog *datapb.FieldBinlog,
@@ -519,6 +537,10 @@ func (pw *PackedBinlogRecordWriter) GetLogs() (
 	return pw.fieldBinlogs, pw.statsLog, pw.bm25StatsLog
 }
 
+func (pw *PackedBinlogRecordWriter) GetZoneMapLogs() map[FieldID]*datapb.FieldBinlog {
+	return pw.zoneMapLogs
+}
+
 func (pw *PackedBinlogRecordWriter) GetRowNum() int64 {
 	return pw.rowNum
 }
@@ -569,5 +591,6 @@ func newPackedBinlogRecordWriter(collectionID, partitionID, segmentID UniqueID,
 		columnGroups:        columnGroups,
 		pkstats:             stats,
 		bm25Stats:           bm25Stats,
+		zoneMaps:            newZoneMapCollector(schema, maxRowNum),
 	}, nil
 }
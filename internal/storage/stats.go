@@ -30,6 +30,7 @@ import (
 	"github.com/milvus-io/milvus/internal/util/bloomfilter"
 	"github.com/milvus-io/milvus/pkg/v2/common"
 	"github.com/milvus-io/milvus/pkg/v2/log"
+	"github.com/milvus-io/milvus/pkg/v2/util/compressor"
 	"github.com/milvus-io/milvus/pkg/v2/util/merr"
 	"github.com/milvus-io/milvus/pkg/v2/util/paramtable"
 	"github.com/milvus-io/milvus/pkg/v2/util/typeutil"
@@ -235,8 +236,8 @@ func (sw *StatsWriter) GenerateList(stats []*PrimaryKeyStats) error {
 	if err != nil {
 		return err
 	}
-	sw.buffer = b
-	return nil
+	sw.buffer, err = compressStats(b)
+	return err
 }
 
 // Generate writes Stats to buffer
@@ -245,8 +246,8 @@ func (sw *StatsWriter) Generate(stats *PrimaryKeyStats) error {
 	if err != nil {
 		return err
 	}
-	sw.buffer = b
-	return nil
+	sw.buffer, err = compressStats(b)
+	return err
 }
 
 // GenerateByData writes Int64Stats or StringStats from @msgs with @fieldID to @buffer
@@ -266,6 +267,24 @@ func (sw *StatsWriter) GenerateByData(fieldID int64, pkType schemapb.DataType, m
 	return sw.Generate(stats)
 }
 
+// compressStats compresses the serialized stats with the configured algorithm
+func compressStats(b []byte) ([]byte, error) {
+	compressType := paramtable.Get().CommonCfg.StatsLogCompressType.GetValue()
+	if compressType == "" {
+		return b, nil
+	}
+	return compressor.CompressBytes(compressor.CompressType(compressType), b, nil)
+}
+
+// decompressStats decompresses the stats if compressed, the stats without compression are returned as is
+func decompressStats(b []byte) ([]byte, error) {
+	compressType, ok := compressor.DetectCompressType(b)
+	if !ok {
+		return b, nil
+	}
+	return compressor.DecompressBytes(compressType, b, nil)
+}
+
 // StatsReader reads stats
 type StatsReader struct {
 	buffer []byte
@@ -278,12 +297,16 @@ func (sr *StatsReader) SetBuffer(buffer []byte) {
 
 // GetInt64Stats returns buffer as PrimaryKeyStats
 func (sr *StatsReader) GetPrimaryKeyStats() (*PrimaryKeyStats, error) {
+	buffer, err := decompressStats(sr.buffer)
+	if err != nil {
+		return nil, err
+	}
 	stats := &PrimaryKeyStats{}
-	err := json.Unmarshal(sr.buffer, &stats)
+	err = json.Unmarshal(buffer, &stats)
 	if err != nil {
 		return nil, merr.WrapErrParameterInvalid(
 			"valid JSON",
-			string(sr.buffer),
+			string(buffer),
 			err.Error())
 	}
 
@@ -292,12 +315,16 @@ func (sr *StatsReader) GetPrimaryKeyStats() (*PrimaryKeyStats, error) {
 
 // GetInt64Stats returns buffer as PrimaryKeyStats
 func (sr *StatsReader) GetPrimaryKeyStatsList() ([]*PrimaryKeyStats, error) {
+	buffer, err := decompressStats(sr.buffer)
+	if err != nil {
+		return nil, err
+	}
 	stats := []*PrimaryKeyStats{}
-	err := json.Unmarshal(sr.buffer, &stats)
+	err = json.Unmarshal(buffer, &stats)
 	if err != nil {
 		return nil, merr.WrapErrParameterInvalid(
 			"valid JSON",
-			string(sr.buffer),
+			string(buffer),
 			err.Error())
 	}
 
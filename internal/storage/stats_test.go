@@ -25,6 +25,7 @@ import (
 	"github.com/milvus-io/milvus/internal/json"
 	"github.com/milvus-io/milvus/internal/util/bloomfilter"
 	"github.com/milvus-io/milvus/pkg/v2/common"
+	"github.com/milvus-io/milvus/pkg/v2/util/compressor"
 	"github.com/milvus-io/milvus/pkg/v2/util/merr"
 	"github.com/milvus-io/milvus/pkg/v2/util/paramtable"
 )
@@ -204,3 +205,42 @@ func TestMarshalStats(t *testing.T) {
 		assert.True(t, stat1[0].BF.Test(b))
 	}
 }
+
+func TestCompressedStats(t *testing.T) {
+	paramtable.Init()
+	pt := paramtable.Get()
+	defer pt.Reset(pt.CommonCfg.StatsLogCompressType.Key)
+
+	stat, err := NewPrimaryKeyStats(1, int64(schemapb.DataType_Int64), 1000)
+	assert.NoError(t, err)
+	for i := 0; i < 100; i++ {
+		stat.Update(NewInt64PrimaryKey(int64(i)))
+	}
+
+	for _, compressType := range []string{"", "zstd", "lz4", "snappy"} {
+		pt.Save(pt.CommonCfg.StatsLogCompressType.Key, compressType)
+
+		sw := &StatsWriter{}
+		err = sw.GenerateList([]*PrimaryKeyStats{stat})
+		assert.NoError(t, err)
+		// the stats logs are read without knowing the compress type
+		stats, err := DeserializeStatsList(&Blob{Value: sw.GetBuffer()})
+		assert.NoError(t, err)
+		assert.Equal(t, 1, len(stats))
+		assert.True(t, stats[0].MaxPk.EQ(NewInt64PrimaryKey(99)))
+
+		err = sw.Generate(stat)
+		assert.NoError(t, err)
+		results, err := DeserializeStats([]*Blob{{Value: sw.GetBuffer()}})
+		assert.NoError(t, err)
+		assert.Equal(t, 1, len(results))
+		assert.True(t, results[0].MinPk.EQ(NewInt64PrimaryKey(0)))
+	}
+
+	// the unsupported compress type falls back to no compression
+	pt.Save(pt.CommonCfg.StatsLogCompressType.Key, "unknown")
+	sw := &StatsWriter{}
+	assert.NoError(t, sw.Generate(stat))
+	_, ok := compressor.DetectCompressType(sw.GetBuffer())
+	assert.False(t, ok)
+}
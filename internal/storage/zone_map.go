@@ -0,0 +1,270 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/apache/arrow/go/v17/arrow"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/allocator"
+	"github.com/milvus-io/milvus/internal/util/bloomfilter"
+	"github.com/milvus-io/milvus/pkg/v2/common"
+	"github.com/milvus-io/milvus/pkg/v2/proto/datapb"
+	"github.com/milvus-io/milvus/pkg/v2/util/merr"
+	"github.com/milvus-io/milvus/pkg/v2/util/metautil"
+	"github.com/milvus-io/milvus/pkg/v2/util/paramtable"
+)
+
+// SupportZoneMap returns whether the zone map could be collected for the data type.
+func SupportZoneMap(dataType schemapb.DataType) bool {
+	switch dataType {
+	case schemapb.DataType_Int8,
+		schemapb.DataType_Int16,
+		schemapb.DataType_Int32,
+		schemapb.DataType_Int64,
+		schemapb.DataType_Float,
+		schemapb.DataType_Double,
+		schemapb.DataType_String,
+		schemapb.DataType_VarChar:
+		return true
+	default:
+		return false
+	}
+}
+
+// SupportBloomFilter returns whether the bloom filter could be built for the data type.
+// Floating point fields are excluded since equal filters on them are rarely used.
+func SupportBloomFilter(dataType schemapb.DataType) bool {
+	switch dataType {
+	case schemapb.DataType_Int8,
+		schemapb.DataType_Int16,
+		schemapb.DataType_Int32,
+		schemapb.DataType_Int64,
+		schemapb.DataType_String,
+		schemapb.DataType_VarChar:
+		return true
+	default:
+		return false
+	}
+}
+
+// NewZoneMapStats creates the field stats which only keeps the min/max value and the null count of a scalar field.
+// Unlike the pk stats, no bloom filter is kept, the zone map is only used to skip the segments by range/equal/in filters.
+func NewZoneMapStats(fieldID int64, dataType schemapb.DataType) *FieldStats {
+	return &FieldStats{
+		FieldID: fieldID,
+		Type:    dataType,
+	}
+}
+
+// NewZoneMapStatsList creates the zone map stats of the scalar fields except the primary key for a batch of rowNum rows.
+// The zone maps of all supported fields are collected if zoneMapEnabled, while the fields enabling bloom filter
+// by the field property always have their zone maps collected, along with a bloom filter of the values.
+func NewZoneMapStatsList(schema *schemapb.CollectionSchema, rowNum int64, zoneMapEnabled bool) []*FieldStats {
+	statsList := make([]*FieldStats, 0)
+	for _, field := range schema.GetFields() {
+		if common.IsSystemField(field.GetFieldID()) || field.GetIsPrimaryKey() || !SupportZoneMap(field.GetDataType()) {
+			continue
+		}
+		bfEnabled := SupportBloomFilter(field.GetDataType()) && common.IsBloomFilterEnabled(field.GetTypeParams()...)
+		if !zoneMapEnabled && !bfEnabled {
+			continue
+		}
+		stats := NewZoneMapStats(field.GetFieldID(), field.GetDataType())
+		if bfEnabled {
+			bfType := paramtable.Get().CommonCfg.BloomFilterType.GetValue()
+			stats.BFType = bloomfilter.BFTypeFromString(bfType)
+			stats.BF = bloomfilter.NewBloomFilterWithType(
+				uint(rowNum),
+				paramtable.Get().CommonCfg.MaxBloomFalsePositive.GetAsFloat(),
+				bfType)
+		}
+		statsList = append(statsList, stats)
+	}
+	return statsList
+}
+
+// UpdateZoneMap updates the min/max value and the null count by the field data.
+// NaN values are skipped since they never match any range/equal/in filter.
+func (stats *FieldStats) UpdateZoneMap(data FieldData) {
+	for i := 0; i < data.RowNum(); i++ {
+		stats.updateZoneMapByRow(data.GetRow(i))
+	}
+}
+
+// UpdateZoneMapByArrow updates the min/max value and the null count by the arrow column of a record.
+func (stats *FieldStats) UpdateZoneMapByArrow(column arrow.Array) error {
+	entry, ok := serdeMap[stats.Type]
+	if !ok {
+		return merr.WrapErrParameterInvalidMsg("unsupported zone map data type %s", stats.Type.String())
+	}
+	for i := 0; i < column.Len(); i++ {
+		row, ok := entry.deserialize(column, i)
+		if !ok {
+			return merr.WrapErrParameterInvalidMsg("unexpected arrow column type %s of field %d", column.DataType().String(), stats.FieldID)
+		}
+		stats.updateZoneMapByRow(row)
+	}
+	return nil
+}
+
+func (stats *FieldStats) updateZoneMapByRow(row any) {
+	if row == nil {
+		stats.NullCount++
+		return
+	}
+	switch v := row.(type) {
+	case float32:
+		if math.IsNaN(float64(v)) {
+			return
+		}
+	case float64:
+		if math.IsNaN(v) {
+			return
+		}
+	}
+	if stats.BF != nil {
+		stats.Update(NewScalarFieldValue(stats.Type, row))
+	} else {
+		stats.UpdateMinMax(NewScalarFieldValue(stats.Type, row))
+	}
+}
+
+// MergeZoneMap merges the zone map of another batch of rows into the stats.
+// The min/max value of the batch is ignored if all of its rows are null,
+// the deserialized min/max of such batch is only a zero value placeholder.
+func (stats *FieldStats) MergeZoneMap(other *FieldStats, rowNum int64) {
+	stats.NullCount += other.NullCount
+	if other.NullCount >= rowNum {
+		return
+	}
+	if other.Min != nil {
+		stats.UpdateMinMax(other.Min)
+	}
+	if other.Max != nil {
+		stats.UpdateMinMax(other.Max)
+	}
+}
+
+// BloomFilterMayContain tests the value against the bloom filter built by the zone map stats,
+// the value is encoded in the same way as FieldStats.Update.
+func BloomFilterMayContain(bf bloomfilter.BloomFilterInterface, value ScalarFieldValue) bool {
+	switch v := value.GetValue().(type) {
+	case int8:
+		return bf.Test(encodeBloomFilterInt(uint64(v)))
+	case int16:
+		return bf.Test(encodeBloomFilterInt(uint64(v)))
+	case int32:
+		return bf.Test(encodeBloomFilterInt(uint64(v)))
+	case int64:
+		return bf.Test(encodeBloomFilterInt(uint64(v)))
+	case string:
+		return bf.TestString(v)
+	default:
+		return true
+	}
+}
+
+func encodeBloomFilterInt(v uint64) []byte {
+	b := make([]byte, 8)
+	common.Endian.PutUint64(b, v)
+	return b
+}
+
+// SerializeZoneMap serializes the zone map of a batch of rows to a statslog blob.
+func SerializeZoneMap(stats *FieldStats, rowNum int64) (*Blob, error) {
+	sw := &FieldStatsWriter{}
+	if err := sw.GenerateList([]*FieldStats{stats}); err != nil {
+		return nil, err
+	}
+	buffer := sw.GetBuffer()
+	return &Blob{
+		Key:        fmt.Sprintf("%d", stats.FieldID),
+		Value:      buffer,
+		RowNum:     rowNum,
+		MemorySize: int64(len(buffer)),
+	}, nil
+}
+
+// zoneMapCollector collects the zone maps of a whole segment written by the binlog record writers,
+// e.g. by compaction or sort, so that the rewritten segments could still be pruned.
+type zoneMapCollector struct {
+	stats []*FieldStats
+}
+
+func newZoneMapCollector(schema *schemapb.CollectionSchema, maxRowNum int64) *zoneMapCollector {
+	return &zoneMapCollector{
+		stats: NewZoneMapStatsList(schema, maxRowNum, paramtable.Get().DataNodeCfg.ZoneMapEnabled.GetAsBool()),
+	}
+}
+
+func (c *zoneMapCollector) update(r Record) error {
+	stats := c.stats[:0]
+	for _, fieldStats := range c.stats {
+		column := r.Column(fieldStats.FieldID)
+		if column == nil {
+			// the zone map of the field missing in the record is dropped, an incomplete zone map shall not be written.
+			continue
+		}
+		if err := fieldStats.UpdateZoneMapByArrow(column); err != nil {
+			return err
+		}
+		stats = append(stats, fieldStats)
+	}
+	c.stats = stats
+	return nil
+}
+
+// write writes one statslog for the zone map of each field, all rows of the segment are covered by the statslog.
+func (c *zoneMapCollector) write(alloc allocator.Interface, blobsWriter ChunkedBlobsWriter, rootPath string,
+	collectionID, partitionID, segmentID UniqueID, rowNum int64,
+) (map[FieldID]*datapb.FieldBinlog, error) {
+	logs := make(map[FieldID]*datapb.FieldBinlog, len(c.stats))
+	if len(c.stats) == 0 || rowNum == 0 {
+		return logs, nil
+	}
+	id, _, err := alloc.Alloc(uint32(len(c.stats)))
+	if err != nil {
+		return nil, err
+	}
+	for _, fieldStats := range c.stats {
+		blob, err := SerializeZoneMap(fieldStats, rowNum)
+		if err != nil {
+			return nil, err
+		}
+		blob.Key = metautil.BuildStatsLogPath(rootPath, collectionID, partitionID, segmentID, fieldStats.FieldID, id)
+		if err := blobsWriter([]*Blob{blob}); err != nil {
+			return nil, err
+		}
+		logs[fieldStats.FieldID] = &datapb.FieldBinlog{
+			FieldID: fieldStats.FieldID,
+			Binlogs: []*datapb.Binlog{
+				{
+					LogSize:    int64(len(blob.GetValue())),
+					MemorySize: int64(len(blob.GetValue())),
+					LogPath:    blob.Key,
+					EntriesNum: rowNum,
+				},
+			},
+		}
+		id++
+	}
+	return logs, nil
+}
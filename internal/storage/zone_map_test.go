@@ -0,0 +1,175 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"math"
+	"testing"
+
+	"github.com/apache/arrow/go/v17/arrow/array"
+	"github.com/apache/arrow/go/v17/arrow/memory"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/pkg/v2/common"
+)
+
+func TestSupportZoneMap(t *testing.T) {
+	assert.True(t, SupportZoneMap(schemapb.DataType_Int8))
+	assert.True(t, SupportZoneMap(schemapb.DataType_Int64))
+	assert.True(t, SupportZoneMap(schemapb.DataType_Double))
+	assert.True(t, SupportZoneMap(schemapb.DataType_VarChar))
+	assert.False(t, SupportZoneMap(schemapb.DataType_Bool))
+	assert.False(t, SupportZoneMap(schemapb.DataType_JSON))
+	assert.False(t, SupportZoneMap(schemapb.DataType_Array))
+	assert.False(t, SupportZoneMap(schemapb.DataType_FloatVector))
+}
+
+func TestZoneMapUpdate(t *testing.T) {
+	t.Run("int64", func(t *testing.T) {
+		stats := NewZoneMapStats(100, schemapb.DataType_Int64)
+		stats.UpdateZoneMap(&Int64FieldData{Data: []int64{5, -3, 9}})
+		assert.Equal(t, int64(-3), stats.Min.GetValue())
+		assert.Equal(t, int64(9), stats.Max.GetValue())
+		assert.Equal(t, int64(0), stats.NullCount)
+		assert.Nil(t, stats.BF)
+	})
+
+	t.Run("nullable", func(t *testing.T) {
+		stats := NewZoneMapStats(100, schemapb.DataType_VarChar)
+		stats.UpdateZoneMap(&StringFieldData{
+			Data:      []string{"", "b", "", "d"},
+			ValidData: []bool{false, true, false, true},
+			Nullable:  true,
+		})
+		assert.Equal(t, "b", stats.Min.GetValue())
+		assert.Equal(t, "d", stats.Max.GetValue())
+		assert.Equal(t, int64(2), stats.NullCount)
+	})
+
+	t.Run("nan", func(t *testing.T) {
+		stats := NewZoneMapStats(100, schemapb.DataType_Double)
+		stats.UpdateZoneMap(&DoubleFieldData{Data: []float64{math.NaN(), 1.5, -2.5}})
+		assert.Equal(t, -2.5, stats.Min.GetValue())
+		assert.Equal(t, 1.5, stats.Max.GetValue())
+	})
+}
+
+func TestZoneMapSerializeAndMerge(t *testing.T) {
+	batch1 := NewZoneMapStats(100, schemapb.DataType_Int32)
+	batch1.UpdateZoneMap(&Int32FieldData{Data: []int32{10, 20}, ValidData: []bool{true, true}, Nullable: true})
+	batch2 := NewZoneMapStats(100, schemapb.DataType_Int32)
+	batch2.UpdateZoneMap(&Int32FieldData{Data: []int32{0, 0}, ValidData: []bool{false, false}, Nullable: true})
+	batch3 := NewZoneMapStats(100, schemapb.DataType_Int32)
+	batch3.UpdateZoneMap(&Int32FieldData{Data: []int32{30, 0}, ValidData: []bool{true, false}, Nullable: true})
+
+	merged := NewZoneMapStats(100, schemapb.DataType_Int32)
+	for _, batch := range []*FieldStats{batch1, batch2, batch3} {
+		blob, err := SerializeZoneMap(batch, 2)
+		require.NoError(t, err)
+		assert.Equal(t, int64(2), blob.RowNum)
+		assert.Equal(t, "100", blob.GetKey())
+
+		stats, err := DeserializeFieldStats(blob)
+		require.NoError(t, err)
+		require.Len(t, stats, 1)
+		assert.Equal(t, batch.NullCount, stats[0].NullCount)
+		merged.MergeZoneMap(stats[0], blob.RowNum)
+	}
+	// the zero value placeholder of the all null batch should not be merged.
+	assert.Equal(t, int32(10), merged.Min.GetValue())
+	assert.Equal(t, int32(30), merged.Max.GetValue())
+	assert.Equal(t, int64(3), merged.NullCount)
+}
+
+func TestNewZoneMapStatsList(t *testing.T) {
+	bfEnabled := []*commonpb.KeyValuePair{{Key: common.BloomFilterEnabledKey, Value: "true"}}
+	schema := &schemapb.CollectionSchema{
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: common.RowIDField, DataType: schemapb.DataType_Int64},
+			{FieldID: common.TimeStampField, DataType: schemapb.DataType_Int64},
+			{FieldID: 100, DataType: schemapb.DataType_Int64, IsPrimaryKey: true, TypeParams: bfEnabled},
+			{FieldID: 101, DataType: schemapb.DataType_Int32, TypeParams: bfEnabled},
+			{FieldID: 102, DataType: schemapb.DataType_VarChar},
+			{FieldID: 103, DataType: schemapb.DataType_Double, TypeParams: bfEnabled},
+			{FieldID: 104, DataType: schemapb.DataType_JSON},
+		},
+	}
+
+	statsList := NewZoneMapStatsList(schema, 100, true)
+	require.Len(t, statsList, 3)
+	assert.Equal(t, int64(101), statsList[0].FieldID)
+	assert.NotNil(t, statsList[0].BF)
+	assert.Equal(t, int64(102), statsList[1].FieldID)
+	assert.Nil(t, statsList[1].BF)
+	// bloom filter is not supported for floating point fields
+	assert.Equal(t, int64(103), statsList[2].FieldID)
+	assert.Nil(t, statsList[2].BF)
+
+	statsList = NewZoneMapStatsList(schema, 100, false)
+	require.Len(t, statsList, 1)
+	assert.Equal(t, int64(101), statsList[0].FieldID)
+}
+
+func TestZoneMapBloomFilter(t *testing.T) {
+	schema := &schemapb.CollectionSchema{
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: 101, DataType: schemapb.DataType_Int16, TypeParams: []*commonpb.KeyValuePair{{Key: common.BloomFilterEnabledKey, Value: "true"}}},
+			{FieldID: 102, DataType: schemapb.DataType_VarChar, TypeParams: []*commonpb.KeyValuePair{{Key: common.BloomFilterEnabledKey, Value: "true"}}},
+		},
+	}
+	statsList := NewZoneMapStatsList(schema, 3, false)
+	require.Len(t, statsList, 2)
+	statsList[0].UpdateZoneMap(&Int16FieldData{Data: []int16{-5, 0, 1000}})
+	statsList[1].UpdateZoneMap(&StringFieldData{Data: []string{"abc", "", "xyz"}, ValidData: []bool{true, false, true}, Nullable: true})
+
+	for _, stats := range statsList {
+		blob, err := SerializeZoneMap(stats, 3)
+		require.NoError(t, err)
+		deserialized, err := DeserializeFieldStats(blob)
+		require.NoError(t, err)
+		require.Len(t, deserialized, 1)
+		require.NotNil(t, deserialized[0].BF)
+		stats.BF = deserialized[0].BF
+	}
+
+	assert.True(t, BloomFilterMayContain(statsList[0].BF, NewInt16FieldValue(-5)))
+	assert.True(t, BloomFilterMayContain(statsList[0].BF, NewInt16FieldValue(1000)))
+	assert.False(t, BloomFilterMayContain(statsList[0].BF, NewInt16FieldValue(7)))
+	assert.True(t, BloomFilterMayContain(statsList[1].BF, NewVarCharFieldValue("abc")))
+	assert.False(t, BloomFilterMayContain(statsList[1].BF, NewVarCharFieldValue("abd")))
+	assert.Equal(t, int64(1), statsList[1].NullCount)
+}
+
+func TestZoneMapUpdateByArrow(t *testing.T) {
+	builder := array.NewInt64Builder(memory.DefaultAllocator)
+	builder.AppendValues([]int64{3, 1, 0}, []bool{true, true, false})
+	column := builder.NewArray()
+	defer column.Release()
+
+	stats := NewZoneMapStats(100, schemapb.DataType_Int64)
+	require.NoError(t, stats.UpdateZoneMapByArrow(column))
+	assert.Equal(t, int64(1), stats.Min.GetValue())
+	assert.Equal(t, int64(3), stats.Max.GetValue())
+	assert.Equal(t, int64(1), stats.NullCount)
+
+	// the column type mismatches the field type
+	stats = NewZoneMapStats(100, schemapb.DataType_VarChar)
+	assert.Error(t, stats.UpdateZoneMapByArrow(column))
+}
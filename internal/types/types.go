@@ -40,6 +40,9 @@ import (
 // Otherwise, the request will pass. Limit also returns limit of limiter.
 type Limiter interface {
 	Check(dbID int64, collectionIDToPartIDs map[int64][]int64, rt internalpb.RateType, n int) error
+	CheckUser(username string, rt internalpb.RateType, n int) error
+	// CancelUser refunds the tokens taken by CheckUser, used when the request is rejected by Check afterwards.
+	CancelUser(username string, rt internalpb.RateType, n int)
 	Alloc(ctx context.Context, dbID int64, collectionIDToPartIDs map[int64][]int64, rt internalpb.RateType, n int) error
 }
 
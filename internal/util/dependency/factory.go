@@ -7,6 +7,7 @@ import (
 	"go.uber.org/zap"
 
 	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/streamingutil"
 	"github.com/milvus-io/milvus/pkg/v2/common"
 	"github.com/milvus-io/milvus/pkg/v2/log"
 	"github.com/milvus-io/milvus/pkg/v2/metrics"
@@ -20,8 +21,12 @@ const (
 	mqTypeRocksmq = "rocksmq"
 	mqTypeKafka   = "kafka"
 	mqTypePulsar  = "pulsar"
+	mqTypeFileLog = "filelog"
 )
 
+// ErrMsgStreamUnsupported is returned by the msgstream factory of a mq which can only be accessed by the streaming service.
+var ErrMsgStreamUnsupported = errors.New("msgstream is not supported by the mq, it is only accessible through the streaming service")
+
 type mqEnable struct {
 	Rocksmq bool
 	Natsmq  bool
@@ -94,6 +99,12 @@ func (f *DefaultFactory) initMQ(standalone bool, params *paramtable.ComponentPar
 		f.msgStreamFactory = msgstream.NewPmsFactory(&params.ServiceParam)
 	case mqTypeKafka:
 		f.msgStreamFactory = msgstream.NewKmsFactory(&params.ServiceParam)
+	case mqTypeFileLog:
+		// filelog is a wal implementation of the streaming service, there is no msgstream on it.
+		if !streamingutil.IsStreamingServiceEnabled() {
+			return errors.Newf("mq %s is only valid with the streaming service enabled", mqType)
+		}
+		f.msgStreamFactory = walOnlyMsgStreamFactory{}
 	}
 	if f.msgStreamFactory == nil {
 		return errors.New("failed to create MQ: check the milvus log for initialization failures")
@@ -127,15 +138,31 @@ func mustSelectMQType(standalone bool, mqType string, enable mqEnable) string {
 
 // Validate mq type.
 func validateMQType(standalone bool, mqType string) error {
-	if mqType != mqTypeNatsmq && mqType != mqTypeRocksmq && mqType != mqTypeKafka && mqType != mqTypePulsar {
+	if mqType != mqTypeNatsmq && mqType != mqTypeRocksmq && mqType != mqTypeKafka && mqType != mqTypePulsar && mqType != mqTypeFileLog {
 		return errors.Newf("mq type %s is invalid", mqType)
 	}
-	if !standalone && (mqType == mqTypeRocksmq || mqType == mqTypeNatsmq) {
-		return errors.Newf("mq %s is only valid in standalone mode")
+	if !standalone && (mqType == mqTypeRocksmq || mqType == mqTypeNatsmq || mqType == mqTypeFileLog) {
+		return errors.Newf("mq %s is only valid in standalone mode", mqType)
 	}
 	return nil
 }
 
+// walOnlyMsgStreamFactory is the msgstream factory of the mq which is only accessible through the streaming service.
+type walOnlyMsgStreamFactory struct{}
+
+func (walOnlyMsgStreamFactory) NewMsgStream(ctx context.Context) (msgstream.MsgStream, error) {
+	return nil, ErrMsgStreamUnsupported
+}
+
+func (walOnlyMsgStreamFactory) NewTtMsgStream(ctx context.Context) (msgstream.MsgStream, error) {
+	return nil, ErrMsgStreamUnsupported
+}
+
+func (walOnlyMsgStreamFactory) NewMsgStreamDisposer(ctx context.Context) func([]string, string) error {
+	// the channels are managed by the streaming service, nothing to dispose.
+	return func([]string, string) error { return nil }
+}
+
 func (f *DefaultFactory) NewMsgStream(ctx context.Context) (msgstream.MsgStream, error) {
 	return f.msgStreamFactory.NewMsgStream(ctx)
 }
@@ -167,6 +194,9 @@ func HealthCheck(mqType string) *common.MQClusterStatus {
 	case mqTypeRocksmq:
 		// TODO: implement health checker for rocks mq
 		clusterStatus.Health = true
+	case mqTypeFileLog:
+		// filelog is a local file wal, it's healthy as long as the streaming node is alive.
+		clusterStatus.Health = true
 	case mqTypePulsar:
 		msgstream.PulsarHealthCheck(clusterStatus)
 	case mqTypeKafka:
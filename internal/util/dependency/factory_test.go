@@ -1,10 +1,12 @@
 package dependency
 
 import (
+	"context"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 
+	"github.com/milvus-io/milvus/internal/util/streamingutil"
 	"github.com/milvus-io/milvus/pkg/v2/util/paramtable"
 )
 
@@ -13,6 +15,8 @@ func TestValidateMQType(t *testing.T) {
 	assert.Error(t, validateMQType(false, mqTypeDefault))
 	assert.Error(t, validateMQType(false, mqTypeNatsmq))
 	assert.Error(t, validateMQType(false, mqTypeRocksmq))
+	assert.Error(t, validateMQType(false, mqTypeFileLog))
+	assert.NoError(t, validateMQType(true, mqTypeFileLog))
 }
 
 func TestSelectMQType(t *testing.T) {
@@ -32,6 +36,8 @@ func TestSelectMQType(t *testing.T) {
 	assert.Equal(t, mustSelectMQType(true, mqTypeKafka, mqEnable{true, true, true, true}), mqTypeKafka)
 	assert.Panics(t, func() { mustSelectMQType(false, mqTypeRocksmq, mqEnable{true, true, true, true}) })
 	assert.Panics(t, func() { mustSelectMQType(false, mqTypeNatsmq, mqEnable{true, true, true, true}) })
+	assert.Equal(t, mustSelectMQType(true, mqTypeFileLog, mqEnable{true, true, true, true}), mqTypeFileLog)
+	assert.Panics(t, func() { mustSelectMQType(false, mqTypeFileLog, mqEnable{true, true, true, true}) })
 	assert.Equal(t, mustSelectMQType(false, mqTypePulsar, mqEnable{true, true, true, true}), mqTypePulsar)
 	assert.Equal(t, mustSelectMQType(false, mqTypeKafka, mqEnable{true, true, true, true}), mqTypeKafka)
 }
@@ -49,6 +55,7 @@ func TestHealthCheck(t *testing.T) {
 	}{
 		{mqTypeNatsmq, true},
 		{mqTypeRocksmq, true},
+		{mqTypeFileLog, true},
 		{mqTypePulsar, false},
 		{mqTypeKafka, false},
 		{"invalidType", false},
@@ -62,3 +69,27 @@ func TestHealthCheck(t *testing.T) {
 		})
 	}
 }
+
+func TestInitFileLogMQ(t *testing.T) {
+	paramtable.Init()
+	params := paramtable.Get()
+	params.Save(params.MQCfg.Type.Key, mqTypeFileLog)
+	defer params.Reset(params.MQCfg.Type.Key)
+
+	// filelog is only accessible through the streaming service.
+	assert.Panics(t, func() { NewFactory(true).Init(params) })
+
+	streamingutil.SetStreamingServiceEnabled()
+	defer streamingutil.UnsetStreamingServiceEnabled()
+
+	assert.Panics(t, func() { NewFactory(false).Init(params) })
+
+	f := NewFactory(true)
+	assert.NotPanics(t, func() { f.Init(params) })
+
+	_, err := f.NewMsgStream(context.Background())
+	assert.ErrorIs(t, err, ErrMsgStreamUnsupported)
+	_, err = f.NewTtMsgStream(context.Background())
+	assert.ErrorIs(t, err, ErrMsgStreamUnsupported)
+	assert.NoError(t, f.NewMsgStreamDisposer(context.Background())([]string{"ch"}, "sub"))
+}
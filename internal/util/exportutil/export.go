@@ -0,0 +1,94 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package exportutil holds the helpers shared by the datacoord, which validates the export requests,
+// and the datanode, which executes the export tasks.
+package exportutil
+
+import (
+	"github.com/samber/lo"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/parser/planparserv2"
+	"github.com/milvus-io/milvus/internal/util/exprutil"
+	"github.com/milvus-io/milvus/pkg/v2/common"
+	"github.com/milvus-io/milvus/pkg/v2/util/merr"
+	"github.com/milvus-io/milvus/pkg/v2/util/typeutil"
+)
+
+// NewRowFilter parses the filter expression and compiles it into a row filter.
+func NewRowFilter(schema *schemapb.CollectionSchema, filter string) (exprutil.RowFilter, error) {
+	schemaHelper, err := typeutil.CreateSchemaHelper(schema)
+	if err != nil {
+		return nil, err
+	}
+	expr, err := planparserv2.ParseExpr(schemaHelper, filter, nil)
+	if err != nil {
+		return nil, merr.WrapErrParameterInvalidMsg("failed to parse filter '%s', %s", filter, err.Error())
+	}
+	rowFilter, err := exprutil.NewRowFilter(expr)
+	if err != nil {
+		return nil, merr.WrapErrParameterInvalidMsg("unsupported filter '%s' for export, %s", filter, err.Error())
+	}
+	return rowFilter, nil
+}
+
+// GetFields returns the fields to export, and the schema of the fields to read which also
+// includes the primary key, the fields referenced by the filter and the system fields.
+// All the fields except the function output fields are exported if outputFields is empty.
+func GetFields(schema *schemapb.CollectionSchema, outputFields []string, filter string,
+) ([]*schemapb.FieldSchema, *schemapb.CollectionSchema, error) {
+	userFields := lo.Filter(schema.GetFields(), func(field *schemapb.FieldSchema, _ int) bool {
+		return !common.IsSystemField(field.GetFieldID())
+	})
+	nameToField := lo.KeyBy(userFields, func(field *schemapb.FieldSchema) string {
+		return field.GetName()
+	})
+	var fields []*schemapb.FieldSchema
+	if len(outputFields) == 0 {
+		fields = lo.Filter(userFields, func(field *schemapb.FieldSchema, _ int) bool {
+			return !field.GetIsFunctionOutput()
+		})
+	} else {
+		for _, name := range outputFields {
+			field, ok := nameToField[name]
+			if !ok {
+				return nil, nil, merr.WrapErrFieldNotFound(name)
+			}
+			fields = append(fields, field)
+		}
+		fields = lo.UniqBy(fields, func(field *schemapb.FieldSchema) int64 {
+			return field.GetFieldID()
+		})
+	}
+
+	readFieldIDs := typeutil.NewSet(lo.Map(fields, func(field *schemapb.FieldSchema, _ int) int64 {
+		return field.GetFieldID()
+	})...)
+	for _, field := range userFields {
+		// the filter may reference any scalar field, read the primary key and scalar fields as they are cheap
+		if field.GetIsPrimaryKey() || (filter != "" && !typeutil.IsVectorType(field.GetDataType())) {
+			readFieldIDs.Insert(field.GetFieldID())
+		}
+	}
+	readSchema := &schemapb.CollectionSchema{
+		Name: schema.GetName(),
+		Fields: lo.Filter(userFields, func(field *schemapb.FieldSchema, _ int) bool {
+			return readFieldIDs.Contain(field.GetFieldID())
+		}),
+	}
+	return fields, typeutil.AppendSystemFields(readSchema), nil
+}
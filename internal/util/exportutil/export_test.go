@@ -0,0 +1,96 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package exportutil
+
+import (
+	"testing"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/pkg/v2/common"
+	"github.com/milvus-io/milvus/pkg/v2/util/merr"
+)
+
+func newTestSchema() *schemapb.CollectionSchema {
+	return &schemapb.CollectionSchema{
+		Name: "coll",
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: common.RowIDField, Name: common.RowIDFieldName, DataType: schemapb.DataType_Int64},
+			{FieldID: common.TimeStampField, Name: common.TimeStampFieldName, DataType: schemapb.DataType_Int64},
+			{FieldID: 100, Name: "pk", DataType: schemapb.DataType_Int64, IsPrimaryKey: true},
+			{FieldID: 101, Name: "int32", DataType: schemapb.DataType_Int32},
+			{FieldID: 102, Name: "varchar", DataType: schemapb.DataType_VarChar},
+			{
+				FieldID: 103, Name: "vec", DataType: schemapb.DataType_FloatVector,
+				TypeParams: []*commonpb.KeyValuePair{{Key: "dim", Value: "4"}},
+			},
+			{
+				FieldID: 104, Name: "sparse", DataType: schemapb.DataType_SparseFloatVector,
+				IsFunctionOutput: true,
+			},
+		},
+	}
+}
+
+func TestGetFields(t *testing.T) {
+	schema := newTestSchema()
+	fieldIDs := func(fields []*schemapb.FieldSchema) []int64 {
+		return lo.Map(fields, func(field *schemapb.FieldSchema, _ int) int64 {
+			return field.GetFieldID()
+		})
+	}
+
+	// all the fields except the function output
+	fields, readSchema, err := GetFields(schema, nil, "")
+	assert.NoError(t, err)
+	assert.Equal(t, []int64{100, 101, 102, 103}, fieldIDs(fields))
+	assert.ElementsMatch(t, []int64{100, 101, 102, 103, common.RowIDField, common.TimeStampField}, fieldIDs(readSchema.GetFields()))
+
+	// the primary key is always read
+	fields, readSchema, err = GetFields(schema, []string{"vec", "vec"}, "")
+	assert.NoError(t, err)
+	assert.Equal(t, []int64{103}, fieldIDs(fields))
+	assert.ElementsMatch(t, []int64{100, 103, common.RowIDField, common.TimeStampField}, fieldIDs(readSchema.GetFields()))
+
+	// the scalar fields are read for the filter
+	fields, readSchema, err = GetFields(schema, []string{"sparse"}, "int32 > 1")
+	assert.NoError(t, err)
+	assert.Equal(t, []int64{104}, fieldIDs(fields))
+	assert.ElementsMatch(t, []int64{100, 101, 102, 104, common.RowIDField, common.TimeStampField}, fieldIDs(readSchema.GetFields()))
+
+	// system fields and unknown fields are not allowed
+	_, _, err = GetFields(schema, []string{common.TimeStampFieldName}, "")
+	assert.ErrorIs(t, err, merr.ErrFieldNotFound)
+	_, _, err = GetFields(schema, []string{"unknown"}, "")
+	assert.ErrorIs(t, err, merr.ErrFieldNotFound)
+}
+
+func TestNewRowFilter(t *testing.T) {
+	schema := newTestSchema()
+	filter, err := NewRowFilter(schema, `int32 > 1 and varchar like "a%"`)
+	assert.NoError(t, err)
+	assert.True(t, filter(map[int64]any{100: int64(1), 101: int32(2), 102: "abc"}))
+	assert.False(t, filter(map[int64]any{100: int64(1), 101: int32(1), 102: "abc"}))
+
+	_, err = NewRowFilter(schema, "int32 >")
+	assert.ErrorIs(t, err, merr.ErrParameterInvalid)
+	_, err = NewRowFilter(schema, "int32 + 1 > 2")
+	assert.ErrorIs(t, err, merr.ErrParameterInvalid)
+}
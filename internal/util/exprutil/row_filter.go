@@ -0,0 +1,252 @@
+package exprutil
+
+import (
+	"cmp"
+	"regexp"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/pkg/v2/proto/planpb"
+)
+
+// RowFilter evaluates a filter expression against a row, the row maps field id to the field value.
+type RowFilter func(row map[int64]any) bool
+
+// NewRowFilter compiles the parsed filter expression into a RowFilter evaluated in Go, for the
+// callers which filter rows outside segcore, such as export. Only comparisons, ranges, IN lists,
+// null checks, like and logical operators on scalar fields are supported.
+// As in segcore, a null value never satisfies a comparison.
+func NewRowFilter(expr *planpb.Expr) (RowFilter, error) {
+	if expr == nil {
+		return func(map[int64]any) bool { return true }, nil
+	}
+	switch e := expr.GetExpr().(type) {
+	case *planpb.Expr_AlwaysTrueExpr:
+		return func(map[int64]any) bool { return true }, nil
+	case *planpb.Expr_BinaryExpr:
+		left, err := NewRowFilter(e.BinaryExpr.GetLeft())
+		if err != nil {
+			return nil, err
+		}
+		right, err := NewRowFilter(e.BinaryExpr.GetRight())
+		if err != nil {
+			return nil, err
+		}
+		switch e.BinaryExpr.GetOp() {
+		case planpb.BinaryExpr_LogicalAnd:
+			return func(row map[int64]any) bool { return left(row) && right(row) }, nil
+		case planpb.BinaryExpr_LogicalOr:
+			return func(row map[int64]any) bool { return left(row) || right(row) }, nil
+		}
+		return nil, errors.Newf("unsupported binary operator %s", e.BinaryExpr.GetOp())
+	case *planpb.Expr_UnaryExpr:
+		if e.UnaryExpr.GetOp() != planpb.UnaryExpr_Not {
+			return nil, errors.Newf("unsupported unary operator %s", e.UnaryExpr.GetOp())
+		}
+		child, err := NewRowFilter(e.UnaryExpr.GetChild())
+		if err != nil {
+			return nil, err
+		}
+		return func(row map[int64]any) bool { return !child(row) }, nil
+	case *planpb.Expr_UnaryRangeExpr:
+		return newUnaryRangeFilter(e.UnaryRangeExpr)
+	case *planpb.Expr_BinaryRangeExpr:
+		return newBinaryRangeFilter(e.BinaryRangeExpr)
+	case *planpb.Expr_TermExpr:
+		return newTermFilter(e.TermExpr)
+	case *planpb.Expr_NullExpr:
+		fieldID, err := getScalarFieldID(e.NullExpr.GetColumnInfo())
+		if err != nil {
+			return nil, err
+		}
+		switch e.NullExpr.GetOp() {
+		case planpb.NullExpr_IsNull:
+			return func(row map[int64]any) bool { return row[fieldID] == nil }, nil
+		case planpb.NullExpr_IsNotNull:
+			return func(row map[int64]any) bool { return row[fieldID] != nil }, nil
+		}
+		return nil, errors.Newf("unsupported null operator %s", e.NullExpr.GetOp())
+	}
+	return nil, errors.Newf("unsupported expression %T", expr.GetExpr())
+}
+
+func getScalarFieldID(column *planpb.ColumnInfo) (int64, error) {
+	switch column.GetDataType() {
+	case schemapb.DataType_Bool, schemapb.DataType_Int8, schemapb.DataType_Int16, schemapb.DataType_Int32,
+		schemapb.DataType_Int64, schemapb.DataType_Float, schemapb.DataType_Double,
+		schemapb.DataType_String, schemapb.DataType_VarChar:
+		return column.GetFieldId(), nil
+	}
+	return 0, errors.Newf("unsupported filter on field %d of type %s", column.GetFieldId(), column.GetDataType())
+}
+
+func newUnaryRangeFilter(expr *planpb.UnaryRangeExpr) (RowFilter, error) {
+	fieldID, err := getScalarFieldID(expr.GetColumnInfo())
+	if err != nil {
+		return nil, err
+	}
+	value := expr.GetValue()
+	var match func(c int) bool
+	switch expr.GetOp() {
+	case planpb.OpType_GreaterThan:
+		match = func(c int) bool { return c > 0 }
+	case planpb.OpType_GreaterEqual:
+		match = func(c int) bool { return c >= 0 }
+	case planpb.OpType_LessThan:
+		match = func(c int) bool { return c < 0 }
+	case planpb.OpType_LessEqual:
+		match = func(c int) bool { return c <= 0 }
+	case planpb.OpType_Equal:
+		match = func(c int) bool { return c == 0 }
+	case planpb.OpType_NotEqual:
+		match = func(c int) bool { return c != 0 }
+	case planpb.OpType_PrefixMatch, planpb.OpType_PostfixMatch:
+		hasAffix := strings.HasPrefix
+		if expr.GetOp() == planpb.OpType_PostfixMatch {
+			hasAffix = strings.HasSuffix
+		}
+		return func(row map[int64]any) bool {
+			s, ok := row[fieldID].(string)
+			return ok && hasAffix(s, value.GetStringVal())
+		}, nil
+	case planpb.OpType_Match:
+		re, err := likeToRegexp(value.GetStringVal())
+		if err != nil {
+			return nil, err
+		}
+		return func(row map[int64]any) bool {
+			s, ok := row[fieldID].(string)
+			return ok && re.MatchString(s)
+		}, nil
+	default:
+		return nil, errors.Newf("unsupported operator %s", expr.GetOp())
+	}
+	return func(row map[int64]any) bool {
+		c, ok := compareValue(row[fieldID], value)
+		return ok && match(c)
+	}, nil
+}
+
+func newBinaryRangeFilter(expr *planpb.BinaryRangeExpr) (RowFilter, error) {
+	fieldID, err := getScalarFieldID(expr.GetColumnInfo())
+	if err != nil {
+		return nil, err
+	}
+	return func(row map[int64]any) bool {
+		lower, ok := compareValue(row[fieldID], expr.GetLowerValue())
+		if !ok || lower < 0 || (lower == 0 && !expr.GetLowerInclusive()) {
+			return false
+		}
+		upper, ok := compareValue(row[fieldID], expr.GetUpperValue())
+		if !ok || upper > 0 || (upper == 0 && !expr.GetUpperInclusive()) {
+			return false
+		}
+		return true
+	}, nil
+}
+
+func newTermFilter(expr *planpb.TermExpr) (RowFilter, error) {
+	if expr.GetIsInField() {
+		return nil, errors.New("unsupported term expression on array field")
+	}
+	fieldID, err := getScalarFieldID(expr.GetColumnInfo())
+	if err != nil {
+		return nil, err
+	}
+	values := expr.GetValues()
+	return func(row map[int64]any) bool {
+		for _, value := range values {
+			if c, ok := compareValue(row[fieldID], value); ok && c == 0 {
+				return true
+			}
+		}
+		return false
+	}, nil
+}
+
+// likeToRegexp translates the pattern of like, where '%' matches any characters, '_' matches
+// a single character, and a backslash escapes the wildcards.
+func likeToRegexp(pattern string) (*regexp.Regexp, error) {
+	var builder strings.Builder
+	builder.WriteString("^")
+	for i := 0; i < len(pattern); i++ {
+		c := pattern[i]
+		switch {
+		case c == '\\' && i+1 < len(pattern) && (pattern[i+1] == '%' || pattern[i+1] == '_'):
+			builder.WriteString(regexp.QuoteMeta(pattern[i+1 : i+2]))
+			i++
+		case c == '%':
+			builder.WriteString("(?s:.*)")
+		case c == '_':
+			builder.WriteString("(?s:.)")
+		default:
+			builder.WriteString(regexp.QuoteMeta(pattern[i : i+1]))
+		}
+	}
+	builder.WriteString("$")
+	return regexp.Compile(builder.String())
+}
+
+// compareValue compares the field value with the value in the expression,
+// returns false if the values are not comparable, e.g. the field value is null.
+func compareValue(v any, value *planpb.GenericValue) (int, bool) {
+	switch val := value.GetVal().(type) {
+	case *planpb.GenericValue_BoolVal:
+		b, ok := v.(bool)
+		if !ok {
+			return 0, false
+		}
+		if b == val.BoolVal {
+			return 0, true
+		}
+		if b {
+			return 1, true
+		}
+		return -1, true
+	case *planpb.GenericValue_Int64Val:
+		if i, ok := toInt64(v); ok {
+			return cmp.Compare(i, val.Int64Val), true
+		}
+		if f, ok := toFloat64(v); ok {
+			return cmp.Compare(f, float64(val.Int64Val)), true
+		}
+	case *planpb.GenericValue_FloatVal:
+		if f, ok := toFloat64(v); ok {
+			return cmp.Compare(f, val.FloatVal), true
+		}
+	case *planpb.GenericValue_StringVal:
+		if s, ok := v.(string); ok {
+			return strings.Compare(s, val.StringVal), true
+		}
+	}
+	return 0, false
+}
+
+func toInt64(v any) (int64, bool) {
+	switch i := v.(type) {
+	case int8:
+		return int64(i), true
+	case int16:
+		return int64(i), true
+	case int32:
+		return int64(i), true
+	case int64:
+		return i, true
+	}
+	return 0, false
+}
+
+func toFloat64(v any) (float64, bool) {
+	switch f := v.(type) {
+	case float32:
+		return float64(f), true
+	case float64:
+		return f, true
+	}
+	if i, ok := toInt64(v); ok {
+		return float64(i), true
+	}
+	return 0, false
+}
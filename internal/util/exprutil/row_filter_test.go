@@ -0,0 +1,96 @@
+package exprutil
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/parser/planparserv2"
+	"github.com/milvus-io/milvus/pkg/v2/proto/planpb"
+	"github.com/milvus-io/milvus/pkg/v2/util/typeutil"
+)
+
+func TestNewRowFilter(t *testing.T) {
+	schema := &schemapb.CollectionSchema{
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: 100, Name: "pk", DataType: schemapb.DataType_Int64, IsPrimaryKey: true},
+			{FieldID: 101, Name: "int32", DataType: schemapb.DataType_Int32},
+			{FieldID: 102, Name: "float", DataType: schemapb.DataType_Float},
+			{FieldID: 103, Name: "varchar", DataType: schemapb.DataType_VarChar, Nullable: true},
+			{FieldID: 104, Name: "bool", DataType: schemapb.DataType_Bool},
+			{FieldID: 105, Name: "json", DataType: schemapb.DataType_JSON},
+			{
+				FieldID: 106, Name: "vec", DataType: schemapb.DataType_FloatVector,
+				TypeParams: []*commonpb.KeyValuePair{{Key: "dim", Value: "4"}},
+			},
+		},
+	}
+	schemaHelper, err := typeutil.CreateSchemaHelper(schema)
+	require.NoError(t, err)
+
+	row := map[int64]any{
+		100: int64(1),
+		101: int32(10),
+		102: float32(1.5),
+		103: "hello",
+		104: true,
+		105: []byte(`{"a": 1}`),
+	}
+	nullRow := map[int64]any{
+		100: int64(2),
+		101: int32(20),
+		102: float32(2.5),
+		103: nil,
+		104: false,
+		105: []byte(`{"a": 2}`),
+	}
+
+	cases := []struct {
+		expr        string
+		row         bool
+		nullRow     bool
+		unsupported bool
+	}{
+		{expr: "", row: true, nullRow: true},
+		{expr: "pk == 1", row: true, nullRow: false},
+		{expr: "pk != 1", row: false, nullRow: true},
+		{expr: "pk in [2, 3]", row: false, nullRow: true},
+		{expr: "pk not in [2, 3]", row: true, nullRow: false},
+		{expr: "int32 > 10", row: false, nullRow: true},
+		{expr: "int32 >= 10", row: true, nullRow: true},
+		{expr: "5 < int32 < 15", row: true, nullRow: false},
+		{expr: "10 < int32 <= 20", row: false, nullRow: true},
+		{expr: "float < 2", row: true, nullRow: false},
+		{expr: "float > 1.2 and int32 < 15", row: true, nullRow: false},
+		{expr: "pk == 3 or bool == false", row: false, nullRow: true},
+		{expr: "varchar == \"hello\"", row: true, nullRow: false},
+		{expr: "varchar like \"he%\"", row: true, nullRow: false},
+		{expr: "varchar like \"%lo\"", row: true, nullRow: false},
+		{expr: "varchar like \"h_l%o\"", row: true, nullRow: false},
+		{expr: "varchar like \"h_o%\"", row: false, nullRow: false},
+		{expr: "varchar is null", row: false, nullRow: true},
+		{expr: "varchar is not null", row: true, nullRow: false},
+		{expr: "json[\"a\"] == 1", unsupported: true},
+		{expr: "int32 + 1 == 11", unsupported: true},
+	}
+	for _, c := range cases {
+		t.Run(c.expr, func(t *testing.T) {
+			var expr *planpb.Expr
+			if c.expr != "" {
+				expr, err = planparserv2.ParseExpr(schemaHelper, c.expr, nil)
+				require.NoError(t, err)
+			}
+			filter, err := NewRowFilter(expr)
+			if c.unsupported {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, c.row, filter(row))
+			assert.Equal(t, c.nullRow, filter(nullRow))
+		})
+	}
+}
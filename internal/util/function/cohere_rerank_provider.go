@@ -0,0 +1,92 @@
+/*
+ * # Licensed to the LF AI & Data foundation under one
+ * # or more contributor license agreements. See the NOTICE file
+ * # distributed with this work for additional information
+ * # regarding copyright ownership. The ASF licenses this file
+ * # to you under the Apache License, Version 2.0 (the
+ * # "License"); you may not use this file except in compliance
+ * # with the License. You may obtain a copy of the License at
+ * #
+ * #     http://www.apache.org/licenses/LICENSE-2.0
+ * #
+ * # Unless required by applicable law or agreed to in writing, software
+ * # distributed under the License is distributed on an "AS IS" BASIS,
+ * # WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * # See the License for the specific language governing permissions and
+ * # limitations under the License.
+ */
+
+package function
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/util/function/models/cohere"
+)
+
+type CohereRerankProvider struct {
+	client          *cohere.CohereRerank
+	modelName       string
+	maxTokensPerDoc int64
+
+	maxBatch   int
+	timeoutSec int64
+}
+
+// cohereRerankURL is the endpoint of the Cohere rerank service, the api key comes from MILVUSAI_COHERE_API_KEY.
+var cohereRerankURL = "https://api.cohere.com/v2/rerank"
+
+func createCohereRerankClient() (*cohere.CohereRerank, error) {
+	apiKey := os.Getenv(cohereAIAKEnvStr)
+	if apiKey == "" {
+		return nil, fmt.Errorf("Missing credentials. Please configure the %s environment variable in the Milvus service.", cohereAIAKEnvStr)
+	}
+
+	c := cohere.NewCohereRerankClient(apiKey, cohereRerankURL)
+	return c, nil
+}
+
+func NewCohereRerankProvider(functionSchema *schemapb.FunctionSchema) (*CohereRerankProvider, error) {
+	var modelName string
+	var maxTokensPerDoc int64
+	var err error
+	for _, param := range functionSchema.Params {
+		switch strings.ToLower(param.Key) {
+		case modelNameParamKey:
+			modelName = param.Value
+		case maxTokensPerDocParamKey:
+			if maxTokensPerDoc, err = strconv.ParseInt(param.Value, 10, 64); err != nil || maxTokensPerDoc <= 0 {
+				return nil, fmt.Errorf("[%s param's value: %s] is not a valid positive number", maxTokensPerDocParamKey, param.Value)
+			}
+		default:
+		}
+	}
+
+	c, err := createCohereRerankClient()
+	if err != nil {
+		return nil, err
+	}
+
+	provider := CohereRerankProvider{
+		client:          c,
+		modelName:       modelName,
+		maxTokensPerDoc: maxTokensPerDoc,
+		// Cohere recommends not sending more than 1,000 documents in a single request
+		maxBatch:   1000,
+		timeoutSec: 30,
+	}
+	return &provider, nil
+}
+
+func (provider *CohereRerankProvider) MaxBatch() int {
+	return provider.maxBatch
+}
+
+func (provider *CohereRerankProvider) CallRerank(ctx context.Context, query string, texts []string) ([]float32, error) {
+	return provider.client.Rerank(ctx, provider.modelName, query, texts, provider.maxTokensPerDoc, provider.timeoutSec)
+}
@@ -117,7 +117,8 @@ const (
 	truncationDirectionParamKey string = "truncation_direction"
 	endpointParamKey            string = "endpoint"
 
-	enableTeiEnvStr string = "MILVUSAI_ENABLE_TEI"
+	enableTeiEnvStr         string = "MILVUSAI_ENABLE_TEI"
+	teiRerankEndpointEnvStr string = "MILVUSAI_TEI_RERANK_ENDPOINT"
 )
 
 const enableConfigAKAndURL string = "ENABLE_CONFIG_AK_AND_URL"
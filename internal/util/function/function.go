@@ -35,7 +35,7 @@ func NewFunctionRunner(coll *schemapb.CollectionSchema, schema *schemapb.Functio
 	switch schema.GetType() {
 	case schemapb.FunctionType_BM25:
 		return NewBM25FunctionRunner(coll, schema)
-	case schemapb.FunctionType_TextEmbedding:
+	case schemapb.FunctionType_TextEmbedding, FunctionTypeRerank:
 		return nil, nil
 	default:
 		return nil, fmt.Errorf("unknown functionRunner type %s", schema.GetType().String())
@@ -66,6 +66,8 @@ func createFunction(coll *schemapb.CollectionSchema, schema *schemapb.FunctionSc
 			return nil, err
 		}
 		return f, nil
+	case FunctionTypeRerank: // rerank function only works on search results
+		return nil, nil
 	default:
 		return nil, fmt.Errorf("unknown functionRunner type %s", schema.GetType().String())
 	}
@@ -73,7 +75,18 @@ func createFunction(coll *schemapb.CollectionSchema, schema *schemapb.FunctionSc
 
 // Since bm25 and embedding are implemented in different ways, the bm25 function is not verified here.
 func ValidateFunctions(schema *schemapb.CollectionSchema) error {
+	hasRerank := false
 	for _, fSchema := range schema.Functions {
+		if fSchema.GetType() == FunctionTypeRerank {
+			if hasRerank {
+				return fmt.Errorf("Collection [%s] can only have one rerank function", schema.GetName())
+			}
+			hasRerank = true
+			if _, err := NewRerankFunction(schema, fSchema); err != nil {
+				return fmt.Errorf("Check function [%s:%s] failed, the err is: %v", fSchema.Name, fSchema.GetType().String(), err)
+			}
+			continue
+		}
 		f, err := createFunction(schema, fSchema)
 		if err != nil {
 			return err
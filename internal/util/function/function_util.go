@@ -29,6 +29,7 @@ func HasNonBM25Functions(functions []*schemapb.FunctionSchema, outputIDs []int64
 		switch fSchema.GetType() {
 		case schemapb.FunctionType_BM25:
 		case schemapb.FunctionType_Unknown:
+		case FunctionTypeRerank:
 		default:
 			if len(outputIDs) == 0 {
 				return true
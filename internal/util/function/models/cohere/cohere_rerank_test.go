@@ -0,0 +1,89 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cohere
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRerankClientCheck(t *testing.T) {
+	assert.Error(t, NewCohereRerankClient("", "mock_uri").Check())
+	assert.Error(t, NewCohereRerankClient("mock_key", "").Check())
+	assert.NoError(t, NewCohereRerankClient("mock_key", "mock_uri").Check())
+}
+
+func TestRerankOK(t *testing.T) {
+	repStr := `{
+  "id": "07734bd2-2473-4f07-94e1-0d9f0e6843cf",
+  "results": [
+    {
+      "index": 2,
+      "relevance_score": 0.999071
+    },
+    {
+      "index": 0,
+      "relevance_score": 0.32713068
+    },
+    {
+      "index": 1,
+      "relevance_score": 0.1
+    }
+  ],
+  "meta": {
+    "api_version": {
+      "version": "2",
+      "is_experimental": false
+    },
+    "billed_units": {
+      "search_units": 1
+    }
+  }
+}`
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req RerankRequest
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		assert.Equal(t, "rerank-v3.5", req.Model)
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(repStr))
+	}))
+	defer ts.Close()
+
+	c := NewCohereRerankClient("mock_key", ts.URL)
+	scores, err := c.Rerank(context.Background(), "rerank-v3.5", "query", []string{"doc0", "doc1", "doc2"}, 0, 0)
+	assert.NoError(t, err)
+	assert.Equal(t, []float32{0.32713068, 0.1, 0.999071}, scores)
+
+	_, err = c.Rerank(context.Background(), "rerank-v3.5", "query", []string{"doc0", "doc1", "doc2", "doc3"}, 0, 0)
+	assert.Error(t, err)
+}
+
+func TestRerankFailed(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+	}))
+	defer ts.Close()
+
+	c := NewCohereRerankClient("mock_key", ts.URL)
+	_, err := c.Rerank(context.Background(), "rerank-v3.5", "query", []string{"doc0"}, 0, 0)
+	assert.Error(t, err)
+}
@@ -39,23 +39,31 @@ type TEIEmbedding struct {
 	url    string
 }
 
-func NewTEIEmbeddingClient(apiKey string, endpoint string) (*TEIEmbedding, error) {
+func buildURL(endpoint string, path string) (string, error) {
 	base, err := url.Parse(endpoint)
 	if err != nil {
-		return nil, err
+		return "", err
 	}
 	if base.Scheme != "http" && base.Scheme != "https" {
-		return nil, fmt.Errorf("endpoint: [%s] is not a valid http/https link", endpoint)
+		return "", fmt.Errorf("endpoint: [%s] is not a valid http/https link", endpoint)
 	}
 	if base.Host == "" {
-		return nil, fmt.Errorf("endpoint: [%s] is not a valid http/https link", endpoint)
+		return "", fmt.Errorf("endpoint: [%s] is not a valid http/https link", endpoint)
 	}
 
-	base.Path = "/embed"
+	base.Path = path
+	return base.String(), nil
+}
+
+func NewTEIEmbeddingClient(apiKey string, endpoint string) (*TEIEmbedding, error) {
+	u, err := buildURL(endpoint, "/embed")
+	if err != nil {
+		return nil, err
+	}
 
 	return &TEIEmbedding{
 		apiKey: apiKey,
-		url:    base.String(),
+		url:    u,
 	}, nil
 }
 
@@ -0,0 +1,95 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tei
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/milvus-io/milvus/internal/util/function/models/utils"
+)
+
+type RerankRequest struct {
+	Query               string   `json:"query"`
+	Texts               []string `json:"texts"`
+	Truncate            bool     `json:"truncate,omitempty"`
+	TruncationDirection string   `json:"truncation_direction,omitempty"`
+}
+
+type RerankResult struct {
+	Index int     `json:"index"`
+	Score float32 `json:"score"`
+}
+
+type TEIRerank struct {
+	apiKey string
+	url    string
+}
+
+func NewTEIRerankClient(apiKey string, endpoint string) (*TEIRerank, error) {
+	u, err := buildURL(endpoint, "/rerank")
+	if err != nil {
+		return nil, err
+	}
+
+	return &TEIRerank{
+		apiKey: apiKey,
+		url:    u,
+	}, nil
+}
+
+// Rerank returns the relevance scores of the texts in the same order as the input texts.
+func (c *TEIRerank) Rerank(ctx context.Context, query string, texts []string, truncate bool, truncationDirection string, timeoutSec int64) ([]float32, error) {
+	var r RerankRequest
+	r.Query = query
+	r.Texts = texts
+	r.Truncate = truncate
+	if truncationDirection != "" {
+		r.TruncationDirection = truncationDirection
+	}
+
+	data, err := json.Marshal(r)
+	if err != nil {
+		return nil, err
+	}
+
+	if timeoutSec <= 0 {
+		timeoutSec = utils.DefaultTimeout
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, time.Duration(timeoutSec)*time.Second)
+	defer cancel()
+	headers := map[string]string{
+		"Content-Type": "application/json",
+	}
+	if c.apiKey != "" {
+		headers["Authorization"] = fmt.Sprintf("Bearer %s", c.apiKey)
+	}
+	body, err := utils.RetrySend(ctx, data, http.MethodPost, c.url, headers, 3, 1)
+	if err != nil {
+		return nil, err
+	}
+	var res []RerankResult
+	err = json.Unmarshal(body, &res)
+	if err != nil {
+		return nil, err
+	}
+	return utils.ScoresByIndex(len(texts), res, func(r RerankResult) (int, float32) { return r.Index, r.Score })
+}
@@ -0,0 +1,75 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tei
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRerankClientCheck(t *testing.T) {
+	{
+		_, err := NewTEIRerankClient("", "http://mymock.com")
+		assert.NoError(t, err)
+	}
+
+	{
+		_, err := NewTEIRerankClient("", "mock")
+		assert.Error(t, err)
+	}
+}
+
+func TestRerankOK(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/rerank", r.URL.Path)
+		var req RerankRequest
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		assert.Equal(t, "query", req.Query)
+		// results are sorted by scores
+		res := []RerankResult{{Index: 1, Score: 0.9}, {Index: 0, Score: 0.1}}
+		data, _ := json.Marshal(res)
+		w.WriteHeader(http.StatusOK)
+		w.Write(data)
+	}))
+	defer ts.Close()
+
+	c, err := NewTEIRerankClient("mock_key", ts.URL)
+	assert.NoError(t, err)
+	scores, err := c.Rerank(context.Background(), "query", []string{"doc0", "doc1"}, true, "Right", 0)
+	assert.NoError(t, err)
+	assert.Equal(t, []float32{0.1, 0.9}, scores)
+
+	_, err = c.Rerank(context.Background(), "query", []string{"doc0", "doc1", "doc2"}, false, "", 0)
+	assert.Error(t, err)
+}
+
+func TestRerankFailed(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+	}))
+	defer ts.Close()
+
+	c, err := NewTEIRerankClient("mock_key", ts.URL)
+	assert.NoError(t, err)
+	_, err = c.Rerank(context.Background(), "query", []string{"doc0"}, false, "", 0)
+	assert.Error(t, err)
+}
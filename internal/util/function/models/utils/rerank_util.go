@@ -0,0 +1,40 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package utils
+
+import (
+	"fmt"
+)
+
+// ScoresByIndex restores the relevance scores returned by rerank services to the order of input texts,
+// the services usually return results sorted by scores, each result carrying the index of its text.
+func ScoresByIndex[T any](numTexts int, results []T, get func(T) (int, float32)) ([]float32, error) {
+	if len(results) != numTexts {
+		return nil, fmt.Errorf("Rerank failed. The number of texts and scores does not match text:[%d], score:[%d]", numTexts, len(results))
+	}
+	scores := make([]float32, numTexts)
+	seen := make([]bool, numTexts)
+	for _, r := range results {
+		idx, score := get(r)
+		if idx < 0 || idx >= numTexts || seen[idx] {
+			return nil, fmt.Errorf("Rerank failed. Invalid text index [%d] in rerank results", idx)
+		}
+		seen[idx] = true
+		scores[idx] = score
+	}
+	return scores, nil
+}
@@ -0,0 +1,81 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package voyageai
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRerankClientCheck(t *testing.T) {
+	assert.Error(t, NewVoyageAIRerankClient("", "mock_uri").Check())
+	assert.Error(t, NewVoyageAIRerankClient("mock_key", "").Check())
+	assert.NoError(t, NewVoyageAIRerankClient("mock_key", "mock_uri").Check())
+}
+
+func TestRerankOK(t *testing.T) {
+	repStr := `{
+  "object": "list",
+  "data": [
+    {
+      "relevance_score": 0.4375,
+      "index": 1
+    },
+    {
+      "relevance_score": 0.421875,
+      "index": 0
+    }
+  ],
+  "model": "rerank-2",
+  "usage": {
+    "total_tokens": 26
+  }
+}`
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req RerankRequest
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		assert.Equal(t, "rerank-2", req.Model)
+		assert.True(t, req.Truncation)
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(repStr))
+	}))
+	defer ts.Close()
+
+	c := NewVoyageAIRerankClient("mock_key", ts.URL)
+	scores, err := c.Rerank(context.Background(), "rerank-2", "query", []string{"doc0", "doc1"}, true, 0)
+	assert.NoError(t, err)
+	assert.Equal(t, []float32{0.421875, 0.4375}, scores)
+
+	_, err = c.Rerank(context.Background(), "rerank-2", "query", []string{"doc0"}, true, 0)
+	assert.Error(t, err)
+}
+
+func TestRerankFailed(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+	}))
+	defer ts.Close()
+
+	c := NewVoyageAIRerankClient("mock_key", ts.URL)
+	_, err := c.Rerank(context.Background(), "rerank-2", "query", []string{"doc0"}, true, 0)
+	assert.Error(t, err)
+}
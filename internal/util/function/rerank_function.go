@@ -0,0 +1,132 @@
+/*
+ * # Licensed to the LF AI & Data foundation under one
+ * # or more contributor license agreements. See the NOTICE file
+ * # distributed with this work for additional information
+ * # regarding copyright ownership. The ASF licenses this file
+ * # to you under the Apache License, Version 2.0 (the
+ * # "License"); you may not use this file except in compliance
+ * # with the License. You may obtain a copy of the License at
+ * #
+ * #     http://www.apache.org/licenses/LICENSE-2.0
+ * #
+ * # Unless required by applicable law or agreed to in writing, software
+ * # distributed under the License is distributed on an "AS IS" BASIS,
+ * # WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * # See the License for the specific language governing permissions and
+ * # limitations under the License.
+ */
+
+package function
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+)
+
+// FunctionTypeRerank is the type of the rerank function declared in the collection schema,
+// milvus-proto has not defined it yet.
+const FunctionTypeRerank schemapb.FunctionType = 3
+
+const maxTokensPerDocParamKey string = "max_tokens_per_doc"
+
+// Cross-encoder rerank for retrieval task
+type rerankProvider interface {
+	MaxBatch() int
+	// CallRerank returns the relevance scores of texts to the query, in the same order as texts
+	CallRerank(ctx context.Context, query string, texts []string) ([]float32, error)
+}
+
+// RerankFunction rescores the search results by the relevance between the queries and
+// the text field of the hits, which is computed by a rerank model service.
+// The endpoint and credentials of the service only come from the environment of the Milvus service.
+type RerankFunction struct {
+	FunctionBase
+
+	inputField *schemapb.FieldSchema
+	rrProvider rerankProvider
+}
+
+func NewRerankFunction(coll *schemapb.CollectionSchema, functionSchema *schemapb.FunctionSchema) (*RerankFunction, error) {
+	if len(functionSchema.GetInputFieldNames()) != 1 {
+		return nil, fmt.Errorf("Rerank function should only have one input field, but now is %d", len(functionSchema.GetInputFieldNames()))
+	}
+	if len(functionSchema.GetOutputFieldNames()) != 0 {
+		return nil, fmt.Errorf("Rerank function should not have output field, but now is %d", len(functionSchema.GetOutputFieldNames()))
+	}
+
+	base, err := NewFunctionBase(coll, functionSchema)
+	if err != nil {
+		return nil, err
+	}
+
+	var inputField *schemapb.FieldSchema
+	for _, field := range coll.GetFields() {
+		if field.GetName() == functionSchema.GetInputFieldNames()[0] {
+			inputField = field
+			break
+		}
+	}
+	if inputField == nil {
+		return nil, fmt.Errorf("Rerank function input field [%s] not found in collection [%s]", functionSchema.GetInputFieldNames()[0], coll.GetName())
+	}
+	if !isValidInputDataType(inputField.GetDataType()) {
+		return nil, fmt.Errorf("Rerank function only supports varchar or text field as input field, but got %s", inputField.GetDataType().String())
+	}
+
+	var rrP rerankProvider
+	var newProviderErr error
+	switch base.provider {
+	case cohereProvider:
+		rrP, newProviderErr = NewCohereRerankProvider(functionSchema)
+	case voyageAIProvider:
+		rrP, newProviderErr = NewVoyageAIRerankProvider(functionSchema)
+	case teiProvider:
+		rrP, newProviderErr = NewTEIRerankProvider(functionSchema)
+	default:
+		return nil, fmt.Errorf("Unsupported rerank service provider: [%s] , list of supported [%s, %s, %s]", base.provider, cohereProvider, voyageAIProvider, teiProvider)
+	}
+
+	if newProviderErr != nil {
+		return nil, newProviderErr
+	}
+	return &RerankFunction{
+		FunctionBase: *base,
+		inputField:   inputField,
+		rrProvider:   rrP,
+	}, nil
+}
+
+func (runner *RerankFunction) GetInputFieldName() string {
+	return runner.inputField.GetName()
+}
+
+// Rerank computes the relevance scores of the search results, texts are the input field values of
+// the hits grouped by queries, topks holds the number of hits of each query.
+func (runner *RerankFunction) Rerank(ctx context.Context, queries []string, topks []int64, texts []string) ([]float32, error) {
+	if len(topks) != len(queries) {
+		return nil, fmt.Errorf("Rerank function got %d queries, but the nq of search is %d", len(queries), len(topks))
+	}
+
+	scores := make([]float32, 0, len(texts))
+	var start int64
+	for i, topk := range topks {
+		if start+topk > int64(len(texts)) {
+			return nil, fmt.Errorf("Rerank function got %d texts, less than the number of hits", len(texts))
+		}
+		for j := start; j < start+topk; j += int64(runner.rrProvider.MaxBatch()) {
+			end := min(j+int64(runner.rrProvider.MaxBatch()), start+topk)
+			batch, err := runner.rrProvider.CallRerank(ctx, queries[i], texts[j:end])
+			if err != nil {
+				return nil, err
+			}
+			if len(batch) != int(end-j) {
+				return nil, fmt.Errorf("Rerank failed. The number of texts and scores does not match text:[%d], score:[%d]", end-j, len(batch))
+			}
+			scores = append(scores, batch...)
+		}
+		start += topk
+	}
+	return scores, nil
+}
@@ -0,0 +1,212 @@
+/*
+ * # Licensed to the LF AI & Data foundation under one
+ * # or more contributor license agreements. See the NOTICE file
+ * # distributed with this work for additional information
+ * # regarding copyright ownership. The ASF licenses this file
+ * # to you under the Apache License, Version 2.0 (the
+ * # "License"); you may not use this file except in compliance
+ * # with the License. You may obtain a copy of the License at
+ * #
+ * #     http://www.apache.org/licenses/LICENSE-2.0
+ * #
+ * # Unless required by applicable law or agreed to in writing, software
+ * # distributed under the License is distributed on an "AS IS" BASIS,
+ * # WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * # See the License for the specific language governing permissions and
+ * # limitations under the License.
+ */
+
+package function
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+)
+
+func TestRerankFunction(t *testing.T) {
+	suite.Run(t, new(RerankFunctionSuite))
+}
+
+type RerankFunctionSuite struct {
+	suite.Suite
+	schema *schemapb.CollectionSchema
+}
+
+func (s *RerankFunctionSuite) SetupTest() {
+	s.schema = &schemapb.CollectionSchema{
+		Name: "test",
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: 100, Name: "int64", DataType: schemapb.DataType_Int64},
+			{FieldID: 101, Name: "text", DataType: schemapb.DataType_VarChar},
+		},
+	}
+}
+
+// createRerankServer mocks the rerank services, the score of a document is its length,
+// and the results are returned in the reverse order of documents.
+func createRerankServer(s *RerankFunctionSuite, provider string) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req map[string]any
+		s.NoError(json.NewDecoder(r.Body).Decode(&req))
+		docsKey := "documents"
+		if provider == teiProvider {
+			docsKey = "texts"
+		}
+		docs := req[docsKey].([]any)
+		results := make([]map[string]any, 0, len(docs))
+		for i := len(docs) - 1; i >= 0; i-- {
+			score := float32(len(docs[i].(string)))
+			if provider == teiProvider {
+				results = append(results, map[string]any{"index": i, "score": score})
+			} else {
+				results = append(results, map[string]any{"index": i, "relevance_score": score})
+			}
+		}
+
+		var resp any
+		switch provider {
+		case cohereProvider:
+			resp = map[string]any{"id": "mock", "results": results}
+		case voyageAIProvider:
+			resp = map[string]any{"object": "list", "data": results}
+		default:
+			resp = results
+		}
+		data, _ := json.Marshal(resp)
+		w.WriteHeader(http.StatusOK)
+		w.Write(data)
+	}))
+}
+
+// setupRerankServer points the rerank services to url, the returned func restores them.
+func (s *RerankFunctionSuite) setupRerankServer(url string) func() {
+	s.T().Setenv(cohereAIAKEnvStr, "mock")
+	s.T().Setenv(voyageAIAKEnvStr, "mock")
+	s.T().Setenv(teiRerankEndpointEnvStr, url)
+	oldCohereURL, oldVoyageaiURL := cohereRerankURL, voyageaiRerankURL
+	cohereRerankURL, voyageaiRerankURL = url, url
+	return func() {
+		cohereRerankURL, voyageaiRerankURL = oldCohereURL, oldVoyageaiURL
+	}
+}
+
+func (s *RerankFunctionSuite) newFunctionSchema(provider string) *schemapb.FunctionSchema {
+	return &schemapb.FunctionSchema{
+		Name:            "rerank",
+		Type:            FunctionTypeRerank,
+		InputFieldNames: []string{"text"},
+		Params: []*commonpb.KeyValuePair{
+			{Key: Provider, Value: provider},
+			{Key: modelNameParamKey, Value: "mock-rerank"},
+			{Key: maxClientBatchSizeParamKey, Value: "2"},
+		},
+	}
+}
+
+func (s *RerankFunctionSuite) TestRerank() {
+	for _, provider := range []string{cohereProvider, voyageAIProvider, teiProvider} {
+		ts := createRerankServer(s, provider)
+		restore := s.setupRerankServer(ts.URL)
+		runner, err := NewRerankFunction(s.schema, s.newFunctionSchema(provider))
+		s.NoError(err)
+		s.Equal("text", runner.GetInputFieldName())
+
+		queries := []string{"q1", "q2"}
+		scores, err := runner.Rerank(context.Background(), queries, []int64{3, 1}, []string{"a", "aaa", "aa", "aaaa"})
+		s.NoError(err)
+		s.Equal([]float32{1, 3, 2, 4}, scores)
+
+		// nq mismatch
+		_, err = runner.Rerank(context.Background(), queries, []int64{4}, []string{"a", "aaa", "aa", "aaaa"})
+		s.Error(err)
+		// texts less than hits
+		_, err = runner.Rerank(context.Background(), queries, []int64{3, 2}, []string{"a", "aaa", "aa", "aaaa"})
+		s.Error(err)
+		restore()
+		ts.Close()
+	}
+}
+
+func (s *RerankFunctionSuite) TestCredentialsFromEnv() {
+	s.T().Setenv(cohereAIAKEnvStr, "")
+	s.T().Setenv(teiRerankEndpointEnvStr, "")
+	// the endpoint and credentials in the function params are ignored
+	fSchema := s.newFunctionSchema(cohereProvider)
+	fSchema.Params = append(fSchema.Params,
+		&commonpb.KeyValuePair{Key: apiKeyParamKey, Value: "mock"},
+		&commonpb.KeyValuePair{Key: embeddingURLParamKey, Value: "mock"})
+	_, err := NewRerankFunction(s.schema, fSchema)
+	s.Error(err)
+
+	fSchema = s.newFunctionSchema(teiProvider)
+	fSchema.Params = append(fSchema.Params, &commonpb.KeyValuePair{Key: endpointParamKey, Value: "http://mock"})
+	_, err = NewRerankFunction(s.schema, fSchema)
+	s.Error(err)
+
+	s.T().Setenv(cohereAIAKEnvStr, "mock")
+	_, err = NewRerankFunction(s.schema, s.newFunctionSchema(cohereProvider))
+	s.NoError(err)
+	s.T().Setenv(teiRerankEndpointEnvStr, "http://mock")
+	_, err = NewRerankFunction(s.schema, s.newFunctionSchema(teiProvider))
+	s.NoError(err)
+}
+
+func (s *RerankFunctionSuite) TestNewRerankFunctionFailed() {
+	s.T().Setenv(cohereAIAKEnvStr, "mock")
+	{
+		fSchema := s.newFunctionSchema(cohereProvider)
+		fSchema.InputFieldNames = []string{"text", "int64"}
+		_, err := NewRerankFunction(s.schema, fSchema)
+		s.Error(err)
+	}
+	{
+		fSchema := s.newFunctionSchema(cohereProvider)
+		fSchema.OutputFieldNames = []string{"int64"}
+		_, err := NewRerankFunction(s.schema, fSchema)
+		s.Error(err)
+	}
+	{
+		fSchema := s.newFunctionSchema(cohereProvider)
+		fSchema.InputFieldNames = []string{"int64"}
+		_, err := NewRerankFunction(s.schema, fSchema)
+		s.Error(err)
+	}
+	{
+		fSchema := s.newFunctionSchema(cohereProvider)
+		fSchema.InputFieldNames = []string{"not_exist"}
+		_, err := NewRerankFunction(s.schema, fSchema)
+		s.Error(err)
+	}
+	{
+		_, err := NewRerankFunction(s.schema, s.newFunctionSchema(openAIProvider))
+		s.Error(err)
+	}
+	{
+		// invalid tei endpoint
+		s.T().Setenv(teiRerankEndpointEnvStr, "mock")
+		_, err := NewRerankFunction(s.schema, s.newFunctionSchema(teiProvider))
+		s.Error(err)
+	}
+}
+
+func (s *RerankFunctionSuite) TestValidateFunctions() {
+	s.T().Setenv(cohereAIAKEnvStr, "mock")
+	s.schema.Functions = []*schemapb.FunctionSchema{s.newFunctionSchema(cohereProvider)}
+	s.NoError(ValidateFunctions(s.schema))
+
+	s.schema.Functions = append(s.schema.Functions, s.newFunctionSchema(cohereProvider))
+	s.Error(ValidateFunctions(s.schema))
+
+	fSchema := s.newFunctionSchema(cohereProvider)
+	fSchema.InputFieldNames = []string{"int64"}
+	s.schema.Functions = []*schemapb.FunctionSchema{fSchema}
+	s.Error(ValidateFunctions(s.schema))
+}
@@ -0,0 +1,102 @@
+/*
+ * # Licensed to the LF AI & Data foundation under one
+ * # or more contributor license agreements. See the NOTICE file
+ * # distributed with this work for additional information
+ * # regarding copyright ownership. The ASF licenses this file
+ * # to you under the Apache License, Version 2.0 (the
+ * # "License"); you may not use this file except in compliance
+ * # with the License. You may obtain a copy of the License at
+ * #
+ * #     http://www.apache.org/licenses/LICENSE-2.0
+ * #
+ * # Unless required by applicable law or agreed to in writing, software
+ * # distributed under the License is distributed on an "AS IS" BASIS,
+ * # WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * # See the License for the specific language governing permissions and
+ * # limitations under the License.
+ */
+
+package function
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/util/function/models/tei"
+)
+
+type TeiRerankProvider struct {
+	client *tei.TEIRerank
+
+	truncate            bool
+	truncationDirection string
+
+	maxBatch   int
+	timeoutSec int64
+}
+
+func createTEIRerankClient() (*tei.TEIRerank, error) {
+	enable := os.Getenv(enableTeiEnvStr)
+	if strings.ToLower(enable) == "false" {
+		return nil, fmt.Errorf("TEI model serving is not enabled")
+	}
+
+	endpoint := os.Getenv(teiRerankEndpointEnvStr)
+	if endpoint == "" {
+		return nil, fmt.Errorf("Missing endpoint. Please configure the %s environment variable in the Milvus service.", teiRerankEndpointEnvStr)
+	}
+	return tei.NewTEIRerankClient("", endpoint)
+}
+
+func NewTEIRerankProvider(functionSchema *schemapb.FunctionSchema) (*TeiRerankProvider, error) {
+	var err error
+	// TEI default client batch size
+	maxBatch := 32
+	truncate := false
+	// TEI default is right
+	truncationDirection := ""
+
+	for _, param := range functionSchema.Params {
+		switch strings.ToLower(param.Key) {
+		case maxClientBatchSizeParamKey:
+			if maxBatch, err = strconv.Atoi(param.Value); err != nil || maxBatch <= 0 {
+				return nil, fmt.Errorf("[%s param's value: %s] is not a valid number", maxClientBatchSizeParamKey, param.Value)
+			}
+		case truncationDirectionParamKey:
+			if truncationDirection = param.Value; truncationDirection != "Left" && truncationDirection != "Right" {
+				return nil, fmt.Errorf("[%s param's value: %s] is not invalid, only supports [Left/Right]", truncationDirectionParamKey, param.Value)
+			}
+		case truncateParamKey:
+			if truncate, err = strconv.ParseBool(param.Value); err != nil {
+				return nil, fmt.Errorf("[%s param's value: %s] is invalid, only supports: [true/false]", truncateParamKey, param.Value)
+			}
+		default:
+		}
+	}
+
+	c, err := createTEIRerankClient()
+	if err != nil {
+		return nil, err
+	}
+
+	provider := TeiRerankProvider{
+		client:              c,
+		truncate:            truncate,
+		truncationDirection: truncationDirection,
+		maxBatch:            maxBatch,
+		timeoutSec:          30,
+	}
+	return &provider, nil
+}
+
+func (provider *TeiRerankProvider) MaxBatch() int {
+	return provider.maxBatch
+}
+
+func (provider *TeiRerankProvider) CallRerank(ctx context.Context, query string, texts []string) ([]float32, error) {
+	return provider.client.Rerank(ctx, query, texts, provider.truncate, provider.truncationDirection, provider.timeoutSec)
+}
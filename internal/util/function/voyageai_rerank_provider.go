@@ -0,0 +1,92 @@
+/*
+ * # Licensed to the LF AI & Data foundation under one
+ * # or more contributor license agreements. See the NOTICE file
+ * # distributed with this work for additional information
+ * # regarding copyright ownership. The ASF licenses this file
+ * # to you under the Apache License, Version 2.0 (the
+ * # "License"); you may not use this file except in compliance
+ * # with the License. You may obtain a copy of the License at
+ * #
+ * #     http://www.apache.org/licenses/LICENSE-2.0
+ * #
+ * # Unless required by applicable law or agreed to in writing, software
+ * # distributed under the License is distributed on an "AS IS" BASIS,
+ * # WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * # See the License for the specific language governing permissions and
+ * # limitations under the License.
+ */
+
+package function
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/util/function/models/voyageai"
+)
+
+type VoyageAIRerankProvider struct {
+	client     *voyageai.VoyageAIRerank
+	modelName  string
+	truncation bool
+
+	maxBatch   int
+	timeoutSec int64
+}
+
+// voyageaiRerankURL is the endpoint of the VoyageAI rerank service, the api key comes from MILVUSAI_VOYAGEAI_API_KEY.
+var voyageaiRerankURL = "https://api.voyageai.com/v1/rerank"
+
+func createVoyageAIRerankClient() (*voyageai.VoyageAIRerank, error) {
+	apiKey := os.Getenv(voyageAIAKEnvStr)
+	if apiKey == "" {
+		return nil, fmt.Errorf("Missing credentials. Please configure the %s environment variable in the Milvus service.", voyageAIAKEnvStr)
+	}
+
+	c := voyageai.NewVoyageAIRerankClient(apiKey, voyageaiRerankURL)
+	return c, nil
+}
+
+func NewVoyageAIRerankProvider(functionSchema *schemapb.FunctionSchema) (*VoyageAIRerankProvider, error) {
+	var modelName string
+	truncation := true
+	var err error
+	for _, param := range functionSchema.Params {
+		switch strings.ToLower(param.Key) {
+		case modelNameParamKey:
+			modelName = param.Value
+		case truncationParamKey:
+			if truncation, err = strconv.ParseBool(param.Value); err != nil {
+				return nil, fmt.Errorf("[%s param's value: %s] is invalid, only supports: [true/false]", truncationParamKey, param.Value)
+			}
+		default:
+		}
+	}
+
+	c, err := createVoyageAIRerankClient()
+	if err != nil {
+		return nil, err
+	}
+
+	provider := VoyageAIRerankProvider{
+		client:     c,
+		modelName:  modelName,
+		truncation: truncation,
+		// The number of documents cannot exceed 1,000
+		maxBatch:   1000,
+		timeoutSec: 30,
+	}
+	return &provider, nil
+}
+
+func (provider *VoyageAIRerankProvider) MaxBatch() int {
+	return provider.maxBatch
+}
+
+func (provider *VoyageAIRerankProvider) CallRerank(ctx context.Context, query string, texts []string) ([]float32, error) {
+	return provider.client.Rerank(ctx, provider.modelName, query, texts, provider.truncation, provider.timeoutSec)
+}
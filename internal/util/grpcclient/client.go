@@ -118,6 +118,7 @@ type ClientBase[T interface {
 	ClientMaxSendSize      int
 	ClientMaxRecvSize      int
 	CompressionEnabled     bool
+	CompressionType        string
 	RetryServiceNameConfig string
 
 	DialTimeout      time.Duration
@@ -157,6 +158,7 @@ func NewClientBase[T interface {
 		InitialBackoff:          config.InitialBackoff.GetAsFloat(),
 		MaxBackoff:              config.MaxBackoff.GetAsFloat(),
 		CompressionEnabled:      config.CompressionEnabled.GetAsBool(),
+		CompressionType:         config.CompressionType.GetValue(),
 		minResetInterval:        config.MinResetInterval.GetAsDuration(time.Millisecond),
 		minSessionCheckInterval: config.MinSessionCheckInterval.GetAsDuration(time.Millisecond),
 		maxCancelError:          config.MaxCancelError.GetAsInt32(),
@@ -268,7 +270,10 @@ func (c *ClientBase[T]) connect(ctx context.Context) error {
 	var conn *grpc.ClientConn
 	compress := None
 	if c.CompressionEnabled {
-		compress = Zstd
+		compress = c.CompressionType
+		if compress == None {
+			compress = Zstd
+		}
 	}
 	if c.encryption {
 		log.Ctx(ctx).Debug("Running in internalTLS mode with encryption enabled")
@@ -21,49 +21,69 @@ import (
 	"bytes"
 	"io"
 
-	"github.com/klauspost/compress/zstd"
 	"google.golang.org/grpc/encoding"
+
+	"github.com/milvus-io/milvus/pkg/v2/util/compressor"
 )
 
 const (
-	None = ""
-	Zstd = "zstd"
+	None   = ""
+	Zstd   = string(compressor.CompressTypeZstd)
+	Lz4    = string(compressor.CompressTypeLz4)
+	Snappy = string(compressor.CompressTypeSnappy)
 )
 
 type grpcCompressor struct {
-	encoder *zstd.Encoder
-	decoder *zstd.Decoder
+	name         string
+	compressor   compressor.Compressor
+	decompressor compressor.Decompressor
 }
 
 func init() {
-	enc, _ := zstd.NewWriter(nil)
-	dec, _ := zstd.NewReader(nil)
-	c := &grpcCompressor{
-		encoder: enc,
-		decoder: dec,
+	for _, name := range []string{Zstd, Lz4, Snappy} {
+		c, err := newGrpcCompressor(name)
+		if err != nil {
+			panic(err)
+		}
+		encoding.RegisterCompressor(c)
+	}
+}
+
+func newGrpcCompressor(name string) (*grpcCompressor, error) {
+	enc, err := compressor.NewCompressor(compressor.CompressType(name), nil)
+	if err != nil {
+		return nil, err
 	}
-	encoding.RegisterCompressor(c)
+	dec, err := compressor.NewDecompressor(compressor.CompressType(name), nil)
+	if err != nil {
+		return nil, err
+	}
+	return &grpcCompressor{
+		name:         name,
+		compressor:   enc,
+		decompressor: dec,
+	}, nil
 }
 
 func (c *grpcCompressor) Compress(w io.Writer) (io.WriteCloser, error) {
-	return &zstdWriteCloser{
-		enc:    c.encoder,
+	return &blockWriteCloser{
+		enc:    c.compressor,
 		writer: w,
 	}, nil
 }
 
-type zstdWriteCloser struct {
-	enc    *zstd.Encoder
+type blockWriteCloser struct {
+	enc    compressor.Compressor
 	writer io.Writer    // Compressed data will be written here.
 	buf    bytes.Buffer // Buffer uncompressed data here, compress on Close.
 }
 
-func (z *zstdWriteCloser) Write(p []byte) (int, error) {
+func (z *blockWriteCloser) Write(p []byte) (int, error) {
 	return z.buf.Write(p)
 }
 
-func (z *zstdWriteCloser) Close() error {
-	compressed := z.enc.EncodeAll(z.buf.Bytes(), nil)
+func (z *blockWriteCloser) Close() error {
+	compressed := z.enc.CompressBytes(z.buf.Bytes(), nil)
 	_, err := io.Copy(z.writer, bytes.NewReader(compressed))
 	return err
 }
@@ -74,7 +94,7 @@ func (c *grpcCompressor) Decompress(r io.Reader) (io.Reader, error) {
 		return nil, err
 	}
 
-	uncompressed, err := c.decoder.DecodeAll(compressed, nil)
+	uncompressed, err := c.decompressor.DecompressBytes(compressed, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -82,5 +102,5 @@ func (c *grpcCompressor) Decompress(r io.Reader) (io.Reader, error) {
 }
 
 func (c *grpcCompressor) Name() string {
-	return Zstd
+	return c.name
 }
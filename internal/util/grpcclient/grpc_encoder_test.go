@@ -24,21 +24,26 @@ import (
 )
 
 func TestGrpcEncoder(t *testing.T) {
-	data := "hello zstd algorithm!"
-	var buf bytes.Buffer
+	for _, name := range []string{Zstd, Lz4, Snappy} {
+		t.Run(name, func(t *testing.T) {
+			data := "hello " + name + " algorithm!"
+			var buf bytes.Buffer
 
-	compressor := encoding.GetCompressor(Zstd)
-	writer, err := compressor.Compress(&buf)
-	assert.NoError(t, err)
-	written, err := writer.Write([]byte(data))
-	assert.NoError(t, err)
-	assert.Equal(t, written, len(data))
-	err = writer.Close()
-	assert.NoError(t, err)
+			compressor := encoding.GetCompressor(name)
+			assert.Equal(t, name, compressor.Name())
+			writer, err := compressor.Compress(&buf)
+			assert.NoError(t, err)
+			written, err := writer.Write([]byte(data))
+			assert.NoError(t, err)
+			assert.Equal(t, written, len(data))
+			err = writer.Close()
+			assert.NoError(t, err)
 
-	reader, err := compressor.Decompress(bytes.NewReader(buf.Bytes()))
-	assert.NoError(t, err)
-	result := make([]byte, len(data))
-	reader.Read(result)
-	assert.Equal(t, data, string(result))
+			reader, err := compressor.Decompress(bytes.NewReader(buf.Bytes()))
+			assert.NoError(t, err)
+			result := make([]byte, len(data))
+			reader.Read(result)
+			assert.Equal(t, data, string(result))
+		})
+	}
 }
@@ -0,0 +1,174 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package avro
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/linkedin/goavro/v2"
+	"go.uber.org/atomic"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/importutilv2/common"
+	"github.com/milvus-io/milvus/pkg/v2/log"
+	"github.com/milvus-io/milvus/pkg/v2/util/merr"
+)
+
+type reader struct {
+	ctx    context.Context
+	cm     storage.ChunkManager
+	schema *schemapb.CollectionSchema
+
+	fileSize *atomic.Int64
+	filePath string
+	r        storage.FileReader
+	ocf      *goavro.OCFReader
+
+	// the schema of avro file, used to unwrap the union values
+	avroSchema *avroType
+	namedTypes map[string]*avroType
+
+	bufferSize int
+	count      int64
+
+	parser *common.TypedRowParser
+
+	// the index of the next row, and the handler of the rows failed to parse
+	rowIndex      int64
+	badRowHandler common.BadRowHandler
+}
+
+// NewReader reads the avro object container file, each record of the file is a row,
+// the union types are accepted for the nullable fields.
+func NewReader(ctx context.Context, cm storage.ChunkManager, schema *schemapb.CollectionSchema, path string, bufferSize int) (*reader, error) {
+	r, err := cm.Reader(ctx, path)
+	if err != nil {
+		return nil, merr.WrapErrImportFailed(fmt.Sprintf("read avro file failed, path=%s, err=%s", path, err.Error()))
+	}
+	ocf, err := goavro.NewOCFReader(bufio.NewReader(r))
+	if err != nil {
+		r.Close()
+		return nil, merr.WrapErrImportFailed(fmt.Sprintf("new avro reader failed, path=%s, err=%v", path, err))
+	}
+	avroSchema, namedTypes, err := parseSchema(ocf.Codec().Schema())
+	if err != nil {
+		r.Close()
+		return nil, err
+	}
+	log.Info("create avro reader done", zap.String("path", path), zap.String("compression", ocf.CompressionName()))
+
+	count, err := common.EstimateReadCountPerBatch(bufferSize, schema)
+	if err != nil {
+		r.Close()
+		return nil, err
+	}
+	parser, err := common.NewTypedRowParser(schema)
+	if err != nil {
+		r.Close()
+		return nil, err
+	}
+	return &reader{
+		ctx:        ctx,
+		cm:         cm,
+		schema:     schema,
+		fileSize:   atomic.NewInt64(0),
+		filePath:   path,
+		r:          r,
+		ocf:        ocf,
+		avroSchema: avroSchema,
+		namedTypes: namedTypes,
+		bufferSize: bufferSize,
+		count:      count,
+		parser:     parser,
+	}, nil
+}
+
+func (r *reader) Read() (*storage.InsertData, error) {
+	insertData, err := storage.NewInsertData(r.schema)
+	if err != nil {
+		return nil, err
+	}
+	var cnt int64 = 0
+	for r.ocf.Scan() {
+		datum, err := r.ocf.Read()
+		if err != nil {
+			return nil, merr.WrapErrImportFailed(fmt.Sprintf("failed to read avro record, err=%v", err))
+		}
+		record, ok := normalize(datum, r.avroSchema, r.namedTypes).(map[string]any)
+		if !ok {
+			return nil, merr.WrapErrImportFailed(fmt.Sprintf("invalid avro record, got type '%T'", datum))
+		}
+		rowIndex := r.rowIndex
+		r.rowIndex++
+		row, err := r.parser.Parse(record)
+		if err != nil {
+			if err = common.HandleBadRow(r.badRowHandler, r.filePath, rowIndex, err); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		if err = common.AppendRow(r.schema, insertData, row); err != nil {
+			if err = common.HandleBadRow(r.badRowHandler, r.filePath, rowIndex, err); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		cnt++
+		if cnt >= r.count {
+			cnt = 0
+			if insertData.GetMemorySize() >= r.bufferSize {
+				break
+			}
+		}
+	}
+	if err = r.ocf.Err(); err != nil {
+		return nil, merr.WrapErrImportFailed(fmt.Sprintf("failed to read avro file, err=%v", err))
+	}
+	if insertData.GetRowNum() == 0 {
+		return nil, io.EOF
+	}
+	return insertData, nil
+}
+
+// SetBadRowHandler sets the handler of the rows failed to parse, the reading is aborted by
+// the first bad row if no handler is set.
+func (r *reader) SetBadRowHandler(handler common.BadRowHandler) {
+	r.badRowHandler = handler
+}
+
+func (r *reader) Size() (int64, error) {
+	if size := r.fileSize.Load(); size != 0 {
+		return size, nil
+	}
+	size, err := r.cm.Size(r.ctx, r.filePath)
+	if err != nil {
+		return 0, err
+	}
+	r.fileSize.Store(size)
+	return size, nil
+}
+
+func (r *reader) Close() {
+	if err := r.r.Close(); err != nil {
+		log.Warn("close avro file reader failed", zap.Error(err))
+	}
+}
@@ -0,0 +1,396 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package avro
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"math"
+	"testing"
+
+	"github.com/linkedin/goavro/v2"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/json"
+	"github.com/milvus-io/milvus/internal/mocks"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/nullutil"
+	"github.com/milvus-io/milvus/internal/util/testutil"
+	"github.com/milvus-io/milvus/pkg/v2/common"
+	"github.com/milvus-io/milvus/pkg/v2/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/v2/util/typeutil"
+)
+
+type ReaderSuite struct {
+	suite.Suite
+
+	numRows     int
+	pkDataType  schemapb.DataType
+	vecDataType schemapb.DataType
+}
+
+func (suite *ReaderSuite) SetupSuite() {
+	paramtable.Get().Init(paramtable.NewBaseTable())
+}
+
+func (suite *ReaderSuite) SetupTest() {
+	// default suite params
+	suite.numRows = 100
+	suite.pkDataType = schemapb.DataType_Int64
+	suite.vecDataType = schemapb.DataType_FloatVector
+}
+
+func avroPrimitive(dataType schemapb.DataType) string {
+	switch dataType {
+	case schemapb.DataType_Bool:
+		return "boolean"
+	case schemapb.DataType_Int8, schemapb.DataType_Int16, schemapb.DataType_Int32:
+		return "int"
+	case schemapb.DataType_Int64:
+		return "long"
+	case schemapb.DataType_Float:
+		return "float"
+	case schemapb.DataType_Double:
+		return "double"
+	default:
+		return "string"
+	}
+}
+
+// createAvroSchema generates the avro schema of the collection, the nullable fields are
+// declared as union with null.
+func createAvroSchema(schema *schemapb.CollectionSchema) string {
+	fields := make([]map[string]any, 0)
+	for _, field := range schema.GetFields() {
+		if field.GetIsFunctionOutput() || field.GetIsDynamic() {
+			continue
+		}
+		var t any
+		switch field.GetDataType() {
+		case schemapb.DataType_Array:
+			t = map[string]any{"type": "array", "items": avroPrimitive(field.GetElementType())}
+		case schemapb.DataType_FloatVector:
+			t = map[string]any{"type": "array", "items": "float"}
+		case schemapb.DataType_Int8Vector:
+			t = map[string]any{"type": "array", "items": "int"}
+		case schemapb.DataType_BinaryVector, schemapb.DataType_Float16Vector, schemapb.DataType_BFloat16Vector:
+			t = "bytes"
+		case schemapb.DataType_SparseFloatVector:
+			t = map[string]any{"type": "map", "values": "float"}
+		default:
+			t = avroPrimitive(field.GetDataType())
+		}
+		if field.GetNullable() {
+			t = []any{"null", t}
+		}
+		fields = append(fields, map[string]any{"name": field.GetName(), "type": t})
+	}
+	bs, _ := json.Marshal(map[string]any{"type": "record", "name": "row", "namespace": "milvus", "fields": fields})
+	return string(bs)
+}
+
+// createAvroRecords converts the insert data into the records accepted by goavro.
+func createAvroRecords(schema *schemapb.CollectionSchema, insertData *storage.InsertData) []any {
+	records := make([]any, 0, insertData.GetRowNum())
+	for i := 0; i < insertData.GetRowNum(); i++ {
+		record := make(map[string]any)
+		for _, field := range schema.GetFields() {
+			if field.GetIsFunctionOutput() || field.GetIsDynamic() {
+				continue
+			}
+			row := insertData.Data[field.GetFieldID()].GetRow(i)
+			if row == nil {
+				record[field.GetName()] = nil
+				continue
+			}
+			var value any
+			unionName := avroPrimitive(field.GetDataType())
+			switch field.GetDataType() {
+			case schemapb.DataType_Int8:
+				value = int32(row.(int8))
+			case schemapb.DataType_Int16:
+				value = int32(row.(int16))
+			case schemapb.DataType_JSON:
+				value = string(row.([]byte))
+			case schemapb.DataType_Array:
+				unionName = "array"
+				sf := row.(*schemapb.ScalarField)
+				switch field.GetElementType() {
+				case schemapb.DataType_Bool:
+					value = toAnySlice(sf.GetBoolData().GetData())
+				case schemapb.DataType_Int8, schemapb.DataType_Int16, schemapb.DataType_Int32:
+					value = toAnySlice(sf.GetIntData().GetData())
+				case schemapb.DataType_Int64:
+					value = toAnySlice(sf.GetLongData().GetData())
+				case schemapb.DataType_Float:
+					value = toAnySlice(sf.GetFloatData().GetData())
+				case schemapb.DataType_Double:
+					value = toAnySlice(sf.GetDoubleData().GetData())
+				default:
+					value = toAnySlice(sf.GetStringData().GetData())
+				}
+			case schemapb.DataType_FloatVector:
+				value = toAnySlice(row.([]float32))
+			case schemapb.DataType_Int8Vector:
+				vec := make([]any, 0)
+				for _, v := range row.([]int8) {
+					vec = append(vec, int32(v))
+				}
+				value = vec
+			case schemapb.DataType_SparseFloatVector:
+				mp := make(map[string]any)
+				for k, v := range typeutil.SparseFloatBytesToMap(row.([]byte)) {
+					mp[fmt.Sprint(k)] = v
+				}
+				value = mp
+			default:
+				value = row
+			}
+			if field.GetNullable() {
+				value = goavro.Union(unionName, value)
+			}
+			record[field.GetName()] = value
+		}
+		records = append(records, record)
+	}
+	return records
+}
+
+func toAnySlice[T any](values []T) []any {
+	out := make([]any, len(values))
+	for i, v := range values {
+		out[i] = v
+	}
+	return out
+}
+
+func (suite *ReaderSuite) createReader(schema *schemapb.CollectionSchema, avroSchema string, records []any) *reader {
+	buf := &bytes.Buffer{}
+	w, err := goavro.NewOCFWriter(goavro.OCFConfig{W: buf, Schema: avroSchema, CompressionName: goavro.CompressionDeflateLabel})
+	suite.NoError(err)
+	suite.NoError(w.Append(records))
+
+	type mockReader struct {
+		io.Reader
+		io.Closer
+		io.ReaderAt
+		io.Seeker
+	}
+	cm := mocks.NewChunkManager(suite.T())
+	cm.EXPECT().Reader(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, s string) (storage.FileReader, error) {
+		r := &mockReader{Reader: bytes.NewReader(buf.Bytes()), Closer: io.NopCloser(nil)}
+		return r, nil
+	})
+	reader, err := NewReader(context.Background(), cm, schema, "mockPath", math.MaxInt)
+	suite.NoError(err)
+	return reader
+}
+
+func (suite *ReaderSuite) newSchema(dataType schemapb.DataType, elemType schemapb.DataType, nullable bool) *schemapb.CollectionSchema {
+	return &schemapb.CollectionSchema{
+		Fields: []*schemapb.FieldSchema{
+			{
+				FieldID:      100,
+				Name:         "pk",
+				IsPrimaryKey: true,
+				DataType:     suite.pkDataType,
+				TypeParams: []*commonpb.KeyValuePair{
+					{
+						Key:   common.MaxLengthKey,
+						Value: "128",
+					},
+				},
+			},
+			{
+				FieldID:  101,
+				Name:     "vec",
+				DataType: suite.vecDataType,
+				TypeParams: []*commonpb.KeyValuePair{
+					{
+						Key:   common.DimKey,
+						Value: "8",
+					},
+				},
+			},
+			{
+				FieldID:     102,
+				Name:        dataType.String(),
+				DataType:    dataType,
+				ElementType: elemType,
+				TypeParams: []*commonpb.KeyValuePair{
+					{
+						Key:   common.MaxLengthKey,
+						Value: "128",
+					},
+					{
+						Key:   common.MaxCapacityKey,
+						Value: "128",
+					},
+				},
+				Nullable: nullable,
+			},
+		},
+	}
+}
+
+func (suite *ReaderSuite) run(dataType schemapb.DataType, elemType schemapb.DataType, nullable bool) {
+	schema := suite.newSchema(dataType, elemType, nullable)
+	insertData, err := testutil.CreateInsertData(schema, suite.numRows)
+	suite.NoError(err)
+
+	reader := suite.createReader(schema, createAvroSchema(schema), createAvroRecords(schema, insertData))
+	defer reader.Close()
+
+	res, err := reader.Read()
+	suite.NoError(err)
+	for fieldID, data := range res.Data {
+		suite.Equal(suite.numRows, data.RowNum())
+		for i := 0; i < suite.numRows; i++ {
+			suite.Equal(insertData.Data[fieldID].GetRow(i), data.GetRow(i))
+		}
+	}
+
+	_, err = reader.Read()
+	suite.ErrorIs(err, io.EOF)
+}
+
+func (suite *ReaderSuite) TestReadScalarFields() {
+	for _, nullable := range []bool{false, true} {
+		suite.run(schemapb.DataType_Bool, schemapb.DataType_None, nullable)
+		suite.run(schemapb.DataType_Int8, schemapb.DataType_None, nullable)
+		suite.run(schemapb.DataType_Int16, schemapb.DataType_None, nullable)
+		suite.run(schemapb.DataType_Int32, schemapb.DataType_None, nullable)
+		suite.run(schemapb.DataType_Int64, schemapb.DataType_None, nullable)
+		suite.run(schemapb.DataType_Float, schemapb.DataType_None, nullable)
+		suite.run(schemapb.DataType_Double, schemapb.DataType_None, nullable)
+		suite.run(schemapb.DataType_VarChar, schemapb.DataType_None, nullable)
+		suite.run(schemapb.DataType_JSON, schemapb.DataType_None, nullable)
+
+		suite.run(schemapb.DataType_Array, schemapb.DataType_Bool, nullable)
+		suite.run(schemapb.DataType_Array, schemapb.DataType_Int8, nullable)
+		suite.run(schemapb.DataType_Array, schemapb.DataType_Int32, nullable)
+		suite.run(schemapb.DataType_Array, schemapb.DataType_Int64, nullable)
+		suite.run(schemapb.DataType_Array, schemapb.DataType_Float, nullable)
+		suite.run(schemapb.DataType_Array, schemapb.DataType_Double, nullable)
+		suite.run(schemapb.DataType_Array, schemapb.DataType_String, nullable)
+	}
+}
+
+func (suite *ReaderSuite) TestStringPK() {
+	suite.pkDataType = schemapb.DataType_VarChar
+	suite.run(schemapb.DataType_Int32, schemapb.DataType_None, false)
+}
+
+func (suite *ReaderSuite) TestVector() {
+	suite.vecDataType = schemapb.DataType_BinaryVector
+	suite.run(schemapb.DataType_Int32, schemapb.DataType_None, false)
+	suite.vecDataType = schemapb.DataType_FloatVector
+	suite.run(schemapb.DataType_Int32, schemapb.DataType_None, false)
+	suite.vecDataType = schemapb.DataType_Float16Vector
+	suite.run(schemapb.DataType_Int32, schemapb.DataType_None, false)
+	suite.vecDataType = schemapb.DataType_BFloat16Vector
+	suite.run(schemapb.DataType_Int32, schemapb.DataType_None, false)
+	suite.vecDataType = schemapb.DataType_SparseFloatVector
+	suite.run(schemapb.DataType_Int32, schemapb.DataType_None, false)
+	suite.vecDataType = schemapb.DataType_Int8Vector
+	suite.run(schemapb.DataType_Int32, schemapb.DataType_None, false)
+}
+
+func (suite *ReaderSuite) TestDefaultValue() {
+	schema := suite.newSchema(schemapb.DataType_Int64, schemapb.DataType_None, false)
+	fieldSchema, err := testutil.CreateFieldWithDefaultValue(schemapb.DataType_Int64, 102, true)
+	suite.NoError(err)
+	schema.Fields[2] = fieldSchema
+	insertData, err := testutil.CreateInsertData(schema, suite.numRows)
+	suite.NoError(err)
+
+	reader := suite.createReader(schema, createAvroSchema(schema), createAvroRecords(schema, insertData))
+	res, err := reader.Read()
+	suite.NoError(err)
+	defaultValue, err := nullutil.GetDefaultValue(fieldSchema)
+	suite.NoError(err)
+	for i := 0; i < suite.numRows; i++ {
+		expect := insertData.Data[102].GetRow(i)
+		if expect == nil {
+			expect = defaultValue
+		}
+		suite.Equal(expect, res.Data[102].GetRow(i))
+	}
+}
+
+func (suite *ReaderSuite) TestDynamicField() {
+	schema := suite.newSchema(schemapb.DataType_Int32, schemapb.DataType_None, false)
+	schema.EnableDynamicField = true
+	schema.Fields = append(schema.Fields, &schemapb.FieldSchema{
+		FieldID:   103,
+		Name:      "$meta",
+		IsDynamic: true,
+		DataType:  schemapb.DataType_JSON,
+	})
+	insertData, err := testutil.CreateInsertData(schema, suite.numRows)
+	suite.NoError(err)
+
+	// the column not defined in schema is put into the dynamic field
+	var avroSchema map[string]any
+	suite.NoError(json.Unmarshal([]byte(createAvroSchema(schema)), &avroSchema))
+	avroSchema["fields"] = append(avroSchema["fields"].([]any),
+		map[string]any{"name": "extra", "type": map[string]any{"type": "map", "values": "long"}})
+	bs, err := json.Marshal(avroSchema)
+	suite.NoError(err)
+	records := createAvroRecords(schema, insertData)
+	for i, record := range records {
+		record.(map[string]any)["extra"] = map[string]any{"a": int64(i)}
+	}
+
+	reader := suite.createReader(schema, string(bs), records)
+	res, err := reader.Read()
+	suite.NoError(err)
+	for i := 0; i < suite.numRows; i++ {
+		var dynamic map[string]any
+		suite.NoError(json.Unmarshal(res.Data[103].GetRow(i).([]byte), &dynamic))
+		suite.Equal(map[string]any{"extra": map[string]any{"a": float64(i)}}, dynamic)
+	}
+}
+
+func (suite *ReaderSuite) TestInvalidFile() {
+	type mockReader struct {
+		io.Reader
+		io.Closer
+		io.ReaderAt
+		io.Seeker
+	}
+	cm := mocks.NewChunkManager(suite.T())
+	cm.EXPECT().Reader(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, s string) (storage.FileReader, error) {
+		return &mockReader{Reader: bytes.NewReader([]byte("not an avro file")), Closer: io.NopCloser(nil)}, nil
+	})
+	schema := suite.newSchema(schemapb.DataType_Int32, schemapb.DataType_None, false)
+	_, err := NewReader(context.Background(), cm, schema, "mockPath", math.MaxInt)
+	suite.Error(err)
+
+	// the top-level type is not record
+	_, _, err = parseSchema(`{"type": "array", "items": "int"}`)
+	suite.Error(err)
+}
+
+func TestAvroReader(t *testing.T) {
+	suite.Run(t, new(ReaderSuite))
+}
@@ -0,0 +1,226 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package avro
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/milvus-io/milvus/internal/json"
+	"github.com/milvus-io/milvus/pkg/v2/util/merr"
+)
+
+const (
+	avroRecord = "record"
+	avroArray  = "array"
+	avroMap    = "map"
+	avroUnion  = "union"
+	avroNull   = "null"
+	// a reference to the named type defined before
+	avroRef = "ref"
+)
+
+// avroType is the parsed avro schema, which is used to unwrap the union values decoded by goavro.
+// goavro decodes a non-null union value as a map with the type name as the single key,
+// such as {"long": 1}, which can't be distinguished from a map value without schema.
+type avroType struct {
+	kind    string
+	name    string
+	fields  map[string]*avroType
+	items   *avroType
+	values  *avroType
+	members []*avroType
+}
+
+type schemaParser struct {
+	named map[string]*avroType
+}
+
+// parseSchema parses the avro schema of the file, the top-level type must be a record.
+func parseSchema(schema string) (*avroType, map[string]*avroType, error) {
+	var raw any
+	if err := json.Unmarshal([]byte(schema), &raw); err != nil {
+		return nil, nil, merr.WrapErrImportFailed(fmt.Sprintf("invalid avro schema, err=%v", err))
+	}
+	p := &schemaParser{named: make(map[string]*avroType)}
+	t, err := p.parse(raw, "")
+	if err != nil {
+		return nil, nil, err
+	}
+	if t.kind != avroRecord {
+		return nil, nil, merr.WrapErrImportFailed(fmt.Sprintf("the top-level type of avro file should be record, but got '%s'", t.kind))
+	}
+	return t, p.named, nil
+}
+
+func (p *schemaParser) parse(raw any, namespace string) (*avroType, error) {
+	switch value := raw.(type) {
+	case string:
+		if isPrimitive(value) {
+			return &avroType{kind: value, name: value}, nil
+		}
+		return &avroType{kind: avroRef, name: fullName(value, namespace)}, nil
+	case []any:
+		t := &avroType{kind: avroUnion, name: avroUnion}
+		for _, member := range value {
+			mt, err := p.parse(member, namespace)
+			if err != nil {
+				return nil, err
+			}
+			t.members = append(t.members, mt)
+		}
+		return t, nil
+	case map[string]any:
+		kind, _ := value["type"].(string)
+		switch kind {
+		case avroRecord, "error":
+			name, _ := value["name"].(string)
+			if ns, ok := value["namespace"].(string); ok && !strings.Contains(name, ".") {
+				namespace = ns
+			}
+			t := &avroType{kind: avroRecord, name: fullName(name, namespace), fields: make(map[string]*avroType)}
+			p.named[t.name] = t
+			fields, _ := value["fields"].([]any)
+			for _, f := range fields {
+				field, ok := f.(map[string]any)
+				if !ok {
+					return nil, merr.WrapErrImportFailed(fmt.Sprintf("invalid field '%v' of avro record '%s'", f, name))
+				}
+				fieldName, _ := field["name"].(string)
+				ft, err := p.parse(field["type"], namespace)
+				if err != nil {
+					return nil, err
+				}
+				t.fields[fieldName] = ft
+			}
+			return t, nil
+		case avroArray:
+			items, err := p.parse(value["items"], namespace)
+			if err != nil {
+				return nil, err
+			}
+			return &avroType{kind: avroArray, name: avroArray, items: items}, nil
+		case avroMap:
+			values, err := p.parse(value["values"], namespace)
+			if err != nil {
+				return nil, err
+			}
+			return &avroType{kind: avroMap, name: avroMap, values: values}, nil
+		case "enum", "fixed":
+			name, _ := value["name"].(string)
+			if ns, ok := value["namespace"].(string); ok && !strings.Contains(name, ".") {
+				namespace = ns
+			}
+			t := &avroType{kind: kind, name: fullName(name, namespace)}
+			p.named[t.name] = t
+			return t, nil
+		default:
+			// primitive type with attributes, such as logical types
+			if logicalType, ok := value["logicalType"].(string); ok {
+				return &avroType{kind: kind, name: kind + "." + logicalType}, nil
+			}
+			return p.parse(kind, namespace)
+		}
+	default:
+		return nil, merr.WrapErrImportFailed(fmt.Sprintf("invalid avro schema '%v'", raw))
+	}
+}
+
+func isPrimitive(name string) bool {
+	switch name {
+	case avroNull, "boolean", "int", "long", "float", "double", "bytes", "string":
+		return true
+	}
+	return false
+}
+
+func fullName(name, namespace string) string {
+	if namespace == "" || strings.Contains(name, ".") {
+		return name
+	}
+	return namespace + "." + name
+}
+
+// normalize unwraps the union values recursively, so that the values are in go native types only.
+func normalize(value any, t *avroType, named map[string]*avroType) any {
+	if value == nil || t == nil {
+		return value
+	}
+	switch t.kind {
+	case avroRef:
+		return normalize(value, named[t.name], named)
+	case avroUnion:
+		wrapped, ok := value.(map[string]any)
+		if !ok || len(wrapped) != 1 {
+			return value
+		}
+		for name, inner := range wrapped {
+			return normalize(inner, unionMember(t, name, named), named)
+		}
+	case avroRecord:
+		record, ok := value.(map[string]any)
+		if !ok {
+			return value
+		}
+		for k, v := range record {
+			record[k] = normalize(v, t.fields[k], named)
+		}
+		return record
+	case avroArray:
+		arr, ok := value.([]any)
+		if !ok {
+			return value
+		}
+		for i, v := range arr {
+			arr[i] = normalize(v, t.items, named)
+		}
+		return arr
+	case avroMap:
+		mp, ok := value.(map[string]any)
+		if !ok {
+			return value
+		}
+		for k, v := range mp {
+			mp[k] = normalize(v, t.values, named)
+		}
+		return mp
+	}
+	return value
+}
+
+// unionMember finds the member type of union by the type name which goavro wraps the value with.
+func unionMember(t *avroType, name string, named map[string]*avroType) *avroType {
+	var nonNull []*avroType
+	for _, member := range t.members {
+		memberName := member.name
+		if member.kind == avroRef {
+			if resolved, ok := named[member.name]; ok {
+				member = resolved
+			}
+		}
+		if memberName == name || strings.HasSuffix(memberName, "."+name) {
+			return member
+		}
+		if member.kind != avroNull {
+			nonNull = append(nonNull, member)
+		}
+	}
+	if len(nonNull) == 1 {
+		return nonNull[0]
+	}
+	return nil
+}
@@ -28,16 +28,22 @@ func FilterWithDelete(r *reader) (Filter, error) {
 	if err != nil {
 		return nil, err
 	}
-	return func(row map[int64]interface{}) bool {
-		rowPk := row[pkField.GetFieldID()]
-		rowTs := row[common.TimeStampField]
-		for i, pk := range r.deleteData.Pks {
-			if pk.GetValue() == rowPk && int64(r.deleteData.Tss[i]) > rowTs.(int64) {
-				return false
-			}
+	deletes := make(map[any]typeutil.Timestamp, len(r.deleteData.Pks))
+	for i, pk := range r.deleteData.Pks {
+		if ts, ok := deletes[pk.GetValue()]; !ok || r.deleteData.Tss[i] > ts {
+			deletes[pk.GetValue()] = r.deleteData.Tss[i]
 		}
-		return true
-	}, nil
+	}
+	return FilterWithDeletedPks(pkField.GetFieldID(), deletes), nil
+}
+
+// FilterWithDeletedPks filters out the rows deleted after they were inserted,
+// deletes maps the primary key to its latest delete timestamp.
+func FilterWithDeletedPks(pkFieldID int64, deletes map[any]typeutil.Timestamp) Filter {
+	return func(row map[int64]interface{}) bool {
+		ts, ok := deletes[row[pkFieldID]]
+		return !ok || int64(ts) <= row[common.TimeStampField].(int64)
+	}
 }
 
 func FilterWithTimeRange(tsStart, tsEnd uint64) Filter {
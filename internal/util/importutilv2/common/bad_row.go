@@ -0,0 +1,114 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common
+
+import (
+	"fmt"
+
+	"github.com/cockroachdb/errors"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/pkg/v2/util/merr"
+)
+
+// BadRow describes a row rejected by the reader, such as a row with an illegal field value.
+type BadRow struct {
+	File string `json:"file"`
+	// Row is the index of the row in the import file, starting from 0.
+	// For an import file consisting of several JSONL files, the rows are counted across the files.
+	Row int64 `json:"row"`
+	// Field is the name of the illegal field, empty if the error is not caused by a single field.
+	Field  string `json:"field,omitempty"`
+	Reason string `json:"reason"`
+}
+
+// BadRowHandler handles the bad rows found by the readers. The bad row is skipped if
+// the handler returns nil, otherwise the reading is aborted with the returned error.
+type BadRowHandler func(row *BadRow) error
+
+// HandleBadRow passes the bad row to the handler, the original error is returned if there is no handler.
+func HandleBadRow(handler BadRowHandler, file string, row int64, err error) error {
+	if handler == nil {
+		return err
+	}
+	return handler(&BadRow{
+		File:   file,
+		Row:    row,
+		Field:  GetErrorField(err),
+		Reason: err.Error(),
+	})
+}
+
+// AppendRow appends the parsed row to the insert data. If the appending fails, the fields which
+// have got the row are restored, so that the row could be skipped as a bad row by the handler.
+func AppendRow(schema *schemapb.CollectionSchema, insertData *storage.InsertData, row map[storage.FieldID]any) error {
+	rowNum := insertData.GetRowNum()
+	err := insertData.Append(row)
+	if err == nil {
+		return nil
+	}
+	err = merr.WrapErrImportFailed(fmt.Sprintf("failed to append row, err=%s", err.Error()))
+	for _, field := range schema.GetFields() {
+		fieldData, ok := insertData.Data[field.GetFieldID()]
+		if !ok || fieldData.RowNum() == rowNum {
+			continue
+		}
+		restored, newErr := storage.NewFieldData(field.GetDataType(), field, rowNum)
+		if newErr != nil {
+			return newErr
+		}
+		for i := 0; i < rowNum; i++ {
+			if newErr = restored.AppendRow(fieldData.GetRow(i)); newErr != nil {
+				return newErr
+			}
+		}
+		insertData.Data[field.GetFieldID()] = restored
+	}
+	return err
+}
+
+// FieldError attaches the field name to the error of parsing a field value,
+// the error message is kept unchanged.
+type FieldError struct {
+	Field string
+	Err   error
+}
+
+func (e *FieldError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *FieldError) Unwrap() error {
+	return e.Err
+}
+
+func NewFieldError(field string, err error) error {
+	if err == nil {
+		return nil
+	}
+	return &FieldError{Field: field, Err: err}
+}
+
+// GetErrorField returns the name of the field which causes the error, empty if unknown.
+func GetErrorField(err error) string {
+	var fieldErr *FieldError
+	if errors.As(err, &fieldErr) {
+		return fieldErr.Field
+	}
+	return ""
+}
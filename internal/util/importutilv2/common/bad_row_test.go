@@ -0,0 +1,64 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/pkg/v2/common"
+)
+
+func TestAppendRow(t *testing.T) {
+	schema := &schemapb.CollectionSchema{
+		Fields: []*schemapb.FieldSchema{
+			{
+				FieldID:      100,
+				Name:         "pk",
+				IsPrimaryKey: true,
+				DataType:     schemapb.DataType_Int64,
+			},
+			{
+				FieldID:    101,
+				Name:       "str",
+				DataType:   schemapb.DataType_VarChar,
+				TypeParams: []*commonpb.KeyValuePair{{Key: common.MaxLengthKey, Value: "16"}},
+				Nullable:   true,
+			},
+		},
+	}
+	insertData, err := storage.NewInsertData(schema)
+	assert.NoError(t, err)
+	assert.NoError(t, AppendRow(schema, insertData, map[storage.FieldID]any{100: int64(1), 101: "a"}))
+	assert.NoError(t, AppendRow(schema, insertData, map[storage.FieldID]any{100: int64(2), 101: nil}))
+
+	// the value of str is illegal, the partially appended row is removed
+	err = AppendRow(schema, insertData, map[storage.FieldID]any{100: int64(3), 101: 3})
+	assert.ErrorContains(t, err, "failed to append row")
+	assert.Equal(t, 2, insertData.Data[100].RowNum())
+	assert.Equal(t, 2, insertData.Data[101].RowNum())
+
+	assert.NoError(t, AppendRow(schema, insertData, map[storage.FieldID]any{100: int64(4), 101: "b"}))
+	assert.Equal(t, []any{int64(1), int64(2), int64(4)},
+		[]any{insertData.Data[100].GetRow(0), insertData.Data[100].GetRow(1), insertData.Data[100].GetRow(2)})
+	assert.Equal(t, []any{"a", nil, "b"},
+		[]any{insertData.Data[101].GetRow(0), insertData.Data[101].GetRow(1), insertData.Data[101].GetRow(2)})
+}
@@ -0,0 +1,577 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/samber/lo"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/json"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/nullutil"
+	"github.com/milvus-io/milvus/pkg/v2/util/merr"
+	"github.com/milvus-io/milvus/pkg/v2/util/parameterutil"
+	"github.com/milvus-io/milvus/pkg/v2/util/typeutil"
+)
+
+// TypedRowParser parses the rows of the self-describing formats, such as Avro and ORC.
+// Different from JSON and CSV, the values of these formats are decoded into go native types,
+// integers are int8/int16/int32/int64, floating numbers are float32/float64,
+// lists are []any, maps and records are map[string]any, and binaries are []byte.
+type TypedRowParser struct {
+	id2Dim       map[int64]int
+	id2Field     map[int64]*schemapb.FieldSchema
+	name2FieldID map[string]int64
+	pkField      *schemapb.FieldSchema
+	dynamicField *schemapb.FieldSchema
+}
+
+func NewTypedRowParser(schema *schemapb.CollectionSchema) (*TypedRowParser, error) {
+	id2Field := lo.KeyBy(schema.GetFields(), func(field *schemapb.FieldSchema) int64 {
+		return field.GetFieldID()
+	})
+
+	id2Dim := make(map[int64]int)
+	for id, field := range id2Field {
+		if typeutil.IsVectorType(field.GetDataType()) && !typeutil.IsSparseFloatVectorType(field.GetDataType()) {
+			dim, err := typeutil.GetDim(field)
+			if err != nil {
+				return nil, err
+			}
+			id2Dim[id] = int(dim)
+		}
+	}
+
+	pkField, err := typeutil.GetPrimaryFieldSchema(schema)
+	if err != nil {
+		return nil, err
+	}
+	dynamicField := typeutil.GetDynamicField(schema)
+
+	name2FieldID := lo.SliceToMap(
+		lo.Filter(schema.GetFields(), func(field *schemapb.FieldSchema, _ int) bool {
+			return !field.GetIsFunctionOutput() && !typeutil.IsAutoPKField(field) && field.GetName() != dynamicField.GetName()
+		}),
+		func(field *schemapb.FieldSchema) (string, int64) {
+			return field.GetName(), field.GetFieldID()
+		},
+	)
+
+	return &TypedRowParser{
+		id2Dim:       id2Dim,
+		id2Field:     id2Field,
+		name2FieldID: name2FieldID,
+		pkField:      pkField,
+		dynamicField: dynamicField,
+	}, nil
+}
+
+func (r *TypedRowParser) wrapTypeError(v any, fieldID int64) error {
+	field := r.id2Field[fieldID]
+	return merr.WrapErrImportFailed(fmt.Sprintf("expected type '%s' for field '%s', got type '%T' with value '%v'",
+		field.GetDataType().String(), field.GetName(), v, v))
+}
+
+func (r *TypedRowParser) wrapDimError(actualDim int, fieldID int64) error {
+	field := r.id2Field[fieldID]
+	return merr.WrapErrImportFailed(fmt.Sprintf("expected dim '%d' for field '%s' with type '%s', got dim '%d'",
+		r.id2Dim[fieldID], field.GetName(), field.GetDataType().String(), actualDim))
+}
+
+func (r *TypedRowParser) wrapArrayValueTypeError(v any, eleType schemapb.DataType) error {
+	return merr.WrapErrImportFailed(fmt.Sprintf("expected element type '%s' in array field, got type '%T' with value '%v'",
+		eleType.String(), v, v))
+}
+
+// Parse converts a row keyed by column names into the row keyed by field ids,
+// the columns not defined in schema are put into the dynamic field if it's enabled.
+func (r *TypedRowParser) Parse(values map[string]any) (map[storage.FieldID]any, error) {
+	if _, ok := values[r.pkField.GetName()]; ok && r.pkField.GetAutoID() {
+		return nil, merr.WrapErrImportFailed(
+			fmt.Sprintf("the primary key '%s' is auto-generated, no need to provide", r.pkField.GetName()))
+	}
+	dynamicValues := make(map[string]any)
+	row := make(map[storage.FieldID]any)
+	for key, value := range values {
+		if fieldID, ok := r.name2FieldID[key]; ok {
+			data, err := r.parseEntity(fieldID, value)
+			if err != nil {
+				return nil, NewFieldError(key, err)
+			}
+			row[fieldID] = data
+		} else if r.dynamicField != nil {
+			if key == r.dynamicField.GetName() && value == nil {
+				continue
+			}
+			dynamicValues[key] = value
+		} else {
+			return nil, merr.WrapErrImportFailed(fmt.Sprintf("the field '%s' is not defined in schema", key))
+		}
+	}
+	for fieldName, fieldID := range r.name2FieldID {
+		if _, ok := row[fieldID]; !ok {
+			field := r.id2Field[fieldID]
+			if field.GetDefaultValue() != nil {
+				data, err := nullutil.GetDefaultValue(field)
+				if err != nil {
+					return nil, NewFieldError(fieldName, err)
+				}
+				row[fieldID] = data
+			} else if field.GetNullable() {
+				row[fieldID] = nil
+			} else {
+				return nil, NewFieldError(fieldName,
+					merr.WrapErrImportFailed(fmt.Sprintf("value of field '%s' is missed", fieldName)))
+			}
+		}
+	}
+	if r.dynamicField == nil {
+		return row, nil
+	}
+	if err := r.combineDynamicRow(dynamicValues, row); err != nil {
+		return nil, NewFieldError(r.dynamicField.GetName(), err)
+	}
+	return row, nil
+}
+
+// combineDynamicRow merges the undefined columns and the value of dynamic field column into the dynamic field,
+// the value of dynamic field column could be a JSON object string or a map, and duplicated keys are not allowed.
+func (r *TypedRowParser) combineDynamicRow(dynamicValues map[string]any, row map[storage.FieldID]any) error {
+	dynamicFieldID := r.dynamicField.GetFieldID()
+	if obj, ok := dynamicValues[r.dynamicField.GetName()]; ok {
+		var mp map[string]any
+		switch value := obj.(type) {
+		case string:
+			if err := json.Unmarshal([]byte(value), &mp); err != nil {
+				return merr.WrapErrImportFailed("illegal value for dynamic field, not a JSON format string")
+			}
+		case []byte:
+			if err := json.Unmarshal(value, &mp); err != nil {
+				return merr.WrapErrImportFailed("illegal value for dynamic field, not a JSON format string")
+			}
+		case map[string]any:
+			mp = value
+		default:
+			return merr.WrapErrImportFailed("illegal value for dynamic field, not a JSON object")
+		}
+		delete(dynamicValues, r.dynamicField.GetName())
+		for k, v := range mp {
+			if _, ok = dynamicValues[k]; ok {
+				return merr.WrapErrImportFailed(fmt.Sprintf("duplicated key is not allowed, key=%s", k))
+			}
+			dynamicValues[k] = v
+		}
+	}
+	bs, err := json.Marshal(dynamicValues)
+	if err != nil {
+		return merr.WrapErrImportFailed(fmt.Sprintf("failed to marshal dynamic field, err=%v", err))
+	}
+	row[dynamicFieldID] = bs
+	return nil
+}
+
+func (r *TypedRowParser) parseEntity(fieldID int64, obj any) (any, error) {
+	field := r.id2Field[fieldID]
+	if obj == nil {
+		if field.GetDefaultValue() != nil {
+			return nullutil.GetDefaultValue(field)
+		}
+		if field.GetNullable() {
+			return nil, nil
+		}
+		return nil, merr.WrapErrImportFailed(fmt.Sprintf("the value of field '%s' is null but the field is not nullable", field.GetName()))
+	}
+	if field.GetNullable() && typeutil.IsVectorType(field.GetDataType()) {
+		return nil, merr.WrapErrParameterInvalidMsg("not support nullable in vector")
+	}
+
+	switch field.GetDataType() {
+	case schemapb.DataType_Bool:
+		b, ok := obj.(bool)
+		if !ok {
+			return nil, r.wrapTypeError(obj, fieldID)
+		}
+		return b, nil
+	case schemapb.DataType_Int8:
+		num, ok := toInt64(obj)
+		if !ok || num < math.MinInt8 || num > math.MaxInt8 {
+			return nil, r.wrapTypeError(obj, fieldID)
+		}
+		return int8(num), nil
+	case schemapb.DataType_Int16:
+		num, ok := toInt64(obj)
+		if !ok || num < math.MinInt16 || num > math.MaxInt16 {
+			return nil, r.wrapTypeError(obj, fieldID)
+		}
+		return int16(num), nil
+	case schemapb.DataType_Int32:
+		num, ok := toInt64(obj)
+		if !ok || num < math.MinInt32 || num > math.MaxInt32 {
+			return nil, r.wrapTypeError(obj, fieldID)
+		}
+		return int32(num), nil
+	case schemapb.DataType_Int64:
+		num, ok := toInt64(obj)
+		if !ok {
+			return nil, r.wrapTypeError(obj, fieldID)
+		}
+		return num, nil
+	case schemapb.DataType_Float:
+		num, ok := toFloat64(obj)
+		if !ok {
+			return nil, r.wrapTypeError(obj, fieldID)
+		}
+		return float32(num), typeutil.VerifyFloats32([]float32{float32(num)})
+	case schemapb.DataType_Double:
+		num, ok := toFloat64(obj)
+		if !ok {
+			return nil, r.wrapTypeError(obj, fieldID)
+		}
+		return num, typeutil.VerifyFloats64([]float64{num})
+	case schemapb.DataType_String, schemapb.DataType_VarChar:
+		value, ok := obj.(string)
+		if !ok {
+			return nil, r.wrapTypeError(obj, fieldID)
+		}
+		maxLength, err := parameterutil.GetMaxLength(field)
+		if err != nil {
+			return nil, err
+		}
+		if err = CheckVarcharLength(value, maxLength); err != nil {
+			return nil, err
+		}
+		return value, nil
+	case schemapb.DataType_JSON:
+		return r.parseJSON(fieldID, obj)
+	case schemapb.DataType_Array:
+		arr, ok := obj.([]any)
+		if !ok {
+			return nil, r.wrapTypeError(obj, fieldID)
+		}
+		maxCapacity, err := parameterutil.GetMaxCapacity(field)
+		if err != nil {
+			return nil, err
+		}
+		if err = CheckArrayCapacity(len(arr), maxCapacity); err != nil {
+			return nil, err
+		}
+		return r.arrayToFieldData(arr, field.GetElementType())
+	case schemapb.DataType_FloatVector:
+		vec, err := r.parseFloats(fieldID, obj)
+		if err != nil {
+			return nil, err
+		}
+		return vec, typeutil.VerifyFloats32(vec)
+	case schemapb.DataType_Float16Vector, schemapb.DataType_BFloat16Vector:
+		// accept the raw bytes, or the float numbers which are converted into half precision
+		if bs, ok := obj.([]byte); ok {
+			if len(bs) != r.id2Dim[fieldID]*2 {
+				return nil, r.wrapDimError(len(bs)/2, fieldID)
+			}
+			if field.GetDataType() == schemapb.DataType_Float16Vector {
+				return bs, typeutil.VerifyFloats16(bs)
+			}
+			return bs, typeutil.VerifyBFloats16(bs)
+		}
+		floats, err := r.parseFloats(fieldID, obj)
+		if err != nil {
+			return nil, err
+		}
+		vec := make([]byte, 0, len(floats)*2)
+		if field.GetDataType() == schemapb.DataType_Float16Vector {
+			for _, f := range floats {
+				vec = append(vec, typeutil.Float32ToFloat16Bytes(f)...)
+			}
+			return vec, typeutil.VerifyFloats16(vec)
+		}
+		for _, f := range floats {
+			vec = append(vec, typeutil.Float32ToBFloat16Bytes(f)...)
+		}
+		return vec, typeutil.VerifyBFloats16(vec)
+	case schemapb.DataType_BinaryVector:
+		// accept the packed bytes, or the list of uint8 numbers
+		vec, err := r.parseBytes(fieldID, obj, 0, math.MaxUint8)
+		if err != nil {
+			return nil, err
+		}
+		if len(vec) != r.id2Dim[fieldID]/8 {
+			return nil, r.wrapDimError(len(vec)*8, fieldID)
+		}
+		return vec, nil
+	case schemapb.DataType_Int8Vector:
+		bs, err := r.parseBytes(fieldID, obj, math.MinInt8, math.MaxInt8)
+		if err != nil {
+			return nil, err
+		}
+		if len(bs) != r.id2Dim[fieldID] {
+			return nil, r.wrapDimError(len(bs), fieldID)
+		}
+		vec := make([]int8, len(bs))
+		for i, b := range bs {
+			vec[i] = int8(b)
+		}
+		return vec, nil
+	case schemapb.DataType_SparseFloatVector:
+		return r.parseSparseVector(fieldID, obj)
+	default:
+		return nil, merr.WrapErrImportFailed(fmt.Sprintf("parse row failed, unsupported data type: %s",
+			field.GetDataType().String()))
+	}
+}
+
+// parseJSON accepts a JSON format string or bytes, or a map which is marshaled into JSON.
+func (r *TypedRowParser) parseJSON(fieldID int64, obj any) (any, error) {
+	var bs []byte
+	switch value := obj.(type) {
+	case string:
+		bs = []byte(value)
+	case []byte:
+		bs = value
+	case map[string]any:
+		return json.Marshal(value)
+	default:
+		return nil, r.wrapTypeError(obj, fieldID)
+	}
+	var dummy any
+	if err := json.Unmarshal(bs, &dummy); err != nil {
+		return nil, merr.WrapErrImportFailed(fmt.Sprintf("invalid JSON value for field '%s', err=%v", r.id2Field[fieldID].GetName(), err))
+	}
+	return bs, nil
+}
+
+func (r *TypedRowParser) parseFloats(fieldID int64, obj any) ([]float32, error) {
+	arr, ok := obj.([]any)
+	if !ok {
+		return nil, r.wrapTypeError(obj, fieldID)
+	}
+	if len(arr) != r.id2Dim[fieldID] {
+		return nil, r.wrapDimError(len(arr), fieldID)
+	}
+	vec := make([]float32, len(arr))
+	for i, v := range arr {
+		num, ok := toFloat64(v)
+		if !ok {
+			return nil, r.wrapTypeError(v, fieldID)
+		}
+		vec[i] = float32(num)
+	}
+	return vec, nil
+}
+
+// parseBytes accepts the raw bytes, or a list of integers in the range of [minValue, maxValue].
+func (r *TypedRowParser) parseBytes(fieldID int64, obj any, minValue, maxValue int64) ([]byte, error) {
+	if bs, ok := obj.([]byte); ok {
+		return bs, nil
+	}
+	arr, ok := obj.([]any)
+	if !ok {
+		return nil, r.wrapTypeError(obj, fieldID)
+	}
+	bs := make([]byte, len(arr))
+	for i, v := range arr {
+		num, ok := toInt64(v)
+		if !ok || num < minValue || num > maxValue {
+			return nil, r.wrapTypeError(v, fieldID)
+		}
+		bs[i] = byte(num)
+	}
+	return bs, nil
+}
+
+// parseSparseVector accepts a JSON format string, a map from index to value,
+// or a map with "indices" and "values" lists.
+func (r *TypedRowParser) parseSparseVector(fieldID int64, obj any) (any, error) {
+	var mp map[string]any
+	switch value := obj.(type) {
+	case string:
+		if err := json.Unmarshal([]byte(value), &mp); err != nil {
+			return nil, r.wrapTypeError(obj, fieldID)
+		}
+		return typeutil.CreateSparseFloatRowFromMap(mp)
+	case map[string]any:
+		mp = make(map[string]any, len(value))
+		for k, v := range value {
+			normalized, ok := normalizeSparseValue(v)
+			if !ok {
+				return nil, r.wrapTypeError(obj, fieldID)
+			}
+			mp[k] = normalized
+		}
+		return typeutil.CreateSparseFloatRowFromMap(mp)
+	default:
+		return nil, r.wrapTypeError(obj, fieldID)
+	}
+}
+
+// normalizeSparseValue converts the numbers into float64 which is accepted by CreateSparseFloatRowFromMap.
+func normalizeSparseValue(v any) (any, bool) {
+	if arr, ok := v.([]any); ok {
+		ret := make([]any, len(arr))
+		for i, e := range arr {
+			num, ok := toFloat64(e)
+			if !ok {
+				return nil, false
+			}
+			ret[i] = num
+		}
+		return ret, true
+	}
+	return toFloat64(v)
+}
+
+func (r *TypedRowParser) arrayToFieldData(arr []any, eleType schemapb.DataType) (*schemapb.ScalarField, error) {
+	switch eleType {
+	case schemapb.DataType_Bool:
+		values := make([]bool, len(arr))
+		for i, v := range arr {
+			value, ok := v.(bool)
+			if !ok {
+				return nil, r.wrapArrayValueTypeError(arr, eleType)
+			}
+			values[i] = value
+		}
+		return &schemapb.ScalarField{
+			Data: &schemapb.ScalarField_BoolData{
+				BoolData: &schemapb.BoolArray{
+					Data: values,
+				},
+			},
+		}, nil
+	case schemapb.DataType_Int8, schemapb.DataType_Int16, schemapb.DataType_Int32:
+		values := make([]int32, len(arr))
+		for i, v := range arr {
+			num, ok := toInt64(v)
+			if !ok || num < math.MinInt32 || num > math.MaxInt32 {
+				return nil, r.wrapArrayValueTypeError(arr, eleType)
+			}
+			values[i] = int32(num)
+		}
+		return &schemapb.ScalarField{
+			Data: &schemapb.ScalarField_IntData{
+				IntData: &schemapb.IntArray{
+					Data: values,
+				},
+			},
+		}, nil
+	case schemapb.DataType_Int64:
+		values := make([]int64, len(arr))
+		for i, v := range arr {
+			num, ok := toInt64(v)
+			if !ok {
+				return nil, r.wrapArrayValueTypeError(arr, eleType)
+			}
+			values[i] = num
+		}
+		return &schemapb.ScalarField{
+			Data: &schemapb.ScalarField_LongData{
+				LongData: &schemapb.LongArray{
+					Data: values,
+				},
+			},
+		}, nil
+	case schemapb.DataType_Float:
+		values := make([]float32, len(arr))
+		for i, v := range arr {
+			num, ok := toFloat64(v)
+			if !ok {
+				return nil, r.wrapArrayValueTypeError(arr, eleType)
+			}
+			values[i] = float32(num)
+		}
+		if err := typeutil.VerifyFloats32(values); err != nil {
+			return nil, fmt.Errorf("float32 verification failed: %w", err)
+		}
+		return &schemapb.ScalarField{
+			Data: &schemapb.ScalarField_FloatData{
+				FloatData: &schemapb.FloatArray{
+					Data: values,
+				},
+			},
+		}, nil
+	case schemapb.DataType_Double:
+		values := make([]float64, len(arr))
+		for i, v := range arr {
+			num, ok := toFloat64(v)
+			if !ok {
+				return nil, r.wrapArrayValueTypeError(arr, eleType)
+			}
+			values[i] = num
+		}
+		if err := typeutil.VerifyFloats64(values); err != nil {
+			return nil, fmt.Errorf("float64 verification failed: %w", err)
+		}
+		return &schemapb.ScalarField{
+			Data: &schemapb.ScalarField_DoubleData{
+				DoubleData: &schemapb.DoubleArray{
+					Data: values,
+				},
+			},
+		}, nil
+	case schemapb.DataType_VarChar, schemapb.DataType_String:
+		values := make([]string, len(arr))
+		for i, v := range arr {
+			value, ok := v.(string)
+			if !ok {
+				return nil, r.wrapArrayValueTypeError(arr, eleType)
+			}
+			values[i] = value
+		}
+		return &schemapb.ScalarField{
+			Data: &schemapb.ScalarField_StringData{
+				StringData: &schemapb.StringArray{
+					Data: values,
+				},
+			},
+		}, nil
+	default:
+		return nil, fmt.Errorf("unsupported array data type '%s'", eleType.String())
+	}
+}
+
+func toInt64(v any) (int64, bool) {
+	switch value := v.(type) {
+	case int8:
+		return int64(value), true
+	case int16:
+		return int64(value), true
+	case int32:
+		return int64(value), true
+	case int64:
+		return value, true
+	case int:
+		return int64(value), true
+	case uint8:
+		return int64(value), true
+	default:
+		return 0, false
+	}
+}
+
+func toFloat64(v any) (float64, bool) {
+	switch value := v.(type) {
+	case float32:
+		return float64(value), true
+	case float64:
+		return value, true
+	default:
+		num, ok := toInt64(v)
+		return float64(num), ok
+	}
+}
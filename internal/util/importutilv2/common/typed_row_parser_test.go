@@ -0,0 +1,208 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common
+
+import (
+	"testing"
+
+	"github.com/cockroachdb/errors"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/json"
+	"github.com/milvus-io/milvus/pkg/v2/common"
+	"github.com/milvus-io/milvus/pkg/v2/util/typeutil"
+)
+
+func newTypedRowParserSchema() *schemapb.CollectionSchema {
+	return &schemapb.CollectionSchema{
+		Fields: []*schemapb.FieldSchema{
+			{
+				FieldID:      100,
+				Name:         "id",
+				IsPrimaryKey: true,
+				DataType:     schemapb.DataType_Int64,
+			},
+			{
+				FieldID:    101,
+				Name:       "vector",
+				DataType:   schemapb.DataType_FloatVector,
+				TypeParams: []*commonpb.KeyValuePair{{Key: common.DimKey, Value: "2"}},
+			},
+			{
+				FieldID:    102,
+				Name:       "binary",
+				DataType:   schemapb.DataType_BinaryVector,
+				TypeParams: []*commonpb.KeyValuePair{{Key: common.DimKey, Value: "16"}},
+			},
+			{
+				FieldID:  103,
+				Name:     "sparse",
+				DataType: schemapb.DataType_SparseFloatVector,
+			},
+			{
+				FieldID:    104,
+				Name:       "name",
+				DataType:   schemapb.DataType_VarChar,
+				TypeParams: []*commonpb.KeyValuePair{{Key: common.MaxLengthKey, Value: "8"}},
+			},
+			{
+				FieldID:     105,
+				Name:        "tags",
+				DataType:    schemapb.DataType_Array,
+				ElementType: schemapb.DataType_Int16,
+				TypeParams:  []*commonpb.KeyValuePair{{Key: common.MaxCapacityKey, Value: "4"}},
+			},
+			{
+				FieldID:  106,
+				Name:     "age",
+				DataType: schemapb.DataType_Int8,
+				Nullable: true,
+				DefaultValue: &schemapb.ValueField{
+					Data: &schemapb.ValueField_IntData{IntData: 18},
+				},
+			},
+			{
+				FieldID:  107,
+				Name:     "score",
+				DataType: schemapb.DataType_Float,
+				Nullable: true,
+			},
+			{
+				FieldID:   108,
+				Name:      "$meta",
+				IsDynamic: true,
+				DataType:  schemapb.DataType_JSON,
+			},
+		},
+	}
+}
+
+func TestTypedRowParser_Parse(t *testing.T) {
+	r, err := NewTypedRowParser(newTypedRowParserSchema())
+	assert.NoError(t, err)
+
+	row, err := r.Parse(map[string]any{
+		"id":     int64(1),
+		"vector": []any{float32(0.5), float64(1.5)},
+		"binary": []byte{0x01, 0x02},
+		"sparse": map[string]any{"1": float32(0.5), "10": float64(1)},
+		"name":   "abc",
+		"tags":   []any{int32(1), int16(2)},
+		"score":  nil,
+		"x":      int32(8),
+		"$meta":  map[string]any{"y": "z"},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), row[100])
+	assert.Equal(t, []float32{0.5, 1.5}, row[101])
+	assert.Equal(t, []byte{0x01, 0x02}, row[102])
+	assert.Equal(t, typeutil.CreateSparseFloatRow([]uint32{1, 10}, []float32{0.5, 1}), row[103])
+	assert.Equal(t, "abc", row[104])
+	assert.Equal(t, []int32{1, 2}, row[105].(*schemapb.ScalarField).GetIntData().GetData())
+	assert.Equal(t, int8(18), row[106])
+	assert.Nil(t, row[107])
+	var dynamic map[string]any
+	assert.NoError(t, json.Unmarshal(row[108].([]byte), &dynamic))
+	assert.Equal(t, map[string]any{"x": float64(8), "y": "z"}, dynamic)
+
+	// the list of uint8 for binary vector, and the indices/values for sparse vector
+	row, err = r.Parse(map[string]any{
+		"id":     int32(2),
+		"vector": []any{float32(0.5), float32(1.5)},
+		"binary": []any{uint8(1), int64(2)},
+		"sparse": map[string]any{"indices": []any{int64(1)}, "values": []any{float32(2)}},
+		"name":   "abc",
+		"tags":   []any{},
+		"age":    int32(20),
+		"score":  float32(0.5),
+		"$meta":  `{"y": 1}`,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2), row[100])
+	assert.Equal(t, []byte{0x01, 0x02}, row[102])
+	assert.Equal(t, typeutil.CreateSparseFloatRow([]uint32{1}, []float32{2}), row[103])
+	assert.Equal(t, int8(20), row[106])
+	assert.Equal(t, float32(0.5), row[107])
+}
+
+func TestTypedRowParser_Parse_Invalid(t *testing.T) {
+	r, err := NewTypedRowParser(newTypedRowParserSchema())
+	assert.NoError(t, err)
+
+	newRow := func(key string, value any) map[string]any {
+		row := map[string]any{
+			"id":     int64(1),
+			"vector": []any{float32(0.5), float32(1.5)},
+			"binary": []byte{0x01, 0x02},
+			"sparse": map[string]any{"1": float32(0.5)},
+			"name":   "abc",
+			"tags":   []any{int32(1)},
+		}
+		if value == nil {
+			delete(row, key)
+		} else {
+			row[key] = value
+		}
+		return row
+	}
+
+	cases := []struct {
+		name      string
+		row       map[string]any
+		expectErr string
+	}{
+		{name: "missed", row: newRow("name", nil), expectErr: "value of field 'name' is missed"},
+		{name: "wrong_type", row: newRow("id", "1"), expectErr: "expected type 'Int64'"},
+		{name: "out_of_range", row: newRow("age", int32(1000)), expectErr: "expected type 'Int8'"},
+		{name: "wrong_dim", row: newRow("vector", []any{float32(1)}), expectErr: "expected dim '2'"},
+		{name: "wrong_binary_dim", row: newRow("binary", []byte{0x01}), expectErr: "expected dim '16'"},
+		{name: "exceed_max_length", row: newRow("name", "abcdefghi"), expectErr: "exceeds max_length"},
+		{name: "exceed_max_capacity", row: newRow("tags", []any{int32(1), int32(2), int32(3), int32(4), int32(5)}), expectErr: "exceeds max_capacity"},
+		{name: "wrong_element", row: newRow("tags", []any{"a"}), expectErr: "expected element type"},
+		{name: "invalid_sparse", row: newRow("sparse", map[string]any{"1": "a"}), expectErr: "expected type 'SparseFloatVector'"},
+		{name: "invalid_dynamic", row: newRow("$meta", int32(1)), expectErr: "not a JSON object"},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			_, err = r.Parse(c.row)
+			assert.ErrorContains(t, err, c.expectErr)
+		})
+	}
+
+	// the name of the illegal field is attached to the error
+	_, err = r.Parse(newRow("vector", []any{float32(1)}))
+	assert.Equal(t, "vector", GetErrorField(err))
+	_, err = r.Parse(newRow("name", nil))
+	assert.Equal(t, "name", GetErrorField(err))
+	_, err = r.Parse(newRow("$meta", int32(1)))
+	assert.Equal(t, "$meta", GetErrorField(err))
+	assert.Equal(t, "", GetErrorField(errors.New("mock err")))
+
+	row := newRow("x", int32(1))
+	row["$meta"] = map[string]any{"x": 2}
+	_, err = r.Parse(row)
+	assert.ErrorContains(t, err, "duplicated key is not allowed")
+
+	schema := newTypedRowParserSchema()
+	schema.Fields[1].Nullable = true
+	r, err = NewTypedRowParser(schema)
+	assert.NoError(t, err)
+	_, err = r.Parse(newRow("name", "abc"))
+	assert.ErrorContains(t, err, "not support nullable in vector")
+}
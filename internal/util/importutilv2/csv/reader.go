@@ -22,6 +22,7 @@ import (
 	"fmt"
 	"io"
 
+	"github.com/cockroachdb/errors"
 	"go.uber.org/atomic"
 	"go.uber.org/zap"
 
@@ -46,6 +47,10 @@ type reader struct {
 	bufferSize int
 	count      int64
 	filePath   string
+
+	// the index of the next row, and the handler of the rows failed to parse
+	rowIndex      int64
+	badRowHandler common.BadRowHandler
 }
 
 func NewReader(ctx context.Context, cm storage.ChunkManager, schema *schemapb.CollectionSchema, path string, bufferSize int, sep rune, nullkey string) (*reader, error) {
@@ -92,16 +97,34 @@ func (r *reader) Read() (*storage.InsertData, error) {
 	var cnt int64 = 0
 	for {
 		value, err := r.cr.Read()
-		if err == io.EOF || len(value) == 0 {
+		if err == io.EOF {
 			break
 		}
-		row, err := r.parser.Parse(value)
-		if err != nil {
-			return nil, merr.WrapErrImportFailed(fmt.Sprintf("failed to parse row, error: %v", err))
+		// the malformed row is skipped as a bad row, the reader is able to continue with the next row
+		var parseErr *csv.ParseError
+		if err != nil && !errors.As(err, &parseErr) {
+			return nil, merr.WrapErrImportFailed(fmt.Sprintf("failed to read csv file, error: %v", err))
+		}
+		rowIndex := r.rowIndex
+		r.rowIndex++
+		var row Row
+		if value != nil {
+			// the record is returned along with the error of wrong number of fields, which is checked by the parser
+			row, err = r.parser.Parse(value)
 		}
-		err = insertData.Append(row)
 		if err != nil {
-			return nil, merr.WrapErrImportFailed(fmt.Sprintf("failed to append row, error: %v", err))
+			err = common.NewFieldError(common.GetErrorField(err),
+				merr.WrapErrImportFailed(fmt.Sprintf("failed to parse row, error: %v", err)))
+			if err = common.HandleBadRow(r.badRowHandler, r.filePath, rowIndex, err); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		if err = common.AppendRow(r.schema, insertData, row); err != nil {
+			if err = common.HandleBadRow(r.badRowHandler, r.filePath, rowIndex, err); err != nil {
+				return nil, err
+			}
+			continue
 		}
 		cnt++
 		if cnt >= r.count {
@@ -120,6 +143,12 @@ func (r *reader) Read() (*storage.InsertData, error) {
 	return insertData, nil
 }
 
+// SetBadRowHandler sets the handler of the rows failed to parse, the reading is aborted by
+// the first bad row if no handler is set.
+func (r *reader) SetBadRowHandler(handler common.BadRowHandler) {
+	r.badRowHandler = handler
+}
+
 func (r *reader) Close() {}
 
 func (r *reader) Size() (int64, error) {
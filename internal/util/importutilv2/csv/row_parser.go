@@ -109,7 +109,7 @@ func (r *rowParser) Parse(strArr []string) (Row, error) {
 		if field, ok := r.name2Field[r.header[index]]; ok {
 			data, err := r.parseEntity(field, value)
 			if err != nil {
-				return nil, err
+				return nil, common.NewFieldError(field.GetName(), err)
 			}
 			row[field.GetFieldID()] = data
 		} else if r.dynamicField != nil {
@@ -124,7 +124,7 @@ func (r *rowParser) Parse(strArr []string) (Row, error) {
 	if r.dynamicField != nil {
 		err := r.combineDynamicRow(dynamicValues, row)
 		if err != nil {
-			return nil, err
+			return nil, common.NewFieldError(r.dynamicField.GetName(), err)
 		}
 	}
 	return row, nil
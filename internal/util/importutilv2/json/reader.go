@@ -51,6 +51,10 @@ type reader struct {
 	isOldFormat bool
 
 	parser RowParser
+
+	// the index of the next row, and the handler of the rows failed to parse
+	rowIndex      int64
+	badRowHandler common.BadRowHandler
 }
 
 func NewReader(ctx context.Context, cm storage.ChunkManager, schema *schemapb.CollectionSchema, path string, bufferSize int) (*reader, error) {
@@ -138,13 +142,20 @@ func (j *reader) Read() (*storage.InsertData, error) {
 		if err = j.dec.Decode(&value); err != nil {
 			return nil, merr.WrapErrImportFailed(fmt.Sprintf("failed to parse row, error: %v", err))
 		}
+		rowIndex := j.rowIndex
+		j.rowIndex++
 		row, err := j.parser.Parse(value)
 		if err != nil {
-			return nil, err
+			if err = common.HandleBadRow(j.badRowHandler, j.filePath, rowIndex, err); err != nil {
+				return nil, err
+			}
+			continue
 		}
-		err = insertData.Append(row)
-		if err != nil {
-			return nil, merr.WrapErrImportFailed(fmt.Sprintf("failed to append row, err=%s", err.Error()))
+		if err = common.AppendRow(j.schema, insertData, row); err != nil {
+			if err = common.HandleBadRow(j.badRowHandler, j.filePath, rowIndex, err); err != nil {
+				return nil, err
+			}
+			continue
 		}
 		cnt++
 		if cnt >= j.count {
@@ -168,6 +179,12 @@ func (j *reader) Read() (*storage.InsertData, error) {
 	return insertData, nil
 }
 
+// SetBadRowHandler sets the handler of the rows failed to parse, the reading is aborted by
+// the first bad row if no handler is set.
+func (j *reader) SetBadRowHandler(handler common.BadRowHandler) {
+	j.badRowHandler = handler
+}
+
 func (j *reader) Size() (int64, error) {
 	if size := j.fileSize.Load(); size != 0 {
 		return size, nil
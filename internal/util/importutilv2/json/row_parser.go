@@ -115,7 +115,7 @@ func (r *rowParser) Parse(raw any) (Row, error) {
 		if fieldID, ok := r.name2FieldID[key]; ok {
 			data, err := r.parseEntity(fieldID, value)
 			if err != nil {
-				return nil, err
+				return nil, common.NewFieldError(key, err)
 			}
 			row[fieldID] = data
 		} else if r.dynamicField != nil {
@@ -133,13 +133,14 @@ func (r *rowParser) Parse(raw any) (Row, error) {
 			if r.id2Field[fieldID].GetDefaultValue() != nil {
 				data, err := nullutil.GetDefaultValue(r.id2Field[fieldID])
 				if err != nil {
-					return nil, err
+					return nil, common.NewFieldError(fieldName, err)
 				}
 				row[fieldID] = data
 			}
 		}
 		if _, ok = row[fieldID]; !ok {
-			return nil, merr.WrapErrImportFailed(fmt.Sprintf("value of field '%s' is missed", fieldName))
+			return nil, common.NewFieldError(fieldName,
+				merr.WrapErrImportFailed(fmt.Sprintf("value of field '%s' is missed", fieldName)))
 		}
 	}
 	if r.dynamicField == nil {
@@ -148,7 +149,7 @@ func (r *rowParser) Parse(raw any) (Row, error) {
 	// combine the redundant pairs into dynamic field(if it has)
 	err := r.combineDynamicRow(dynamicValues, row)
 	if err != nil {
-		return nil, err
+		return nil, common.NewFieldError(r.dynamicField.GetName(), err)
 	}
 	return row, err
 }
@@ -0,0 +1,224 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package jsonl
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"go.uber.org/atomic"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/importutilv2/common"
+	importjson "github.com/milvus-io/milvus/internal/util/importutilv2/json"
+	"github.com/milvus-io/milvus/pkg/v2/log"
+	"github.com/milvus-io/milvus/pkg/v2/util/merr"
+)
+
+const readerBufferSize = 64 * 1024
+
+type reader struct {
+	ctx    context.Context
+	cm     storage.ChunkManager
+	schema *schemapb.CollectionSchema
+
+	fileSize *atomic.Int64
+	paths    []string
+
+	// the file being read, and the number of lines read from it
+	fileIndex int
+	r         storage.FileReader
+	br        *bufio.Reader
+	lineNum   int64
+
+	bufferSize int
+	count      int64
+
+	parser importjson.RowParser
+
+	// the index of the next row counted across the files, and the handler of the rows failed to parse
+	rowIndex      int64
+	badRowHandler common.BadRowHandler
+}
+
+// NewReader reads the newline-delimited JSON files one by one, each non-blank line is a JSON object of a row.
+// Only one line is held in memory at a time, so the file size is not limited by the memory.
+func NewReader(ctx context.Context, cm storage.ChunkManager, schema *schemapb.CollectionSchema, paths []string, bufferSize int) (*reader, error) {
+	if len(paths) == 0 {
+		return nil, merr.WrapErrImportFailed("no jsonl file to import")
+	}
+	count, err := common.EstimateReadCountPerBatch(bufferSize, schema)
+	if err != nil {
+		return nil, err
+	}
+	parser, err := importjson.NewRowParser(schema)
+	if err != nil {
+		return nil, err
+	}
+	return &reader{
+		ctx:        ctx,
+		cm:         cm,
+		schema:     schema,
+		fileSize:   atomic.NewInt64(0),
+		paths:      paths,
+		bufferSize: bufferSize,
+		count:      count,
+		parser:     parser,
+	}, nil
+}
+
+func (j *reader) openNextFile() error {
+	path := j.paths[j.fileIndex]
+	r, err := j.cm.Reader(j.ctx, path)
+	if err != nil {
+		return merr.WrapErrImportFailed(fmt.Sprintf("read jsonl file failed, path=%s, err=%s", path, err.Error()))
+	}
+	j.r = r
+	j.br = bufio.NewReaderSize(r, readerBufferSize)
+	j.lineNum = 0
+	return nil
+}
+
+func (j *reader) closeFile() {
+	if j.r == nil {
+		return
+	}
+	if err := j.r.Close(); err != nil {
+		log.Warn("close jsonl file reader failed", zap.String("path", j.paths[j.fileIndex]), zap.Error(err))
+	}
+	j.r = nil
+	j.br = nil
+	j.fileIndex++
+}
+
+func (j *reader) Read() (*storage.InsertData, error) {
+	insertData, err := storage.NewInsertData(j.schema)
+	if err != nil {
+		return nil, err
+	}
+	var cnt int64 = 0
+	for {
+		if j.br == nil {
+			if j.fileIndex >= len(j.paths) {
+				break
+			}
+			if err = j.openNextFile(); err != nil {
+				return nil, err
+			}
+		}
+		line, readErr := j.br.ReadBytes('\n')
+		if readErr != nil && readErr != io.EOF {
+			return nil, merr.WrapErrImportFailed(fmt.Sprintf("failed to read jsonl file '%s' at line %d, err=%v",
+				j.paths[j.fileIndex], j.lineNum+1, readErr))
+		}
+		if len(line) > 0 {
+			j.lineNum++
+			appended, err := j.parseLine(line, insertData)
+			if err != nil {
+				return nil, err
+			}
+			if appended {
+				cnt++
+			}
+		}
+		if readErr == io.EOF {
+			j.closeFile()
+		}
+		if cnt >= j.count {
+			cnt = 0
+			if insertData.GetMemorySize() >= j.bufferSize {
+				break
+			}
+		}
+	}
+	if insertData.GetRowNum() == 0 {
+		return nil, io.EOF
+	}
+	return insertData, nil
+}
+
+// parseLine parses a line into a row and appends it to the insert data, the blank line is skipped.
+func (j *reader) parseLine(line []byte, insertData *storage.InsertData) (bool, error) {
+	line = bytes.TrimSpace(line)
+	if len(line) == 0 {
+		return false, nil
+	}
+	rowIndex := j.rowIndex
+	j.rowIndex++
+	// each line is decoded independently, so a malformed line could be skipped as a bad row
+	row, err := j.parseRow(line)
+	if err != nil {
+		err = common.NewFieldError(common.GetErrorField(err), j.wrapLineError(err))
+		return false, common.HandleBadRow(j.badRowHandler, j.paths[j.fileIndex], rowIndex, err)
+	}
+	if err = common.AppendRow(j.schema, insertData, row); err != nil {
+		err = common.NewFieldError(common.GetErrorField(err), j.wrapLineError(err))
+		return false, common.HandleBadRow(j.badRowHandler, j.paths[j.fileIndex], rowIndex, err)
+	}
+	return true, nil
+}
+
+func (j *reader) parseRow(line []byte) (importjson.Row, error) {
+	dec := json.NewDecoder(bytes.NewReader(line))
+	// Treat number value as a string instead of a float64, the same as the json reader.
+	dec.UseNumber()
+	var value any
+	if err := dec.Decode(&value); err != nil {
+		return nil, err
+	}
+	if _, err := dec.Token(); err != io.EOF {
+		return nil, fmt.Errorf("unexpected content after the JSON object")
+	}
+	return j.parser.Parse(value)
+}
+
+func (j *reader) wrapLineError(err error) error {
+	return merr.WrapErrImportFailed(fmt.Sprintf("failed to parse line %d of jsonl file '%s', err=%s",
+		j.lineNum, j.paths[j.fileIndex], err.Error()))
+}
+
+// SetBadRowHandler sets the handler of the rows failed to parse, the reading is aborted by
+// the first bad row if no handler is set.
+func (j *reader) SetBadRowHandler(handler common.BadRowHandler) {
+	j.badRowHandler = handler
+}
+
+func (j *reader) Size() (int64, error) {
+	if size := j.fileSize.Load(); size != 0 {
+		return size, nil
+	}
+	var total int64
+	for _, path := range j.paths {
+		size, err := j.cm.Size(j.ctx, path)
+		if err != nil {
+			return 0, err
+		}
+		total += size
+	}
+	j.fileSize.Store(total)
+	return total, nil
+}
+
+func (j *reader) Close() {
+	j.closeFile()
+}
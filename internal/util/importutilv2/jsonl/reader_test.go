@@ -0,0 +1,356 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package jsonl
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/mocks"
+	"github.com/milvus-io/milvus/internal/storage"
+	importcommon "github.com/milvus-io/milvus/internal/util/importutilv2/common"
+	"github.com/milvus-io/milvus/internal/util/testutil"
+	"github.com/milvus-io/milvus/pkg/v2/common"
+	"github.com/milvus-io/milvus/pkg/v2/util/merr"
+	"github.com/milvus-io/milvus/pkg/v2/util/paramtable"
+)
+
+type mockReader struct {
+	io.Reader
+	io.Closer
+	io.ReaderAt
+	io.Seeker
+}
+
+type ReaderSuite struct {
+	suite.Suite
+
+	numRows     int
+	pkDataType  schemapb.DataType
+	vecDataType schemapb.DataType
+}
+
+func (suite *ReaderSuite) SetupSuite() {
+	paramtable.Get().Init(paramtable.NewBaseTable())
+}
+
+func (suite *ReaderSuite) SetupTest() {
+	// default suite params
+	suite.numRows = 100
+	suite.pkDataType = schemapb.DataType_Int64
+	suite.vecDataType = schemapb.DataType_FloatVector
+}
+
+func (suite *ReaderSuite) newSchema(dataType schemapb.DataType, elemType schemapb.DataType, nullable bool) *schemapb.CollectionSchema {
+	return &schemapb.CollectionSchema{
+		Fields: []*schemapb.FieldSchema{
+			{
+				FieldID:      100,
+				Name:         "pk",
+				IsPrimaryKey: true,
+				DataType:     suite.pkDataType,
+				TypeParams: []*commonpb.KeyValuePair{
+					{
+						Key:   common.MaxLengthKey,
+						Value: "128",
+					},
+				},
+			},
+			{
+				FieldID:  101,
+				Name:     "vec",
+				DataType: suite.vecDataType,
+				TypeParams: []*commonpb.KeyValuePair{
+					{
+						Key:   common.DimKey,
+						Value: "8",
+					},
+				},
+			},
+			{
+				FieldID:     102,
+				Name:        dataType.String(),
+				DataType:    dataType,
+				ElementType: elemType,
+				TypeParams: []*commonpb.KeyValuePair{
+					{
+						Key:   common.MaxLengthKey,
+						Value: "128",
+					},
+					{
+						Key:   common.MaxCapacityKey,
+						Value: "128",
+					},
+				},
+				Nullable: nullable,
+			},
+		},
+	}
+}
+
+// createLines marshals the rows of insert data into lines.
+func (suite *ReaderSuite) createLines(schema *schemapb.CollectionSchema, insertData *storage.InsertData) []string {
+	rows, err := testutil.CreateInsertDataRowsForJSON(schema, insertData)
+	suite.NoError(err)
+	lines := make([]string, 0, len(rows))
+	for _, row := range rows {
+		bs, err := json.Marshal(row)
+		suite.NoError(err)
+		lines = append(lines, string(bs))
+	}
+	return lines
+}
+
+// mockFiles mocks the chunk manager to return the content of files by path.
+func (suite *ReaderSuite) mockFiles(files map[string]string) storage.ChunkManager {
+	cm := mocks.NewChunkManager(suite.T())
+	cm.EXPECT().Reader(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, path string) (storage.FileReader, error) {
+		content, ok := files[path]
+		if !ok {
+			return nil, merr.WrapErrIoKeyNotFound(path)
+		}
+		return &mockReader{Reader: strings.NewReader(content), Closer: io.NopCloser(nil)}, nil
+	}).Maybe()
+	cm.EXPECT().Size(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, path string) (int64, error) {
+		return int64(len(files[path])), nil
+	}).Maybe()
+	return cm
+}
+
+func (suite *ReaderSuite) run(dataType schemapb.DataType, elemType schemapb.DataType, nullable bool) {
+	schema := suite.newSchema(dataType, elemType, nullable)
+	insertData, err := testutil.CreateInsertData(schema, suite.numRows)
+	suite.NoError(err)
+	lines := suite.createLines(schema, insertData)
+
+	cm := suite.mockFiles(map[string]string{"a.jsonl": strings.Join(lines, "\n")})
+	reader, err := NewReader(context.Background(), cm, schema, []string{"a.jsonl"}, math.MaxInt)
+	suite.NoError(err)
+	defer reader.Close()
+
+	res, err := reader.Read()
+	suite.NoError(err)
+	for fieldID, data := range res.Data {
+		suite.Equal(suite.numRows, data.RowNum())
+		for i := 0; i < suite.numRows; i++ {
+			suite.Equal(insertData.Data[fieldID].GetRow(i), data.GetRow(i))
+		}
+	}
+
+	_, err = reader.Read()
+	suite.ErrorIs(err, io.EOF)
+}
+
+func (suite *ReaderSuite) TestReadScalarFields() {
+	for _, nullable := range []bool{false, true} {
+		suite.run(schemapb.DataType_Bool, schemapb.DataType_None, nullable)
+		suite.run(schemapb.DataType_Int8, schemapb.DataType_None, nullable)
+		suite.run(schemapb.DataType_Int16, schemapb.DataType_None, nullable)
+		suite.run(schemapb.DataType_Int32, schemapb.DataType_None, nullable)
+		suite.run(schemapb.DataType_Int64, schemapb.DataType_None, nullable)
+		suite.run(schemapb.DataType_Float, schemapb.DataType_None, nullable)
+		suite.run(schemapb.DataType_Double, schemapb.DataType_None, nullable)
+		suite.run(schemapb.DataType_VarChar, schemapb.DataType_None, nullable)
+		suite.run(schemapb.DataType_JSON, schemapb.DataType_None, nullable)
+
+		suite.run(schemapb.DataType_Array, schemapb.DataType_Bool, nullable)
+		suite.run(schemapb.DataType_Array, schemapb.DataType_Int32, nullable)
+		suite.run(schemapb.DataType_Array, schemapb.DataType_Int64, nullable)
+		suite.run(schemapb.DataType_Array, schemapb.DataType_Float, nullable)
+		suite.run(schemapb.DataType_Array, schemapb.DataType_String, nullable)
+	}
+}
+
+func (suite *ReaderSuite) TestVector() {
+	suite.vecDataType = schemapb.DataType_BinaryVector
+	suite.run(schemapb.DataType_Int32, schemapb.DataType_None, false)
+	suite.vecDataType = schemapb.DataType_Float16Vector
+	suite.run(schemapb.DataType_Int32, schemapb.DataType_None, false)
+	suite.vecDataType = schemapb.DataType_SparseFloatVector
+	suite.run(schemapb.DataType_Int32, schemapb.DataType_None, false)
+}
+
+func (suite *ReaderSuite) TestMultipleFiles() {
+	schema := suite.newSchema(schemapb.DataType_VarChar, schemapb.DataType_None, false)
+	suite.numRows = 1000
+	insertData, err := testutil.CreateInsertData(schema, suite.numRows)
+	suite.NoError(err)
+	lines := suite.createLines(schema, insertData)
+
+	// blank lines, CRLF line endings and trailing newline are accepted
+	files := map[string]string{
+		"a.jsonl":  strings.Join(lines[:300], "\n") + "\n\n",
+		"b.ndjson": strings.Join(lines[300:301], "\n"),
+		"c.jsonl":  "\r\n" + strings.Join(lines[301:], "\r\n") + "\n",
+	}
+	paths := []string{"a.jsonl", "b.ndjson", "c.jsonl"}
+	cm := suite.mockFiles(files)
+	// the small buffer size makes the rows read in several batches
+	reader, err := NewReader(context.Background(), cm, schema, paths, 16*1024)
+	suite.NoError(err)
+	defer reader.Close()
+
+	size, err := reader.Size()
+	suite.NoError(err)
+	suite.Equal(int64(len(files["a.jsonl"])+len(files["b.ndjson"])+len(files["c.jsonl"])), size)
+
+	offset := 0
+	for {
+		res, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		suite.NoError(err)
+		suite.True(res.GetRowNum() < suite.numRows)
+		for i := 0; i < res.GetRowNum(); i++ {
+			suite.Equal(insertData.Data[100].GetRow(offset+i), res.Data[100].GetRow(i))
+			suite.Equal(insertData.Data[102].GetRow(offset+i), res.Data[102].GetRow(i))
+		}
+		offset += res.GetRowNum()
+	}
+	suite.Equal(suite.numRows, offset)
+}
+
+func (suite *ReaderSuite) TestInvalidLine() {
+	schema := suite.newSchema(schemapb.DataType_Int32, schemapb.DataType_None, false)
+	insertData, err := testutil.CreateInsertData(schema, 3)
+	suite.NoError(err)
+	lines := suite.createLines(schema, insertData)
+
+	cases := []struct {
+		name      string
+		content   string
+		expectErr string
+	}{
+		{
+			name:      "invalid_json",
+			content:   lines[0] + "\n\n" + `{"pk": 1,` + "\n" + lines[1],
+			expectErr: "failed to parse line 3 of jsonl file 'a.jsonl'",
+		},
+		{
+			name:      "multiple_objects",
+			content:   lines[0] + lines[1],
+			expectErr: "failed to parse line 1 of jsonl file 'a.jsonl'",
+		},
+		{
+			name:      "missed_field",
+			content:   lines[0] + "\n" + lines[1] + "\n" + `{"pk": 1}`,
+			expectErr: "failed to parse line 3 of jsonl file 'a.jsonl'",
+		},
+		{
+			name:      "not_object",
+			content:   `[1, 2]`,
+			expectErr: "failed to parse line 1 of jsonl file 'a.jsonl'",
+		},
+	}
+	for _, c := range cases {
+		suite.Run(c.name, func() {
+			cm := suite.mockFiles(map[string]string{"a.jsonl": c.content})
+			reader, err := NewReader(context.Background(), cm, schema, []string{"a.jsonl"}, math.MaxInt)
+			suite.NoError(err)
+			defer reader.Close()
+			_, err = reader.Read()
+			suite.ErrorIs(err, merr.ErrImportFailed)
+			suite.ErrorContains(err, c.expectErr)
+		})
+	}
+
+	// the line number restarts from the second file
+	cm := suite.mockFiles(map[string]string{"a.jsonl": lines[0], "b.jsonl": lines[1] + "\nxxx"})
+	reader, err := NewReader(context.Background(), cm, schema, []string{"a.jsonl", "b.jsonl"}, math.MaxInt)
+	suite.NoError(err)
+	_, err = reader.Read()
+	suite.ErrorContains(err, fmt.Sprintf("failed to parse line %d of jsonl file '%s'", 2, "b.jsonl"))
+
+	// the file not found
+	cm = suite.mockFiles(map[string]string{})
+	reader, err = NewReader(context.Background(), cm, schema, []string{"a.jsonl"}, math.MaxInt)
+	suite.NoError(err)
+	_, err = reader.Read()
+	suite.Error(err)
+
+	_, err = NewReader(context.Background(), cm, schema, nil, math.MaxInt)
+	suite.Error(err)
+}
+
+func (suite *ReaderSuite) TestBadRows() {
+	schema := suite.newSchema(schemapb.DataType_Int32, schemapb.DataType_None, false)
+	insertData, err := testutil.CreateInsertData(schema, 4)
+	suite.NoError(err)
+	lines := suite.createLines(schema, insertData)
+	var row map[string]any
+	suite.NoError(json.Unmarshal([]byte(lines[2]), &row))
+	row["Int32"] = "abc"
+	bs, err := json.Marshal(row)
+	suite.NoError(err)
+
+	files := map[string]string{
+		"a.jsonl": lines[0] + "\n" + `{"pk": 1,`,
+		"b.jsonl": string(bs) + "\n\n" + lines[3],
+	}
+	paths := []string{"a.jsonl", "b.jsonl"}
+	cm := suite.mockFiles(files)
+	reader, err := NewReader(context.Background(), cm, schema, paths, math.MaxInt)
+	suite.NoError(err)
+	badRows := make([]*importcommon.BadRow, 0)
+	reader.SetBadRowHandler(func(row *importcommon.BadRow) error {
+		badRows = append(badRows, row)
+		return nil
+	})
+	res, err := reader.Read()
+	suite.NoError(err)
+	suite.Equal(2, res.GetRowNum())
+	suite.Equal(insertData.Data[100].GetRow(0), res.Data[100].GetRow(0))
+	suite.Equal(insertData.Data[100].GetRow(3), res.Data[100].GetRow(1))
+	_, err = reader.Read()
+	suite.ErrorIs(err, io.EOF)
+
+	// the rows are counted across the files
+	suite.Equal(2, len(badRows))
+	suite.Equal("a.jsonl", badRows[0].File)
+	suite.Equal(int64(1), badRows[0].Row)
+	suite.Equal("", badRows[0].Field)
+	suite.Contains(badRows[0].Reason, "failed to parse line 2 of jsonl file 'a.jsonl'")
+	suite.Equal("b.jsonl", badRows[1].File)
+	suite.Equal(int64(2), badRows[1].Row)
+	suite.Equal("Int32", badRows[1].Field)
+	suite.Contains(badRows[1].Reason, "failed to parse line 1 of jsonl file 'b.jsonl'")
+
+	// the reading is aborted if the handler returns error
+	cm = suite.mockFiles(files)
+	reader, err = NewReader(context.Background(), cm, schema, paths, math.MaxInt)
+	suite.NoError(err)
+	reader.SetBadRowHandler(func(row *importcommon.BadRow) error {
+		return merr.WrapErrImportFailed("too many bad rows")
+	})
+	_, err = reader.Read()
+	suite.ErrorContains(err, "too many bad rows")
+}
+
+func TestJSONLReader(t *testing.T) {
+	suite.Run(t, new(ReaderSuite))
+}
@@ -43,6 +43,14 @@ const (
 
 	// CSVNullKey specifies the null key used when importing CSV files.
 	CSVNullKey = "nullkey"
+
+	// DryRun indicates whether to validate the files only, without importing any data, default to false.
+	// The bad rows found are collected into a report instead of failing the import.
+	DryRun = "dry_run"
+
+	// MaxBadRows specifies the maximum number of bad rows allowed to be skipped in each file, default to 0,
+	// which means the import fails on the first bad row.
+	MaxBadRows = "max_bad_rows"
 )
 
 // Options for backup-restore mode.
@@ -160,3 +168,23 @@ func GetCSVNullKey(options Options) (string, error) {
 	}
 	return nullKey, nil
 }
+
+func IsDryRun(options Options) bool {
+	dryRun, err := funcutil.GetAttrByKeyFromRepeatedKV(DryRun, options)
+	if err != nil || strings.ToLower(dryRun) != "true" {
+		return false
+	}
+	return true
+}
+
+func GetMaxBadRows(options Options) (int64, error) {
+	value, err := funcutil.GetAttrByKeyFromRepeatedKV(MaxBadRows, options)
+	if err != nil || len(value) == 0 {
+		return 0, nil
+	}
+	maxBadRows, err := strconv.ParseInt(value, 10, 64)
+	if err != nil || maxBadRows < 0 {
+		return 0, merr.WrapErrImportFailed(fmt.Sprintf("invalid %s, expected a non-negative integer, got '%s'", MaxBadRows, value))
+	}
+	return maxBadRows, nil
+}
@@ -88,3 +88,20 @@ func TestOption_ParseTimeRange(t *testing.T) {
 	_, _, err = ParseTimeRange(options)
 	assert.ErrorIs(t, err, merr.ErrImportFailed)
 }
+
+func TestOption_BadRows(t *testing.T) {
+	assert.False(t, IsDryRun(nil))
+	assert.True(t, IsDryRun([]*commonpb.KeyValuePair{{Key: DryRun, Value: "True"}}))
+	assert.False(t, IsDryRun([]*commonpb.KeyValuePair{{Key: DryRun, Value: "false"}}))
+
+	maxBadRows, err := GetMaxBadRows(nil)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(0), maxBadRows)
+	maxBadRows, err = GetMaxBadRows([]*commonpb.KeyValuePair{{Key: MaxBadRows, Value: "100"}})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(100), maxBadRows)
+	_, err = GetMaxBadRows([]*commonpb.KeyValuePair{{Key: MaxBadRows, Value: "-1"}})
+	assert.ErrorIs(t, err, merr.ErrImportFailed)
+	_, err = GetMaxBadRows([]*commonpb.KeyValuePair{{Key: MaxBadRows, Value: "abc"}})
+	assert.ErrorIs(t, err, merr.ErrImportFailed)
+}
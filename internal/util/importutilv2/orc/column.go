@@ -0,0 +1,435 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package orc
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+
+	"github.com/milvus-io/milvus/pkg/v2/util/merr"
+)
+
+type streamKey struct {
+	column uint32
+	kind   streamKind
+}
+
+// stripeReader decodes the columns of a stripe batch by batch, the values are decoded as go native types:
+// tinyint/smallint/int/bigint -> int8/int16/int32/int64, float/double -> float32/float64,
+// string/varchar/char -> string, binary -> []byte, array -> []any, map/struct -> map[string]any,
+// and the null values are decoded as nil.
+type stripeReader struct {
+	types     []*orcType
+	encodings []*columnEncoding
+	streams   map[streamKey]*byteStream
+
+	// rows of the stripe not decoded yet
+	remaining int
+
+	// the decoders keep their positions in the streams across batches
+	boolDecoders map[streamKey]*boolDecoder
+	byteDecoders map[streamKey]*byteRLEDecoder
+	intDecoders  map[streamKey]*intRLEDecoder
+	dictionaries map[uint32][][]byte
+}
+
+func newStripeReader(types []*orcType, encodings []*columnEncoding, numRows int) *stripeReader {
+	return &stripeReader{
+		types:        types,
+		encodings:    encodings,
+		streams:      make(map[streamKey]*byteStream),
+		remaining:    numRows,
+		boolDecoders: make(map[streamKey]*boolDecoder),
+		byteDecoders: make(map[streamKey]*byteRLEDecoder),
+		intDecoders:  make(map[streamKey]*intRLEDecoder),
+		dictionaries: make(map[uint32][][]byte),
+	}
+}
+
+// nextRows decodes at most n rows, each row is a map of top-level column name to value.
+func (r *stripeReader) nextRows(n int) ([]map[string]any, error) {
+	n = min(n, r.remaining)
+	root := r.types[0]
+	rows := make([]map[string]any, n)
+	for j := range rows {
+		rows[j] = make(map[string]any, len(root.subtypes))
+	}
+	for j, child := range root.subtypes {
+		values, err := r.readColumn(child, n)
+		if err != nil {
+			return nil, merr.WrapErrImportFailed(fmt.Sprintf("failed to read orc column '%s', err=%v", root.fieldNames[j], err))
+		}
+		for k, v := range values {
+			rows[k][root.fieldNames[j]] = v
+		}
+	}
+	r.remaining -= n
+	return rows, nil
+}
+
+func (r *stripeReader) stream(column uint32, kind streamKind) *byteStream {
+	return r.streams[streamKey{column: column, kind: kind}]
+}
+
+func (r *stripeReader) requireStream(column uint32, kind streamKind) (*byteStream, error) {
+	s := r.stream(column, kind)
+	if s == nil {
+		return nil, fmt.Errorf("stream %d of column %d not found", kind, column)
+	}
+	return s, nil
+}
+
+func (r *stripeReader) boolDecoder(column uint32, kind streamKind) (*boolDecoder, error) {
+	key := streamKey{column: column, kind: kind}
+	if d, ok := r.boolDecoders[key]; ok {
+		return d, nil
+	}
+	s, err := r.requireStream(column, kind)
+	if err != nil {
+		return nil, err
+	}
+	d := newBoolDecoder(s)
+	r.boolDecoders[key] = d
+	return d, nil
+}
+
+func (r *stripeReader) byteDecoder(column uint32, kind streamKind) (*byteRLEDecoder, error) {
+	key := streamKey{column: column, kind: kind}
+	if d, ok := r.byteDecoders[key]; ok {
+		return d, nil
+	}
+	s, err := r.requireStream(column, kind)
+	if err != nil {
+		return nil, err
+	}
+	d := newByteRLEDecoder(s)
+	r.byteDecoders[key] = d
+	return d, nil
+}
+
+func (r *stripeReader) intDecoder(column uint32, kind streamKind, signed bool) (*intRLEDecoder, error) {
+	key := streamKey{column: column, kind: kind}
+	if d, ok := r.intDecoders[key]; ok {
+		return d, nil
+	}
+	s, err := r.requireStream(column, kind)
+	if err != nil {
+		return nil, err
+	}
+	d := newIntRLEDecoder(s, signed, r.isV2(column))
+	r.intDecoders[key] = d
+	return d, nil
+}
+
+func (r *stripeReader) isV2(column uint32) bool {
+	if int(column) >= len(r.encodings) {
+		return false
+	}
+	kind := r.encodings[column].kind
+	return kind == encodingDirectV2 || kind == encodingDictionaryV2
+}
+
+func (r *stripeReader) isDictionary(column uint32) bool {
+	if int(column) >= len(r.encodings) {
+		return false
+	}
+	kind := r.encodings[column].kind
+	return kind == encodingDictionary || kind == encodingDictionaryV2
+}
+
+// readColumn reads n values of the column, including the null values.
+func (r *stripeReader) readColumn(column uint32, n int) ([]any, error) {
+	if int(column) >= len(r.types) {
+		return nil, fmt.Errorf("column %d not found", column)
+	}
+	var present []bool
+	nonNull := n
+	if r.stream(column, streamPresent) != nil {
+		d, err := r.boolDecoder(column, streamPresent)
+		if err != nil {
+			return nil, err
+		}
+		present, err = d.next(n)
+		if err != nil {
+			return nil, err
+		}
+		nonNull = 0
+		for _, p := range present {
+			if p {
+				nonNull++
+			}
+		}
+	}
+	values, err := r.readValues(column, nonNull)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read column %d of type '%s', err=%w", column, r.types[column].kind, err)
+	}
+	if present == nil {
+		return values, nil
+	}
+	out := make([]any, n)
+	j := 0
+	for i, p := range present {
+		if p {
+			out[i] = values[j]
+			j++
+		}
+	}
+	return out, nil
+}
+
+// readValues reads n non-null values of the column.
+func (r *stripeReader) readValues(column uint32, n int) ([]any, error) {
+	t := r.types[column]
+	out := make([]any, n)
+	if n == 0 {
+		return out, nil
+	}
+	switch t.kind {
+	case kindBoolean:
+		d, err := r.boolDecoder(column, streamData)
+		if err != nil {
+			return nil, err
+		}
+		values, err := d.next(n)
+		if err != nil {
+			return nil, err
+		}
+		for i, v := range values {
+			out[i] = v
+		}
+	case kindByte:
+		d, err := r.byteDecoder(column, streamData)
+		if err != nil {
+			return nil, err
+		}
+		values, err := d.next(n)
+		if err != nil {
+			return nil, err
+		}
+		for i, v := range values {
+			out[i] = int8(v)
+		}
+	case kindShort, kindInt, kindLong:
+		d, err := r.intDecoder(column, streamData, true)
+		if err != nil {
+			return nil, err
+		}
+		values, err := d.next(n)
+		if err != nil {
+			return nil, err
+		}
+		for i, v := range values {
+			switch t.kind {
+			case kindShort:
+				out[i] = int16(v)
+			case kindInt:
+				out[i] = int32(v)
+			default:
+				out[i] = v
+			}
+		}
+	case kindFloat:
+		s, err := r.requireStream(column, streamData)
+		if err != nil {
+			return nil, err
+		}
+		b, err := s.readBytes(n * 4)
+		if err != nil {
+			return nil, err
+		}
+		for i := range out {
+			out[i] = math.Float32frombits(binary.LittleEndian.Uint32(b[i*4:]))
+		}
+	case kindDouble:
+		s, err := r.requireStream(column, streamData)
+		if err != nil {
+			return nil, err
+		}
+		b, err := s.readBytes(n * 8)
+		if err != nil {
+			return nil, err
+		}
+		for i := range out {
+			out[i] = math.Float64frombits(binary.LittleEndian.Uint64(b[i*8:]))
+		}
+	case kindString, kindVarchar, kindChar, kindBinary:
+		values, err := r.readBinaries(column, n)
+		if err != nil {
+			return nil, err
+		}
+		for i, v := range values {
+			if t.kind == kindBinary {
+				out[i] = v
+			} else {
+				out[i] = string(v)
+			}
+		}
+	case kindList:
+		if len(t.subtypes) != 1 {
+			return nil, fmt.Errorf("invalid subtypes of list")
+		}
+		lengths, total, err := r.readLengths(column, n)
+		if err != nil {
+			return nil, err
+		}
+		elements, err := r.readColumn(t.subtypes[0], total)
+		if err != nil {
+			return nil, err
+		}
+		offset := 0
+		for i, length := range lengths {
+			out[i] = elements[offset : offset+length]
+			offset += length
+		}
+	case kindMap:
+		if len(t.subtypes) != 2 {
+			return nil, fmt.Errorf("invalid subtypes of map")
+		}
+		lengths, total, err := r.readLengths(column, n)
+		if err != nil {
+			return nil, err
+		}
+		keys, err := r.readColumn(t.subtypes[0], total)
+		if err != nil {
+			return nil, err
+		}
+		values, err := r.readColumn(t.subtypes[1], total)
+		if err != nil {
+			return nil, err
+		}
+		offset := 0
+		for i, length := range lengths {
+			m := make(map[string]any, length)
+			for j := offset; j < offset+length; j++ {
+				m[fmt.Sprint(keys[j])] = values[j]
+			}
+			out[i] = m
+			offset += length
+		}
+	case kindStruct:
+		if len(t.subtypes) != len(t.fieldNames) {
+			return nil, fmt.Errorf("mismatched subtypes and field names of struct")
+		}
+		for i := range out {
+			out[i] = make(map[string]any, len(t.subtypes))
+		}
+		for j, child := range t.subtypes {
+			values, err := r.readColumn(child, n)
+			if err != nil {
+				return nil, err
+			}
+			for i, v := range values {
+				out[i].(map[string]any)[t.fieldNames[j]] = v
+			}
+		}
+	default:
+		return nil, fmt.Errorf("unsupported orc type '%s'", t.kind)
+	}
+	return out, nil
+}
+
+func (r *stripeReader) readLengths(column uint32, n int) ([]int, int, error) {
+	d, err := r.intDecoder(column, streamLength, false)
+	if err != nil {
+		return nil, 0, err
+	}
+	values, err := d.next(n)
+	if err != nil {
+		return nil, 0, err
+	}
+	lengths := make([]int, n)
+	total := 0
+	for i, v := range values {
+		if v < 0 {
+			return nil, 0, fmt.Errorf("invalid length %d", v)
+		}
+		lengths[i] = int(v)
+		total += int(v)
+	}
+	return lengths, total, nil
+}
+
+func (r *stripeReader) readBinaries(column uint32, n int) ([][]byte, error) {
+	if r.isDictionary(column) {
+		dictionary, err := r.readDictionary(column)
+		if err != nil {
+			return nil, err
+		}
+		d, err := r.intDecoder(column, streamData, false)
+		if err != nil {
+			return nil, err
+		}
+		indices, err := d.next(n)
+		if err != nil {
+			return nil, err
+		}
+		out := make([][]byte, n)
+		for i, index := range indices {
+			if index < 0 || int(index) >= len(dictionary) {
+				return nil, fmt.Errorf("dictionary index %d out of range %d", index, len(dictionary))
+			}
+			out[i] = dictionary[index]
+		}
+		return out, nil
+	}
+	lengths, _, err := r.readLengths(column, n)
+	if err != nil {
+		return nil, err
+	}
+	s, err := r.requireStream(column, streamData)
+	if err != nil {
+		return nil, err
+	}
+	out := make([][]byte, n)
+	for i, length := range lengths {
+		b, err := s.readBytes(length)
+		if err != nil {
+			return nil, err
+		}
+		out[i] = append(make([]byte, 0, length), b...)
+	}
+	return out, nil
+}
+
+// readDictionary decodes the dictionary of the column once, the length stream of
+// dictionary encoded columns holds the lengths of the dictionary entries.
+func (r *stripeReader) readDictionary(column uint32) ([][]byte, error) {
+	if dictionary, ok := r.dictionaries[column]; ok {
+		return dictionary, nil
+	}
+	size := int(r.encodings[column].dictionarySize)
+	lengths, _, err := r.readLengths(column, size)
+	if err != nil {
+		return nil, err
+	}
+	s := r.stream(column, streamDictionaryData)
+	if s == nil {
+		s = newByteStream(nil)
+	}
+	dictionary := make([][]byte, size)
+	for i, length := range lengths {
+		b, err := s.readBytes(length)
+		if err != nil {
+			return nil, err
+		}
+		dictionary[i] = b
+	}
+	r.dictionaries[column] = dictionary
+	return dictionary, nil
+}
@@ -0,0 +1,145 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package orc
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/milvus-io/milvus/pkg/v2/util/merr"
+)
+
+const (
+	orcMagic = "ORC"
+	// the postscript length is stored in the last byte, so the postscript is at most 255 bytes
+	maxPostScriptSize = 256
+)
+
+// orcFile is the metadata of an ORC file, which is used to decode the stripes.
+type orcFile struct {
+	r      io.ReaderAt
+	ps     *postScript
+	footer *footer
+}
+
+func openFile(r io.ReaderAt, size int64) (*orcFile, error) {
+	if size <= int64(len(orcMagic)) {
+		return nil, merr.WrapErrImportFailed(fmt.Sprintf("invalid orc file, file size %d is too small", size))
+	}
+	tailSize := int64(maxPostScriptSize)
+	if tailSize > size {
+		tailSize = size
+	}
+	tail, err := readAt(r, size-tailSize, tailSize)
+	if err != nil {
+		return nil, err
+	}
+	psLength := int64(tail[len(tail)-1])
+	if psLength+1 > tailSize {
+		return nil, merr.WrapErrImportFailed(fmt.Sprintf("invalid orc file, postscript length %d overflows", psLength))
+	}
+	ps, err := unmarshalPostScript(tail[tailSize-1-psLength : tailSize-1])
+	if err != nil {
+		return nil, merr.WrapErrImportFailed(fmt.Sprintf("failed to parse orc postscript, err=%v", err))
+	}
+	if ps.magic != orcMagic {
+		return nil, merr.WrapErrImportFailed("invalid orc file, magic not found in postscript")
+	}
+	switch ps.compression {
+	case compressionNone, compressionZlib, compressionSnappy, compressionLz4, compressionZstd:
+	default:
+		return nil, merr.WrapErrImportFailed(fmt.Sprintf("unsupported orc compression kind %d", ps.compression))
+	}
+
+	footerOffset := size - 1 - psLength - int64(ps.footerLength)
+	if footerOffset < 0 {
+		return nil, merr.WrapErrImportFailed(fmt.Sprintf("invalid orc file, footer length %d overflows", ps.footerLength))
+	}
+	footerBytes, err := readAt(r, footerOffset, int64(ps.footerLength))
+	if err != nil {
+		return nil, err
+	}
+	footerBytes, err = decompress(ps.compression, ps.compressionBlockSize, footerBytes)
+	if err != nil {
+		return nil, err
+	}
+	f, err := unmarshalFooter(footerBytes)
+	if err != nil {
+		return nil, merr.WrapErrImportFailed(fmt.Sprintf("failed to parse orc footer, err=%v", err))
+	}
+	if len(f.types) == 0 || f.types[0].kind != kindStruct {
+		return nil, merr.WrapErrImportFailed("the top-level type of orc file should be struct")
+	}
+	if len(f.types[0].subtypes) != len(f.types[0].fieldNames) {
+		return nil, merr.WrapErrImportFailed("invalid orc file, mismatched columns and field names")
+	}
+	return &orcFile{r: r, ps: ps, footer: f}, nil
+}
+
+func readAt(r io.ReaderAt, offset int64, length int64) ([]byte, error) {
+	buf := make([]byte, length)
+	n, err := r.ReadAt(buf, offset)
+	if err != nil && !(err == io.EOF && int64(n) == length) {
+		return nil, merr.WrapErrImportFailed(fmt.Sprintf("failed to read orc file at offset %d, err=%v", offset, err))
+	}
+	return buf, nil
+}
+
+func (f *orcFile) numStripes() int {
+	return len(f.footer.stripes)
+}
+
+// openStripe decompresses the streams of the stripe, the rows are decoded batch by batch by the returned reader.
+func (f *orcFile) openStripe(i int) (*stripeReader, error) {
+	info := f.footer.stripes[i]
+	data, err := readAt(f.r, int64(info.offset), int64(info.indexLength+info.dataLength+info.footerLength))
+	if err != nil {
+		return nil, err
+	}
+	footerStart := info.indexLength + info.dataLength
+	footerBytes, err := decompress(f.ps.compression, f.ps.compressionBlockSize, data[footerStart:])
+	if err != nil {
+		return nil, err
+	}
+	sf, err := unmarshalStripeFooter(footerBytes)
+	if err != nil {
+		return nil, merr.WrapErrImportFailed(fmt.Sprintf("failed to parse orc stripe footer, err=%v", err))
+	}
+
+	sr := newStripeReader(f.footer.types, sf.encodings, int(info.numberOfRows))
+	// the streams are stored consecutively in the order of stripe footer
+	var offset uint64
+	for _, stream := range sf.streams {
+		if offset+stream.length > footerStart {
+			return nil, merr.WrapErrImportFailed(fmt.Sprintf("invalid orc stripe, stream of column %d overflows", stream.column))
+		}
+		raw := data[offset : offset+stream.length]
+		offset += stream.length
+		switch stream.kind {
+		case streamPresent, streamData, streamLength, streamDictionaryData, streamSecondary:
+		default:
+			// the index streams are not needed
+			continue
+		}
+		decoded, err := decompress(f.ps.compression, f.ps.compressionBlockSize, raw)
+		if err != nil {
+			return nil, err
+		}
+		sr.streams[streamKey{column: stream.column, kind: stream.kind}] = newByteStream(decoded)
+	}
+	return sr, nil
+}
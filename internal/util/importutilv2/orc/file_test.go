@@ -0,0 +1,225 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package orc
+
+import (
+	"bytes"
+	"fmt"
+	"math"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadStripes(t *testing.T) {
+	root := structOf(
+		[]string{"b", "i8", "i16", "i32", "i64", "f", "d", "s", "bin", "list", "map", "nested"},
+		primitive(kindBoolean),
+		primitive(kindByte),
+		primitive(kindShort),
+		primitive(kindInt),
+		primitive(kindLong),
+		primitive(kindFloat),
+		primitive(kindDouble),
+		primitive(kindString),
+		primitive(kindBinary),
+		listOf(primitive(kindFloat)),
+		mapOf(primitive(kindString), primitive(kindDouble)),
+		structOf([]string{"x", "y"}, primitive(kindLong), listOf(primitive(kindString))),
+	)
+	rows := make([]map[string]any, 0, 1000)
+	for i := 0; i < 1000; i++ {
+		row := map[string]any{
+			"b":    i%3 == 0,
+			"i8":   int8(i),
+			"i16":  int16(-i),
+			"i32":  int32(i * 1000),
+			"i64":  int64(i) << 40,
+			"f":    float32(i) / 2,
+			"d":    float64(i) / 3,
+			"s":    fmt.Sprintf("str_%d", i),
+			"bin":  []byte{byte(i), byte(i >> 8)},
+			"list": []any{float32(i), float32(i + 1)},
+			"map":  map[string]any{"a": float64(i), fmt.Sprint(i): float64(-i)},
+			"nested": map[string]any{
+				"x": int64(i),
+				"y": []any{"y", fmt.Sprint(i)},
+			},
+		}
+		if i%7 == 0 {
+			// null values of columns and elements
+			row["s"] = nil
+			row["list"] = []any{nil, float32(i)}
+			row["nested"] = nil
+		}
+		if i%11 == 0 {
+			row["i64"] = nil
+			row["map"] = nil
+		}
+		rows = append(rows, row)
+	}
+
+	for _, compression := range []compressionKind{compressionNone, compressionZlib} {
+		t.Run(fmt.Sprintf("compression_%d", compression), func(t *testing.T) {
+			w := newTestWriter(root, compression)
+			w.writeStripe(rows[:300])
+			w.writeStripe(rows[300:])
+			data := w.close()
+
+			f, err := openFile(bytes.NewReader(data), int64(len(data)))
+			require.NoError(t, err)
+			assert.Equal(t, 2, f.numStripes())
+			assert.Equal(t, uint64(1000), f.footer.numberOfRows)
+
+			// decode the rows in batches which are not aligned with the runs and the stripes
+			var actual []map[string]any
+			for i := 0; i < f.numStripes(); i++ {
+				stripe, err := f.openStripe(i)
+				require.NoError(t, err)
+				for stripe.remaining > 0 {
+					batch, err := stripe.nextRows(7)
+					require.NoError(t, err)
+					actual = append(actual, batch...)
+				}
+			}
+			assert.Equal(t, rows, actual)
+		})
+	}
+}
+
+func TestOpenInvalidFile(t *testing.T) {
+	_, err := openFile(bytes.NewReader([]byte("ORC")), 3)
+	assert.Error(t, err)
+
+	data := []byte("not an orc file")
+	_, err = openFile(bytes.NewReader(data), int64(len(data)))
+	assert.Error(t, err)
+
+	// the top-level type is not struct
+	w := newTestWriter(primitive(kindLong), compressionNone)
+	data = w.close()
+	_, err = openFile(bytes.NewReader(data), int64(len(data)))
+	assert.Error(t, err)
+
+	// unsupported column type
+	w = newTestWriter(structOf([]string{"a"}, primitive(kindLong)), compressionNone)
+	w.writeStripe([]map[string]any{{"a": int64(1)}})
+	w.columnTypes[1].kind = kindDecimal
+	data = w.close()
+	f, err := openFile(bytes.NewReader(data), int64(len(data)))
+	require.NoError(t, err)
+	stripe, err := f.openStripe(0)
+	require.NoError(t, err)
+	_, err = stripe.nextRows(1)
+	assert.Error(t, err)
+}
+
+// TestReadApacheFiles reads the files written by the Java implementation of Apache ORC,
+// the expected values come from the examples of Apache ORC.
+func TestReadApacheFiles(t *testing.T) {
+	readAll := func(t *testing.T, name string, batchSize int) (*orcFile, []map[string]any) {
+		data, err := os.ReadFile(filepath.Join("testdata", name))
+		require.NoError(t, err)
+		f, err := openFile(bytes.NewReader(data), int64(len(data)))
+		require.NoError(t, err)
+		var rows []map[string]any
+		for i := 0; i < f.numStripes(); i++ {
+			stripe, err := f.openStripe(i)
+			require.NoError(t, err)
+			for stripe.remaining > 0 {
+				batch, err := stripe.nextRows(batchSize)
+				require.NoError(t, err)
+				rows = append(rows, batch...)
+			}
+		}
+		require.Equal(t, int(f.footer.numberOfRows), len(rows))
+		return f, rows
+	}
+
+	t.Run("all types", func(t *testing.T) {
+		f, rows := readAll(t, "TestOrcFile.test1.orc", 1)
+		assert.Equal(t, compressionZlib, f.ps.compression)
+		middle := map[string]any{"list": []any{
+			map[string]any{"int1": int32(1), "string1": "bye"},
+			map[string]any{"int1": int32(2), "string1": "sigh"},
+		}}
+		assert.Equal(t, []map[string]any{
+			{
+				"boolean1": false,
+				"byte1":    int8(1),
+				"short1":   int16(1024),
+				"int1":     int32(65536),
+				"long1":    int64(math.MaxInt64),
+				"float1":   float32(1.0),
+				"double1":  float64(-15.0),
+				"bytes1":   []byte{0, 1, 2, 3, 4},
+				"string1":  "hi",
+				"middle":   middle,
+				"list": []any{
+					map[string]any{"int1": int32(3), "string1": "good"},
+					map[string]any{"int1": int32(4), "string1": "bad"},
+				},
+				"map": map[string]any{},
+			},
+			{
+				"boolean1": true,
+				"byte1":    int8(100),
+				"short1":   int16(2048),
+				"int1":     int32(65536),
+				"long1":    int64(math.MaxInt64),
+				"float1":   float32(2.0),
+				"double1":  float64(-5.0),
+				"bytes1":   []byte{},
+				"string1":  "bye",
+				"middle":   middle,
+				"list": []any{
+					map[string]any{"int1": int32(100000000), "string1": "cat"},
+					map[string]any{"int1": int32(-100000), "string1": "in"},
+					map[string]any{"int1": int32(1234), "string1": "hat"},
+				},
+				"map": map[string]any{
+					"chani":   map[string]any{"int1": int32(5), "string1": "chani"},
+					"mauddib": map[string]any{"int1": int32(1), "string1": "mauddib"},
+				},
+			},
+		}, rows)
+	})
+
+	t.Run("snappy", func(t *testing.T) {
+		f, rows := readAll(t, "TestOrcFile.testSnappy.orc", 333)
+		assert.Equal(t, compressionSnappy, f.ps.compression)
+		assert.Equal(t, 2, f.numStripes())
+		assert.Equal(t, map[string]any{"int1": int32(-1160101563), "string1": "f50dcb8"}, rows[0])
+		assert.Equal(t, map[string]any{"int1": int32(1181413113), "string1": "382fdaaa"}, rows[1])
+		assert.Equal(t, map[string]any{"int1": int32(2065800427), "string1": "ce3fa8a7"}, rows[5000])
+		assert.Equal(t, map[string]any{"int1": int32(1892038365), "string1": "9f0dd209"}, rows[5001])
+		assert.Equal(t, map[string]any{"int1": int32(213079623), "string1": "baf72702"}, rows[9999])
+	})
+
+	t.Run("lz4", func(t *testing.T) {
+		f, rows := readAll(t, "TestVectorOrcFile.testLz4.orc", 1000)
+		assert.Equal(t, compressionLz4, f.ps.compression)
+		assert.Equal(t, map[string]any{"x": int64(-1155099828), "y": int32(0), "z": int64(-8072133231410116475)}, rows[0])
+		assert.Equal(t, map[string]any{"x": int64(-836442134), "y": int32(1), "z": int64(1238145679872042884)}, rows[1])
+		assert.Equal(t, map[string]any{"x": int64(898918724), "y": int32(5000), "z": int64(1881344816801364450)}, rows[5000])
+		assert.Equal(t, map[string]any{"x": int64(187127133), "y": int32(5001), "z": int64(3794412423421873208)}, rows[5001])
+		assert.Equal(t, map[string]any{"x": int64(-130188541), "y": int32(9999), "z": int64(-3963157978320431882)}, rows[9999])
+	})
+}
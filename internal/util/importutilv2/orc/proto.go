@@ -0,0 +1,317 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package orc
+
+import (
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+// The metadata of ORC file is encoded in protobuf, only the messages and fields
+// required to read the data are decoded here, see https://orc.apache.org/specification/ORCv1/.
+
+type compressionKind uint64
+
+const (
+	compressionNone   compressionKind = 0
+	compressionZlib   compressionKind = 1
+	compressionSnappy compressionKind = 2
+	compressionLzo    compressionKind = 3
+	compressionLz4    compressionKind = 4
+	compressionZstd   compressionKind = 5
+)
+
+type typeKind uint64
+
+const (
+	kindBoolean          typeKind = 0
+	kindByte             typeKind = 1
+	kindShort            typeKind = 2
+	kindInt              typeKind = 3
+	kindLong             typeKind = 4
+	kindFloat            typeKind = 5
+	kindDouble           typeKind = 6
+	kindString           typeKind = 7
+	kindBinary           typeKind = 8
+	kindTimestamp        typeKind = 9
+	kindList             typeKind = 10
+	kindMap              typeKind = 11
+	kindStruct           typeKind = 12
+	kindUnion            typeKind = 13
+	kindDecimal          typeKind = 14
+	kindDate             typeKind = 15
+	kindVarchar          typeKind = 16
+	kindChar             typeKind = 17
+	kindTimestampInstant typeKind = 18
+)
+
+var typeKindName = map[typeKind]string{
+	kindBoolean:          "boolean",
+	kindByte:             "tinyint",
+	kindShort:            "smallint",
+	kindInt:              "int",
+	kindLong:             "bigint",
+	kindFloat:            "float",
+	kindDouble:           "double",
+	kindString:           "string",
+	kindBinary:           "binary",
+	kindTimestamp:        "timestamp",
+	kindList:             "array",
+	kindMap:              "map",
+	kindStruct:           "struct",
+	kindUnion:            "uniontype",
+	kindDecimal:          "decimal",
+	kindDate:             "date",
+	kindVarchar:          "varchar",
+	kindChar:             "char",
+	kindTimestampInstant: "timestamp with local time zone",
+}
+
+func (k typeKind) String() string {
+	return typeKindName[k]
+}
+
+type streamKind uint64
+
+const (
+	streamPresent        streamKind = 0
+	streamData           streamKind = 1
+	streamLength         streamKind = 2
+	streamDictionaryData streamKind = 3
+	streamSecondary      streamKind = 5
+)
+
+type encodingKind uint64
+
+const (
+	encodingDirect       encodingKind = 0
+	encodingDictionary   encodingKind = 1
+	encodingDirectV2     encodingKind = 2
+	encodingDictionaryV2 encodingKind = 3
+)
+
+type postScript struct {
+	footerLength         uint64
+	compression          compressionKind
+	compressionBlockSize uint64
+	magic                string
+}
+
+type footer struct {
+	stripes      []*stripeInformation
+	types        []*orcType
+	numberOfRows uint64
+}
+
+type stripeInformation struct {
+	offset       uint64
+	indexLength  uint64
+	dataLength   uint64
+	footerLength uint64
+	numberOfRows uint64
+}
+
+type orcType struct {
+	kind       typeKind
+	subtypes   []uint32
+	fieldNames []string
+}
+
+type stripeFooter struct {
+	streams   []*streamInformation
+	encodings []*columnEncoding
+}
+
+type streamInformation struct {
+	kind   streamKind
+	column uint32
+	length uint64
+}
+
+type columnEncoding struct {
+	kind           encodingKind
+	dictionarySize uint32
+}
+
+// walkMessage iterates the fields of a protobuf message, the value is set for varint fields,
+// and the bytes are set for length-delimited fields, the other fields are skipped.
+func walkMessage(b []byte, visit func(num protowire.Number, typ protowire.Type, value uint64, bytes []byte) error) error {
+	for len(b) > 0 {
+		num, typ, n := protowire.ConsumeTag(b)
+		if n < 0 {
+			return protowire.ParseError(n)
+		}
+		b = b[n:]
+		var value uint64
+		var bytes []byte
+		switch typ {
+		case protowire.VarintType:
+			value, n = protowire.ConsumeVarint(b)
+		case protowire.BytesType:
+			bytes, n = protowire.ConsumeBytes(b)
+		default:
+			n = protowire.ConsumeFieldValue(num, typ, b)
+		}
+		if n < 0 {
+			return protowire.ParseError(n)
+		}
+		b = b[n:]
+		if typ != protowire.VarintType && typ != protowire.BytesType {
+			continue
+		}
+		if err := visit(num, typ, value, bytes); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// appendUint32s decodes the repeated uint32 field, which could be packed or not.
+func appendUint32s(dst []uint32, typ protowire.Type, value uint64, bytes []byte) ([]uint32, error) {
+	if typ == protowire.VarintType {
+		return append(dst, uint32(value)), nil
+	}
+	for len(bytes) > 0 {
+		v, n := protowire.ConsumeVarint(bytes)
+		if n < 0 {
+			return nil, protowire.ParseError(n)
+		}
+		dst = append(dst, uint32(v))
+		bytes = bytes[n:]
+	}
+	return dst, nil
+}
+
+func unmarshalPostScript(b []byte) (*postScript, error) {
+	ps := &postScript{}
+	err := walkMessage(b, func(num protowire.Number, typ protowire.Type, value uint64, bytes []byte) error {
+		switch num {
+		case 1:
+			ps.footerLength = value
+		case 2:
+			ps.compression = compressionKind(value)
+		case 3:
+			ps.compressionBlockSize = value
+		case 8000:
+			ps.magic = string(bytes)
+		}
+		return nil
+	})
+	return ps, err
+}
+
+func unmarshalFooter(b []byte) (*footer, error) {
+	f := &footer{}
+	err := walkMessage(b, func(num protowire.Number, typ protowire.Type, value uint64, bytes []byte) error {
+		switch num {
+		case 3:
+			stripe, err := unmarshalStripeInformation(bytes)
+			if err != nil {
+				return err
+			}
+			f.stripes = append(f.stripes, stripe)
+		case 4:
+			t, err := unmarshalType(bytes)
+			if err != nil {
+				return err
+			}
+			f.types = append(f.types, t)
+		case 6:
+			f.numberOfRows = value
+		}
+		return nil
+	})
+	return f, err
+}
+
+func unmarshalStripeInformation(b []byte) (*stripeInformation, error) {
+	s := &stripeInformation{}
+	err := walkMessage(b, func(num protowire.Number, typ protowire.Type, value uint64, bytes []byte) error {
+		switch num {
+		case 1:
+			s.offset = value
+		case 2:
+			s.indexLength = value
+		case 3:
+			s.dataLength = value
+		case 4:
+			s.footerLength = value
+		case 5:
+			s.numberOfRows = value
+		}
+		return nil
+	})
+	return s, err
+}
+
+func unmarshalType(b []byte) (*orcType, error) {
+	t := &orcType{}
+	err := walkMessage(b, func(num protowire.Number, typ protowire.Type, value uint64, bytes []byte) error {
+		var err error
+		switch num {
+		case 1:
+			t.kind = typeKind(value)
+		case 2:
+			t.subtypes, err = appendUint32s(t.subtypes, typ, value, bytes)
+		case 3:
+			t.fieldNames = append(t.fieldNames, string(bytes))
+		}
+		return err
+	})
+	return t, err
+}
+
+func unmarshalStripeFooter(b []byte) (*stripeFooter, error) {
+	sf := &stripeFooter{}
+	err := walkMessage(b, func(num protowire.Number, typ protowire.Type, value uint64, bytes []byte) error {
+		switch num {
+		case 1:
+			stream := &streamInformation{}
+			err := walkMessage(bytes, func(num protowire.Number, typ protowire.Type, value uint64, bytes []byte) error {
+				switch num {
+				case 1:
+					stream.kind = streamKind(value)
+				case 2:
+					stream.column = uint32(value)
+				case 3:
+					stream.length = value
+				}
+				return nil
+			})
+			if err != nil {
+				return err
+			}
+			sf.streams = append(sf.streams, stream)
+		case 2:
+			encoding := &columnEncoding{}
+			err := walkMessage(bytes, func(num protowire.Number, typ protowire.Type, value uint64, bytes []byte) error {
+				switch num {
+				case 1:
+					encoding.kind = encodingKind(value)
+				case 2:
+					encoding.dictionarySize = uint32(value)
+				}
+				return nil
+			})
+			if err != nil {
+				return err
+			}
+			sf.encodings = append(sf.encodings, encoding)
+		}
+		return nil
+	})
+	return sf, err
+}
@@ -0,0 +1,181 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package orc
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"go.uber.org/atomic"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/importutilv2/common"
+	"github.com/milvus-io/milvus/pkg/v2/log"
+	"github.com/milvus-io/milvus/pkg/v2/util/merr"
+)
+
+type reader struct {
+	ctx    context.Context
+	cm     storage.ChunkManager
+	schema *schemapb.CollectionSchema
+
+	fileSize *atomic.Int64
+	filePath string
+	r        storage.FileReader
+	file     *orcFile
+
+	// the stripe being decoded and the index of the next stripe, rows are decoded from the stripe
+	// batch by batch, the decoded rows which are not consumed yet are kept in rows
+	stripe     *stripeReader
+	nextStripe int
+	rows       []map[string]any
+
+	bufferSize int
+	count      int64
+
+	parser *common.TypedRowParser
+
+	// the index of the next row, and the handler of the rows failed to parse
+	rowIndex      int64
+	badRowHandler common.BadRowHandler
+}
+
+// NewReader reads the ORC file stripe by stripe, the rows of a stripe are decoded in batches of the
+// estimated row count of bufferSize, and the top-level columns are mapped to the fields by name.
+func NewReader(ctx context.Context, cm storage.ChunkManager, schema *schemapb.CollectionSchema, path string, bufferSize int) (*reader, error) {
+	size, err := cm.Size(ctx, path)
+	if err != nil {
+		return nil, merr.WrapErrImportFailed(fmt.Sprintf("get orc file size failed, path=%s, err=%s", path, err.Error()))
+	}
+	r, err := cm.Reader(ctx, path)
+	if err != nil {
+		return nil, merr.WrapErrImportFailed(fmt.Sprintf("read orc file failed, path=%s, err=%s", path, err.Error()))
+	}
+	file, err := openFile(r, size)
+	if err != nil {
+		r.Close()
+		return nil, err
+	}
+	log.Info("create orc reader done", zap.String("path", path), zap.Int64("fileSize", size),
+		zap.Uint64("numRows", file.footer.numberOfRows), zap.Int("numStripes", file.numStripes()))
+
+	count, err := common.EstimateReadCountPerBatch(bufferSize, schema)
+	if err != nil {
+		r.Close()
+		return nil, err
+	}
+	parser, err := common.NewTypedRowParser(schema)
+	if err != nil {
+		r.Close()
+		return nil, err
+	}
+	return &reader{
+		ctx:        ctx,
+		cm:         cm,
+		schema:     schema,
+		fileSize:   atomic.NewInt64(size),
+		filePath:   path,
+		r:          r,
+		file:       file,
+		bufferSize: bufferSize,
+		count:      count,
+		parser:     parser,
+	}, nil
+}
+
+func (r *reader) Read() (*storage.InsertData, error) {
+	insertData, err := storage.NewInsertData(r.schema)
+	if err != nil {
+		return nil, err
+	}
+	var cnt int64 = 0
+	for {
+		if len(r.rows) == 0 {
+			if r.stripe == nil || r.stripe.remaining == 0 {
+				if r.nextStripe >= r.file.numStripes() {
+					break
+				}
+				r.stripe, err = r.file.openStripe(r.nextStripe)
+				if err != nil {
+					return nil, err
+				}
+				r.nextStripe++
+				continue
+			}
+			r.rows, err = r.stripe.nextRows(int(r.count))
+			if err != nil {
+				return nil, err
+			}
+			continue
+		}
+		rowIndex := r.rowIndex
+		r.rowIndex++
+		row, err := r.parser.Parse(r.rows[0])
+		r.rows[0] = nil
+		r.rows = r.rows[1:]
+		if err != nil {
+			if err = common.HandleBadRow(r.badRowHandler, r.filePath, rowIndex, err); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		if err = common.AppendRow(r.schema, insertData, row); err != nil {
+			if err = common.HandleBadRow(r.badRowHandler, r.filePath, rowIndex, err); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		cnt++
+		if cnt >= r.count {
+			cnt = 0
+			if insertData.GetMemorySize() >= r.bufferSize {
+				break
+			}
+		}
+	}
+	if insertData.GetRowNum() == 0 {
+		return nil, io.EOF
+	}
+	return insertData, nil
+}
+
+// SetBadRowHandler sets the handler of the rows failed to parse, the reading is aborted by
+// the first bad row if no handler is set.
+func (r *reader) SetBadRowHandler(handler common.BadRowHandler) {
+	r.badRowHandler = handler
+}
+
+func (r *reader) Size() (int64, error) {
+	if size := r.fileSize.Load(); size != 0 {
+		return size, nil
+	}
+	size, err := r.cm.Size(r.ctx, r.filePath)
+	if err != nil {
+		return 0, err
+	}
+	r.fileSize.Store(size)
+	return size, nil
+}
+
+func (r *reader) Close() {
+	if err := r.r.Close(); err != nil {
+		log.Warn("close orc file reader failed", zap.Error(err))
+	}
+}
@@ -0,0 +1,442 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package orc
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/json"
+	"github.com/milvus-io/milvus/internal/mocks"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/nullutil"
+	"github.com/milvus-io/milvus/internal/util/testutil"
+	"github.com/milvus-io/milvus/pkg/v2/common"
+	"github.com/milvus-io/milvus/pkg/v2/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/v2/util/typeutil"
+)
+
+type ReaderSuite struct {
+	suite.Suite
+
+	numRows     int
+	pkDataType  schemapb.DataType
+	vecDataType schemapb.DataType
+}
+
+func (suite *ReaderSuite) SetupSuite() {
+	paramtable.Get().Init(paramtable.NewBaseTable())
+}
+
+func (suite *ReaderSuite) SetupTest() {
+	// default suite params
+	suite.numRows = 100
+	suite.pkDataType = schemapb.DataType_Int64
+	suite.vecDataType = schemapb.DataType_FloatVector
+}
+
+func orcPrimitive(dataType schemapb.DataType) *testType {
+	switch dataType {
+	case schemapb.DataType_Bool:
+		return primitive(kindBoolean)
+	case schemapb.DataType_Int8:
+		return primitive(kindByte)
+	case schemapb.DataType_Int16:
+		return primitive(kindShort)
+	case schemapb.DataType_Int32:
+		return primitive(kindInt)
+	case schemapb.DataType_Int64:
+		return primitive(kindLong)
+	case schemapb.DataType_Float:
+		return primitive(kindFloat)
+	case schemapb.DataType_Double:
+		return primitive(kindDouble)
+	default:
+		return primitive(kindString)
+	}
+}
+
+// createORCFile writes the insert data into an ORC file with the given number of rows per stripe.
+func createORCFile(schema *schemapb.CollectionSchema, insertData *storage.InsertData, rowsPerStripe int) []byte {
+	var names []string
+	var types []*testType
+	var fields []*schemapb.FieldSchema
+	for _, field := range schema.GetFields() {
+		if field.GetIsFunctionOutput() || field.GetIsDynamic() {
+			continue
+		}
+		var t *testType
+		switch field.GetDataType() {
+		case schemapb.DataType_Array:
+			// the elements of int8/int16 array are stored as int32
+			elem := orcPrimitive(field.GetElementType())
+			if elem.kind == kindByte || elem.kind == kindShort {
+				elem = primitive(kindInt)
+			}
+			t = listOf(elem)
+		case schemapb.DataType_FloatVector:
+			t = listOf(primitive(kindFloat))
+		case schemapb.DataType_Int8Vector:
+			t = listOf(primitive(kindByte))
+		case schemapb.DataType_BinaryVector, schemapb.DataType_Float16Vector, schemapb.DataType_BFloat16Vector:
+			t = primitive(kindBinary)
+		case schemapb.DataType_SparseFloatVector:
+			t = mapOf(primitive(kindString), primitive(kindFloat))
+		default:
+			t = orcPrimitive(field.GetDataType())
+		}
+		names = append(names, field.GetName())
+		types = append(types, t)
+		fields = append(fields, field)
+	}
+
+	rows := make([]map[string]any, 0, insertData.GetRowNum())
+	for i := 0; i < insertData.GetRowNum(); i++ {
+		row := make(map[string]any)
+		for _, field := range fields {
+			value := insertData.Data[field.GetFieldID()].GetRow(i)
+			switch field.GetDataType() {
+			case schemapb.DataType_JSON:
+				if value != nil {
+					value = string(value.([]byte))
+				}
+			case schemapb.DataType_Array:
+				if value != nil {
+					sf := value.(*schemapb.ScalarField)
+					switch field.GetElementType() {
+					case schemapb.DataType_Bool:
+						value = sf.GetBoolData().GetData()
+					case schemapb.DataType_Int8, schemapb.DataType_Int16, schemapb.DataType_Int32:
+						value = sf.GetIntData().GetData()
+					case schemapb.DataType_Int64:
+						value = sf.GetLongData().GetData()
+					case schemapb.DataType_Float:
+						value = sf.GetFloatData().GetData()
+					case schemapb.DataType_Double:
+						value = sf.GetDoubleData().GetData()
+					default:
+						value = sf.GetStringData().GetData()
+					}
+				}
+			case schemapb.DataType_SparseFloatVector:
+				mp := make(map[string]any)
+				for k, v := range typeutil.SparseFloatBytesToMap(value.([]byte)) {
+					mp[fmt.Sprint(k)] = v
+				}
+				value = mp
+			}
+			row[field.GetName()] = value
+		}
+		rows = append(rows, row)
+	}
+
+	w := newTestWriter(structOf(names, types...), compressionZlib)
+	for len(rows) > 0 {
+		n := rowsPerStripe
+		if n > len(rows) {
+			n = len(rows)
+		}
+		w.writeStripe(rows[:n])
+		rows = rows[n:]
+	}
+	return w.close()
+}
+
+func (suite *ReaderSuite) createReader(schema *schemapb.CollectionSchema, data []byte, bufferSize int) *reader {
+	type mockReader struct {
+		io.Reader
+		io.Closer
+		io.ReaderAt
+		io.Seeker
+	}
+	cm := mocks.NewChunkManager(suite.T())
+	cm.EXPECT().Size(mock.Anything, mock.Anything).Return(int64(len(data)), nil)
+	cm.EXPECT().Reader(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, s string) (storage.FileReader, error) {
+		r := bytes.NewReader(data)
+		return &mockReader{Reader: r, Closer: io.NopCloser(nil), ReaderAt: r, Seeker: r}, nil
+	})
+	reader, err := NewReader(context.Background(), cm, schema, "mockPath", bufferSize)
+	suite.NoError(err)
+	return reader
+}
+
+func (suite *ReaderSuite) newSchema(dataType schemapb.DataType, elemType schemapb.DataType, nullable bool) *schemapb.CollectionSchema {
+	return &schemapb.CollectionSchema{
+		Fields: []*schemapb.FieldSchema{
+			{
+				FieldID:      100,
+				Name:         "pk",
+				IsPrimaryKey: true,
+				DataType:     suite.pkDataType,
+				TypeParams: []*commonpb.KeyValuePair{
+					{
+						Key:   common.MaxLengthKey,
+						Value: "128",
+					},
+				},
+			},
+			{
+				FieldID:  101,
+				Name:     "vec",
+				DataType: suite.vecDataType,
+				TypeParams: []*commonpb.KeyValuePair{
+					{
+						Key:   common.DimKey,
+						Value: "8",
+					},
+				},
+			},
+			{
+				FieldID:     102,
+				Name:        dataType.String(),
+				DataType:    dataType,
+				ElementType: elemType,
+				TypeParams: []*commonpb.KeyValuePair{
+					{
+						Key:   common.MaxLengthKey,
+						Value: "128",
+					},
+					{
+						Key:   common.MaxCapacityKey,
+						Value: "128",
+					},
+				},
+				Nullable: nullable,
+			},
+		},
+	}
+}
+
+func (suite *ReaderSuite) run(dataType schemapb.DataType, elemType schemapb.DataType, nullable bool) {
+	schema := suite.newSchema(dataType, elemType, nullable)
+	insertData, err := testutil.CreateInsertData(schema, suite.numRows)
+	suite.NoError(err)
+
+	reader := suite.createReader(schema, createORCFile(schema, insertData, 30), math.MaxInt)
+	defer reader.Close()
+
+	size, err := reader.Size()
+	suite.NoError(err)
+	suite.True(size > 0)
+
+	res, err := reader.Read()
+	suite.NoError(err)
+	for fieldID, data := range res.Data {
+		suite.Equal(suite.numRows, data.RowNum())
+		for i := 0; i < suite.numRows; i++ {
+			suite.Equal(insertData.Data[fieldID].GetRow(i), data.GetRow(i))
+		}
+	}
+
+	_, err = reader.Read()
+	suite.ErrorIs(err, io.EOF)
+}
+
+func (suite *ReaderSuite) TestReadScalarFields() {
+	for _, nullable := range []bool{false, true} {
+		suite.run(schemapb.DataType_Bool, schemapb.DataType_None, nullable)
+		suite.run(schemapb.DataType_Int8, schemapb.DataType_None, nullable)
+		suite.run(schemapb.DataType_Int16, schemapb.DataType_None, nullable)
+		suite.run(schemapb.DataType_Int32, schemapb.DataType_None, nullable)
+		suite.run(schemapb.DataType_Int64, schemapb.DataType_None, nullable)
+		suite.run(schemapb.DataType_Float, schemapb.DataType_None, nullable)
+		suite.run(schemapb.DataType_Double, schemapb.DataType_None, nullable)
+		suite.run(schemapb.DataType_VarChar, schemapb.DataType_None, nullable)
+		suite.run(schemapb.DataType_JSON, schemapb.DataType_None, nullable)
+
+		suite.run(schemapb.DataType_Array, schemapb.DataType_Bool, nullable)
+		suite.run(schemapb.DataType_Array, schemapb.DataType_Int8, nullable)
+		suite.run(schemapb.DataType_Array, schemapb.DataType_Int32, nullable)
+		suite.run(schemapb.DataType_Array, schemapb.DataType_Int64, nullable)
+		suite.run(schemapb.DataType_Array, schemapb.DataType_Float, nullable)
+		suite.run(schemapb.DataType_Array, schemapb.DataType_Double, nullable)
+		suite.run(schemapb.DataType_Array, schemapb.DataType_String, nullable)
+	}
+}
+
+func (suite *ReaderSuite) TestStringPK() {
+	suite.pkDataType = schemapb.DataType_VarChar
+	suite.run(schemapb.DataType_Int32, schemapb.DataType_None, false)
+}
+
+func (suite *ReaderSuite) TestVector() {
+	suite.vecDataType = schemapb.DataType_BinaryVector
+	suite.run(schemapb.DataType_Int32, schemapb.DataType_None, false)
+	suite.vecDataType = schemapb.DataType_FloatVector
+	suite.run(schemapb.DataType_Int32, schemapb.DataType_None, false)
+	suite.vecDataType = schemapb.DataType_Float16Vector
+	suite.run(schemapb.DataType_Int32, schemapb.DataType_None, false)
+	suite.vecDataType = schemapb.DataType_BFloat16Vector
+	suite.run(schemapb.DataType_Int32, schemapb.DataType_None, false)
+	suite.vecDataType = schemapb.DataType_SparseFloatVector
+	suite.run(schemapb.DataType_Int32, schemapb.DataType_None, false)
+	suite.vecDataType = schemapb.DataType_Int8Vector
+	suite.run(schemapb.DataType_Int32, schemapb.DataType_None, false)
+}
+
+func (suite *ReaderSuite) TestDefaultValue() {
+	schema := suite.newSchema(schemapb.DataType_Int64, schemapb.DataType_None, false)
+	fieldSchema, err := testutil.CreateFieldWithDefaultValue(schemapb.DataType_Int64, 102, true)
+	suite.NoError(err)
+	schema.Fields[2] = fieldSchema
+	insertData, err := testutil.CreateInsertData(schema, suite.numRows)
+	suite.NoError(err)
+
+	reader := suite.createReader(schema, createORCFile(schema, insertData, suite.numRows), math.MaxInt)
+	res, err := reader.Read()
+	suite.NoError(err)
+	defaultValue, err := nullutil.GetDefaultValue(fieldSchema)
+	suite.NoError(err)
+	for i := 0; i < suite.numRows; i++ {
+		expect := insertData.Data[102].GetRow(i)
+		if expect == nil {
+			expect = defaultValue
+		}
+		suite.Equal(expect, res.Data[102].GetRow(i))
+	}
+}
+
+func (suite *ReaderSuite) TestDynamicField() {
+	schema := suite.newSchema(schemapb.DataType_Int32, schemapb.DataType_None, false)
+	schema.EnableDynamicField = true
+	schema.Fields = append(schema.Fields, &schemapb.FieldSchema{
+		FieldID:   103,
+		Name:      "$meta",
+		IsDynamic: true,
+		DataType:  schemapb.DataType_JSON,
+	})
+	insertData, err := testutil.CreateInsertData(schema, suite.numRows)
+	suite.NoError(err)
+
+	// the column not defined in schema is put into the dynamic field
+	extraField := &schemapb.FieldSchema{FieldID: 104, Name: "extra", DataType: schemapb.DataType_Int64}
+	extraData, err := testutil.CreateInsertData(&schemapb.CollectionSchema{Fields: []*schemapb.FieldSchema{extraField}}, suite.numRows)
+	suite.NoError(err)
+	insertData.Data[104] = extraData.Data[104]
+	fileSchema := &schemapb.CollectionSchema{Fields: append(schema.GetFields(), extraField)}
+
+	reader := suite.createReader(schema, createORCFile(fileSchema, insertData, suite.numRows), math.MaxInt)
+	res, err := reader.Read()
+	suite.NoError(err)
+	for i := 0; i < suite.numRows; i++ {
+		var dynamic map[string]any
+		suite.NoError(json.Unmarshal(res.Data[103].GetRow(i).([]byte), &dynamic))
+		suite.Equal(map[string]any{"extra": float64(extraData.Data[104].GetRow(i).(int64))}, dynamic)
+	}
+}
+
+func (suite *ReaderSuite) TestReadBatches() {
+	schema := suite.newSchema(schemapb.DataType_VarChar, schemapb.DataType_None, false)
+	suite.numRows = 1000
+	insertData, err := testutil.CreateInsertData(schema, suite.numRows)
+	suite.NoError(err)
+
+	// the small buffer size makes the rows read in several batches
+	reader := suite.createReader(schema, createORCFile(schema, insertData, 300), 16*1024)
+	offset := 0
+	for {
+		res, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		suite.NoError(err)
+		suite.True(res.GetRowNum() < suite.numRows)
+		for i := 0; i < res.GetRowNum(); i++ {
+			suite.Equal(insertData.Data[100].GetRow(offset+i), res.Data[100].GetRow(i))
+			suite.Equal(insertData.Data[102].GetRow(offset+i), res.Data[102].GetRow(i))
+		}
+		offset += res.GetRowNum()
+	}
+	suite.Equal(suite.numRows, offset)
+}
+
+func (suite *ReaderSuite) TestReadApacheFile() {
+	// the file is written by the Java implementation of Apache ORC, it has 10000 rows in 2 stripes
+	data, err := os.ReadFile(filepath.Join("testdata", "TestOrcFile.testSnappy.orc"))
+	suite.NoError(err)
+	schema := &schemapb.CollectionSchema{
+		Fields: []*schemapb.FieldSchema{
+			{
+				FieldID:      100,
+				Name:         "int1",
+				IsPrimaryKey: true,
+				DataType:     schemapb.DataType_Int64,
+			},
+			{
+				FieldID:    101,
+				Name:       "string1",
+				DataType:   schemapb.DataType_VarChar,
+				TypeParams: []*commonpb.KeyValuePair{{Key: common.MaxLengthKey, Value: "64"}},
+			},
+		},
+	}
+
+	reader := suite.createReader(schema, data, 16*1024)
+	var pks []int64
+	var strs []string
+	for {
+		res, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		suite.NoError(err)
+		suite.True(res.GetRowNum() < 10000)
+		for i := 0; i < res.GetRowNum(); i++ {
+			pks = append(pks, res.Data[100].GetRow(i).(int64))
+			strs = append(strs, res.Data[101].GetRow(i).(string))
+		}
+	}
+	suite.Equal(10000, len(pks))
+	suite.Equal([]int64{-1160101563, 1181413113, 2065800427, 1892038365, 213079623},
+		[]int64{pks[0], pks[1], pks[5000], pks[5001], pks[9999]})
+	suite.Equal([]string{"f50dcb8", "382fdaaa", "ce3fa8a7", "9f0dd209", "baf72702"},
+		[]string{strs[0], strs[1], strs[5000], strs[5001], strs[9999]})
+}
+
+func (suite *ReaderSuite) TestInvalidFile() {
+	type mockReader struct {
+		io.Reader
+		io.Closer
+		io.ReaderAt
+		io.Seeker
+	}
+	data := []byte("not an orc file")
+	cm := mocks.NewChunkManager(suite.T())
+	cm.EXPECT().Size(mock.Anything, mock.Anything).Return(int64(len(data)), nil)
+	cm.EXPECT().Reader(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, s string) (storage.FileReader, error) {
+		r := bytes.NewReader(data)
+		return &mockReader{Reader: r, Closer: io.NopCloser(nil), ReaderAt: r, Seeker: r}, nil
+	})
+	schema := suite.newSchema(schemapb.DataType_Int32, schemapb.DataType_None, false)
+	_, err := NewReader(context.Background(), cm, schema, "mockPath", math.MaxInt)
+	suite.Error(err)
+}
+
+func TestORCReader(t *testing.T) {
+	suite.Run(t, new(ReaderSuite))
+}
@@ -0,0 +1,434 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package orc
+
+import (
+	"fmt"
+)
+
+// byteRLEDecoder decodes the bytes encoded in byte run length encoding run by run,
+// the values decoded but not consumed yet are kept for the next read.
+type byteRLEDecoder struct {
+	s       *byteStream
+	pending []byte
+}
+
+func newByteRLEDecoder(s *byteStream) *byteRLEDecoder {
+	return &byteRLEDecoder{s: s}
+}
+
+func (d *byteRLEDecoder) next(n int) ([]byte, error) {
+	for len(d.pending) < n {
+		control, err := d.s.readByte()
+		if err != nil {
+			return nil, err
+		}
+		if int8(control) >= 0 {
+			value, err := d.s.readByte()
+			if err != nil {
+				return nil, err
+			}
+			for i := 0; i < int(control)+3; i++ {
+				d.pending = append(d.pending, value)
+			}
+		} else {
+			literals, err := d.s.readBytes(-int(int8(control)))
+			if err != nil {
+				return nil, err
+			}
+			d.pending = append(d.pending, literals...)
+		}
+	}
+	out := d.pending[:n:n]
+	d.pending = d.pending[n:]
+	return out, nil
+}
+
+// readByteRLE decodes n bytes encoded in byte run length encoding.
+func readByteRLE(s *byteStream, n int) ([]byte, error) {
+	return newByteRLEDecoder(s).next(n)
+}
+
+// boolDecoder decodes the booleans, which are packed into bytes (most significant bit first)
+// and then encoded in byte run length encoding.
+type boolDecoder struct {
+	bytes   *byteRLEDecoder
+	pending []bool
+}
+
+func newBoolDecoder(s *byteStream) *boolDecoder {
+	return &boolDecoder{bytes: newByteRLEDecoder(s)}
+}
+
+func (d *boolDecoder) next(n int) ([]bool, error) {
+	if len(d.pending) < n {
+		packed, err := d.bytes.next((n - len(d.pending) + 7) / 8)
+		if err != nil {
+			return nil, err
+		}
+		for _, b := range packed {
+			for i := 0; i < 8; i++ {
+				d.pending = append(d.pending, b&(0x80>>i) != 0)
+			}
+		}
+	}
+	out := d.pending[:n:n]
+	d.pending = d.pending[n:]
+	return out, nil
+}
+
+// readBooleans decodes n booleans encoded by boolDecoder.
+func readBooleans(s *byteStream, n int) ([]bool, error) {
+	return newBoolDecoder(s).next(n)
+}
+
+// intRLEDecoder decodes the integers encoded in integer run length encoding version 1 or 2 run by run,
+// the values decoded but not consumed yet are kept for the next read.
+type intRLEDecoder struct {
+	s       *byteStream
+	signed  bool
+	v2      bool
+	pending []int64
+}
+
+func newIntRLEDecoder(s *byteStream, signed bool, v2 bool) *intRLEDecoder {
+	return &intRLEDecoder{s: s, signed: signed, v2: v2}
+}
+
+func (d *intRLEDecoder) next(n int) ([]int64, error) {
+	var err error
+	for len(d.pending) < n {
+		if d.v2 {
+			d.pending, err = readIntRLEv2(d.s, d.pending, d.signed)
+		} else {
+			d.pending, err = readIntRLEv1(d.s, d.pending, d.signed)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	out := d.pending[:n:n]
+	d.pending = d.pending[n:]
+	return out, nil
+}
+
+// readIntRLE decodes n integers encoded in integer run length encoding version 1 or 2.
+func readIntRLE(s *byteStream, n int, signed bool, v2 bool) ([]int64, error) {
+	return newIntRLEDecoder(s, signed, v2).next(n)
+}
+
+func readBase(s *byteStream, signed bool) (int64, error) {
+	if signed {
+		return s.readVarint()
+	}
+	v, err := s.readUvarint()
+	return int64(v), err
+}
+
+func readIntRLEv1(s *byteStream, out []int64, signed bool) ([]int64, error) {
+	control, err := s.readByte()
+	if err != nil {
+		return nil, err
+	}
+	if int8(control) >= 0 {
+		delta, err := s.readByte()
+		if err != nil {
+			return nil, err
+		}
+		base, err := readBase(s, signed)
+		if err != nil {
+			return nil, err
+		}
+		for i := 0; i < int(control)+3; i++ {
+			out = append(out, base+int64(i)*int64(int8(delta)))
+		}
+		return out, nil
+	}
+	for i := 0; i < -int(int8(control)); i++ {
+		v, err := readBase(s, signed)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, v)
+	}
+	return out, nil
+}
+
+const (
+	rleShortRepeat = 0
+	rleDirect      = 1
+	rlePatchedBase = 2
+	rleDelta       = 3
+)
+
+func readIntRLEv2(s *byteStream, out []int64, signed bool) ([]int64, error) {
+	header, err := s.readByte()
+	if err != nil {
+		return nil, err
+	}
+	switch header >> 6 {
+	case rleShortRepeat:
+		return readShortRepeat(s, out, header, signed)
+	case rleDirect:
+		return readDirect(s, out, header, signed)
+	case rlePatchedBase:
+		return readPatchedBase(s, out, header)
+	default:
+		return readDelta(s, out, header, signed)
+	}
+}
+
+func readShortRepeat(s *byteStream, out []int64, header byte, signed bool) ([]int64, error) {
+	width := int((header>>3)&0x07) + 1
+	count := int(header&0x07) + 3
+	b, err := s.readBytes(width)
+	if err != nil {
+		return nil, err
+	}
+	var value uint64
+	for _, x := range b {
+		value = value<<8 | uint64(x)
+	}
+	v := int64(value)
+	if signed {
+		v = zigzagDecode(value)
+	}
+	for i := 0; i < count; i++ {
+		out = append(out, v)
+	}
+	return out, nil
+}
+
+func readLength(s *byteStream, header byte) (int, error) {
+	b, err := s.readByte()
+	if err != nil {
+		return 0, err
+	}
+	return (int(header&0x01)<<8 | int(b)) + 1, nil
+}
+
+func readDirect(s *byteStream, out []int64, header byte, signed bool) ([]int64, error) {
+	width := decodeBitWidth(int((header >> 1) & 0x1f))
+	length, err := readLength(s, header)
+	if err != nil {
+		return nil, err
+	}
+	values, err := readBitPacked(s, length, width)
+	if err != nil {
+		return nil, err
+	}
+	for _, v := range values {
+		if signed {
+			out = append(out, zigzagDecode(v))
+		} else {
+			out = append(out, int64(v))
+		}
+	}
+	return out, nil
+}
+
+func readPatchedBase(s *byteStream, out []int64, header byte) ([]int64, error) {
+	width := decodeBitWidth(int((header >> 1) & 0x1f))
+	length, err := readLength(s, header)
+	if err != nil {
+		return nil, err
+	}
+	b, err := s.readBytes(2)
+	if err != nil {
+		return nil, err
+	}
+	baseWidth := int((b[0]>>5)&0x07) + 1
+	patchWidth := decodeBitWidth(int(b[0] & 0x1f))
+	patchGapWidth := int((b[1]>>5)&0x07) + 1
+	patchListLength := int(b[1] & 0x1f)
+
+	baseBytes, err := s.readBytes(baseWidth)
+	if err != nil {
+		return nil, err
+	}
+	var base uint64
+	for _, x := range baseBytes {
+		base = base<<8 | uint64(x)
+	}
+	// the most significant bit of base value is the sign bit
+	signMask := uint64(1) << (baseWidth*8 - 1)
+	baseValue := int64(base)
+	if base&signMask != 0 {
+		baseValue = -int64(base &^ signMask)
+	}
+
+	values, err := readBitPacked(s, length, width)
+	if err != nil {
+		return nil, err
+	}
+	patchEntryWidth := closestFixedBits(patchGapWidth + patchWidth)
+	if patchEntryWidth > 64 {
+		return nil, fmt.Errorf("invalid patch entry width %d", patchEntryWidth)
+	}
+	patches, err := readBitPacked(s, patchListLength, patchEntryWidth)
+	if err != nil {
+		return nil, err
+	}
+
+	patchMask := uint64(1)<<patchWidth - 1
+	index := 0
+	for i := 0; i < len(patches); i++ {
+		gap := int(patches[i] >> patchWidth)
+		patch := patches[i] & patchMask
+		// the gap larger than 255 is split into several entries with 0 patch
+		for gap == 255 && patch == 0 && i+1 < len(patches) {
+			index += 255
+			i++
+			gap = int(patches[i] >> patchWidth)
+			patch = patches[i] & patchMask
+		}
+		index += gap
+		if index >= len(values) {
+			return nil, fmt.Errorf("patch index %d out of range %d", index, len(values))
+		}
+		values[index] |= patch << width
+	}
+	for _, v := range values {
+		out = append(out, baseValue+int64(v))
+	}
+	return out, nil
+}
+
+func readDelta(s *byteStream, out []int64, header byte, signed bool) ([]int64, error) {
+	width := int((header >> 1) & 0x1f)
+	if width != 0 {
+		width = decodeBitWidth(width)
+	}
+	length, err := readLength(s, header)
+	if err != nil {
+		return nil, err
+	}
+	base, err := readBase(s, signed)
+	if err != nil {
+		return nil, err
+	}
+	deltaBase, err := s.readVarint()
+	if err != nil {
+		return nil, err
+	}
+	out = append(out, base)
+	if length == 1 {
+		return out, nil
+	}
+	prev := base + deltaBase
+	out = append(out, prev)
+	if width == 0 {
+		// fixed delta
+		for i := 2; i < length; i++ {
+			prev += deltaBase
+			out = append(out, prev)
+		}
+		return out, nil
+	}
+	deltas, err := readBitPacked(s, length-2, width)
+	if err != nil {
+		return nil, err
+	}
+	for _, delta := range deltas {
+		if deltaBase < 0 {
+			prev -= int64(delta)
+		} else {
+			prev += int64(delta)
+		}
+		out = append(out, prev)
+	}
+	return out, nil
+}
+
+// readBitPacked reads n values of the bit width, the values are packed in big endian
+// and the last byte is padded.
+func readBitPacked(s *byteStream, n int, width int) ([]uint64, error) {
+	if width <= 0 || width > 64 {
+		return nil, fmt.Errorf("invalid bit width %d", width)
+	}
+	b, err := s.readBytes((n*width + 7) / 8)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]uint64, n)
+	bitPos := 0
+	for i := range out {
+		var v uint64
+		for remaining := width; remaining > 0; {
+			cur := b[bitPos/8]
+			available := 8 - bitPos%8
+			take := available
+			if remaining < take {
+				take = remaining
+			}
+			bits := (uint64(cur) >> (available - take)) & (uint64(1)<<take - 1)
+			v = v<<take | bits
+			remaining -= take
+			bitPos += take
+		}
+		out[i] = v
+	}
+	return out, nil
+}
+
+func decodeBitWidth(code int) int {
+	switch {
+	case code <= 23:
+		return code + 1
+	case code == 24:
+		return 26
+	case code == 25:
+		return 28
+	case code == 26:
+		return 30
+	case code == 27:
+		return 32
+	case code == 28:
+		return 40
+	case code == 29:
+		return 48
+	case code == 30:
+		return 56
+	default:
+		return 64
+	}
+}
+
+func closestFixedBits(n int) int {
+	switch {
+	case n == 0:
+		return 1
+	case n <= 24:
+		return n
+	case n <= 26:
+		return 26
+	case n <= 28:
+		return 28
+	case n <= 30:
+		return 30
+	case n <= 32:
+		return 32
+	case n <= 40:
+		return 40
+	case n <= 48:
+		return 48
+	case n <= 56:
+		return 56
+	default:
+		return 64
+	}
+}
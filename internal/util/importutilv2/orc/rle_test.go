@@ -0,0 +1,103 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package orc
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReadIntRLEv2(t *testing.T) {
+	// the examples come from the ORC specification
+	cases := []struct {
+		name     string
+		data     []byte
+		signed   bool
+		expected []int64
+	}{
+		{
+			name:     "short_repeat",
+			data:     []byte{0x0a, 0x27, 0x10},
+			expected: []int64{10000, 10000, 10000, 10000, 10000},
+		},
+		{
+			name:     "direct",
+			data:     []byte{0x5e, 0x03, 0x5c, 0xa1, 0xab, 0x1e, 0xde, 0xad, 0xbe, 0xef},
+			expected: []int64{23713, 43806, 57005, 48879},
+		},
+		{
+			name: "patched_base",
+			data: []byte{
+				0x8e, 0x13, 0x2b, 0x21, 0x07, 0xd0, 0x1e, 0x00, 0x14, 0x70, 0x28, 0x32, 0x3c, 0x46, 0x50, 0x5a,
+				0x64, 0x6e, 0x78, 0x82, 0x8c, 0x96, 0xa0, 0xaa, 0xb4, 0xbe, 0xfc, 0xe8,
+			},
+			expected: []int64{
+				2030, 2000, 2020, 1000000, 2040, 2050, 2060, 2070, 2080, 2090,
+				2100, 2110, 2120, 2130, 2140, 2150, 2160, 2170, 2180, 2190,
+			},
+		},
+		{
+			name:     "delta",
+			data:     []byte{0xc6, 0x09, 0x02, 0x02, 0x22, 0x42, 0x42, 0x46},
+			expected: []int64{2, 3, 5, 7, 11, 13, 17, 19, 23, 29},
+		},
+		{
+			name:     "signed_short_repeat",
+			data:     []byte{0x00, 0x13},
+			signed:   true,
+			expected: []int64{-10, -10, -10},
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			values, err := readIntRLE(newByteStream(c.data), len(c.expected), c.signed, true)
+			assert.NoError(t, err)
+			assert.Equal(t, c.expected, values)
+		})
+	}
+
+	_, err := readIntRLE(newByteStream([]byte{0x5e, 0x03, 0x5c}), 4, false, true)
+	assert.Error(t, err)
+}
+
+func TestReadIntRLEv1(t *testing.T) {
+	// run of 100 values from 7 with delta -1, and literals [2, 3, 6, 7, 11]
+	data := []byte{0x61, 0xff, 0x07, 0xfb, 0x02, 0x03, 0x06, 0x07, 0x0b}
+	values, err := readIntRLE(newByteStream(data), 105, false, false)
+	assert.NoError(t, err)
+	assert.Len(t, values, 105)
+	assert.Equal(t, int64(7), values[0])
+	assert.Equal(t, int64(-92), values[99])
+	assert.Equal(t, []int64{2, 3, 6, 7, 11}, values[100:])
+}
+
+func TestReadByteRLE(t *testing.T) {
+	// run of 100 zeros, and literals [0x44, 0x45]
+	values, err := readByteRLE(newByteStream([]byte{0x61, 0x00, 0xfe, 0x44, 0x45}), 102)
+	assert.NoError(t, err)
+	assert.Len(t, values, 102)
+	assert.Equal(t, byte(0), values[99])
+	assert.Equal(t, []byte{0x44, 0x45}, values[100:])
+
+	bs, err := readBooleans(newByteStream([]byte{0xff, 0x80}), 8)
+	assert.NoError(t, err)
+	assert.Equal(t, []bool{true, false, false, false, false, false, false, false}, bs)
+
+	_, err = readByteRLE(newByteStream([]byte{0xfe, 0x44}), 2)
+	assert.Error(t, err)
+}
@@ -0,0 +1,147 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package orc
+
+import (
+	"bytes"
+	"compress/flate"
+	"fmt"
+	"io"
+
+	"github.com/golang/snappy"
+	"github.com/klauspost/compress/zstd"
+	"github.com/pierrec/lz4/v4"
+
+	"github.com/milvus-io/milvus/pkg/v2/util/merr"
+)
+
+const chunkHeaderSize = 3
+
+// decompress decodes the compressed stream, which is split into chunks,
+// each chunk has a 3 bytes header: (chunkLength << 1) | isOriginal in little endian.
+func decompress(kind compressionKind, blockSize uint64, data []byte) ([]byte, error) {
+	if kind == compressionNone {
+		return data, nil
+	}
+	out := make([]byte, 0, len(data))
+	for len(data) > 0 {
+		if len(data) < chunkHeaderSize {
+			return nil, merr.WrapErrImportFailed("invalid orc compression chunk header")
+		}
+		header := uint32(data[0]) | uint32(data[1])<<8 | uint32(data[2])<<16
+		isOriginal := header&1 == 1
+		length := int(header >> 1)
+		data = data[chunkHeaderSize:]
+		if length > len(data) {
+			return nil, merr.WrapErrImportFailed(fmt.Sprintf("orc compression chunk overflows, chunk length=%d, remaining=%d", length, len(data)))
+		}
+		chunk := data[:length]
+		data = data[length:]
+		if isOriginal {
+			out = append(out, chunk...)
+			continue
+		}
+		decoded, err := decompressChunk(kind, blockSize, chunk)
+		if err != nil {
+			return nil, merr.WrapErrImportFailed(fmt.Sprintf("failed to decompress orc chunk, err=%v", err))
+		}
+		out = append(out, decoded...)
+	}
+	return out, nil
+}
+
+func decompressChunk(kind compressionKind, blockSize uint64, chunk []byte) ([]byte, error) {
+	switch kind {
+	case compressionZlib:
+		r := flate.NewReader(bytes.NewReader(chunk))
+		defer r.Close()
+		return io.ReadAll(r)
+	case compressionSnappy:
+		return snappy.Decode(nil, chunk)
+	case compressionZstd:
+		decoder, err := zstd.NewReader(nil)
+		if err != nil {
+			return nil, err
+		}
+		defer decoder.Close()
+		return decoder.DecodeAll(chunk, nil)
+	case compressionLz4:
+		buf := make([]byte, blockSize)
+		n, err := lz4.UncompressBlock(chunk, buf)
+		if err != nil {
+			return nil, err
+		}
+		return buf[:n], nil
+	default:
+		return nil, fmt.Errorf("unsupported compression kind %d", kind)
+	}
+}
+
+// byteStream is the decompressed content of a stream.
+type byteStream struct {
+	data []byte
+	pos  int
+}
+
+func newByteStream(data []byte) *byteStream {
+	return &byteStream{data: data}
+}
+
+func (s *byteStream) readByte() (byte, error) {
+	if s.pos >= len(s.data) {
+		return 0, io.ErrUnexpectedEOF
+	}
+	b := s.data[s.pos]
+	s.pos++
+	return b, nil
+}
+
+func (s *byteStream) readBytes(n int) ([]byte, error) {
+	if n < 0 || s.pos+n > len(s.data) {
+		return nil, io.ErrUnexpectedEOF
+	}
+	b := s.data[s.pos : s.pos+n]
+	s.pos += n
+	return b, nil
+}
+
+func (s *byteStream) readUvarint() (uint64, error) {
+	var value uint64
+	for shift := uint(0); shift < 64; shift += 7 {
+		b, err := s.readByte()
+		if err != nil {
+			return 0, err
+		}
+		value |= uint64(b&0x7f) << shift
+		if b < 0x80 {
+			return value, nil
+		}
+	}
+	return 0, fmt.Errorf("varint overflows")
+}
+
+func (s *byteStream) readVarint() (int64, error) {
+	v, err := s.readUvarint()
+	if err != nil {
+		return 0, err
+	}
+	return zigzagDecode(v), nil
+}
+
+func zigzagDecode(v uint64) int64 {
+	return int64(v>>1) ^ -int64(v&1)
+}
@@ -0,0 +1,410 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package orc
+
+import (
+	"bytes"
+	"compress/flate"
+	"encoding/binary"
+	"fmt"
+	"math"
+	"sort"
+
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+// testType describes the type of column written by testWriter.
+type testType struct {
+	kind     typeKind
+	children []*testType
+	names    []string
+}
+
+func primitive(kind typeKind) *testType {
+	return &testType{kind: kind}
+}
+
+func listOf(elem *testType) *testType {
+	return &testType{kind: kindList, children: []*testType{elem}}
+}
+
+func mapOf(key, value *testType) *testType {
+	return &testType{kind: kindMap, children: []*testType{key, value}}
+}
+
+func structOf(names []string, children ...*testType) *testType {
+	return &testType{kind: kindStruct, children: children, names: names}
+}
+
+// testWriter is a minimal ORC writer for tests, all the columns are written in DIRECT_V2 encoding,
+// the integers are encoded in the DIRECT sub-encoding of RLE v2.
+type testWriter struct {
+	root        *testType
+	compression compressionKind
+	blockSize   int
+
+	columnTypes []*testType
+	buf         bytes.Buffer
+	stripes     [][]byte
+	numRows     uint64
+}
+
+func newTestWriter(root *testType, compression compressionKind) *testWriter {
+	w := &testWriter{root: root, compression: compression, blockSize: 1024}
+	w.buf.WriteString(orcMagic)
+	w.flatten(root)
+	return w
+}
+
+func (w *testWriter) flatten(t *testType) {
+	w.columnTypes = append(w.columnTypes, t)
+	for _, child := range t.children {
+		w.flatten(child)
+	}
+}
+
+type testStream struct {
+	kind   streamKind
+	column int
+	data   []byte
+}
+
+// writeStripe writes the rows as a stripe, each row is a map of top-level column name to value.
+func (w *testWriter) writeStripe(rows []map[string]any) {
+	values := make([]any, len(rows))
+	for i, row := range rows {
+		values[i] = row
+	}
+	var streams []*testStream
+	w.writeColumn(0, w.root, values, &streams)
+
+	offset := uint64(w.buf.Len())
+	var data []byte
+	var footer []byte
+	for _, s := range streams {
+		compressed := w.compress(s.data)
+		data = append(data, compressed...)
+		var stream []byte
+		stream = appendVarintField(stream, 1, uint64(s.kind))
+		stream = appendVarintField(stream, 2, uint64(s.column))
+		stream = appendVarintField(stream, 3, uint64(len(compressed)))
+		footer = appendBytesField(footer, 1, stream)
+	}
+	for range w.columnTypes {
+		footer = appendBytesField(footer, 2, appendVarintField(nil, 1, uint64(encodingDirectV2)))
+	}
+	footer = w.compress(footer)
+	w.buf.Write(data)
+	w.buf.Write(footer)
+
+	var info []byte
+	info = appendVarintField(info, 1, offset)
+	info = appendVarintField(info, 2, 0)
+	info = appendVarintField(info, 3, uint64(len(data)))
+	info = appendVarintField(info, 4, uint64(len(footer)))
+	info = appendVarintField(info, 5, uint64(len(rows)))
+	w.stripes = append(w.stripes, info)
+	w.numRows += uint64(len(rows))
+}
+
+func (w *testWriter) writeColumn(column int, t *testType, values []any, streams *[]*testStream) int {
+	nonNull := make([]any, 0, len(values))
+	present := make([]bool, len(values))
+	for i, v := range values {
+		if v != nil {
+			present[i] = true
+			nonNull = append(nonNull, v)
+		}
+	}
+	if len(nonNull) != len(values) {
+		*streams = append(*streams, &testStream{kind: streamPresent, column: column, data: encodeBooleans(present)})
+	}
+
+	next := column + 1
+	switch t.kind {
+	case kindBoolean:
+		bs := make([]bool, len(nonNull))
+		for i, v := range nonNull {
+			bs[i] = v.(bool)
+		}
+		*streams = append(*streams, &testStream{kind: streamData, column: column, data: encodeBooleans(bs)})
+	case kindByte:
+		bs := make([]byte, len(nonNull))
+		for i, v := range nonNull {
+			bs[i] = byte(toInt(v))
+		}
+		*streams = append(*streams, &testStream{kind: streamData, column: column, data: encodeByteRLE(bs)})
+	case kindShort, kindInt, kindLong:
+		ints := make([]int64, len(nonNull))
+		for i, v := range nonNull {
+			ints[i] = toInt(v)
+		}
+		*streams = append(*streams, &testStream{kind: streamData, column: column, data: encodeIntRLEv2(ints, true)})
+	case kindFloat:
+		var data []byte
+		for _, v := range nonNull {
+			data = binary.LittleEndian.AppendUint32(data, math.Float32bits(v.(float32)))
+		}
+		*streams = append(*streams, &testStream{kind: streamData, column: column, data: data})
+	case kindDouble:
+		var data []byte
+		for _, v := range nonNull {
+			data = binary.LittleEndian.AppendUint64(data, math.Float64bits(v.(float64)))
+		}
+		*streams = append(*streams, &testStream{kind: streamData, column: column, data: data})
+	case kindString, kindBinary:
+		var data []byte
+		lengths := make([]int64, len(nonNull))
+		for i, v := range nonNull {
+			var b []byte
+			switch x := v.(type) {
+			case string:
+				b = []byte(x)
+			case []byte:
+				b = x
+			}
+			data = append(data, b...)
+			lengths[i] = int64(len(b))
+		}
+		*streams = append(*streams, &testStream{kind: streamData, column: column, data: data})
+		*streams = append(*streams, &testStream{kind: streamLength, column: column, data: encodeIntRLEv2(lengths, false)})
+	case kindList:
+		lengths := make([]int64, len(nonNull))
+		var elements []any
+		for i, v := range nonNull {
+			list := toList(v)
+			lengths[i] = int64(len(list))
+			elements = append(elements, list...)
+		}
+		*streams = append(*streams, &testStream{kind: streamLength, column: column, data: encodeIntRLEv2(lengths, false)})
+		next = w.writeColumn(next, t.children[0], elements, streams)
+	case kindMap:
+		lengths := make([]int64, len(nonNull))
+		var keys, vals []any
+		for i, v := range nonNull {
+			m := v.(map[string]any)
+			lengths[i] = int64(len(m))
+			sortedKeys := make([]string, 0, len(m))
+			for k := range m {
+				sortedKeys = append(sortedKeys, k)
+			}
+			sort.Strings(sortedKeys)
+			for _, k := range sortedKeys {
+				keys = append(keys, k)
+				vals = append(vals, m[k])
+			}
+		}
+		*streams = append(*streams, &testStream{kind: streamLength, column: column, data: encodeIntRLEv2(lengths, false)})
+		next = w.writeColumn(next, t.children[0], keys, streams)
+		next = w.writeColumn(next, t.children[1], vals, streams)
+	case kindStruct:
+		for i, child := range t.children {
+			childValues := make([]any, len(nonNull))
+			for j, v := range nonNull {
+				childValues[j] = v.(map[string]any)[t.names[i]]
+			}
+			next = w.writeColumn(next, child, childValues, streams)
+		}
+	default:
+		panic(fmt.Sprintf("unsupported type %s", t.kind))
+	}
+	return next
+}
+
+func (w *testWriter) compress(data []byte) []byte {
+	if w.compression == compressionNone {
+		return data
+	}
+	var out []byte
+	for len(data) > 0 {
+		n := w.blockSize
+		if n > len(data) {
+			n = len(data)
+		}
+		chunk := data[:n]
+		data = data[n:]
+		var buf bytes.Buffer
+		fw, _ := flate.NewWriter(&buf, flate.BestCompression)
+		fw.Write(chunk)
+		fw.Close()
+		header := uint32(buf.Len()) << 1
+		body := buf.Bytes()
+		if buf.Len() >= len(chunk) {
+			header = uint32(len(chunk))<<1 | 1
+			body = chunk
+		}
+		out = append(out, byte(header), byte(header>>8), byte(header>>16))
+		out = append(out, body...)
+	}
+	return out
+}
+
+// close writes the file footer and postscript, and returns the content of the file.
+func (w *testWriter) close() []byte {
+	var footer []byte
+	footer = appendVarintField(footer, 1, uint64(len(orcMagic)))
+	footer = appendVarintField(footer, 2, uint64(w.buf.Len()))
+	for _, stripe := range w.stripes {
+		footer = appendBytesField(footer, 3, stripe)
+	}
+	for i, t := range w.columnTypes {
+		var msg []byte
+		msg = appendVarintField(msg, 1, uint64(t.kind))
+		// the children are flattened in pre-order
+		id := uint32(i + 1)
+		var packed []byte
+		for _, child := range t.children {
+			packed = protowire.AppendVarint(packed, uint64(id))
+			id += uint32(countTypes(child))
+		}
+		if len(packed) > 0 {
+			msg = appendBytesField(msg, 2, packed)
+		}
+		for _, name := range t.names {
+			msg = appendBytesField(msg, 3, []byte(name))
+		}
+		footer = appendBytesField(footer, 4, msg)
+	}
+	footer = appendVarintField(footer, 6, w.numRows)
+	footer = w.compress(footer)
+	w.buf.Write(footer)
+
+	var ps []byte
+	ps = appendVarintField(ps, 1, uint64(len(footer)))
+	ps = appendVarintField(ps, 2, uint64(w.compression))
+	ps = appendVarintField(ps, 3, uint64(w.blockSize))
+	ps = appendBytesField(ps, 8000, []byte(orcMagic))
+	w.buf.Write(ps)
+	w.buf.WriteByte(byte(len(ps)))
+	return w.buf.Bytes()
+}
+
+func countTypes(t *testType) int {
+	n := 1
+	for _, child := range t.children {
+		n += countTypes(child)
+	}
+	return n
+}
+
+func appendVarintField(b []byte, num protowire.Number, v uint64) []byte {
+	b = protowire.AppendTag(b, num, protowire.VarintType)
+	return protowire.AppendVarint(b, v)
+}
+
+func appendBytesField(b []byte, num protowire.Number, v []byte) []byte {
+	b = protowire.AppendTag(b, num, protowire.BytesType)
+	return protowire.AppendBytes(b, v)
+}
+
+func toInt(v any) int64 {
+	switch x := v.(type) {
+	case int8:
+		return int64(x)
+	case int16:
+		return int64(x)
+	case int32:
+		return int64(x)
+	case int64:
+		return x
+	case int:
+		return int64(x)
+	case uint8:
+		return int64(x)
+	}
+	panic(fmt.Sprintf("unexpected integer type %T", v))
+}
+
+func toList(v any) []any {
+	switch x := v.(type) {
+	case []any:
+		return x
+	case []bool:
+		return toAnySlice(x)
+	case []int8:
+		return toAnySlice(x)
+	case []int16:
+		return toAnySlice(x)
+	case []int32:
+		return toAnySlice(x)
+	case []int64:
+		return toAnySlice(x)
+	case []float32:
+		return toAnySlice(x)
+	case []float64:
+		return toAnySlice(x)
+	case []string:
+		return toAnySlice(x)
+	case []byte:
+		return toAnySlice(x)
+	}
+	panic(fmt.Sprintf("unexpected list type %T", v))
+}
+
+func toAnySlice[T any](values []T) []any {
+	out := make([]any, len(values))
+	for i, v := range values {
+		out[i] = v
+	}
+	return out
+}
+
+func encodeByteRLE(values []byte) []byte {
+	var out []byte
+	for len(values) > 0 {
+		n := len(values)
+		if n > 128 {
+			n = 128
+		}
+		out = append(out, byte(-int8(n)))
+		out = append(out, values[:n]...)
+		values = values[n:]
+	}
+	return out
+}
+
+func encodeBooleans(values []bool) []byte {
+	packed := make([]byte, (len(values)+7)/8)
+	for i, v := range values {
+		if v {
+			packed[i/8] |= 0x80 >> (i % 8)
+		}
+	}
+	return encodeByteRLE(packed)
+}
+
+// encodeIntRLEv2 encodes the integers in DIRECT sub-encoding with 64 bits width.
+func encodeIntRLEv2(values []int64, signed bool) []byte {
+	var out []byte
+	for len(values) > 0 {
+		n := len(values)
+		if n > 512 {
+			n = 512
+		}
+		// width code 31 is 64 bits
+		out = append(out, byte(rleDirect<<6|31<<1|(n-1)>>8), byte(n-1))
+		for _, v := range values[:n] {
+			u := uint64(v)
+			if signed {
+				u = uint64(v<<1) ^ uint64(v>>63)
+			}
+			out = binary.BigEndian.AppendUint64(out, u)
+		}
+		values = values[n:]
+	}
+	return out
+}
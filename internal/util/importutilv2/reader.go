@@ -21,10 +21,14 @@ import (
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
 	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/importutilv2/avro"
 	"github.com/milvus-io/milvus/internal/util/importutilv2/binlog"
+	"github.com/milvus-io/milvus/internal/util/importutilv2/common"
 	"github.com/milvus-io/milvus/internal/util/importutilv2/csv"
 	"github.com/milvus-io/milvus/internal/util/importutilv2/json"
+	"github.com/milvus-io/milvus/internal/util/importutilv2/jsonl"
 	"github.com/milvus-io/milvus/internal/util/importutilv2/numpy"
+	"github.com/milvus-io/milvus/internal/util/importutilv2/orc"
 	"github.com/milvus-io/milvus/internal/util/importutilv2/parquet"
 	"github.com/milvus-io/milvus/pkg/v2/proto/internalpb"
 	"github.com/milvus-io/milvus/pkg/v2/util/merr"
@@ -44,6 +48,12 @@ type Reader interface {
 	Close()
 }
 
+// BadRowSkipper is implemented by the row-based readers (JSON, JSONL, CSV, Avro and ORC),
+// which are able to skip the rows failed to parse and continue reading.
+type BadRowSkipper interface {
+	SetBadRowHandler(handler common.BadRowHandler)
+}
+
 func NewReader(ctx context.Context,
 	cm storage.ChunkManager,
 	schema *schemapb.CollectionSchema,
@@ -81,6 +91,12 @@ func NewReader(ctx context.Context,
 			return nil, err
 		}
 		return csv.NewReader(ctx, cm, schema, importFile.GetPaths()[0], bufferSize, sep, nullkey)
+	case Avro:
+		return avro.NewReader(ctx, cm, schema, importFile.GetPaths()[0], bufferSize)
+	case ORC:
+		return orc.NewReader(ctx, cm, schema, importFile.GetPaths()[0], bufferSize)
+	case JSONL:
+		return jsonl.NewReader(ctx, cm, schema, importFile.GetPaths(), bufferSize)
 	}
 	return nil, merr.WrapErrImportFailed("unexpected import file")
 }
@@ -6,6 +6,7 @@ import (
 	"github.com/stretchr/testify/assert"
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/pkg/v2/common"
 )
 
 func Test_BitmapIndexChecker(t *testing.T) {
@@ -31,3 +32,21 @@ func Test_BitmapIndexChecker(t *testing.T) {
 	assert.Error(t, c.CheckValidDataType(IndexBitmap, &schemapb.FieldSchema{DataType: schemapb.DataType_Array, ElementType: schemapb.DataType_Double}))
 	assert.Error(t, c.CheckValidDataType(IndexBitmap, &schemapb.FieldSchema{DataType: schemapb.DataType_Double, IsPrimaryKey: true}))
 }
+
+func Test_BitmapIndexCheckTrain(t *testing.T) {
+	c := newBITMAPChecker()
+
+	assert.NoError(t, c.CheckTrain(schemapb.DataType_Int64, map[string]string{}))
+	assert.NoError(t, c.CheckTrain(schemapb.DataType_Int64, map[string]string{
+		common.BitmapRunOptimizeKey:      "false",
+		common.BitmapOffsetBitsKey:       "64",
+		common.BitmapCardinalityLimitKey: "500",
+	}))
+	assert.NoError(t, c.CheckTrain(schemapb.DataType_VarChar, map[string]string{common.BitmapOffsetBitsKey: "32"}))
+
+	assert.Error(t, c.CheckTrain(schemapb.DataType_Int64, map[string]string{common.BitmapRunOptimizeKey: "yes"}))
+	assert.Error(t, c.CheckTrain(schemapb.DataType_Int64, map[string]string{common.BitmapOffsetBitsKey: "16"}))
+	assert.Error(t, c.CheckTrain(schemapb.DataType_Int64, map[string]string{common.BitmapOffsetBitsKey: "roaring64"}))
+	assert.Error(t, c.CheckTrain(schemapb.DataType_Int64, map[string]string{common.BitmapCardinalityLimitKey: "0"}))
+	assert.Error(t, c.CheckTrain(schemapb.DataType_Int64, map[string]string{common.BitmapCardinalityLimitKey: "2000"}))
+}
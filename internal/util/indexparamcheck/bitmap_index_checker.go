@@ -2,8 +2,10 @@ package indexparamcheck
 
 import (
 	"fmt"
+	"strconv"
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/pkg/v2/common"
 	"github.com/milvus-io/milvus/pkg/v2/util/typeutil"
 )
 
@@ -12,6 +14,9 @@ type BITMAPChecker struct {
 }
 
 func (c *BITMAPChecker) CheckTrain(dataType schemapb.DataType, params map[string]string) error {
+	if err := checkBitmapParams(params); err != nil {
+		return err
+	}
 	return c.scalarIndexChecker.CheckTrain(dataType, params)
 }
 
@@ -37,3 +42,22 @@ func (c *BITMAPChecker) CheckValidDataType(indexType IndexType, field *schemapb.
 func newBITMAPChecker() *BITMAPChecker {
 	return &BITMAPChecker{}
 }
+
+// checkBitmapParams checks the optional roaring bitmap params shared by BITMAP and HYBRID index.
+func checkBitmapParams(params map[string]string) error {
+	if _, ok := params[common.BitmapCardinalityLimitKey]; ok &&
+		!CheckIntByRange(params, common.BitmapCardinalityLimitKey, 1, MaxBitmapCardinalityLimit) {
+		return fmt.Errorf("failed to check bitmap cardinality limit, should be larger than 0 and smaller than %d",
+			MaxBitmapCardinalityLimit)
+	}
+	if runOptimize, ok := params[common.BitmapRunOptimizeKey]; ok {
+		if _, err := strconv.ParseBool(runOptimize); err != nil {
+			return fmt.Errorf("invalid %s value: %s, expected: true, false", common.BitmapRunOptimizeKey, runOptimize)
+		}
+	}
+	if offsetBits, ok := params[common.BitmapOffsetBitsKey]; ok &&
+		!CheckStrByValues(params, common.BitmapOffsetBitsKey, BitmapOffsetBitsOptions) {
+		return fmt.Errorf("invalid %s value: %s, expected: %v", common.BitmapOffsetBitsKey, offsetBits, BitmapOffsetBitsOptions)
+	}
+	return nil
+}
@@ -51,6 +51,9 @@ const (
 	MaxBitmapCardinalityLimit = 1000
 )
 
+// BitmapOffsetBitsOptions is the set of supported roaring bitmap widths for BITMAP and HYBRID index.
+var BitmapOffsetBitsOptions = []string{"32", "64"} // const
+
 var (
 	FloatVectorMetrics       = []string{metric.L2, metric.IP, metric.COSINE}                                        // const
 	SparseFloatVectorMetrics = []string{metric.IP, metric.BM25}                                                     // const
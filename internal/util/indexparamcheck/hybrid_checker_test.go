@@ -34,4 +34,18 @@ func Test_HybridIndexChecker(t *testing.T) {
 	assert.Error(t, c.CheckTrain(schemapb.DataType_JSON, map[string]string{}))
 	assert.Error(t, c.CheckTrain(schemapb.DataType_Float, map[string]string{"bitmap_cardinality_limit": "0"}))
 	assert.Error(t, c.CheckTrain(schemapb.DataType_Double, map[string]string{"bitmap_cardinality_limit": "2000"}))
+
+	assert.NoError(t, c.CheckTrain(schemapb.DataType_Int64, map[string]string{
+		"bitmap_cardinality_limit": "100",
+		"bitmap_run_optimize":      "true",
+		"bitmap_offset_bits":       "64",
+	}))
+	assert.Error(t, c.CheckTrain(schemapb.DataType_Int64, map[string]string{
+		"bitmap_cardinality_limit": "100",
+		"bitmap_run_optimize":      "1x",
+	}))
+	assert.Error(t, c.CheckTrain(schemapb.DataType_Int64, map[string]string{
+		"bitmap_cardinality_limit": "100",
+		"bitmap_offset_bits":       "128",
+	}))
 }
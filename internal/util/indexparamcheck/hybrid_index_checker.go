@@ -17,6 +17,9 @@ func (c *HYBRIDChecker) CheckTrain(dataType schemapb.DataType, params map[string
 		return fmt.Errorf("failed to check bitmap cardinality limit, should be larger than 0 and smaller than %d",
 			MaxBitmapCardinalityLimit)
 	}
+	if err := checkBitmapParams(params); err != nil {
+		return err
+	}
 	return c.scalarIndexChecker.CheckTrain(dataType, params)
 }
 
@@ -51,6 +51,11 @@ func IsVectorMmapIndex(indexType IndexType) bool {
 	return vecindexmgr.GetVecIndexMgrInstance().IsMMapSupported(indexType)
 }
 
+// IsBitmapIndex check if the index is backed by roaring bitmaps
+func IsBitmapIndex(indexType IndexType) bool {
+	return indexType == IndexBitmap || indexType == IndexHybrid
+}
+
 func IsOffsetCacheSupported(indexType IndexType) bool {
 	return indexType == IndexBitmap
 }
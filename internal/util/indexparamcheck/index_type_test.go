@@ -32,6 +32,13 @@ func TestIsScalarMmapIndex(t *testing.T) {
 	})
 }
 
+func TestIsBitmapIndex(t *testing.T) {
+	assert.True(t, IsBitmapIndex(IndexBitmap))
+	assert.True(t, IsBitmapIndex(IndexHybrid))
+	assert.False(t, IsBitmapIndex(IndexINVERTED))
+	assert.False(t, IsBitmapIndex("HNSW"))
+}
+
 func TestIsVectorMmapIndex(t *testing.T) {
 	t.Run("vector index", func(t *testing.T) {
 		assert.True(t, IsVectorMmapIndex("FLAT"))
@@ -0,0 +1,494 @@
+package reduce
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/samber/lo"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/pkg/v2/proto/internalpb"
+	"github.com/milvus-io/milvus/pkg/v2/util/merr"
+	"github.com/milvus-io/milvus/pkg/v2/util/typeutil"
+)
+
+// AggregateName returns the output field name of the aggregate, like count(*) or sum(price).
+func AggregateName(op internalpb.AggregateOp, fieldName string) string {
+	if fieldName == "" {
+		fieldName = "*"
+	}
+	return fmt.Sprintf("%s(%s)", strings.ToLower(op.String()), fieldName)
+}
+
+// CheckAggregate returns error if the aggregate could not be computed on the field of the data type.
+func CheckAggregate(op internalpb.AggregateOp, dataType schemapb.DataType) error {
+	var ok bool
+	switch op {
+	case internalpb.AggregateOp_Count, internalpb.AggregateOp_Min, internalpb.AggregateOp_Max:
+		ok = IsOrderByType(dataType)
+	case internalpb.AggregateOp_Sum, internalpb.AggregateOp_Avg:
+		ok = typeutil.IsIntegerType(dataType) || typeutil.IsFloatingType(dataType)
+	}
+	if !ok {
+		return merr.WrapErrParameterInvalidMsg("%s is not supported on field of type %s", strings.ToLower(op.String()), dataType.String())
+	}
+	return nil
+}
+
+type aggState struct {
+	count    int64
+	sumInt   int64
+	sumFloat float64
+	// min or max value
+	value any
+}
+
+// addInt64 returns the sum of a and b, and false if it overflows int64.
+func addInt64(a, b int64) (int64, bool) {
+	sum := a + b
+	if (a > 0 && b > 0 && sum < 0) || (a < 0 && b < 0 && sum >= 0) {
+		return sum, false
+	}
+	return sum, true
+}
+
+func (s *aggState) addSumInt(n int64) error {
+	sum, ok := addInt64(s.sumInt, n)
+	if !ok {
+		return merr.WrapErrParameterInvalidMsg("sum of integer field overflows int64")
+	}
+	s.sumInt = sum
+	return nil
+}
+
+func (s *aggState) add(op internalpb.AggregateOp, v any) error {
+	if v == nil {
+		return nil
+	}
+	s.count++
+	switch op {
+	case internalpb.AggregateOp_Sum, internalpb.AggregateOp_Avg:
+		switch n := v.(type) {
+		case int32:
+			s.sumFloat += float64(n)
+			return s.addSumInt(int64(n))
+		case int64:
+			s.sumFloat += float64(n)
+			return s.addSumInt(n)
+		case float32:
+			s.sumFloat += float64(n)
+		case float64:
+			s.sumFloat += n
+		}
+	case internalpb.AggregateOp_Min:
+		if s.value == nil || compareValue(v, s.value) < 0 {
+			s.value = v
+		}
+	case internalpb.AggregateOp_Max:
+		if s.value == nil || compareValue(v, s.value) > 0 {
+			s.value = v
+		}
+	}
+	return nil
+}
+
+func (s *aggState) merge(op internalpb.AggregateOp, other *aggState) error {
+	s.count += other.count
+	s.sumFloat += other.sumFloat
+	if err := s.addSumInt(other.sumInt); err != nil {
+		return err
+	}
+	if other.value == nil {
+		return nil
+	}
+	if s.value == nil ||
+		(op == internalpb.AggregateOp_Min && compareValue(other.value, s.value) < 0) ||
+		(op == internalpb.AggregateOp_Max && compareValue(other.value, s.value) > 0) {
+		s.value = other.value
+	}
+	return nil
+}
+
+type aggGroup struct {
+	keys   []any
+	states []*aggState
+}
+
+// GroupAggregator groups rows by the group by fields and computes the aggregates of each group.
+// Rows are accumulated by AddRows on segments, the partial states are exchanged between querynodes
+// and proxy by Partials and AddPartials, then proxy computes the final results by Finalize.
+//
+// Partial states are laid out as the group by columns followed by the columns of each aggregate:
+// count for count, sum and count for sum and avg, min or max value and count for min and max.
+// Sum of integer fields fails once it overflows int64.
+//
+// Rows are aggregated before the results of segments are deduplicated by primary key, so a row whose
+// primary key exists in several segments, e.g. inserted twice or being moved by compaction, is counted
+// in each of them.
+type GroupAggregator struct {
+	groupByFields []*schemapb.FieldSchema
+	aggregates    []*internalpb.Aggregate
+	// nil for count(*)
+	aggFields []*schemapb.FieldSchema
+
+	groups map[string]*aggGroup
+	order  []string
+}
+
+func NewGroupAggregator(schema *schemapb.CollectionSchema, groupByFieldIDs []int64, aggregates []*internalpb.Aggregate) (*GroupAggregator, error) {
+	if len(aggregates) == 0 {
+		return nil, merr.WrapErrParameterInvalidMsg("no aggregate provided")
+	}
+	a := &GroupAggregator{
+		aggregates: aggregates,
+		aggFields:  make([]*schemapb.FieldSchema, len(aggregates)),
+		groups:     make(map[string]*aggGroup),
+	}
+	for _, fieldID := range groupByFieldIDs {
+		field := typeutil.GetField(schema, fieldID)
+		if field == nil {
+			return nil, merr.WrapErrFieldNotFound(fieldID)
+		}
+		if !IsOrderByType(field.GetDataType()) {
+			return nil, merr.WrapErrParameterInvalidMsg("group by field %s of type %s is not supported", field.GetName(), field.GetDataType().String())
+		}
+		a.groupByFields = append(a.groupByFields, field)
+	}
+	for i, agg := range aggregates {
+		if agg.GetFieldID() == 0 {
+			if agg.GetOp() != internalpb.AggregateOp_Count {
+				return nil, merr.WrapErrParameterInvalidMsg("field is required by %s", strings.ToLower(agg.GetOp().String()))
+			}
+			continue
+		}
+		field := typeutil.GetField(schema, agg.GetFieldID())
+		if field == nil {
+			return nil, merr.WrapErrFieldNotFound(agg.GetFieldID())
+		}
+		if err := CheckAggregate(agg.GetOp(), field.GetDataType()); err != nil {
+			return nil, err
+		}
+		a.aggFields[i] = field
+	}
+	return a, nil
+}
+
+func (a *GroupAggregator) group(keys []any) *aggGroup {
+	key := fmt.Sprintf("%#v", keys)
+	g, ok := a.groups[key]
+	if !ok {
+		g = &aggGroup{
+			keys: keys,
+			states: lo.RepeatBy(len(a.aggregates), func(_ int) *aggState {
+				return &aggState{}
+			}),
+		}
+		a.groups[key] = g
+		a.order = append(a.order, key)
+	}
+	return g
+}
+
+// AddRows accumulates numRows rows retrieved from segment.
+func (a *GroupAggregator) AddRows(fieldsData []*schemapb.FieldData, numRows int) error {
+	findColumn := func(fieldID int64) (*schemapb.FieldData, error) {
+		fieldData, ok := lo.Find(fieldsData, func(fd *schemapb.FieldData) bool {
+			return fd.GetFieldId() == fieldID
+		})
+		if !ok {
+			return nil, merr.WrapErrServiceInternal(fmt.Sprintf("field %d not found in retrieve results", fieldID))
+		}
+		return fieldData, nil
+	}
+
+	groupByColumns := make([]*schemapb.FieldData, len(a.groupByFields))
+	for i, field := range a.groupByFields {
+		column, err := findColumn(field.GetFieldID())
+		if err != nil {
+			return err
+		}
+		groupByColumns[i] = column
+	}
+	aggColumns := make([]*schemapb.FieldData, len(a.aggregates))
+	for i, field := range a.aggFields {
+		if field == nil {
+			continue
+		}
+		column, err := findColumn(field.GetFieldID())
+		if err != nil {
+			return err
+		}
+		aggColumns[i] = column
+	}
+
+	for row := 0; row < numRows; row++ {
+		keys := make([]any, len(groupByColumns))
+		for i, column := range groupByColumns {
+			keys[i] = getValue(column, row)
+		}
+		g := a.group(keys)
+		for i, agg := range a.aggregates {
+			if aggColumns[i] == nil {
+				// count(*)
+				g.states[i].count++
+				continue
+			}
+			if err := g.states[i].add(agg.GetOp(), getValue(aggColumns[i], row)); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (a *GroupAggregator) numPartialColumns() int {
+	n := len(a.groupByFields)
+	for _, agg := range a.aggregates {
+		if agg.GetOp() == internalpb.AggregateOp_Count {
+			n++
+		} else {
+			n += 2
+		}
+	}
+	return n
+}
+
+// AddPartials merges the partial states produced by Partials.
+func (a *GroupAggregator) AddPartials(fieldsData []*schemapb.FieldData) error {
+	if len(fieldsData) == 0 {
+		return nil
+	}
+	if len(fieldsData) != a.numPartialColumns() {
+		return merr.WrapErrServiceInternal(fmt.Sprintf("unexpected number of partial aggregation columns, expected %d, got %d",
+			a.numPartialColumns(), len(fieldsData)))
+	}
+
+	groupByColumns := fieldsData[:len(a.groupByFields)]
+	aggColumns := fieldsData[len(a.groupByFields):]
+	// the count column of the first aggregate always exists
+	numRows := len(aggColumns[lo.Ternary(a.aggregates[0].GetOp() == internalpb.AggregateOp_Count, 0, 1)].GetScalars().GetLongData().GetData())
+	for row := 0; row < numRows; row++ {
+		keys := make([]any, len(groupByColumns))
+		for i, column := range groupByColumns {
+			keys[i] = getValue(column, row)
+		}
+		g := a.group(keys)
+		col := 0
+		for i, agg := range a.aggregates {
+			state := &aggState{}
+			switch agg.GetOp() {
+			case internalpb.AggregateOp_Count:
+				state.count = aggColumns[col].GetScalars().GetLongData().GetData()[row]
+				col++
+			case internalpb.AggregateOp_Sum, internalpb.AggregateOp_Avg:
+				if a.isIntegerSum(i) {
+					state.sumInt = aggColumns[col].GetScalars().GetLongData().GetData()[row]
+				} else {
+					state.sumFloat = aggColumns[col].GetScalars().GetDoubleData().GetData()[row]
+				}
+				state.count = aggColumns[col+1].GetScalars().GetLongData().GetData()[row]
+				col += 2
+			default:
+				state.value = getValue(aggColumns[col], row)
+				state.count = aggColumns[col+1].GetScalars().GetLongData().GetData()[row]
+				col += 2
+			}
+			if err := g.states[i].merge(agg.GetOp(), state); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (a *GroupAggregator) isIntegerSum(i int) bool {
+	return a.aggregates[i].GetOp() == internalpb.AggregateOp_Sum && typeutil.IsIntegerType(a.aggFields[i].GetDataType())
+}
+
+// Partials returns the partial states of all the groups.
+func (a *GroupAggregator) Partials() []*schemapb.FieldData {
+	columns := make([]*schemapb.FieldData, 0, a.numPartialColumns())
+	for _, field := range a.groupByFields {
+		columns = append(columns, newColumn(field.GetDataType(), field.GetFieldID(), field.GetName(), true))
+	}
+	for i, agg := range a.aggregates {
+		name := AggregateName(agg.GetOp(), a.aggFields[i].GetName())
+		switch agg.GetOp() {
+		case internalpb.AggregateOp_Count:
+		case internalpb.AggregateOp_Sum, internalpb.AggregateOp_Avg:
+			columns = append(columns, newColumn(lo.Ternary(a.isIntegerSum(i), schemapb.DataType_Int64, schemapb.DataType_Double),
+				a.aggFields[i].GetFieldID(), name, false))
+		default:
+			columns = append(columns, newColumn(a.aggFields[i].GetDataType(), a.aggFields[i].GetFieldID(), name, true))
+		}
+		columns = append(columns, newColumn(schemapb.DataType_Int64, a.aggFields[i].GetFieldID(), name, false))
+	}
+
+	for _, key := range a.order {
+		g := a.groups[key]
+		for i, v := range g.keys {
+			appendValue(columns[i], v)
+		}
+		col := len(a.groupByFields)
+		for i, agg := range a.aggregates {
+			state := g.states[i]
+			switch agg.GetOp() {
+			case internalpb.AggregateOp_Count:
+			case internalpb.AggregateOp_Sum, internalpb.AggregateOp_Avg:
+				appendValue(columns[col], lo.Ternary[any](a.isIntegerSum(i), state.sumInt, state.sumFloat))
+				col++
+			default:
+				appendValue(columns[col], state.value)
+				col++
+			}
+			appendValue(columns[col], state.count)
+			col++
+		}
+	}
+	return columns
+}
+
+// Finalize returns the group by columns followed by the aggregate results of the groups sorted by group by fields,
+// offset and limit are applied on groups.
+func (a *GroupAggregator) Finalize(offset int64, limit int64) []*schemapb.FieldData {
+	if len(a.groupByFields) == 0 && len(a.groups) == 0 {
+		// aggregates of empty set
+		a.group([]any{})
+	}
+
+	groups := lo.Map(a.order, func(key string, _ int) *aggGroup { return a.groups[key] })
+	sort.SliceStable(groups, func(i, j int) bool {
+		for k := range a.groupByFields {
+			ki, kj := groups[i].keys[k], groups[j].keys[k]
+			switch {
+			case ki == nil && kj == nil:
+				continue
+			case ki == nil:
+				return false
+			case kj == nil:
+				return true
+			}
+			if c := compareValue(ki, kj); c != 0 {
+				return c < 0
+			}
+		}
+		return false
+	})
+	if offset >= int64(len(groups)) {
+		groups = nil
+	} else {
+		groups = groups[offset:]
+	}
+	if limit != typeutil.Unlimited && int64(len(groups)) > limit {
+		groups = groups[:limit]
+	}
+
+	columns := make([]*schemapb.FieldData, 0, len(a.groupByFields)+len(a.aggregates))
+	for _, field := range a.groupByFields {
+		columns = append(columns, newColumn(field.GetDataType(), field.GetFieldID(), field.GetName(), field.GetNullable()))
+	}
+	for i, agg := range a.aggregates {
+		name := AggregateName(agg.GetOp(), a.aggFields[i].GetName())
+		switch agg.GetOp() {
+		case internalpb.AggregateOp_Count:
+			columns = append(columns, newColumn(schemapb.DataType_Int64, 0, name, false))
+		case internalpb.AggregateOp_Sum:
+			columns = append(columns, newColumn(lo.Ternary(a.isIntegerSum(i), schemapb.DataType_Int64, schemapb.DataType_Double), 0, name, true))
+		case internalpb.AggregateOp_Avg:
+			columns = append(columns, newColumn(schemapb.DataType_Double, 0, name, true))
+		default:
+			columns = append(columns, newColumn(a.aggFields[i].GetDataType(), 0, name, true))
+		}
+	}
+
+	for _, g := range groups {
+		for i, v := range g.keys {
+			appendValue(columns[i], v)
+		}
+		for i, agg := range a.aggregates {
+			state := g.states[i]
+			var v any
+			switch agg.GetOp() {
+			case internalpb.AggregateOp_Count:
+				v = state.count
+			case internalpb.AggregateOp_Sum:
+				if state.count > 0 {
+					v = lo.Ternary[any](a.isIntegerSum(i), state.sumInt, state.sumFloat)
+				}
+			case internalpb.AggregateOp_Avg:
+				if state.count > 0 {
+					v = state.sumFloat / float64(state.count)
+				}
+			default:
+				v = state.value
+			}
+			appendValue(columns[len(a.groupByFields)+i], v)
+		}
+	}
+	return columns
+}
+
+// getValue returns the value of the row, nil if the value is null.
+func getValue(fieldData *schemapb.FieldData, idx int) any {
+	if isNull(fieldData, int64(idx)) {
+		return nil
+	}
+	return typeutil.GetData(fieldData, idx)
+}
+
+func newColumn(dataType schemapb.DataType, fieldID int64, fieldName string, nullable bool) *schemapb.FieldData {
+	scalars := &schemapb.ScalarField{}
+	switch dataType {
+	case schemapb.DataType_Bool:
+		scalars.Data = &schemapb.ScalarField_BoolData{BoolData: &schemapb.BoolArray{}}
+	case schemapb.DataType_Int8, schemapb.DataType_Int16, schemapb.DataType_Int32:
+		scalars.Data = &schemapb.ScalarField_IntData{IntData: &schemapb.IntArray{}}
+	case schemapb.DataType_Int64:
+		scalars.Data = &schemapb.ScalarField_LongData{LongData: &schemapb.LongArray{}}
+	case schemapb.DataType_Float:
+		scalars.Data = &schemapb.ScalarField_FloatData{FloatData: &schemapb.FloatArray{}}
+	case schemapb.DataType_Double:
+		scalars.Data = &schemapb.ScalarField_DoubleData{DoubleData: &schemapb.DoubleArray{}}
+	case schemapb.DataType_VarChar, schemapb.DataType_String:
+		scalars.Data = &schemapb.ScalarField_StringData{StringData: &schemapb.StringArray{}}
+	}
+	fieldData := &schemapb.FieldData{
+		Type:      dataType,
+		FieldName: fieldName,
+		FieldId:   fieldID,
+		Field:     &schemapb.FieldData_Scalars{Scalars: scalars},
+	}
+	if nullable {
+		fieldData.ValidData = make([]bool, 0)
+	}
+	return fieldData
+}
+
+// appendValue appends v to the column created by newColumn, zero value is appended as the placeholder of null.
+func appendValue(fieldData *schemapb.FieldData, v any) {
+	if fieldData.ValidData != nil {
+		fieldData.ValidData = append(fieldData.ValidData, v != nil)
+	}
+	scalars := fieldData.GetScalars()
+	switch data := scalars.GetData().(type) {
+	case *schemapb.ScalarField_BoolData:
+		val, _ := v.(bool)
+		data.BoolData.Data = append(data.BoolData.Data, val)
+	case *schemapb.ScalarField_IntData:
+		val, _ := v.(int32)
+		data.IntData.Data = append(data.IntData.Data, val)
+	case *schemapb.ScalarField_LongData:
+		val, _ := v.(int64)
+		data.LongData.Data = append(data.LongData.Data, val)
+	case *schemapb.ScalarField_FloatData:
+		val, _ := v.(float32)
+		data.FloatData.Data = append(data.FloatData.Data, val)
+	case *schemapb.ScalarField_DoubleData:
+		val, _ := v.(float64)
+		data.DoubleData.Data = append(data.DoubleData.Data, val)
+	case *schemapb.ScalarField_StringData:
+		val, _ := v.(string)
+		data.StringData.Data = append(data.StringData.Data, val)
+	}
+}
@@ -0,0 +1,154 @@
+package reduce
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/pkg/v2/proto/internalpb"
+	"github.com/milvus-io/milvus/pkg/v2/util/typeutil"
+)
+
+func TestGroupAggregator(t *testing.T) {
+	schema := &schemapb.CollectionSchema{
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: 100, Name: "pk", DataType: schemapb.DataType_Int64, IsPrimaryKey: true},
+			{FieldID: 101, Name: "category", DataType: schemapb.DataType_VarChar, Nullable: true},
+			{FieldID: 102, Name: "price", DataType: schemapb.DataType_Int64, Nullable: true},
+			{FieldID: 103, Name: "score", DataType: schemapb.DataType_Float},
+			{FieldID: 104, Name: "vec", DataType: schemapb.DataType_FloatVector},
+		},
+	}
+	aggregates := []*internalpb.Aggregate{
+		{Op: internalpb.AggregateOp_Count},
+		{Op: internalpb.AggregateOp_Sum, FieldID: 102},
+		{Op: internalpb.AggregateOp_Avg, FieldID: 103},
+		{Op: internalpb.AggregateOp_Max, FieldID: 103},
+		{Op: internalpb.AggregateOp_Min, FieldID: 102},
+	}
+	genRows := func(categories []string, categoryValid []bool, prices []int64, priceValid []bool, scores []float32) []*schemapb.FieldData {
+		return []*schemapb.FieldData{
+			{
+				Type:    schemapb.DataType_VarChar,
+				FieldId: 101,
+				Field: &schemapb.FieldData_Scalars{Scalars: &schemapb.ScalarField{
+					Data: &schemapb.ScalarField_StringData{StringData: &schemapb.StringArray{Data: categories}},
+				}},
+				ValidData: categoryValid,
+			},
+			{
+				Type:    schemapb.DataType_Int64,
+				FieldId: 102,
+				Field: &schemapb.FieldData_Scalars{Scalars: &schemapb.ScalarField{
+					Data: &schemapb.ScalarField_LongData{LongData: &schemapb.LongArray{Data: prices}},
+				}},
+				ValidData: priceValid,
+			},
+			{
+				Type:    schemapb.DataType_Float,
+				FieldId: 103,
+				Field: &schemapb.FieldData_Scalars{Scalars: &schemapb.ScalarField{
+					Data: &schemapb.ScalarField_FloatData{FloatData: &schemapb.FloatArray{Data: scores}},
+				}},
+			},
+		}
+	}
+
+	t.Run("group by", func(t *testing.T) {
+		// partial states of the segments on two querynodes
+		first, err := NewGroupAggregator(schema, []int64{101}, aggregates)
+		assert.NoError(t, err)
+		assert.NoError(t, first.AddRows(genRows([]string{"b", "a", "", "b"}, []bool{true, true, false, true},
+			[]int64{10, 20, 30, 0}, []bool{true, true, true, false}, []float32{1, 2, 3, 4}), 4))
+		second, err := NewGroupAggregator(schema, []int64{101}, aggregates)
+		assert.NoError(t, err)
+		assert.NoError(t, second.AddRows(genRows([]string{"a", "c"}, []bool{true, true},
+			[]int64{5, 0}, []bool{true, false}, []float32{6, 1}), 2))
+
+		agg, err := NewGroupAggregator(schema, []int64{101}, aggregates)
+		assert.NoError(t, err)
+		for _, partials := range [][]*schemapb.FieldData{first.Partials(), second.Partials(), nil} {
+			assert.NoError(t, agg.AddPartials(partials))
+		}
+		columns := agg.Finalize(0, typeutil.Unlimited)
+		assert.Equal(t, 6, len(columns))
+		// groups are sorted by keys with nulls last
+		assert.Equal(t, []string{"a", "b", "c", ""}, columns[0].GetScalars().GetStringData().GetData())
+		assert.Equal(t, []bool{true, true, true, false}, columns[0].GetValidData())
+		assert.Equal(t, "count(*)", columns[1].GetFieldName())
+		assert.Equal(t, []int64{2, 2, 1, 1}, columns[1].GetScalars().GetLongData().GetData())
+		assert.Equal(t, "sum(price)", columns[2].GetFieldName())
+		assert.Equal(t, []int64{25, 10, 0, 30}, columns[2].GetScalars().GetLongData().GetData())
+		assert.Equal(t, []bool{true, true, false, true}, columns[2].GetValidData())
+		assert.Equal(t, []float64{4, 2.5, 1, 3}, columns[3].GetScalars().GetDoubleData().GetData())
+		assert.Equal(t, schemapb.DataType_Float, columns[4].GetType())
+		assert.Equal(t, []float32{6, 4, 1, 3}, columns[4].GetScalars().GetFloatData().GetData())
+		assert.Equal(t, []int64{5, 10, 0, 30}, columns[5].GetScalars().GetLongData().GetData())
+		assert.Equal(t, []bool{true, true, false, true}, columns[5].GetValidData())
+
+		columns = agg.Finalize(1, 2)
+		assert.Equal(t, []string{"b", "c"}, columns[0].GetScalars().GetStringData().GetData())
+
+		columns = agg.Finalize(10, 2)
+		assert.Empty(t, columns[0].GetScalars().GetStringData().GetData())
+	})
+
+	t.Run("without group by", func(t *testing.T) {
+		agg, err := NewGroupAggregator(schema, nil, aggregates)
+		assert.NoError(t, err)
+		columns := agg.Finalize(0, typeutil.Unlimited)
+		assert.Equal(t, 5, len(columns))
+		assert.Equal(t, []int64{0}, columns[0].GetScalars().GetLongData().GetData())
+		assert.Equal(t, []bool{false}, columns[1].GetValidData())
+
+		agg, err = NewGroupAggregator(schema, nil, aggregates)
+		assert.NoError(t, err)
+		assert.NoError(t, agg.AddRows(genRows([]string{"a", "b"}, nil, []int64{1, 2}, nil, []float32{1, 2}), 2))
+		columns = agg.Finalize(0, typeutil.Unlimited)
+		assert.Equal(t, []int64{2}, columns[0].GetScalars().GetLongData().GetData())
+		assert.Equal(t, []int64{3}, columns[1].GetScalars().GetLongData().GetData())
+	})
+
+	t.Run("sum overflow", func(t *testing.T) {
+		sum := []*internalpb.Aggregate{{Op: internalpb.AggregateOp_Sum, FieldID: 102}}
+		agg, err := NewGroupAggregator(schema, nil, sum)
+		assert.NoError(t, err)
+		assert.Error(t, agg.AddRows(genRows([]string{"a", "a"}, nil, []int64{math.MaxInt64, 1}, nil, []float32{1, 1}), 2))
+
+		agg, err = NewGroupAggregator(schema, nil, sum)
+		assert.NoError(t, err)
+		assert.NoError(t, agg.AddRows(genRows([]string{"a", "a"}, nil, []int64{math.MinInt64 + 1, -1}, nil, []float32{1, 1}), 2))
+		partials := agg.Partials()
+		merged, err := NewGroupAggregator(schema, nil, sum)
+		assert.NoError(t, err)
+		assert.NoError(t, merged.AddPartials(partials))
+		assert.Error(t, merged.AddPartials(partials))
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		_, err := NewGroupAggregator(schema, []int64{101}, nil)
+		assert.Error(t, err)
+		_, err = NewGroupAggregator(schema, []int64{104}, aggregates)
+		assert.Error(t, err)
+		_, err = NewGroupAggregator(schema, []int64{105}, aggregates)
+		assert.Error(t, err)
+		_, err = NewGroupAggregator(schema, nil, []*internalpb.Aggregate{{Op: internalpb.AggregateOp_Sum}})
+		assert.Error(t, err)
+		_, err = NewGroupAggregator(schema, nil, []*internalpb.Aggregate{{Op: internalpb.AggregateOp_Sum, FieldID: 101}})
+		assert.Error(t, err)
+
+		agg, err := NewGroupAggregator(schema, []int64{101}, aggregates)
+		assert.NoError(t, err)
+		assert.Error(t, agg.AddRows(genRows([]string{"a"}, nil, []int64{1}, nil, []float32{1})[:1], 1))
+		assert.Error(t, agg.AddPartials(genRows([]string{"a"}, nil, []int64{1}, nil, []float32{1})))
+	})
+}
+
+func TestAggregateName(t *testing.T) {
+	assert.Equal(t, "count(*)", AggregateName(internalpb.AggregateOp_Count, ""))
+	assert.Equal(t, "avg(price)", AggregateName(internalpb.AggregateOp_Avg, "price"))
+	assert.NoError(t, CheckAggregate(internalpb.AggregateOp_Max, schemapb.DataType_VarChar))
+	assert.Error(t, CheckAggregate(internalpb.AggregateOp_Sum, schemapb.DataType_VarChar))
+}
@@ -0,0 +1,157 @@
+package reduce
+
+import (
+	"cmp"
+	"fmt"
+	"sort"
+
+	"github.com/samber/lo"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/pkg/v2/common"
+	"github.com/milvus-io/milvus/pkg/v2/proto/internalpb"
+	"github.com/milvus-io/milvus/pkg/v2/util/merr"
+	"github.com/milvus-io/milvus/pkg/v2/util/typeutil"
+)
+
+// IsOrderByType returns whether the results could be sorted by the field of the data type.
+func IsOrderByType(dataType schemapb.DataType) bool {
+	switch dataType {
+	case schemapb.DataType_Bool, schemapb.DataType_Int8, schemapb.DataType_Int16, schemapb.DataType_Int32,
+		schemapb.DataType_Int64, schemapb.DataType_Float, schemapb.DataType_Double, schemapb.DataType_VarChar:
+		return true
+	default:
+		return false
+	}
+}
+
+type OrderByResult interface {
+	GetIds() *schemapb.IDs
+	GetFieldsData() []*schemapb.FieldData
+}
+
+// OrderBySelection refers to the row at ResultIndex of the BatchIndex-th result.
+type OrderBySelection struct {
+	BatchIndex  int
+	ResultIndex int64
+}
+
+// SelectByOrder sorts the rows of results by the order by fields and returns the first limit rows.
+// Rows with the same primary key are deduplicated by keeping the one with the largest timestamp,
+// ties are broken by primary key so that paginating by offset is stable.
+func SelectByOrder[T OrderByResult](results []T, orderByFields []*internalpb.OrderByField, limit int64) ([]OrderBySelection, error) {
+	columns := make([][]*schemapb.FieldData, len(results))
+	timestamps := make([][]int64, len(results))
+	for i, r := range results {
+		columns[i] = make([]*schemapb.FieldData, len(orderByFields))
+		for j, orderBy := range orderByFields {
+			fieldData, ok := lo.Find(r.GetFieldsData(), func(fd *schemapb.FieldData) bool {
+				return fd.GetFieldId() == orderBy.GetFieldID()
+			})
+			if !ok {
+				return nil, merr.WrapErrServiceInternal(fmt.Sprintf("order by field %d not found in retrieve results", orderBy.GetFieldID()))
+			}
+			columns[i][j] = fieldData
+		}
+		if tsField, ok := lo.Find(r.GetFieldsData(), func(fd *schemapb.FieldData) bool {
+			return fd.GetFieldId() == common.TimeStampField
+		}); ok {
+			timestamps[i] = tsField.GetScalars().GetLongData().GetData()
+		}
+	}
+
+	selections := make([]OrderBySelection, 0)
+	positions := make(map[any]int)
+	for i, r := range results {
+		size := typeutil.GetSizeOfIDs(r.GetIds())
+		for k := int64(0); k < int64(size); k++ {
+			pk := typeutil.GetPK(r.GetIds(), k)
+			pos, ok := positions[pk]
+			if !ok {
+				positions[pk] = len(selections)
+				selections = append(selections, OrderBySelection{BatchIndex: i, ResultIndex: k})
+				continue
+			}
+			// primary keys duplicate, keep the latest one
+			prev := selections[pos]
+			if timestamps[i] != nil && timestamps[prev.BatchIndex] != nil &&
+				timestamps[i][k] > timestamps[prev.BatchIndex][prev.ResultIndex] {
+				selections[pos] = OrderBySelection{BatchIndex: i, ResultIndex: k}
+			}
+		}
+	}
+
+	sort.SliceStable(selections, func(a, b int) bool {
+		sa, sb := selections[a], selections[b]
+		for j, orderBy := range orderByFields {
+			if c := compareOrderByValue(columns[sa.BatchIndex][j], sa.ResultIndex, columns[sb.BatchIndex][j], sb.ResultIndex, orderBy); c != 0 {
+				return c < 0
+			}
+		}
+		return comparePK(typeutil.GetPK(results[sa.BatchIndex].GetIds(), sa.ResultIndex),
+			typeutil.GetPK(results[sb.BatchIndex].GetIds(), sb.ResultIndex)) < 0
+	})
+
+	if limit != typeutil.Unlimited && int64(len(selections)) > limit {
+		selections = selections[:limit]
+	}
+	return selections, nil
+}
+
+func isNull(fieldData *schemapb.FieldData, idx int64) bool {
+	validData := fieldData.GetValidData()
+	return len(validData) > 0 && !validData[idx]
+}
+
+func compareOrderByValue(a *schemapb.FieldData, ai int64, b *schemapb.FieldData, bi int64, orderBy *internalpb.OrderByField) int {
+	aNull, bNull := isNull(a, ai), isNull(b, bi)
+	switch {
+	case aNull && bNull:
+		return 0
+	case aNull:
+		if orderBy.GetNullsFirst() {
+			return -1
+		}
+		return 1
+	case bNull:
+		if orderBy.GetNullsFirst() {
+			return 1
+		}
+		return -1
+	}
+
+	c := compareValue(typeutil.GetData(a, int(ai)), typeutil.GetData(b, int(bi)))
+	if !orderBy.GetAscending() {
+		c = -c
+	}
+	return c
+}
+
+// compareValue compares two non-null scalar values of the same type.
+func compareValue(a, b any) int {
+	switch av := a.(type) {
+	case bool:
+		return cmp.Compare(lo.Ternary(av, 1, 0), lo.Ternary(b.(bool), 1, 0))
+	case int32:
+		return cmp.Compare(av, b.(int32))
+	case int64:
+		return cmp.Compare(av, b.(int64))
+	case float32:
+		return cmp.Compare(av, b.(float32))
+	case float64:
+		return cmp.Compare(av, b.(float64))
+	case string:
+		return cmp.Compare(av, b.(string))
+	}
+	return 0
+}
+
+func comparePK(a, b any) int {
+	switch av := a.(type) {
+	case int64:
+		return cmp.Compare(av, b.(int64))
+	case string:
+		return cmp.Compare(av, b.(string))
+	}
+	return 0
+}
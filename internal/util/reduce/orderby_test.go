@@ -0,0 +1,101 @@
+package reduce
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/pkg/v2/common"
+	"github.com/milvus-io/milvus/pkg/v2/proto/internalpb"
+	"github.com/milvus-io/milvus/pkg/v2/util/typeutil"
+)
+
+func genOrderByResult(pks []int64, ages []int64, valid []bool, names []string, timestamps []int64) *internalpb.RetrieveResults {
+	return &internalpb.RetrieveResults{
+		Ids: &schemapb.IDs{IdField: &schemapb.IDs_IntId{IntId: &schemapb.LongArray{Data: pks}}},
+		FieldsData: []*schemapb.FieldData{
+			{
+				Type:    schemapb.DataType_Int64,
+				FieldId: 101,
+				Field: &schemapb.FieldData_Scalars{Scalars: &schemapb.ScalarField{
+					Data: &schemapb.ScalarField_LongData{LongData: &schemapb.LongArray{Data: ages}},
+				}},
+				ValidData: valid,
+			},
+			{
+				Type:    schemapb.DataType_VarChar,
+				FieldId: 102,
+				Field: &schemapb.FieldData_Scalars{Scalars: &schemapb.ScalarField{
+					Data: &schemapb.ScalarField_StringData{StringData: &schemapb.StringArray{Data: names}},
+				}},
+			},
+			{
+				Type:    schemapb.DataType_Int64,
+				FieldId: common.TimeStampField,
+				Field: &schemapb.FieldData_Scalars{Scalars: &schemapb.ScalarField{
+					Data: &schemapb.ScalarField_LongData{LongData: &schemapb.LongArray{Data: timestamps}},
+				}},
+			},
+		},
+	}
+}
+
+func selectedPKs(results []*internalpb.RetrieveResults, selections []OrderBySelection) []int64 {
+	pks := make([]int64, 0, len(selections))
+	for _, sel := range selections {
+		pks = append(pks, typeutil.GetPK(results[sel.BatchIndex].GetIds(), sel.ResultIndex).(int64))
+	}
+	return pks
+}
+
+func TestIsOrderByType(t *testing.T) {
+	assert.True(t, IsOrderByType(schemapb.DataType_Int64))
+	assert.True(t, IsOrderByType(schemapb.DataType_VarChar))
+	assert.False(t, IsOrderByType(schemapb.DataType_JSON))
+	assert.False(t, IsOrderByType(schemapb.DataType_FloatVector))
+}
+
+func TestSelectByOrder(t *testing.T) {
+	results := []*internalpb.RetrieveResults{
+		genOrderByResult([]int64{1, 2, 3}, []int64{30, 20, 0}, []bool{true, true, false}, []string{"a", "b", "c"}, []int64{1, 1, 1}),
+		genOrderByResult([]int64{4, 5, 6}, []int64{20, 10, 25}, []bool{true, true, true}, []string{"d", "e", "a"}, []int64{1, 1, 1}),
+	}
+
+	t.Run("ascending nulls last", func(t *testing.T) {
+		selections, err := SelectByOrder(results, []*internalpb.OrderByField{{FieldID: 101, Ascending: true}}, typeutil.Unlimited)
+		assert.NoError(t, err)
+		// ties are broken by primary key
+		assert.Equal(t, []int64{5, 2, 4, 6, 1, 3}, selectedPKs(results, selections))
+	})
+
+	t.Run("descending nulls first", func(t *testing.T) {
+		selections, err := SelectByOrder(results, []*internalpb.OrderByField{{FieldID: 101, NullsFirst: true}}, 3)
+		assert.NoError(t, err)
+		assert.Equal(t, []int64{3, 1, 6}, selectedPKs(results, selections))
+	})
+
+	t.Run("multiple fields", func(t *testing.T) {
+		selections, err := SelectByOrder(results, []*internalpb.OrderByField{
+			{FieldID: 102, Ascending: true},
+			{FieldID: 101, Ascending: true},
+		}, 2)
+		assert.NoError(t, err)
+		assert.Equal(t, []int64{6, 1}, selectedPKs(results, selections))
+	})
+
+	t.Run("duplicated pk", func(t *testing.T) {
+		dup := []*internalpb.RetrieveResults{
+			genOrderByResult([]int64{1, 2}, []int64{10, 20}, nil, []string{"a", "b"}, []int64{1, 1}),
+			genOrderByResult([]int64{1}, []int64{30}, nil, []string{"a"}, []int64{2}),
+		}
+		selections, err := SelectByOrder(dup, []*internalpb.OrderByField{{FieldID: 101, Ascending: true}}, typeutil.Unlimited)
+		assert.NoError(t, err)
+		assert.Equal(t, []OrderBySelection{{BatchIndex: 0, ResultIndex: 1}, {BatchIndex: 1, ResultIndex: 0}}, selections)
+	})
+
+	t.Run("field not found", func(t *testing.T) {
+		_, err := SelectByOrder(results, []*internalpb.OrderByField{{FieldID: 103}}, typeutil.Unlimited)
+		assert.Error(t, err)
+	})
+}
@@ -12,6 +12,7 @@ const (
 	walTypeRocksmq = "rocksmq"
 	walTypeKafka   = "kafka"
 	walTypePulsar  = "pulsar"
+	walTypeFileLog = "filelog"
 )
 
 type walEnable struct {
@@ -58,7 +59,7 @@ func validateWALName(standalone bool, mqType string) error {
 	// we may register more mq type by plugin.
 	// so we should not check all mq type here.
 	// only check standalone type.
-	if !standalone && mqType == walTypeRocksmq {
+	if !standalone && (mqType == walTypeRocksmq || mqType == walTypeFileLog) {
 		return errors.Newf("mq %s is only valid in standalone mode", mqType)
 	}
 	return nil
@@ -8,6 +8,8 @@ import (
 
 func TestValidateWALType(t *testing.T) {
 	assert.Error(t, validateWALName(false, walTypeRocksmq))
+	assert.Error(t, validateWALName(false, walTypeFileLog))
+	assert.NoError(t, validateWALName(true, walTypeFileLog))
 }
 
 func TestSelectWALType(t *testing.T) {
@@ -24,6 +26,8 @@ func TestSelectWALType(t *testing.T) {
 	assert.Equal(t, mustSelectWALName(true, walTypeRocksmq, walEnable{true, true, true}), walTypeRocksmq)
 	assert.Equal(t, mustSelectWALName(true, walTypePulsar, walEnable{true, true, true}), walTypePulsar)
 	assert.Equal(t, mustSelectWALName(true, walTypeKafka, walEnable{true, true, true}), walTypeKafka)
+	assert.Equal(t, mustSelectWALName(true, walTypeFileLog, walEnable{true, true, true}), walTypeFileLog)
+	assert.Panics(t, func() { mustSelectWALName(false, walTypeFileLog, walEnable{true, true, true}) })
 	assert.Panics(t, func() { mustSelectWALName(false, walTypeRocksmq, walEnable{true, true, true}) })
 	assert.Equal(t, mustSelectWALName(false, walTypePulsar, walEnable{true, true, true}), walTypePulsar)
 	assert.Equal(t, mustSelectWALName(false, walTypeKafka, walEnable{true, true, true}), walTypeKafka)
@@ -21,6 +21,7 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/cockroachdb/errors"
 	"github.com/samber/lo"
@@ -142,6 +143,8 @@ const (
 	IsSparseKey               = "is_sparse"
 	AutoIndexName             = "AUTOINDEX"
 	BitmapCardinalityLimitKey = "bitmap_cardinality_limit"
+	BitmapRunOptimizeKey      = "bitmap_run_optimize"
+	BitmapOffsetBitsKey       = "bitmap_offset_bits"
 	IgnoreGrowing             = "ignore_growing"
 	ConsistencyLevel          = "consistency_level"
 	HintsKey                  = "hints"
@@ -161,6 +164,9 @@ const (
 const (
 	CollectionTTLConfigKey      = "collection.ttl.seconds"
 	CollectionAutoCompactionKey = "collection.autocompaction.enabled"
+	// deletes within the time travel window are kept by compaction so that the collection could be queried as of
+	// an earlier timestamp in the window
+	CollectionTimeTravelKey = "collection.timetravel.seconds"
 
 	// rate limit
 	CollectionInsertRateMaxKey   = "collection.insertRate.max.mb"
@@ -201,6 +207,8 @@ const (
 	IndexOffsetCacheEnabledKey = "indexoffsetcache.enabled"
 	ReplicateIDKey             = "replicate.id"
 	ReplicateEndTSKey          = "replicate.endTS"
+	// bloom filters of the scalar fields enabling it are built to skip segments for equal/in filters
+	BloomFilterEnabledKey = "bloomfilter.enabled"
 )
 
 const (
@@ -232,6 +240,16 @@ func IsMmapIndexEnabled(kvs ...*commonpb.KeyValuePair) (bool, bool) {
 	return false, false
 }
 
+func IsBloomFilterEnabled(kvs ...*commonpb.KeyValuePair) bool {
+	for _, kv := range kvs {
+		if kv.Key == BloomFilterEnabledKey {
+			enable, _ := strconv.ParseBool(kv.Value)
+			return enable
+		}
+	}
+	return false
+}
+
 func GetIndexType(indexParams []*commonpb.KeyValuePair) string {
 	for _, param := range indexParams {
 		if param.Key == IndexTypeKey {
@@ -299,6 +317,23 @@ func IsPartitionKeyIsolationPropEnabled(props map[string]string) (bool, error) {
 	return iso, nil
 }
 
+// GetCollectionTimeTravel returns the time travel window of the collection, 0 if it's not set.
+func GetCollectionTimeTravel(kvs ...*commonpb.KeyValuePair) (time.Duration, error) {
+	for _, kv := range kvs {
+		if kv.GetKey() == CollectionTimeTravelKey {
+			seconds, err := strconv.ParseInt(kv.GetValue(), 10, 64)
+			if err != nil {
+				return 0, errors.Wrapf(err, "failed to parse %s", CollectionTimeTravelKey)
+			}
+			if seconds < 0 {
+				return 0, fmt.Errorf("%s should not be negative, got %d", CollectionTimeTravelKey, seconds)
+			}
+			return time.Duration(seconds) * time.Second, nil
+		}
+	}
+	return 0, nil
+}
+
 const (
 	// LatestVerision is the magic number for watch latest revision
 	LatestRevision = int64(-1)
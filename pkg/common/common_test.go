@@ -3,6 +3,7 @@ package common
 import (
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 
@@ -150,6 +151,22 @@ func TestCommonPartitionKeyIsolation(t *testing.T) {
 	})
 }
 
+func TestCollectionTimeTravel(t *testing.T) {
+	window, err := GetCollectionTimeTravel()
+	assert.NoError(t, err)
+	assert.Equal(t, time.Duration(0), window)
+
+	window, err = GetCollectionTimeTravel(&commonpb.KeyValuePair{Key: CollectionTimeTravelKey, Value: "3600"})
+	assert.NoError(t, err)
+	assert.Equal(t, time.Hour, window)
+
+	_, err = GetCollectionTimeTravel(&commonpb.KeyValuePair{Key: CollectionTimeTravelKey, Value: "invalid"})
+	assert.ErrorContains(t, err, "failed to parse "+CollectionTimeTravelKey)
+
+	_, err = GetCollectionTimeTravel(&commonpb.KeyValuePair{Key: CollectionTimeTravelKey, Value: "-1"})
+	assert.Error(t, err)
+}
+
 func TestShouldFieldBeLoaded(t *testing.T) {
 	type testCase struct {
 		tag          string
@@ -258,3 +275,11 @@ func TestReplicateProperty(t *testing.T) {
 		}
 	})
 }
+
+func TestIsBloomFilterEnabled(t *testing.T) {
+	assert.False(t, IsBloomFilterEnabled())
+	assert.False(t, IsBloomFilterEnabled(&commonpb.KeyValuePair{Key: MaxLengthKey, Value: "128"}))
+	assert.False(t, IsBloomFilterEnabled(&commonpb.KeyValuePair{Key: BloomFilterEnabledKey, Value: "false"}))
+	assert.False(t, IsBloomFilterEnabled(&commonpb.KeyValuePair{Key: BloomFilterEnabledKey, Value: "invalid"}))
+	assert.True(t, IsBloomFilterEnabled(&commonpb.KeyValuePair{Key: BloomFilterEnabledKey, Value: "true"}))
+}
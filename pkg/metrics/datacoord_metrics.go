@@ -211,6 +211,26 @@ var (
 			stageLabelName,
 		})
 
+	DataCoordTTLReclaimedRows = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.DataCoordRole,
+			Name:      "ttl_reclaimed_rows",
+			Help:      "counter of expired rows reclaimed by dropping the expired segments",
+		}, []string{
+			collectionIDLabelName,
+		})
+
+	DataCoordTTLReclaimedSize = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.DataCoordRole,
+			Name:      "ttl_reclaimed_size",
+			Help:      "counter of expired binlog bytes reclaimed by dropping the expired segments",
+		}, []string{
+			collectionIDLabelName,
+		})
+
 	ImportJobLatency = prometheus.NewHistogramVec(
 		prometheus.HistogramOpts{
 			Namespace: milvusNamespace,
@@ -380,6 +400,8 @@ func RegisterDataCoord(registry *prometheus.Registry) {
 	registry.MustRegister(DataCoordCompactedSegmentSize)
 	registry.MustRegister(DataCoordCompactionTaskNum)
 	registry.MustRegister(DataCoordCompactionLatency)
+	registry.MustRegister(DataCoordTTLReclaimedRows)
+	registry.MustRegister(DataCoordTTLReclaimedSize)
 	registry.MustRegister(ImportJobLatency)
 	registry.MustRegister(ImportTaskLatency)
 	registry.MustRegister(DataCoordSizeStoredL0Segment)
@@ -422,4 +444,10 @@ func CleanupDataCoordWithCollectionID(collectionID int64) {
 	DataCoordL0DeleteEntriesNum.DeletePartialMatch(prometheus.Labels{
 		collectionIDLabelName: fmt.Sprint(collectionID),
 	})
+	DataCoordTTLReclaimedRows.Delete(prometheus.Labels{
+		collectionIDLabelName: fmt.Sprint(collectionID),
+	})
+	DataCoordTTLReclaimedSize.Delete(prometheus.Labels{
+		collectionIDLabelName: fmt.Sprint(collectionID),
+	})
 }
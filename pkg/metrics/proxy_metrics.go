@@ -364,6 +364,15 @@ var (
 			Help:      "count of operation executed",
 		}, []string{nodeIDLabelName, msgTypeLabelName, statusLabelName})
 
+	// ProxyUserRateLimitReqCount integrates a counter monitoring metric for the requests checked by the limits of users and roles.
+	ProxyUserRateLimitReqCount = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.ProxyRole,
+			Name:      "user_rate_limit_req_count",
+			Help:      "count of requests checked by the rate limits of users and roles",
+		}, []string{nodeIDLabelName, msgTypeLabelName, statusLabelName})
+
 	ProxySlowQueryCount = prometheus.NewCounterVec(
 		prometheus.CounterOpts{
 			Namespace: milvusNamespace,
@@ -506,6 +515,7 @@ func RegisterProxy(registry *prometheus.Registry) {
 	registry.MustRegister(ProxyWorkLoadScore)
 	registry.MustRegister(ProxyExecutingTotalNq)
 	registry.MustRegister(ProxyRateLimitReqCount)
+	registry.MustRegister(ProxyUserRateLimitReqCount)
 
 	registry.MustRegister(ProxySlowQueryCount)
 	registry.MustRegister(ProxyReportValue)
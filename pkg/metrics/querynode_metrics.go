@@ -801,6 +801,39 @@ var (
 		},
 	)
 
+	// QueryNodeFilterCacheHitTotal records the number of segment filter results served from filter cache.
+	QueryNodeFilterCacheHitTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.QueryNodeRole,
+			Name:      "filter_cache_hit_total",
+			Help:      "number of segment filter results hit in filter cache",
+		}, []string{
+			nodeIDLabelName,
+		})
+
+	// QueryNodeFilterCacheMissTotal records the number of segment filter results missed in filter cache.
+	QueryNodeFilterCacheMissTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.QueryNodeRole,
+			Name:      "filter_cache_miss_total",
+			Help:      "number of segment filter results missed in filter cache",
+		}, []string{
+			nodeIDLabelName,
+		})
+
+	// QueryNodeFilterCacheSize records the memory used by filter cache.
+	QueryNodeFilterCacheSize = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.QueryNodeRole,
+			Name:      "filter_cache_size",
+			Help:      "memory size of filter cache (in bytes)",
+		}, []string{
+			nodeIDLabelName,
+		})
+
 	QueryNodeCGOCallLatency = prometheus.NewHistogramVec(
 		prometheus.HistogramOpts{
 			Namespace: milvusNamespace,
@@ -884,6 +917,9 @@ func RegisterQueryNode(registry *prometheus.Registry) {
 	registry.MustRegister(QueryNodeDeleteBufferSize)
 	registry.MustRegister(QueryNodeDeleteBufferRowNum)
 	registry.MustRegister(QueryNodeCGOCallLatency)
+	registry.MustRegister(QueryNodeFilterCacheHitTotal)
+	registry.MustRegister(QueryNodeFilterCacheMissTotal)
+	registry.MustRegister(QueryNodeFilterCacheSize)
 	// Add cgo metrics
 	RegisterCGOMetrics(registry)
 
@@ -1922,6 +1922,9 @@ const (
 	DataNode_DropImport_FullMethodName                    = "/milvus.proto.data.DataNode/DropImport"
 	DataNode_QuerySlot_FullMethodName                     = "/milvus.proto.data.DataNode/QuerySlot"
 	DataNode_DropCompactionPlan_FullMethodName            = "/milvus.proto.data.DataNode/DropCompactionPlan"
+	DataNode_ExportV2_FullMethodName                      = "/milvus.proto.data.DataNode/ExportV2"
+	DataNode_QueryExport_FullMethodName                   = "/milvus.proto.data.DataNode/QueryExport"
+	DataNode_DropExport_FullMethodName                    = "/milvus.proto.data.DataNode/DropExport"
 )
 
 // DataNodeClient is the client API for DataNode service.
@@ -1951,6 +1954,9 @@ type DataNodeClient interface {
 	DropImport(ctx context.Context, in *DropImportRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
 	QuerySlot(ctx context.Context, in *QuerySlotRequest, opts ...grpc.CallOption) (*QuerySlotResponse, error)
 	DropCompactionPlan(ctx context.Context, in *DropCompactionPlanRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	ExportV2(ctx context.Context, in *ExportRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	QueryExport(ctx context.Context, in *QueryExportRequest, opts ...grpc.CallOption) (*QueryExportResponse, error)
+	DropExport(ctx context.Context, in *DropExportRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
 }
 
 type dataNodeClient struct {
@@ -2141,6 +2147,33 @@ func (c *dataNodeClient) DropCompactionPlan(ctx context.Context, in *DropCompact
 	return out, nil
 }
 
+func (c *dataNodeClient) ExportV2(ctx context.Context, in *ExportRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, DataNode_ExportV2_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataNodeClient) QueryExport(ctx context.Context, in *QueryExportRequest, opts ...grpc.CallOption) (*QueryExportResponse, error) {
+	out := new(QueryExportResponse)
+	err := c.cc.Invoke(ctx, DataNode_QueryExport_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataNodeClient) DropExport(ctx context.Context, in *DropExportRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, DataNode_DropExport_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // DataNodeServer is the server API for DataNode service.
 // All implementations should embed UnimplementedDataNodeServer
 // for forward compatibility
@@ -2168,6 +2201,9 @@ type DataNodeServer interface {
 	DropImport(context.Context, *DropImportRequest) (*commonpb.Status, error)
 	QuerySlot(context.Context, *QuerySlotRequest) (*QuerySlotResponse, error)
 	DropCompactionPlan(context.Context, *DropCompactionPlanRequest) (*commonpb.Status, error)
+	ExportV2(context.Context, *ExportRequest) (*commonpb.Status, error)
+	QueryExport(context.Context, *QueryExportRequest) (*QueryExportResponse, error)
+	DropExport(context.Context, *DropExportRequest) (*commonpb.Status, error)
 }
 
 // UnimplementedDataNodeServer should be embedded to have forward compatible implementations.
@@ -2234,6 +2270,15 @@ func (UnimplementedDataNodeServer) QuerySlot(context.Context, *QuerySlotRequest)
 func (UnimplementedDataNodeServer) DropCompactionPlan(context.Context, *DropCompactionPlanRequest) (*commonpb.Status, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DropCompactionPlan not implemented")
 }
+func (UnimplementedDataNodeServer) ExportV2(context.Context, *ExportRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportV2 not implemented")
+}
+func (UnimplementedDataNodeServer) QueryExport(context.Context, *QueryExportRequest) (*QueryExportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryExport not implemented")
+}
+func (UnimplementedDataNodeServer) DropExport(context.Context, *DropExportRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DropExport not implemented")
+}
 
 // UnsafeDataNodeServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to DataNodeServer will
@@ -2606,6 +2651,60 @@ func _DataNode_DropCompactionPlan_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DataNode_ExportV2_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataNodeServer).ExportV2(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DataNode_ExportV2_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataNodeServer).ExportV2(ctx, req.(*ExportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DataNode_QueryExport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryExportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataNodeServer).QueryExport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DataNode_QueryExport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataNodeServer).QueryExport(ctx, req.(*QueryExportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DataNode_DropExport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DropExportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataNodeServer).DropExport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DataNode_DropExport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataNodeServer).DropExport(ctx, req.(*DropExportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // DataNode_ServiceDesc is the grpc.ServiceDesc for DataNode service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -2693,6 +2792,18 @@ var DataNode_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DropCompactionPlan",
 			Handler:    _DataNode_DropCompactionPlan_Handler,
 		},
+		{
+			MethodName: "ExportV2",
+			Handler:    _DataNode_ExportV2_Handler,
+		},
+		{
+			MethodName: "QueryExport",
+			Handler:    _DataNode_QueryExport_Handler,
+		},
+		{
+			MethodName: "DropExport",
+			Handler:    _DataNode_DropExport_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "data_coord.proto",
@@ -23,6 +23,61 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type AggregateOp int32
+
+const (
+	AggregateOp_Count AggregateOp = 0
+	AggregateOp_Sum   AggregateOp = 1
+	AggregateOp_Min   AggregateOp = 2
+	AggregateOp_Max   AggregateOp = 3
+	AggregateOp_Avg   AggregateOp = 4
+)
+
+// Enum value maps for AggregateOp.
+var (
+	AggregateOp_name = map[int32]string{
+		0: "Count",
+		1: "Sum",
+		2: "Min",
+		3: "Max",
+		4: "Avg",
+	}
+	AggregateOp_value = map[string]int32{
+		"Count": 0,
+		"Sum":   1,
+		"Min":   2,
+		"Max":   3,
+		"Avg":   4,
+	}
+)
+
+func (x AggregateOp) Enum() *AggregateOp {
+	p := new(AggregateOp)
+	*p = x
+	return p
+}
+
+func (x AggregateOp) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AggregateOp) Descriptor() protoreflect.EnumDescriptor {
+	return file_internal_proto_enumTypes[0].Descriptor()
+}
+
+func (AggregateOp) Type() protoreflect.EnumType {
+	return &file_internal_proto_enumTypes[0]
+}
+
+func (x AggregateOp) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AggregateOp.Descriptor instead.
+func (AggregateOp) EnumDescriptor() ([]byte, []int) {
+	return file_internal_proto_rawDescGZIP(), []int{0}
+}
+
 type RateScope int32
 
 const (
@@ -30,6 +85,8 @@ const (
 	RateScope_Database   RateScope = 1
 	RateScope_Collection RateScope = 2
 	RateScope_Partition  RateScope = 3
+	RateScope_User       RateScope = 4
+	RateScope_Role       RateScope = 5
 )
 
 // Enum value maps for RateScope.
@@ -39,12 +96,16 @@ var (
 		1: "Database",
 		2: "Collection",
 		3: "Partition",
+		4: "User",
+		5: "Role",
 	}
 	RateScope_value = map[string]int32{
 		"Cluster":    0,
 		"Database":   1,
 		"Collection": 2,
 		"Partition":  3,
+		"User":       4,
+		"Role":       5,
 	}
 )
 
@@ -59,11 +120,11 @@ func (x RateScope) String() string {
 }
 
 func (RateScope) Descriptor() protoreflect.EnumDescriptor {
-	return file_internal_proto_enumTypes[0].Descriptor()
+	return file_internal_proto_enumTypes[1].Descriptor()
 }
 
 func (RateScope) Type() protoreflect.EnumType {
-	return &file_internal_proto_enumTypes[0]
+	return &file_internal_proto_enumTypes[1]
 }
 
 func (x RateScope) Number() protoreflect.EnumNumber {
@@ -72,7 +133,7 @@ func (x RateScope) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use RateScope.Descriptor instead.
 func (RateScope) EnumDescriptor() ([]byte, []int) {
-	return file_internal_proto_rawDescGZIP(), []int{0}
+	return file_internal_proto_rawDescGZIP(), []int{1}
 }
 
 type RateType int32
@@ -132,11 +193,11 @@ func (x RateType) String() string {
 }
 
 func (RateType) Descriptor() protoreflect.EnumDescriptor {
-	return file_internal_proto_enumTypes[1].Descriptor()
+	return file_internal_proto_enumTypes[2].Descriptor()
 }
 
 func (RateType) Type() protoreflect.EnumType {
-	return &file_internal_proto_enumTypes[1]
+	return &file_internal_proto_enumTypes[2]
 }
 
 func (x RateType) Number() protoreflect.EnumNumber {
@@ -145,7 +206,7 @@ func (x RateType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use RateType.Descriptor instead.
 func (RateType) EnumDescriptor() ([]byte, []int) {
-	return file_internal_proto_rawDescGZIP(), []int{1}
+	return file_internal_proto_rawDescGZIP(), []int{2}
 }
 
 type ImportJobState int32
@@ -196,11 +257,11 @@ func (x ImportJobState) String() string {
 }
 
 func (ImportJobState) Descriptor() protoreflect.EnumDescriptor {
-	return file_internal_proto_enumTypes[2].Descriptor()
+	return file_internal_proto_enumTypes[3].Descriptor()
 }
 
 func (ImportJobState) Type() protoreflect.EnumType {
-	return &file_internal_proto_enumTypes[2]
+	return &file_internal_proto_enumTypes[3]
 }
 
 func (x ImportJobState) Number() protoreflect.EnumNumber {
@@ -209,7 +270,7 @@ func (x ImportJobState) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use ImportJobState.Descriptor instead.
 func (ImportJobState) EnumDescriptor() ([]byte, []int) {
-	return file_internal_proto_rawDescGZIP(), []int{2}
+	return file_internal_proto_rawDescGZIP(), []int{3}
 }
 
 type GetTimeTickChannelRequest struct {
@@ -1756,6 +1817,12 @@ type RetrieveRequest struct {
 	ReduceType                   int32                     `protobuf:"varint,17,opt,name=reduce_type,json=reduceType,proto3" json:"reduce_type,omitempty"`
 	ConsistencyLevel             commonpb.ConsistencyLevel `protobuf:"varint,18,opt,name=consistency_level,json=consistencyLevel,proto3,enum=milvus.proto.common.ConsistencyLevel" json:"consistency_level,omitempty"`
 	IsIterator                   bool                      `protobuf:"varint,19,opt,name=is_iterator,json=isIterator,proto3" json:"is_iterator,omitempty"`
+	// sort the results by these fields instead of primary key, limit is applied after sorting
+	OrderByFields []*OrderByField `protobuf:"bytes,20,rep,name=order_by_fields,json=orderByFields,proto3" json:"order_by_fields,omitempty"`
+	// group the matched rows by these fields and compute the aggregates of each group,
+	// partial aggregation states are returned instead of rows
+	GroupByFieldIds []int64      `protobuf:"varint,21,rep,packed,name=group_by_field_ids,json=groupByFieldIds,proto3" json:"group_by_field_ids,omitempty"`
+	Aggregates      []*Aggregate `protobuf:"bytes,22,rep,name=aggregates,proto3" json:"aggregates,omitempty"`
 }
 
 func (x *RetrieveRequest) Reset() {
@@ -1923,6 +1990,146 @@ func (x *RetrieveRequest) GetIsIterator() bool {
 	return false
 }
 
+func (x *RetrieveRequest) GetOrderByFields() []*OrderByField {
+	if x != nil {
+		return x.OrderByFields
+	}
+	return nil
+}
+
+func (x *RetrieveRequest) GetGroupByFieldIds() []int64 {
+	if x != nil {
+		return x.GroupByFieldIds
+	}
+	return nil
+}
+
+func (x *RetrieveRequest) GetAggregates() []*Aggregate {
+	if x != nil {
+		return x.Aggregates
+	}
+	return nil
+}
+
+type OrderByField struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FieldID    int64 `protobuf:"varint,1,opt,name=fieldID,proto3" json:"fieldID,omitempty"`
+	Ascending  bool  `protobuf:"varint,2,opt,name=ascending,proto3" json:"ascending,omitempty"`
+	NullsFirst bool  `protobuf:"varint,3,opt,name=nulls_first,json=nullsFirst,proto3" json:"nulls_first,omitempty"`
+}
+
+func (x *OrderByField) Reset() {
+	*x = OrderByField{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OrderByField) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrderByField) ProtoMessage() {}
+
+func (x *OrderByField) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrderByField.ProtoReflect.Descriptor instead.
+func (*OrderByField) Descriptor() ([]byte, []int) {
+	return file_internal_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *OrderByField) GetFieldID() int64 {
+	if x != nil {
+		return x.FieldID
+	}
+	return 0
+}
+
+func (x *OrderByField) GetAscending() bool {
+	if x != nil {
+		return x.Ascending
+	}
+	return false
+}
+
+func (x *OrderByField) GetNullsFirst() bool {
+	if x != nil {
+		return x.NullsFirst
+	}
+	return false
+}
+
+type Aggregate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Op AggregateOp `protobuf:"varint,1,opt,name=op,proto3,enum=milvus.proto.internal.AggregateOp" json:"op,omitempty"`
+	// 0 for count(*)
+	FieldID int64 `protobuf:"varint,2,opt,name=fieldID,proto3" json:"fieldID,omitempty"`
+}
+
+func (x *Aggregate) Reset() {
+	*x = Aggregate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Aggregate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Aggregate) ProtoMessage() {}
+
+func (x *Aggregate) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Aggregate.ProtoReflect.Descriptor instead.
+func (*Aggregate) Descriptor() ([]byte, []int) {
+	return file_internal_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *Aggregate) GetOp() AggregateOp {
+	if x != nil {
+		return x.Op
+	}
+	return AggregateOp_Count
+}
+
+func (x *Aggregate) GetFieldID() int64 {
+	if x != nil {
+		return x.FieldID
+	}
+	return 0
+}
+
 type RetrieveResults struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1945,7 +2152,7 @@ type RetrieveResults struct {
 func (x *RetrieveResults) Reset() {
 	*x = RetrieveResults{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_internal_proto_msgTypes[18]
+		mi := &file_internal_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1958,7 +2165,7 @@ func (x *RetrieveResults) String() string {
 func (*RetrieveResults) ProtoMessage() {}
 
 func (x *RetrieveResults) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_proto_msgTypes[18]
+	mi := &file_internal_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1971,7 +2178,7 @@ func (x *RetrieveResults) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RetrieveResults.ProtoReflect.Descriptor instead.
 func (*RetrieveResults) Descriptor() ([]byte, []int) {
-	return file_internal_proto_rawDescGZIP(), []int{18}
+	return file_internal_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *RetrieveResults) GetBase() *commonpb.MsgBase {
@@ -2067,7 +2274,7 @@ type LoadIndex struct {
 func (x *LoadIndex) Reset() {
 	*x = LoadIndex{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_internal_proto_msgTypes[19]
+		mi := &file_internal_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2080,7 +2287,7 @@ func (x *LoadIndex) String() string {
 func (*LoadIndex) ProtoMessage() {}
 
 func (x *LoadIndex) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_proto_msgTypes[19]
+	mi := &file_internal_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2093,7 +2300,7 @@ func (x *LoadIndex) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LoadIndex.ProtoReflect.Descriptor instead.
 func (*LoadIndex) Descriptor() ([]byte, []int) {
-	return file_internal_proto_rawDescGZIP(), []int{19}
+	return file_internal_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *LoadIndex) GetBase() *commonpb.MsgBase {
@@ -2150,7 +2357,7 @@ type IndexStats struct {
 func (x *IndexStats) Reset() {
 	*x = IndexStats{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_internal_proto_msgTypes[20]
+		mi := &file_internal_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2163,7 +2370,7 @@ func (x *IndexStats) String() string {
 func (*IndexStats) ProtoMessage() {}
 
 func (x *IndexStats) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_proto_msgTypes[20]
+	mi := &file_internal_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2176,7 +2383,7 @@ func (x *IndexStats) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use IndexStats.ProtoReflect.Descriptor instead.
 func (*IndexStats) Descriptor() ([]byte, []int) {
-	return file_internal_proto_rawDescGZIP(), []int{20}
+	return file_internal_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *IndexStats) GetIndexParams() []*commonpb.KeyValuePair {
@@ -2206,7 +2413,7 @@ type FieldStats struct {
 func (x *FieldStats) Reset() {
 	*x = FieldStats{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_internal_proto_msgTypes[21]
+		mi := &file_internal_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2219,7 +2426,7 @@ func (x *FieldStats) String() string {
 func (*FieldStats) ProtoMessage() {}
 
 func (x *FieldStats) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_proto_msgTypes[21]
+	mi := &file_internal_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2232,7 +2439,7 @@ func (x *FieldStats) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FieldStats.ProtoReflect.Descriptor instead.
 func (*FieldStats) Descriptor() ([]byte, []int) {
-	return file_internal_proto_rawDescGZIP(), []int{21}
+	return file_internal_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *FieldStats) GetCollectionID() int64 {
@@ -2270,7 +2477,7 @@ type SegmentStats struct {
 func (x *SegmentStats) Reset() {
 	*x = SegmentStats{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_internal_proto_msgTypes[22]
+		mi := &file_internal_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2283,7 +2490,7 @@ func (x *SegmentStats) String() string {
 func (*SegmentStats) ProtoMessage() {}
 
 func (x *SegmentStats) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_proto_msgTypes[22]
+	mi := &file_internal_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2296,7 +2503,7 @@ func (x *SegmentStats) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SegmentStats.ProtoReflect.Descriptor instead.
 func (*SegmentStats) Descriptor() ([]byte, []int) {
-	return file_internal_proto_rawDescGZIP(), []int{22}
+	return file_internal_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *SegmentStats) GetSegmentID() int64 {
@@ -2341,7 +2548,7 @@ type ChannelTimeTickMsg struct {
 func (x *ChannelTimeTickMsg) Reset() {
 	*x = ChannelTimeTickMsg{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_internal_proto_msgTypes[23]
+		mi := &file_internal_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2354,7 +2561,7 @@ func (x *ChannelTimeTickMsg) String() string {
 func (*ChannelTimeTickMsg) ProtoMessage() {}
 
 func (x *ChannelTimeTickMsg) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_proto_msgTypes[23]
+	mi := &file_internal_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2367,7 +2574,7 @@ func (x *ChannelTimeTickMsg) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ChannelTimeTickMsg.ProtoReflect.Descriptor instead.
 func (*ChannelTimeTickMsg) Descriptor() ([]byte, []int) {
-	return file_internal_proto_rawDescGZIP(), []int{23}
+	return file_internal_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *ChannelTimeTickMsg) GetBase() *commonpb.MsgBase {
@@ -2415,7 +2622,7 @@ type CredentialInfo struct {
 func (x *CredentialInfo) Reset() {
 	*x = CredentialInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_internal_proto_msgTypes[24]
+		mi := &file_internal_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2428,7 +2635,7 @@ func (x *CredentialInfo) String() string {
 func (*CredentialInfo) ProtoMessage() {}
 
 func (x *CredentialInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_proto_msgTypes[24]
+	mi := &file_internal_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2441,7 +2648,7 @@ func (x *CredentialInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CredentialInfo.ProtoReflect.Descriptor instead.
 func (*CredentialInfo) Descriptor() ([]byte, []int) {
-	return file_internal_proto_rawDescGZIP(), []int{24}
+	return file_internal_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *CredentialInfo) GetUsername() string {
@@ -2491,7 +2698,7 @@ type ListPolicyRequest struct {
 func (x *ListPolicyRequest) Reset() {
 	*x = ListPolicyRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_internal_proto_msgTypes[25]
+		mi := &file_internal_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2504,7 +2711,7 @@ func (x *ListPolicyRequest) String() string {
 func (*ListPolicyRequest) ProtoMessage() {}
 
 func (x *ListPolicyRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_proto_msgTypes[25]
+	mi := &file_internal_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2517,7 +2724,7 @@ func (x *ListPolicyRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListPolicyRequest.ProtoReflect.Descriptor instead.
 func (*ListPolicyRequest) Descriptor() ([]byte, []int) {
-	return file_internal_proto_rawDescGZIP(), []int{25}
+	return file_internal_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *ListPolicyRequest) GetBase() *commonpb.MsgBase {
@@ -2542,7 +2749,7 @@ type ListPolicyResponse struct {
 func (x *ListPolicyResponse) Reset() {
 	*x = ListPolicyResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_internal_proto_msgTypes[26]
+		mi := &file_internal_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2555,7 +2762,7 @@ func (x *ListPolicyResponse) String() string {
 func (*ListPolicyResponse) ProtoMessage() {}
 
 func (x *ListPolicyResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_proto_msgTypes[26]
+	mi := &file_internal_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2568,7 +2775,7 @@ func (x *ListPolicyResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListPolicyResponse.ProtoReflect.Descriptor instead.
 func (*ListPolicyResponse) Descriptor() ([]byte, []int) {
-	return file_internal_proto_rawDescGZIP(), []int{26}
+	return file_internal_proto_rawDescGZIP(), []int{28}
 }
 
 func (x *ListPolicyResponse) GetStatus() *commonpb.Status {
@@ -2611,7 +2818,7 @@ type ShowConfigurationsRequest struct {
 func (x *ShowConfigurationsRequest) Reset() {
 	*x = ShowConfigurationsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_internal_proto_msgTypes[27]
+		mi := &file_internal_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2624,7 +2831,7 @@ func (x *ShowConfigurationsRequest) String() string {
 func (*ShowConfigurationsRequest) ProtoMessage() {}
 
 func (x *ShowConfigurationsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_proto_msgTypes[27]
+	mi := &file_internal_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2637,7 +2844,7 @@ func (x *ShowConfigurationsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ShowConfigurationsRequest.ProtoReflect.Descriptor instead.
 func (*ShowConfigurationsRequest) Descriptor() ([]byte, []int) {
-	return file_internal_proto_rawDescGZIP(), []int{27}
+	return file_internal_proto_rawDescGZIP(), []int{29}
 }
 
 func (x *ShowConfigurationsRequest) GetBase() *commonpb.MsgBase {
@@ -2666,7 +2873,7 @@ type ShowConfigurationsResponse struct {
 func (x *ShowConfigurationsResponse) Reset() {
 	*x = ShowConfigurationsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_internal_proto_msgTypes[28]
+		mi := &file_internal_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2679,7 +2886,7 @@ func (x *ShowConfigurationsResponse) String() string {
 func (*ShowConfigurationsResponse) ProtoMessage() {}
 
 func (x *ShowConfigurationsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_proto_msgTypes[28]
+	mi := &file_internal_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2692,7 +2899,7 @@ func (x *ShowConfigurationsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ShowConfigurationsResponse.ProtoReflect.Descriptor instead.
 func (*ShowConfigurationsResponse) Descriptor() ([]byte, []int) {
-	return file_internal_proto_rawDescGZIP(), []int{28}
+	return file_internal_proto_rawDescGZIP(), []int{30}
 }
 
 func (x *ShowConfigurationsResponse) GetStatus() *commonpb.Status {
@@ -2721,7 +2928,7 @@ type Rate struct {
 func (x *Rate) Reset() {
 	*x = Rate{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_internal_proto_msgTypes[29]
+		mi := &file_internal_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2734,7 +2941,7 @@ func (x *Rate) String() string {
 func (*Rate) ProtoMessage() {}
 
 func (x *Rate) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_proto_msgTypes[29]
+	mi := &file_internal_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2747,7 +2954,7 @@ func (x *Rate) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Rate.ProtoReflect.Descriptor instead.
 func (*Rate) Descriptor() ([]byte, []int) {
-	return file_internal_proto_rawDescGZIP(), []int{29}
+	return file_internal_proto_rawDescGZIP(), []int{31}
 }
 
 func (x *Rate) GetRt() RateType {
@@ -2777,7 +2984,7 @@ type ImportFile struct {
 func (x *ImportFile) Reset() {
 	*x = ImportFile{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_internal_proto_msgTypes[30]
+		mi := &file_internal_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2790,7 +2997,7 @@ func (x *ImportFile) String() string {
 func (*ImportFile) ProtoMessage() {}
 
 func (x *ImportFile) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_proto_msgTypes[30]
+	mi := &file_internal_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2803,7 +3010,7 @@ func (x *ImportFile) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ImportFile.ProtoReflect.Descriptor instead.
 func (*ImportFile) Descriptor() ([]byte, []int) {
-	return file_internal_proto_rawDescGZIP(), []int{30}
+	return file_internal_proto_rawDescGZIP(), []int{32}
 }
 
 func (x *ImportFile) GetId() int64 {
@@ -2841,7 +3048,7 @@ type ImportRequestInternal struct {
 func (x *ImportRequestInternal) Reset() {
 	*x = ImportRequestInternal{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_internal_proto_msgTypes[31]
+		mi := &file_internal_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2854,7 +3061,7 @@ func (x *ImportRequestInternal) String() string {
 func (*ImportRequestInternal) ProtoMessage() {}
 
 func (x *ImportRequestInternal) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_proto_msgTypes[31]
+	mi := &file_internal_proto_msgTypes[33]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2867,7 +3074,7 @@ func (x *ImportRequestInternal) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ImportRequestInternal.ProtoReflect.Descriptor instead.
 func (*ImportRequestInternal) Descriptor() ([]byte, []int) {
-	return file_internal_proto_rawDescGZIP(), []int{31}
+	return file_internal_proto_rawDescGZIP(), []int{33}
 }
 
 // Deprecated: Marked as deprecated in internal.proto.
@@ -2956,7 +3163,7 @@ type ImportRequest struct {
 func (x *ImportRequest) Reset() {
 	*x = ImportRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_internal_proto_msgTypes[32]
+		mi := &file_internal_proto_msgTypes[34]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2969,7 +3176,7 @@ func (x *ImportRequest) String() string {
 func (*ImportRequest) ProtoMessage() {}
 
 func (x *ImportRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_proto_msgTypes[32]
+	mi := &file_internal_proto_msgTypes[34]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2982,7 +3189,7 @@ func (x *ImportRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ImportRequest.ProtoReflect.Descriptor instead.
 func (*ImportRequest) Descriptor() ([]byte, []int) {
-	return file_internal_proto_rawDescGZIP(), []int{32}
+	return file_internal_proto_rawDescGZIP(), []int{34}
 }
 
 func (x *ImportRequest) GetDbName() string {
@@ -3032,7 +3239,7 @@ type ImportResponse struct {
 func (x *ImportResponse) Reset() {
 	*x = ImportResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_internal_proto_msgTypes[33]
+		mi := &file_internal_proto_msgTypes[35]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3045,7 +3252,7 @@ func (x *ImportResponse) String() string {
 func (*ImportResponse) ProtoMessage() {}
 
 func (x *ImportResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_proto_msgTypes[33]
+	mi := &file_internal_proto_msgTypes[35]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3058,7 +3265,7 @@ func (x *ImportResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ImportResponse.ProtoReflect.Descriptor instead.
 func (*ImportResponse) Descriptor() ([]byte, []int) {
-	return file_internal_proto_rawDescGZIP(), []int{33}
+	return file_internal_proto_rawDescGZIP(), []int{35}
 }
 
 func (x *ImportResponse) GetStatus() *commonpb.Status {
@@ -3087,7 +3294,7 @@ type GetImportProgressRequest struct {
 func (x *GetImportProgressRequest) Reset() {
 	*x = GetImportProgressRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_internal_proto_msgTypes[34]
+		mi := &file_internal_proto_msgTypes[36]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3100,7 +3307,7 @@ func (x *GetImportProgressRequest) String() string {
 func (*GetImportProgressRequest) ProtoMessage() {}
 
 func (x *GetImportProgressRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_proto_msgTypes[34]
+	mi := &file_internal_proto_msgTypes[36]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3113,7 +3320,7 @@ func (x *GetImportProgressRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetImportProgressRequest.ProtoReflect.Descriptor instead.
 func (*GetImportProgressRequest) Descriptor() ([]byte, []int) {
-	return file_internal_proto_rawDescGZIP(), []int{34}
+	return file_internal_proto_rawDescGZIP(), []int{36}
 }
 
 func (x *GetImportProgressRequest) GetDbName() string {
@@ -3148,7 +3355,7 @@ type ImportTaskProgress struct {
 func (x *ImportTaskProgress) Reset() {
 	*x = ImportTaskProgress{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_internal_proto_msgTypes[35]
+		mi := &file_internal_proto_msgTypes[37]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3161,7 +3368,7 @@ func (x *ImportTaskProgress) String() string {
 func (*ImportTaskProgress) ProtoMessage() {}
 
 func (x *ImportTaskProgress) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_proto_msgTypes[35]
+	mi := &file_internal_proto_msgTypes[37]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3174,7 +3381,7 @@ func (x *ImportTaskProgress) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ImportTaskProgress.ProtoReflect.Descriptor instead.
 func (*ImportTaskProgress) Descriptor() ([]byte, []int) {
-	return file_internal_proto_rawDescGZIP(), []int{35}
+	return file_internal_proto_rawDescGZIP(), []int{37}
 }
 
 func (x *ImportTaskProgress) GetFileName() string {
@@ -3253,7 +3460,7 @@ type GetImportProgressResponse struct {
 func (x *GetImportProgressResponse) Reset() {
 	*x = GetImportProgressResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_internal_proto_msgTypes[36]
+		mi := &file_internal_proto_msgTypes[38]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3266,7 +3473,7 @@ func (x *GetImportProgressResponse) String() string {
 func (*GetImportProgressResponse) ProtoMessage() {}
 
 func (x *GetImportProgressResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_proto_msgTypes[36]
+	mi := &file_internal_proto_msgTypes[38]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3279,7 +3486,7 @@ func (x *GetImportProgressResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetImportProgressResponse.ProtoReflect.Descriptor instead.
 func (*GetImportProgressResponse) Descriptor() ([]byte, []int) {
-	return file_internal_proto_rawDescGZIP(), []int{36}
+	return file_internal_proto_rawDescGZIP(), []int{38}
 }
 
 func (x *GetImportProgressResponse) GetStatus() *commonpb.Status {
@@ -3364,7 +3571,7 @@ type ListImportsRequestInternal struct {
 func (x *ListImportsRequestInternal) Reset() {
 	*x = ListImportsRequestInternal{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_internal_proto_msgTypes[37]
+		mi := &file_internal_proto_msgTypes[39]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3377,7 +3584,7 @@ func (x *ListImportsRequestInternal) String() string {
 func (*ListImportsRequestInternal) ProtoMessage() {}
 
 func (x *ListImportsRequestInternal) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_proto_msgTypes[37]
+	mi := &file_internal_proto_msgTypes[39]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3390,7 +3597,7 @@ func (x *ListImportsRequestInternal) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListImportsRequestInternal.ProtoReflect.Descriptor instead.
 func (*ListImportsRequestInternal) Descriptor() ([]byte, []int) {
-	return file_internal_proto_rawDescGZIP(), []int{37}
+	return file_internal_proto_rawDescGZIP(), []int{39}
 }
 
 func (x *ListImportsRequestInternal) GetDbID() int64 {
@@ -3419,7 +3626,7 @@ type ListImportsRequest struct {
 func (x *ListImportsRequest) Reset() {
 	*x = ListImportsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_internal_proto_msgTypes[38]
+		mi := &file_internal_proto_msgTypes[40]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3432,7 +3639,7 @@ func (x *ListImportsRequest) String() string {
 func (*ListImportsRequest) ProtoMessage() {}
 
 func (x *ListImportsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_proto_msgTypes[38]
+	mi := &file_internal_proto_msgTypes[40]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3445,7 +3652,7 @@ func (x *ListImportsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListImportsRequest.ProtoReflect.Descriptor instead.
 func (*ListImportsRequest) Descriptor() ([]byte, []int) {
-	return file_internal_proto_rawDescGZIP(), []int{38}
+	return file_internal_proto_rawDescGZIP(), []int{40}
 }
 
 func (x *ListImportsRequest) GetDbName() string {
@@ -3478,7 +3685,7 @@ type ListImportsResponse struct {
 func (x *ListImportsResponse) Reset() {
 	*x = ListImportsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_internal_proto_msgTypes[39]
+		mi := &file_internal_proto_msgTypes[41]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3491,7 +3698,7 @@ func (x *ListImportsResponse) String() string {
 func (*ListImportsResponse) ProtoMessage() {}
 
 func (x *ListImportsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_proto_msgTypes[39]
+	mi := &file_internal_proto_msgTypes[41]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3504,7 +3711,7 @@ func (x *ListImportsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListImportsResponse.ProtoReflect.Descriptor instead.
 func (*ListImportsResponse) Descriptor() ([]byte, []int) {
-	return file_internal_proto_rawDescGZIP(), []int{39}
+	return file_internal_proto_rawDescGZIP(), []int{41}
 }
 
 func (x *ListImportsResponse) GetStatus() *commonpb.Status {
@@ -3843,7 +4050,7 @@ var file_internal_proto_rawDesc = []byte{
 	0x14, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x65, 0x64, 0x44, 0x61, 0x74,
 	0x61, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x14, 0x74, 0x6f, 0x74,
 	0x61, 0x6c, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x65, 0x64, 0x44, 0x61, 0x74, 0x61, 0x53, 0x69, 0x7a,
-	0x65, 0x22, 0x97, 0x06, 0x0a, 0x0f, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x52, 0x65,
+	0x65, 0x22, 0xd3, 0x07, 0x0a, 0x0f, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x52, 0x65,
 	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x30, 0x0a, 0x04, 0x62, 0x61, 0x73, 0x65, 0x18, 0x01, 0x20,
 	0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f,
 	0x74, 0x6f, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x4d, 0x73, 0x67, 0x42, 0x61, 0x73,
@@ -3892,7 +4099,31 @@ var file_internal_proto_rawDesc = []byte{
 	0x6e, 0x63, 0x79, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x52, 0x10, 0x63, 0x6f, 0x6e, 0x73, 0x69, 0x73,
 	0x74, 0x65, 0x6e, 0x63, 0x79, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x73,
 	0x5f, 0x69, 0x74, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x13, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x0a, 0x69, 0x73, 0x49, 0x74, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x22, 0xd0, 0x04, 0x0a, 0x0f,
+	0x0a, 0x69, 0x73, 0x49, 0x74, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x4b, 0x0a, 0x0f, 0x6f,
+	0x72, 0x64, 0x65, 0x72, 0x5f, 0x62, 0x79, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x14,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2e, 0x4f, 0x72, 0x64,
+	0x65, 0x72, 0x42, 0x79, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x52, 0x0d, 0x6f, 0x72, 0x64, 0x65, 0x72,
+	0x42, 0x79, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x12, 0x2b, 0x0a, 0x12, 0x67, 0x72, 0x6f, 0x75,
+	0x70, 0x5f, 0x62, 0x79, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x15,
+	0x20, 0x03, 0x28, 0x03, 0x52, 0x0f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x42, 0x79, 0x46, 0x69, 0x65,
+	0x6c, 0x64, 0x49, 0x64, 0x73, 0x12, 0x40, 0x0a, 0x0a, 0x61, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61,
+	0x74, 0x65, 0x73, 0x18, 0x16, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6d, 0x69, 0x6c, 0x76,
+	0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61,
+	0x6c, 0x2e, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x52, 0x0a, 0x61, 0x67, 0x67,
+	0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x73, 0x22, 0x67, 0x0a, 0x0c, 0x4f, 0x72, 0x64, 0x65, 0x72,
+	0x42, 0x79, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x66, 0x69, 0x65, 0x6c, 0x64,
+	0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x49,
+	0x44, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x73, 0x63, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x61, 0x73, 0x63, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x12,
+	0x1f, 0x0a, 0x0b, 0x6e, 0x75, 0x6c, 0x6c, 0x73, 0x5f, 0x66, 0x69, 0x72, 0x73, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x6e, 0x75, 0x6c, 0x6c, 0x73, 0x46, 0x69, 0x72, 0x73, 0x74,
+	0x22, 0x59, 0x0a, 0x09, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x12, 0x32, 0x0a,
+	0x02, 0x6f, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x22, 0x2e, 0x6d, 0x69, 0x6c, 0x76,
+	0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61,
+	0x6c, 0x2e, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x4f, 0x70, 0x52, 0x02, 0x6f,
+	0x70, 0x12, 0x18, 0x0a, 0x07, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x07, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x49, 0x44, 0x22, 0xd0, 0x04, 0x0a, 0x0f,
 	0x52, 0x65, 0x74, 0x72, 0x69, 0x65, 0x76, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x12,
 	0x30, 0x0a, 0x04, 0x62, 0x61, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e,
 	0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x63, 0x6f, 0x6d,
@@ -4164,35 +4395,40 @@ var file_internal_proto_rawDesc = []byte{
 	0x65, 0x73, 0x73, 0x65, 0x73, 0x12, 0x29, 0x0a, 0x10, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74,
 	0x69, 0x6f, 0x6e, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52,
 	0x0f, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x73,
-	0x2a, 0x45, 0x0a, 0x09, 0x52, 0x61, 0x74, 0x65, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x12, 0x0b, 0x0a,
-	0x07, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08, 0x44, 0x61,
-	0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x10, 0x01, 0x12, 0x0e, 0x0a, 0x0a, 0x43, 0x6f, 0x6c, 0x6c,
-	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x10, 0x02, 0x12, 0x0d, 0x0a, 0x09, 0x50, 0x61, 0x72, 0x74,
-	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x10, 0x03, 0x2a, 0xb9, 0x01, 0x0a, 0x08, 0x52, 0x61, 0x74, 0x65,
-	0x54, 0x79, 0x70, 0x65, 0x12, 0x11, 0x0a, 0x0d, 0x44, 0x44, 0x4c, 0x43, 0x6f, 0x6c, 0x6c, 0x65,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x10, 0x00, 0x12, 0x10, 0x0a, 0x0c, 0x44, 0x44, 0x4c, 0x50, 0x61,
-	0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x10, 0x01, 0x12, 0x0c, 0x0a, 0x08, 0x44, 0x44, 0x4c,
-	0x49, 0x6e, 0x64, 0x65, 0x78, 0x10, 0x02, 0x12, 0x0c, 0x0a, 0x08, 0x44, 0x44, 0x4c, 0x46, 0x6c,
-	0x75, 0x73, 0x68, 0x10, 0x03, 0x12, 0x11, 0x0a, 0x0d, 0x44, 0x44, 0x4c, 0x43, 0x6f, 0x6d, 0x70,
-	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x10, 0x04, 0x12, 0x0d, 0x0a, 0x09, 0x44, 0x4d, 0x4c, 0x49,
-	0x6e, 0x73, 0x65, 0x72, 0x74, 0x10, 0x05, 0x12, 0x0d, 0x0a, 0x09, 0x44, 0x4d, 0x4c, 0x44, 0x65,
-	0x6c, 0x65, 0x74, 0x65, 0x10, 0x06, 0x12, 0x0f, 0x0a, 0x0b, 0x44, 0x4d, 0x4c, 0x42, 0x75, 0x6c,
-	0x6b, 0x4c, 0x6f, 0x61, 0x64, 0x10, 0x07, 0x12, 0x0d, 0x0a, 0x09, 0x44, 0x51, 0x4c, 0x53, 0x65,
-	0x61, 0x72, 0x63, 0x68, 0x10, 0x08, 0x12, 0x0c, 0x0a, 0x08, 0x44, 0x51, 0x4c, 0x51, 0x75, 0x65,
-	0x72, 0x79, 0x10, 0x09, 0x12, 0x0d, 0x0a, 0x09, 0x44, 0x4d, 0x4c, 0x55, 0x70, 0x73, 0x65, 0x72,
-	0x74, 0x10, 0x0a, 0x2a, 0x81, 0x01, 0x0a, 0x0e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x4a, 0x6f,
-	0x62, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x08, 0x0a, 0x04, 0x4e, 0x6f, 0x6e, 0x65, 0x10, 0x00,
-	0x12, 0x0b, 0x0a, 0x07, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x10, 0x01, 0x12, 0x10, 0x0a,
-	0x0c, 0x50, 0x72, 0x65, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x10, 0x02, 0x12,
-	0x0d, 0x0a, 0x09, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x10, 0x03, 0x12, 0x0a,
-	0x0a, 0x06, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x10, 0x04, 0x12, 0x0d, 0x0a, 0x09, 0x43, 0x6f,
-	0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x10, 0x05, 0x12, 0x11, 0x0a, 0x0d, 0x49, 0x6e, 0x64,
-	0x65, 0x78, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x69, 0x6e, 0x67, 0x10, 0x06, 0x12, 0x09, 0x0a, 0x05,
-	0x53, 0x74, 0x61, 0x74, 0x73, 0x10, 0x07, 0x42, 0x35, 0x5a, 0x33, 0x67, 0x69, 0x74, 0x68, 0x75,
-	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2d, 0x69, 0x6f, 0x2f,
-	0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x76, 0x32, 0x2f, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x70, 0x62, 0x62, 0x06,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x2a, 0x3c, 0x0a, 0x0b, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x4f, 0x70, 0x12,
+	0x09, 0x0a, 0x05, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x10, 0x00, 0x12, 0x07, 0x0a, 0x03, 0x53, 0x75,
+	0x6d, 0x10, 0x01, 0x12, 0x07, 0x0a, 0x03, 0x4d, 0x69, 0x6e, 0x10, 0x02, 0x12, 0x07, 0x0a, 0x03,
+	0x4d, 0x61, 0x78, 0x10, 0x03, 0x12, 0x07, 0x0a, 0x03, 0x41, 0x76, 0x67, 0x10, 0x04, 0x2a, 0x59,
+	0x0a, 0x09, 0x52, 0x61, 0x74, 0x65, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x43,
+	0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08, 0x44, 0x61, 0x74, 0x61,
+	0x62, 0x61, 0x73, 0x65, 0x10, 0x01, 0x12, 0x0e, 0x0a, 0x0a, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x10, 0x02, 0x12, 0x0d, 0x0a, 0x09, 0x50, 0x61, 0x72, 0x74, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x10, 0x03, 0x12, 0x08, 0x0a, 0x04, 0x55, 0x73, 0x65, 0x72, 0x10, 0x04, 0x12,
+	0x08, 0x0a, 0x04, 0x52, 0x6f, 0x6c, 0x65, 0x10, 0x05, 0x2a, 0xb9, 0x01, 0x0a, 0x08, 0x52, 0x61,
+	0x74, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x11, 0x0a, 0x0d, 0x44, 0x44, 0x4c, 0x43, 0x6f, 0x6c,
+	0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x10, 0x00, 0x12, 0x10, 0x0a, 0x0c, 0x44, 0x44, 0x4c,
+	0x50, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x10, 0x01, 0x12, 0x0c, 0x0a, 0x08, 0x44,
+	0x44, 0x4c, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x10, 0x02, 0x12, 0x0c, 0x0a, 0x08, 0x44, 0x44, 0x4c,
+	0x46, 0x6c, 0x75, 0x73, 0x68, 0x10, 0x03, 0x12, 0x11, 0x0a, 0x0d, 0x44, 0x44, 0x4c, 0x43, 0x6f,
+	0x6d, 0x70, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x10, 0x04, 0x12, 0x0d, 0x0a, 0x09, 0x44, 0x4d,
+	0x4c, 0x49, 0x6e, 0x73, 0x65, 0x72, 0x74, 0x10, 0x05, 0x12, 0x0d, 0x0a, 0x09, 0x44, 0x4d, 0x4c,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x10, 0x06, 0x12, 0x0f, 0x0a, 0x0b, 0x44, 0x4d, 0x4c, 0x42,
+	0x75, 0x6c, 0x6b, 0x4c, 0x6f, 0x61, 0x64, 0x10, 0x07, 0x12, 0x0d, 0x0a, 0x09, 0x44, 0x51, 0x4c,
+	0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x10, 0x08, 0x12, 0x0c, 0x0a, 0x08, 0x44, 0x51, 0x4c, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x10, 0x09, 0x12, 0x0d, 0x0a, 0x09, 0x44, 0x4d, 0x4c, 0x55, 0x70, 0x73,
+	0x65, 0x72, 0x74, 0x10, 0x0a, 0x2a, 0x81, 0x01, 0x0a, 0x0e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74,
+	0x4a, 0x6f, 0x62, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x08, 0x0a, 0x04, 0x4e, 0x6f, 0x6e, 0x65,
+	0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x10, 0x01, 0x12,
+	0x10, 0x0a, 0x0c, 0x50, 0x72, 0x65, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x10,
+	0x02, 0x12, 0x0d, 0x0a, 0x09, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x10, 0x03,
+	0x12, 0x0a, 0x0a, 0x06, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x10, 0x04, 0x12, 0x0d, 0x0a, 0x09,
+	0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x10, 0x05, 0x12, 0x11, 0x0a, 0x0d, 0x49,
+	0x6e, 0x64, 0x65, 0x78, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x69, 0x6e, 0x67, 0x10, 0x06, 0x12, 0x09,
+	0x0a, 0x05, 0x53, 0x74, 0x61, 0x74, 0x73, 0x10, 0x07, 0x42, 0x35, 0x5a, 0x33, 0x67, 0x69, 0x74,
+	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2d, 0x69,
+	0x6f, 0x2f, 0x6d, 0x69, 0x6c, 0x76, 0x75, 0x73, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x76, 0x32, 0x2f,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x70, 0x62,
+	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -4207,122 +4443,128 @@ func file_internal_proto_rawDescGZIP() []byte {
 	return file_internal_proto_rawDescData
 }
 
-var file_internal_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
-var file_internal_proto_msgTypes = make([]protoimpl.MessageInfo, 41)
+var file_internal_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
+var file_internal_proto_msgTypes = make([]protoimpl.MessageInfo, 43)
 var file_internal_proto_goTypes = []interface{}{
-	(RateScope)(0),                      // 0: milvus.proto.internal.RateScope
-	(RateType)(0),                       // 1: milvus.proto.internal.RateType
-	(ImportJobState)(0),                 // 2: milvus.proto.internal.ImportJobState
-	(*GetTimeTickChannelRequest)(nil),   // 3: milvus.proto.internal.GetTimeTickChannelRequest
-	(*GetStatisticsChannelRequest)(nil), // 4: milvus.proto.internal.GetStatisticsChannelRequest
-	(*GetDdChannelRequest)(nil),         // 5: milvus.proto.internal.GetDdChannelRequest
-	(*NodeInfo)(nil),                    // 6: milvus.proto.internal.NodeInfo
-	(*InitParams)(nil),                  // 7: milvus.proto.internal.InitParams
-	(*StringList)(nil),                  // 8: milvus.proto.internal.StringList
-	(*GetStatisticsRequest)(nil),        // 9: milvus.proto.internal.GetStatisticsRequest
-	(*GetStatisticsResponse)(nil),       // 10: milvus.proto.internal.GetStatisticsResponse
-	(*CreateAliasRequest)(nil),          // 11: milvus.proto.internal.CreateAliasRequest
-	(*DropAliasRequest)(nil),            // 12: milvus.proto.internal.DropAliasRequest
-	(*AlterAliasRequest)(nil),           // 13: milvus.proto.internal.AlterAliasRequest
-	(*CreateIndexRequest)(nil),          // 14: milvus.proto.internal.CreateIndexRequest
-	(*SubSearchRequest)(nil),            // 15: milvus.proto.internal.SubSearchRequest
-	(*SearchRequest)(nil),               // 16: milvus.proto.internal.SearchRequest
-	(*SubSearchResults)(nil),            // 17: milvus.proto.internal.SubSearchResults
-	(*SearchResults)(nil),               // 18: milvus.proto.internal.SearchResults
-	(*CostAggregation)(nil),             // 19: milvus.proto.internal.CostAggregation
-	(*RetrieveRequest)(nil),             // 20: milvus.proto.internal.RetrieveRequest
-	(*RetrieveResults)(nil),             // 21: milvus.proto.internal.RetrieveResults
-	(*LoadIndex)(nil),                   // 22: milvus.proto.internal.LoadIndex
-	(*IndexStats)(nil),                  // 23: milvus.proto.internal.IndexStats
-	(*FieldStats)(nil),                  // 24: milvus.proto.internal.FieldStats
-	(*SegmentStats)(nil),                // 25: milvus.proto.internal.SegmentStats
-	(*ChannelTimeTickMsg)(nil),          // 26: milvus.proto.internal.ChannelTimeTickMsg
-	(*CredentialInfo)(nil),              // 27: milvus.proto.internal.CredentialInfo
-	(*ListPolicyRequest)(nil),           // 28: milvus.proto.internal.ListPolicyRequest
-	(*ListPolicyResponse)(nil),          // 29: milvus.proto.internal.ListPolicyResponse
-	(*ShowConfigurationsRequest)(nil),   // 30: milvus.proto.internal.ShowConfigurationsRequest
-	(*ShowConfigurationsResponse)(nil),  // 31: milvus.proto.internal.ShowConfigurationsResponse
-	(*Rate)(nil),                        // 32: milvus.proto.internal.Rate
-	(*ImportFile)(nil),                  // 33: milvus.proto.internal.ImportFile
-	(*ImportRequestInternal)(nil),       // 34: milvus.proto.internal.ImportRequestInternal
-	(*ImportRequest)(nil),               // 35: milvus.proto.internal.ImportRequest
-	(*ImportResponse)(nil),              // 36: milvus.proto.internal.ImportResponse
-	(*GetImportProgressRequest)(nil),    // 37: milvus.proto.internal.GetImportProgressRequest
-	(*ImportTaskProgress)(nil),          // 38: milvus.proto.internal.ImportTaskProgress
-	(*GetImportProgressResponse)(nil),   // 39: milvus.proto.internal.GetImportProgressResponse
-	(*ListImportsRequestInternal)(nil),  // 40: milvus.proto.internal.ListImportsRequestInternal
-	(*ListImportsRequest)(nil),          // 41: milvus.proto.internal.ListImportsRequest
-	(*ListImportsResponse)(nil),         // 42: milvus.proto.internal.ListImportsResponse
-	nil,                                 // 43: milvus.proto.internal.SearchResults.ChannelsMvccEntry
-	(*commonpb.Address)(nil),            // 44: milvus.proto.common.Address
-	(*commonpb.KeyValuePair)(nil),       // 45: milvus.proto.common.KeyValuePair
-	(*commonpb.Status)(nil),             // 46: milvus.proto.common.Status
-	(*commonpb.MsgBase)(nil),            // 47: milvus.proto.common.MsgBase
-	(commonpb.DslType)(0),               // 48: milvus.proto.common.DslType
-	(commonpb.ConsistencyLevel)(0),      // 49: milvus.proto.common.ConsistencyLevel
-	(*schemapb.IDs)(nil),                // 50: milvus.proto.schema.IDs
-	(*schemapb.FieldData)(nil),          // 51: milvus.proto.schema.FieldData
-	(*milvuspb.PrivilegeGroupInfo)(nil), // 52: milvus.proto.milvus.PrivilegeGroupInfo
-	(*schemapb.CollectionSchema)(nil),   // 53: milvus.proto.schema.CollectionSchema
+	(AggregateOp)(0),                    // 0: milvus.proto.internal.AggregateOp
+	(RateScope)(0),                      // 1: milvus.proto.internal.RateScope
+	(RateType)(0),                       // 2: milvus.proto.internal.RateType
+	(ImportJobState)(0),                 // 3: milvus.proto.internal.ImportJobState
+	(*GetTimeTickChannelRequest)(nil),   // 4: milvus.proto.internal.GetTimeTickChannelRequest
+	(*GetStatisticsChannelRequest)(nil), // 5: milvus.proto.internal.GetStatisticsChannelRequest
+	(*GetDdChannelRequest)(nil),         // 6: milvus.proto.internal.GetDdChannelRequest
+	(*NodeInfo)(nil),                    // 7: milvus.proto.internal.NodeInfo
+	(*InitParams)(nil),                  // 8: milvus.proto.internal.InitParams
+	(*StringList)(nil),                  // 9: milvus.proto.internal.StringList
+	(*GetStatisticsRequest)(nil),        // 10: milvus.proto.internal.GetStatisticsRequest
+	(*GetStatisticsResponse)(nil),       // 11: milvus.proto.internal.GetStatisticsResponse
+	(*CreateAliasRequest)(nil),          // 12: milvus.proto.internal.CreateAliasRequest
+	(*DropAliasRequest)(nil),            // 13: milvus.proto.internal.DropAliasRequest
+	(*AlterAliasRequest)(nil),           // 14: milvus.proto.internal.AlterAliasRequest
+	(*CreateIndexRequest)(nil),          // 15: milvus.proto.internal.CreateIndexRequest
+	(*SubSearchRequest)(nil),            // 16: milvus.proto.internal.SubSearchRequest
+	(*SearchRequest)(nil),               // 17: milvus.proto.internal.SearchRequest
+	(*SubSearchResults)(nil),            // 18: milvus.proto.internal.SubSearchResults
+	(*SearchResults)(nil),               // 19: milvus.proto.internal.SearchResults
+	(*CostAggregation)(nil),             // 20: milvus.proto.internal.CostAggregation
+	(*RetrieveRequest)(nil),             // 21: milvus.proto.internal.RetrieveRequest
+	(*OrderByField)(nil),                // 22: milvus.proto.internal.OrderByField
+	(*Aggregate)(nil),                   // 23: milvus.proto.internal.Aggregate
+	(*RetrieveResults)(nil),             // 24: milvus.proto.internal.RetrieveResults
+	(*LoadIndex)(nil),                   // 25: milvus.proto.internal.LoadIndex
+	(*IndexStats)(nil),                  // 26: milvus.proto.internal.IndexStats
+	(*FieldStats)(nil),                  // 27: milvus.proto.internal.FieldStats
+	(*SegmentStats)(nil),                // 28: milvus.proto.internal.SegmentStats
+	(*ChannelTimeTickMsg)(nil),          // 29: milvus.proto.internal.ChannelTimeTickMsg
+	(*CredentialInfo)(nil),              // 30: milvus.proto.internal.CredentialInfo
+	(*ListPolicyRequest)(nil),           // 31: milvus.proto.internal.ListPolicyRequest
+	(*ListPolicyResponse)(nil),          // 32: milvus.proto.internal.ListPolicyResponse
+	(*ShowConfigurationsRequest)(nil),   // 33: milvus.proto.internal.ShowConfigurationsRequest
+	(*ShowConfigurationsResponse)(nil),  // 34: milvus.proto.internal.ShowConfigurationsResponse
+	(*Rate)(nil),                        // 35: milvus.proto.internal.Rate
+	(*ImportFile)(nil),                  // 36: milvus.proto.internal.ImportFile
+	(*ImportRequestInternal)(nil),       // 37: milvus.proto.internal.ImportRequestInternal
+	(*ImportRequest)(nil),               // 38: milvus.proto.internal.ImportRequest
+	(*ImportResponse)(nil),              // 39: milvus.proto.internal.ImportResponse
+	(*GetImportProgressRequest)(nil),    // 40: milvus.proto.internal.GetImportProgressRequest
+	(*ImportTaskProgress)(nil),          // 41: milvus.proto.internal.ImportTaskProgress
+	(*GetImportProgressResponse)(nil),   // 42: milvus.proto.internal.GetImportProgressResponse
+	(*ListImportsRequestInternal)(nil),  // 43: milvus.proto.internal.ListImportsRequestInternal
+	(*ListImportsRequest)(nil),          // 44: milvus.proto.internal.ListImportsRequest
+	(*ListImportsResponse)(nil),         // 45: milvus.proto.internal.ListImportsResponse
+	nil,                                 // 46: milvus.proto.internal.SearchResults.ChannelsMvccEntry
+	(*commonpb.Address)(nil),            // 47: milvus.proto.common.Address
+	(*commonpb.KeyValuePair)(nil),       // 48: milvus.proto.common.KeyValuePair
+	(*commonpb.Status)(nil),             // 49: milvus.proto.common.Status
+	(*commonpb.MsgBase)(nil),            // 50: milvus.proto.common.MsgBase
+	(commonpb.DslType)(0),               // 51: milvus.proto.common.DslType
+	(commonpb.ConsistencyLevel)(0),      // 52: milvus.proto.common.ConsistencyLevel
+	(*schemapb.IDs)(nil),                // 53: milvus.proto.schema.IDs
+	(*schemapb.FieldData)(nil),          // 54: milvus.proto.schema.FieldData
+	(*milvuspb.PrivilegeGroupInfo)(nil), // 55: milvus.proto.milvus.PrivilegeGroupInfo
+	(*schemapb.CollectionSchema)(nil),   // 56: milvus.proto.schema.CollectionSchema
 }
 var file_internal_proto_depIdxs = []int32{
-	44, // 0: milvus.proto.internal.NodeInfo.address:type_name -> milvus.proto.common.Address
-	45, // 1: milvus.proto.internal.InitParams.start_params:type_name -> milvus.proto.common.KeyValuePair
-	46, // 2: milvus.proto.internal.StringList.status:type_name -> milvus.proto.common.Status
-	47, // 3: milvus.proto.internal.GetStatisticsRequest.base:type_name -> milvus.proto.common.MsgBase
-	47, // 4: milvus.proto.internal.GetStatisticsResponse.base:type_name -> milvus.proto.common.MsgBase
-	46, // 5: milvus.proto.internal.GetStatisticsResponse.status:type_name -> milvus.proto.common.Status
-	45, // 6: milvus.proto.internal.GetStatisticsResponse.stats:type_name -> milvus.proto.common.KeyValuePair
-	47, // 7: milvus.proto.internal.CreateAliasRequest.base:type_name -> milvus.proto.common.MsgBase
-	47, // 8: milvus.proto.internal.DropAliasRequest.base:type_name -> milvus.proto.common.MsgBase
-	47, // 9: milvus.proto.internal.AlterAliasRequest.base:type_name -> milvus.proto.common.MsgBase
-	47, // 10: milvus.proto.internal.CreateIndexRequest.base:type_name -> milvus.proto.common.MsgBase
-	45, // 11: milvus.proto.internal.CreateIndexRequest.extra_params:type_name -> milvus.proto.common.KeyValuePair
-	48, // 12: milvus.proto.internal.SubSearchRequest.dsl_type:type_name -> milvus.proto.common.DslType
-	47, // 13: milvus.proto.internal.SearchRequest.base:type_name -> milvus.proto.common.MsgBase
-	48, // 14: milvus.proto.internal.SearchRequest.dsl_type:type_name -> milvus.proto.common.DslType
-	15, // 15: milvus.proto.internal.SearchRequest.sub_reqs:type_name -> milvus.proto.internal.SubSearchRequest
-	49, // 16: milvus.proto.internal.SearchRequest.consistency_level:type_name -> milvus.proto.common.ConsistencyLevel
-	47, // 17: milvus.proto.internal.SearchResults.base:type_name -> milvus.proto.common.MsgBase
-	46, // 18: milvus.proto.internal.SearchResults.status:type_name -> milvus.proto.common.Status
-	19, // 19: milvus.proto.internal.SearchResults.costAggregation:type_name -> milvus.proto.internal.CostAggregation
-	43, // 20: milvus.proto.internal.SearchResults.channels_mvcc:type_name -> milvus.proto.internal.SearchResults.ChannelsMvccEntry
-	17, // 21: milvus.proto.internal.SearchResults.sub_results:type_name -> milvus.proto.internal.SubSearchResults
-	47, // 22: milvus.proto.internal.RetrieveRequest.base:type_name -> milvus.proto.common.MsgBase
-	49, // 23: milvus.proto.internal.RetrieveRequest.consistency_level:type_name -> milvus.proto.common.ConsistencyLevel
-	47, // 24: milvus.proto.internal.RetrieveResults.base:type_name -> milvus.proto.common.MsgBase
-	46, // 25: milvus.proto.internal.RetrieveResults.status:type_name -> milvus.proto.common.Status
-	50, // 26: milvus.proto.internal.RetrieveResults.ids:type_name -> milvus.proto.schema.IDs
-	51, // 27: milvus.proto.internal.RetrieveResults.fields_data:type_name -> milvus.proto.schema.FieldData
-	19, // 28: milvus.proto.internal.RetrieveResults.costAggregation:type_name -> milvus.proto.internal.CostAggregation
-	47, // 29: milvus.proto.internal.LoadIndex.base:type_name -> milvus.proto.common.MsgBase
-	45, // 30: milvus.proto.internal.LoadIndex.index_params:type_name -> milvus.proto.common.KeyValuePair
-	45, // 31: milvus.proto.internal.IndexStats.index_params:type_name -> milvus.proto.common.KeyValuePair
-	23, // 32: milvus.proto.internal.FieldStats.index_stats:type_name -> milvus.proto.internal.IndexStats
-	47, // 33: milvus.proto.internal.ChannelTimeTickMsg.base:type_name -> milvus.proto.common.MsgBase
-	47, // 34: milvus.proto.internal.ListPolicyRequest.base:type_name -> milvus.proto.common.MsgBase
-	46, // 35: milvus.proto.internal.ListPolicyResponse.status:type_name -> milvus.proto.common.Status
-	52, // 36: milvus.proto.internal.ListPolicyResponse.privilege_groups:type_name -> milvus.proto.milvus.PrivilegeGroupInfo
-	47, // 37: milvus.proto.internal.ShowConfigurationsRequest.base:type_name -> milvus.proto.common.MsgBase
-	46, // 38: milvus.proto.internal.ShowConfigurationsResponse.status:type_name -> milvus.proto.common.Status
-	45, // 39: milvus.proto.internal.ShowConfigurationsResponse.configuations:type_name -> milvus.proto.common.KeyValuePair
-	1,  // 40: milvus.proto.internal.Rate.rt:type_name -> milvus.proto.internal.RateType
-	53, // 41: milvus.proto.internal.ImportRequestInternal.schema:type_name -> milvus.proto.schema.CollectionSchema
-	33, // 42: milvus.proto.internal.ImportRequestInternal.files:type_name -> milvus.proto.internal.ImportFile
-	45, // 43: milvus.proto.internal.ImportRequestInternal.options:type_name -> milvus.proto.common.KeyValuePair
-	33, // 44: milvus.proto.internal.ImportRequest.files:type_name -> milvus.proto.internal.ImportFile
-	45, // 45: milvus.proto.internal.ImportRequest.options:type_name -> milvus.proto.common.KeyValuePair
-	46, // 46: milvus.proto.internal.ImportResponse.status:type_name -> milvus.proto.common.Status
-	46, // 47: milvus.proto.internal.GetImportProgressResponse.status:type_name -> milvus.proto.common.Status
-	2,  // 48: milvus.proto.internal.GetImportProgressResponse.state:type_name -> milvus.proto.internal.ImportJobState
-	38, // 49: milvus.proto.internal.GetImportProgressResponse.task_progresses:type_name -> milvus.proto.internal.ImportTaskProgress
-	46, // 50: milvus.proto.internal.ListImportsResponse.status:type_name -> milvus.proto.common.Status
-	2,  // 51: milvus.proto.internal.ListImportsResponse.states:type_name -> milvus.proto.internal.ImportJobState
-	52, // [52:52] is the sub-list for method output_type
-	52, // [52:52] is the sub-list for method input_type
-	52, // [52:52] is the sub-list for extension type_name
-	52, // [52:52] is the sub-list for extension extendee
-	0,  // [0:52] is the sub-list for field type_name
+	47, // 0: milvus.proto.internal.NodeInfo.address:type_name -> milvus.proto.common.Address
+	48, // 1: milvus.proto.internal.InitParams.start_params:type_name -> milvus.proto.common.KeyValuePair
+	49, // 2: milvus.proto.internal.StringList.status:type_name -> milvus.proto.common.Status
+	50, // 3: milvus.proto.internal.GetStatisticsRequest.base:type_name -> milvus.proto.common.MsgBase
+	50, // 4: milvus.proto.internal.GetStatisticsResponse.base:type_name -> milvus.proto.common.MsgBase
+	49, // 5: milvus.proto.internal.GetStatisticsResponse.status:type_name -> milvus.proto.common.Status
+	48, // 6: milvus.proto.internal.GetStatisticsResponse.stats:type_name -> milvus.proto.common.KeyValuePair
+	50, // 7: milvus.proto.internal.CreateAliasRequest.base:type_name -> milvus.proto.common.MsgBase
+	50, // 8: milvus.proto.internal.DropAliasRequest.base:type_name -> milvus.proto.common.MsgBase
+	50, // 9: milvus.proto.internal.AlterAliasRequest.base:type_name -> milvus.proto.common.MsgBase
+	50, // 10: milvus.proto.internal.CreateIndexRequest.base:type_name -> milvus.proto.common.MsgBase
+	48, // 11: milvus.proto.internal.CreateIndexRequest.extra_params:type_name -> milvus.proto.common.KeyValuePair
+	51, // 12: milvus.proto.internal.SubSearchRequest.dsl_type:type_name -> milvus.proto.common.DslType
+	50, // 13: milvus.proto.internal.SearchRequest.base:type_name -> milvus.proto.common.MsgBase
+	51, // 14: milvus.proto.internal.SearchRequest.dsl_type:type_name -> milvus.proto.common.DslType
+	16, // 15: milvus.proto.internal.SearchRequest.sub_reqs:type_name -> milvus.proto.internal.SubSearchRequest
+	52, // 16: milvus.proto.internal.SearchRequest.consistency_level:type_name -> milvus.proto.common.ConsistencyLevel
+	50, // 17: milvus.proto.internal.SearchResults.base:type_name -> milvus.proto.common.MsgBase
+	49, // 18: milvus.proto.internal.SearchResults.status:type_name -> milvus.proto.common.Status
+	20, // 19: milvus.proto.internal.SearchResults.costAggregation:type_name -> milvus.proto.internal.CostAggregation
+	46, // 20: milvus.proto.internal.SearchResults.channels_mvcc:type_name -> milvus.proto.internal.SearchResults.ChannelsMvccEntry
+	18, // 21: milvus.proto.internal.SearchResults.sub_results:type_name -> milvus.proto.internal.SubSearchResults
+	50, // 22: milvus.proto.internal.RetrieveRequest.base:type_name -> milvus.proto.common.MsgBase
+	52, // 23: milvus.proto.internal.RetrieveRequest.consistency_level:type_name -> milvus.proto.common.ConsistencyLevel
+	22, // 24: milvus.proto.internal.RetrieveRequest.order_by_fields:type_name -> milvus.proto.internal.OrderByField
+	23, // 25: milvus.proto.internal.RetrieveRequest.aggregates:type_name -> milvus.proto.internal.Aggregate
+	0,  // 26: milvus.proto.internal.Aggregate.op:type_name -> milvus.proto.internal.AggregateOp
+	50, // 27: milvus.proto.internal.RetrieveResults.base:type_name -> milvus.proto.common.MsgBase
+	49, // 28: milvus.proto.internal.RetrieveResults.status:type_name -> milvus.proto.common.Status
+	53, // 29: milvus.proto.internal.RetrieveResults.ids:type_name -> milvus.proto.schema.IDs
+	54, // 30: milvus.proto.internal.RetrieveResults.fields_data:type_name -> milvus.proto.schema.FieldData
+	20, // 31: milvus.proto.internal.RetrieveResults.costAggregation:type_name -> milvus.proto.internal.CostAggregation
+	50, // 32: milvus.proto.internal.LoadIndex.base:type_name -> milvus.proto.common.MsgBase
+	48, // 33: milvus.proto.internal.LoadIndex.index_params:type_name -> milvus.proto.common.KeyValuePair
+	48, // 34: milvus.proto.internal.IndexStats.index_params:type_name -> milvus.proto.common.KeyValuePair
+	26, // 35: milvus.proto.internal.FieldStats.index_stats:type_name -> milvus.proto.internal.IndexStats
+	50, // 36: milvus.proto.internal.ChannelTimeTickMsg.base:type_name -> milvus.proto.common.MsgBase
+	50, // 37: milvus.proto.internal.ListPolicyRequest.base:type_name -> milvus.proto.common.MsgBase
+	49, // 38: milvus.proto.internal.ListPolicyResponse.status:type_name -> milvus.proto.common.Status
+	55, // 39: milvus.proto.internal.ListPolicyResponse.privilege_groups:type_name -> milvus.proto.milvus.PrivilegeGroupInfo
+	50, // 40: milvus.proto.internal.ShowConfigurationsRequest.base:type_name -> milvus.proto.common.MsgBase
+	49, // 41: milvus.proto.internal.ShowConfigurationsResponse.status:type_name -> milvus.proto.common.Status
+	48, // 42: milvus.proto.internal.ShowConfigurationsResponse.configuations:type_name -> milvus.proto.common.KeyValuePair
+	2,  // 43: milvus.proto.internal.Rate.rt:type_name -> milvus.proto.internal.RateType
+	56, // 44: milvus.proto.internal.ImportRequestInternal.schema:type_name -> milvus.proto.schema.CollectionSchema
+	36, // 45: milvus.proto.internal.ImportRequestInternal.files:type_name -> milvus.proto.internal.ImportFile
+	48, // 46: milvus.proto.internal.ImportRequestInternal.options:type_name -> milvus.proto.common.KeyValuePair
+	36, // 47: milvus.proto.internal.ImportRequest.files:type_name -> milvus.proto.internal.ImportFile
+	48, // 48: milvus.proto.internal.ImportRequest.options:type_name -> milvus.proto.common.KeyValuePair
+	49, // 49: milvus.proto.internal.ImportResponse.status:type_name -> milvus.proto.common.Status
+	49, // 50: milvus.proto.internal.GetImportProgressResponse.status:type_name -> milvus.proto.common.Status
+	3,  // 51: milvus.proto.internal.GetImportProgressResponse.state:type_name -> milvus.proto.internal.ImportJobState
+	41, // 52: milvus.proto.internal.GetImportProgressResponse.task_progresses:type_name -> milvus.proto.internal.ImportTaskProgress
+	49, // 53: milvus.proto.internal.ListImportsResponse.status:type_name -> milvus.proto.common.Status
+	3,  // 54: milvus.proto.internal.ListImportsResponse.states:type_name -> milvus.proto.internal.ImportJobState
+	55, // [55:55] is the sub-list for method output_type
+	55, // [55:55] is the sub-list for method input_type
+	55, // [55:55] is the sub-list for extension type_name
+	55, // [55:55] is the sub-list for extension extendee
+	0,  // [0:55] is the sub-list for field type_name
 }
 
 func init() { file_internal_proto_init() }
@@ -4548,7 +4790,7 @@ func file_internal_proto_init() {
 			}
 		}
 		file_internal_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RetrieveResults); i {
+			switch v := v.(*OrderByField); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4560,7 +4802,7 @@ func file_internal_proto_init() {
 			}
 		}
 		file_internal_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LoadIndex); i {
+			switch v := v.(*Aggregate); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4572,7 +4814,7 @@ func file_internal_proto_init() {
 			}
 		}
 		file_internal_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*IndexStats); i {
+			switch v := v.(*RetrieveResults); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4584,7 +4826,7 @@ func file_internal_proto_init() {
 			}
 		}
 		file_internal_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FieldStats); i {
+			switch v := v.(*LoadIndex); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4596,7 +4838,7 @@ func file_internal_proto_init() {
 			}
 		}
 		file_internal_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SegmentStats); i {
+			switch v := v.(*IndexStats); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4608,7 +4850,7 @@ func file_internal_proto_init() {
 			}
 		}
 		file_internal_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ChannelTimeTickMsg); i {
+			switch v := v.(*FieldStats); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4620,7 +4862,7 @@ func file_internal_proto_init() {
 			}
 		}
 		file_internal_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CredentialInfo); i {
+			switch v := v.(*SegmentStats); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4632,7 +4874,7 @@ func file_internal_proto_init() {
 			}
 		}
 		file_internal_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListPolicyRequest); i {
+			switch v := v.(*ChannelTimeTickMsg); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4644,7 +4886,7 @@ func file_internal_proto_init() {
 			}
 		}
 		file_internal_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListPolicyResponse); i {
+			switch v := v.(*CredentialInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4656,7 +4898,7 @@ func file_internal_proto_init() {
 			}
 		}
 		file_internal_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ShowConfigurationsRequest); i {
+			switch v := v.(*ListPolicyRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4668,7 +4910,7 @@ func file_internal_proto_init() {
 			}
 		}
 		file_internal_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ShowConfigurationsResponse); i {
+			switch v := v.(*ListPolicyResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4680,7 +4922,7 @@ func file_internal_proto_init() {
 			}
 		}
 		file_internal_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Rate); i {
+			switch v := v.(*ShowConfigurationsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4692,7 +4934,7 @@ func file_internal_proto_init() {
 			}
 		}
 		file_internal_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ImportFile); i {
+			switch v := v.(*ShowConfigurationsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4704,7 +4946,7 @@ func file_internal_proto_init() {
 			}
 		}
 		file_internal_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ImportRequestInternal); i {
+			switch v := v.(*Rate); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4716,7 +4958,7 @@ func file_internal_proto_init() {
 			}
 		}
 		file_internal_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ImportRequest); i {
+			switch v := v.(*ImportFile); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4728,7 +4970,7 @@ func file_internal_proto_init() {
 			}
 		}
 		file_internal_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ImportResponse); i {
+			switch v := v.(*ImportRequestInternal); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4740,7 +4982,7 @@ func file_internal_proto_init() {
 			}
 		}
 		file_internal_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetImportProgressRequest); i {
+			switch v := v.(*ImportRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4752,7 +4994,7 @@ func file_internal_proto_init() {
 			}
 		}
 		file_internal_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ImportTaskProgress); i {
+			switch v := v.(*ImportResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4764,7 +5006,7 @@ func file_internal_proto_init() {
 			}
 		}
 		file_internal_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetImportProgressResponse); i {
+			switch v := v.(*GetImportProgressRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4776,7 +5018,7 @@ func file_internal_proto_init() {
 			}
 		}
 		file_internal_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListImportsRequestInternal); i {
+			switch v := v.(*ImportTaskProgress); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4788,7 +5030,7 @@ func file_internal_proto_init() {
 			}
 		}
 		file_internal_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListImportsRequest); i {
+			switch v := v.(*GetImportProgressResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4800,6 +5042,30 @@ func file_internal_proto_init() {
 			}
 		}
 		file_internal_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListImportsRequestInternal); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListImportsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ListImportsResponse); i {
 			case 0:
 				return &v.state
@@ -4817,8 +5083,8 @@ func file_internal_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_internal_proto_rawDesc,
-			NumEnums:      3,
-			NumMessages:   41,
+			NumEnums:      4,
+			NumMessages:   43,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -11,6 +11,7 @@ import (
 	mqkafka "github.com/milvus-io/milvus/pkg/v2/mq/msgstream/mqwrapper/kafka"
 	mqpulsar "github.com/milvus-io/milvus/pkg/v2/mq/msgstream/mqwrapper/pulsar"
 	"github.com/milvus-io/milvus/pkg/v2/streaming/util/message"
+	"github.com/milvus-io/milvus/pkg/v2/streaming/walimpls/impls/filelog"
 	msgkafka "github.com/milvus-io/milvus/pkg/v2/streaming/walimpls/impls/kafka"
 	msgpulsar "github.com/milvus-io/milvus/pkg/v2/streaming/walimpls/impls/pulsar"
 	"github.com/milvus-io/milvus/pkg/v2/streaming/walimpls/impls/rmq"
@@ -25,6 +26,9 @@ func MustGetMQWrapperIDFromMessage(messageID message.MessageID) common.MessageID
 		return &server.RmqID{MessageID: id.RmqID()}
 	} else if id, ok := messageID.(interface{ KafkaID() rawKafka.Offset }); ok {
 		return mqkafka.NewKafkaID(int64(id.KafkaID()))
+	} else if id, ok := messageID.(interface{ FileLogID() int64 }); ok {
+		// the file log id shares the serialization of the rmq id.
+		return &server.RmqID{MessageID: id.FileLogID()}
 	}
 	panic("unsupported now")
 }
@@ -52,7 +56,7 @@ func DeserializeToMQWrapperID(msgID []byte, walName string) (common.MessageID, e
 			return nil, err
 		}
 		return mqpulsar.NewPulsarID(pulsarID), nil
-	case "rocksmq":
+	case "rocksmq", "filelog":
 		rID := server.DeserializeRmqID(msgID)
 		return &server.RmqID{MessageID: rID}, nil
 	case "kafka":
@@ -78,6 +82,8 @@ func MustGetMessageIDFromMQWrapperIDBytes(walName string, msgIDBytes []byte) mes
 	case "kafka":
 		id := mqkafka.DeserializeKafkaID(msgIDBytes)
 		commonMsgID = mqkafka.NewKafkaID(id)
+	case "filelog":
+		return filelog.NewFileLogID(server.DeserializeRmqID(msgIDBytes))
 	default:
 		panic("unsupported now")
 	}
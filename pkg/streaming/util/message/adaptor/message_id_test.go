@@ -6,6 +6,7 @@ import (
 	"github.com/apache/pulsar-client-go/pulsar"
 	"github.com/stretchr/testify/assert"
 
+	"github.com/milvus-io/milvus/pkg/v2/streaming/walimpls/impls/filelog"
 	msgkafka "github.com/milvus-io/milvus/pkg/v2/streaming/walimpls/impls/kafka"
 	msgpulsar "github.com/milvus-io/milvus/pkg/v2/streaming/walimpls/impls/pulsar"
 	"github.com/milvus-io/milvus/pkg/v2/streaming/walimpls/impls/rmq"
@@ -21,4 +22,7 @@ func TestIDConvension(t *testing.T) {
 
 	kafkaID := MustGetMessageIDFromMQWrapperID(MustGetMQWrapperIDFromMessage(msgkafka.NewKafkaID(1)))
 	assert.True(t, kafkaID.EQ(msgkafka.NewKafkaID(1)))
+
+	fileLogID := MustGetMessageIDFromMQWrapperIDBytes("filelog", MustGetMQWrapperIDFromMessage(filelog.NewFileLogID(1)).Serialize())
+	assert.True(t, fileLogID.EQ(filelog.NewFileLogID(1)))
 }
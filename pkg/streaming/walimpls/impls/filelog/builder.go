@@ -0,0 +1,83 @@
+package filelog
+
+import (
+	"time"
+
+	"github.com/cockroachdb/errors"
+
+	"github.com/milvus-io/milvus/pkg/v2/streaming/util/message"
+	"github.com/milvus-io/milvus/pkg/v2/streaming/walimpls"
+	"github.com/milvus-io/milvus/pkg/v2/streaming/walimpls/registry"
+	"github.com/milvus-io/milvus/pkg/v2/util/paramtable"
+)
+
+const (
+	walName = "filelog"
+
+	syncPolicyAlways   = "always"
+	syncPolicyInterval = "interval"
+	syncPolicyNever    = "never"
+)
+
+func init() {
+	// register the builder to the registry.
+	registry.RegisterBuilder(&builderImpl{})
+	// register the unmarshaler to the message registry.
+	message.RegisterMessageIDUnmsarshaler(walName, UnmarshalMessageID)
+}
+
+// config is the config of the file log.
+type config struct {
+	rootPath      string
+	segmentSize   int64
+	syncPolicy    string
+	syncInterval  time.Duration
+	retentionTime time.Duration
+	retentionSize int64
+}
+
+// newConfigFromParams creates the config from the param table.
+func newConfigFromParams() (*config, error) {
+	params := &paramtable.Get().FileLogCfg
+	cfg := &config{
+		rootPath:      params.Path.GetValue(),
+		segmentSize:   params.SegmentSize.GetAsSize(),
+		syncPolicy:    params.SyncPolicy.GetValue(),
+		syncInterval:  params.SyncInterval.GetAsDurationByParse(),
+		retentionTime: time.Duration(params.RetentionTimeInMinutes.GetAsInt64()) * time.Minute,
+		retentionSize: params.RetentionSizeInMB.GetAsInt64() * 1024 * 1024,
+	}
+	if cfg.rootPath == "" {
+		return nil, errors.Newf("%s is not set", params.Path.Key)
+	}
+	if cfg.segmentSize <= 0 {
+		return nil, errors.Newf("invalid %s: %s", params.SegmentSize.Key, params.SegmentSize.GetValue())
+	}
+	switch cfg.syncPolicy {
+	case syncPolicyAlways, syncPolicyNever:
+	case syncPolicyInterval:
+		if cfg.syncInterval <= 0 {
+			return nil, errors.Newf("invalid %s: %s", params.SyncInterval.Key, params.SyncInterval.GetValue())
+		}
+	default:
+		return nil, errors.Newf("invalid %s: %s", params.SyncPolicy.Key, cfg.syncPolicy)
+	}
+	return cfg, nil
+}
+
+// builderImpl is the builder for file log opener.
+type builderImpl struct{}
+
+// Name of the wal builder, should be a lowercase string.
+func (b *builderImpl) Name() string {
+	return walName
+}
+
+// Build build a wal instance.
+func (b *builderImpl) Build() (walimpls.OpenerImpls, error) {
+	cfg, err := newConfigFromParams()
+	if err != nil {
+		return nil, err
+	}
+	return newOpenerImpl(cfg), nil
+}
@@ -0,0 +1,137 @@
+package filelog
+
+import (
+	"bufio"
+	"encoding/binary"
+	"hash/crc32"
+	"io"
+	"sort"
+
+	"github.com/cockroachdb/errors"
+)
+
+// The layout of an entry in the segment file:
+//
+//	| body length uint32 | crc32c of body uint32 | body |
+//
+// and the layout of the body:
+//
+//	| id int64 | property count uint32 | (key length uint32 | key | value length uint32 | value)... | payload |
+//
+// all the integers are little endian.
+const (
+	entryHeaderSize = 8
+	// maxEntryBodySize protects the recovery from allocating a huge buffer for a corrupted length.
+	maxEntryBodySize = 1 << 30
+)
+
+var (
+	crcTable = crc32.MakeTable(crc32.Castagnoli)
+
+	// errCorruptedEntry is returned if the entry is torn or its checksum mismatches.
+	errCorruptedEntry = errors.New("corrupted file log entry")
+)
+
+// entry is a message in the file log.
+type entry struct {
+	id         int64
+	payload    []byte
+	properties map[string]string
+}
+
+// encode encodes the entry with its header.
+func (e *entry) encode() []byte {
+	keys := make([]string, 0, len(e.properties))
+	bodySize := 8 + 4 + len(e.payload)
+	for k, v := range e.properties {
+		keys = append(keys, k)
+		bodySize += 8 + len(k) + len(v)
+	}
+	// keep the encoding deterministic.
+	sort.Strings(keys)
+
+	buf := make([]byte, entryHeaderSize, entryHeaderSize+bodySize)
+	buf = binary.LittleEndian.AppendUint64(buf, uint64(e.id))
+	buf = binary.LittleEndian.AppendUint32(buf, uint32(len(keys)))
+	for _, k := range keys {
+		buf = binary.LittleEndian.AppendUint32(buf, uint32(len(k)))
+		buf = append(buf, k...)
+		buf = binary.LittleEndian.AppendUint32(buf, uint32(len(e.properties[k])))
+		buf = append(buf, e.properties[k]...)
+	}
+	buf = append(buf, e.payload...)
+
+	binary.LittleEndian.PutUint32(buf[0:4], uint32(bodySize))
+	binary.LittleEndian.PutUint32(buf[4:8], crc32.Checksum(buf[entryHeaderSize:], crcTable))
+	return buf
+}
+
+// readEntry reads the next entry from the reader, returns the entry and the size it takes in the file.
+// io.EOF is returned if there's no more data, errCorruptedEntry is returned if the entry is torn or broken.
+func readEntry(r *bufio.Reader) (*entry, int64, error) {
+	header := make([]byte, entryHeaderSize)
+	n, err := io.ReadFull(r, header)
+	if err != nil {
+		if err == io.EOF {
+			return nil, 0, io.EOF
+		}
+		if err == io.ErrUnexpectedEOF {
+			return nil, 0, errors.Wrapf(errCorruptedEntry, "torn header of %d bytes", n)
+		}
+		return nil, 0, err
+	}
+	bodySize := binary.LittleEndian.Uint32(header[0:4])
+	if bodySize < 12 || bodySize > maxEntryBodySize {
+		return nil, 0, errors.Wrapf(errCorruptedEntry, "invalid body size %d", bodySize)
+	}
+	body := make([]byte, bodySize)
+	if _, err := io.ReadFull(r, body); err != nil {
+		if err == io.EOF || err == io.ErrUnexpectedEOF {
+			return nil, 0, errors.Wrap(errCorruptedEntry, "torn body")
+		}
+		return nil, 0, err
+	}
+	if crc32.Checksum(body, crcTable) != binary.LittleEndian.Uint32(header[4:8]) {
+		return nil, 0, errors.Wrap(errCorruptedEntry, "checksum mismatch")
+	}
+	e, err := decodeEntryBody(body)
+	if err != nil {
+		return nil, 0, err
+	}
+	return e, int64(entryHeaderSize + bodySize), nil
+}
+
+// decodeEntryBody decodes the body whose checksum has been verified.
+func decodeEntryBody(body []byte) (*entry, error) {
+	e := &entry{
+		id: int64(binary.LittleEndian.Uint64(body[0:8])),
+	}
+	count := binary.LittleEndian.Uint32(body[8:12])
+	body = body[12:]
+	readString := func() (string, error) {
+		if len(body) < 4 {
+			return "", errors.Wrap(errCorruptedEntry, "truncated property")
+		}
+		l := binary.LittleEndian.Uint32(body[0:4])
+		if uint64(len(body)-4) < uint64(l) {
+			return "", errors.Wrap(errCorruptedEntry, "truncated property")
+		}
+		s := string(body[4 : 4+l])
+		body = body[4+l:]
+		return s, nil
+	}
+	e.properties = make(map[string]string, count)
+	for i := uint32(0); i < count; i++ {
+		k, err := readString()
+		if err != nil {
+			return nil, err
+		}
+		v, err := readString()
+		if err != nil {
+			return nil, err
+		}
+		e.properties[k] = v
+	}
+	e.payload = body
+	return e, nil
+}
@@ -0,0 +1,192 @@
+package filelog
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/pkg/v2/streaming/util/message"
+	"github.com/milvus-io/milvus/pkg/v2/streaming/util/options"
+	"github.com/milvus-io/milvus/pkg/v2/streaming/util/types"
+	"github.com/milvus-io/milvus/pkg/v2/streaming/walimpls"
+	"github.com/milvus-io/milvus/pkg/v2/streaming/walimpls/registry"
+	"github.com/milvus-io/milvus/pkg/v2/util/paramtable"
+)
+
+func TestMain(m *testing.M) {
+	paramtable.Init()
+	tmpPath, err := os.MkdirTemp("", "filelog_test")
+	if err != nil {
+		panic(err)
+	}
+	defer os.RemoveAll(tmpPath)
+	paramtable.Get().Save(paramtable.Get().FileLogCfg.Path.Key, tmpPath)
+	m.Run()
+}
+
+func TestRegistry(t *testing.T) {
+	registeredB := registry.MustGetBuilder(walName)
+	assert.NotNil(t, registeredB)
+	assert.Equal(t, walName, registeredB.Name())
+
+	id, err := message.UnmarshalMessageID(walName, fileLogID(1).Marshal())
+	assert.NoError(t, err)
+	assert.True(t, id.EQ(fileLogID(1)))
+}
+
+func TestWAL(t *testing.T) {
+	walimpls.NewWALImplsTestFramework(t, 1000, &builderImpl{}).Run()
+}
+
+func TestBuildWithInvalidConfig(t *testing.T) {
+	params := paramtable.Get()
+	params.Save(params.FileLogCfg.SyncPolicy.Key, "sometimes")
+	_, err := (&builderImpl{}).Build()
+	assert.Error(t, err)
+	params.Reset(params.FileLogCfg.SyncPolicy.Key)
+
+	params.Save(params.FileLogCfg.SegmentSize.Key, "0")
+	_, err = (&builderImpl{}).Build()
+	assert.Error(t, err)
+	params.Reset(params.FileLogCfg.SegmentSize.Key)
+
+	o, err := (&builderImpl{}).Build()
+	assert.NoError(t, err)
+	o.Close()
+}
+
+func newTestConfig(t *testing.T) *config {
+	return &config{
+		rootPath:     t.TempDir(),
+		segmentSize:  1024,
+		syncPolicy:   syncPolicyAlways,
+		syncInterval: 10 * time.Millisecond,
+	}
+}
+
+func appendN(t *testing.T, l *fileLog, n int) []int64 {
+	ids := make([]int64, 0, n)
+	for i := 0; i < n; i++ {
+		id, err := l.append(context.Background(), make([]byte, 100), map[string]string{"key": "value"})
+		assert.NoError(t, err)
+		ids = append(ids, id)
+	}
+	return ids
+}
+
+func readAll(t *testing.T, w walimpls.WALImpls, policy options.DeliverPolicy, n int) []int64 {
+	s, err := w.Read(context.Background(), walimpls.ReadOption{
+		Name:          "test",
+		DeliverPolicy: policy,
+	})
+	assert.NoError(t, err)
+	defer s.Close()
+	ids := make([]int64, 0, n)
+	for i := 0; i < n; i++ {
+		msg := <-s.Chan()
+		assert.Equal(t, "value", msg.Properties().ToRawMap()["key"])
+		ids = append(ids, int64(msg.MessageID().(fileLogID)))
+	}
+	return ids
+}
+
+func TestRecoverTornWrite(t *testing.T) {
+	cfg := newTestConfig(t)
+	dir := filepath.Join(cfg.rootPath, "channel")
+	l, err := openFileLog(dir, cfg)
+	assert.NoError(t, err)
+	appendN(t, l, 5)
+	active := l.segments[len(l.segments)-1]
+	assert.NoError(t, l.close())
+
+	// simulate a crash in the middle of writing an entry.
+	f, err := os.OpenFile(active.path, os.O_APPEND|os.O_WRONLY, 0o644)
+	assert.NoError(t, err)
+	e := &entry{id: 5, payload: []byte("torn")}
+	_, err = f.Write(e.encode()[:10])
+	assert.NoError(t, err)
+	assert.NoError(t, f.Close())
+
+	l, err = openFileLog(dir, cfg)
+	assert.NoError(t, err)
+	assert.EqualValues(t, 5, l.nextID)
+	assert.Equal(t, []int64{5, 6}, appendN(t, l, 2))
+	assert.NoError(t, l.close())
+
+	// simulate a bit flip of the last entry.
+	data, err := os.ReadFile(active.path)
+	assert.NoError(t, err)
+	data[len(data)-1] ^= 0xff
+	assert.NoError(t, os.WriteFile(active.path, data, 0o644))
+
+	l, err = openFileLog(dir, cfg)
+	assert.NoError(t, err)
+	assert.EqualValues(t, 6, l.nextID)
+	assert.NoError(t, l.close())
+}
+
+func TestRotateAndTruncate(t *testing.T) {
+	o := newOpenerImpl(newTestConfig(t))
+	channel := types.PChannelInfo{Name: "channel", Term: 1}
+	w, err := o.Open(context.Background(), &walimpls.OpenOption{Channel: channel})
+	assert.NoError(t, err)
+	_, err = o.Open(context.Background(), &walimpls.OpenOption{Channel: channel})
+	assert.Error(t, err)
+
+	l := w.(*walImpl).l
+	// each entry takes more than 100 bytes, so a segment holds about 10 entries.
+	ids := appendN(t, l, 50)
+	assert.Greater(t, len(l.segments), 3)
+	assert.Equal(t, ids, readAll(t, w, options.DeliverPolicyAll(), 50))
+	assert.Equal(t, ids[25:], readAll(t, w, options.DeliverPolicyStartFrom(fileLogID(25)), 25))
+	assert.Equal(t, ids[26:], readAll(t, w, options.DeliverPolicyStartAfter(fileLogID(25)), 24))
+
+	// the segments before the one containing 25 are removed.
+	assert.NoError(t, w.(*walImpl).Truncate(context.Background(), fileLogID(25)))
+	first := l.firstSegment()
+	assert.LessOrEqual(t, first.firstID, int64(25))
+	assert.Greater(t, first.firstID, int64(0))
+	assert.Equal(t, ids[25:], readAll(t, w, options.DeliverPolicyStartFrom(fileLogID(25)), 25))
+	// read from the oldest one if the start message has been truncated.
+	assert.Equal(t, ids[first.firstID:], readAll(t, w, options.DeliverPolicyStartFrom(fileLogID(0)), 50-int(first.firstID)))
+	w.Close()
+
+	// reopen and read after the latest.
+	w, err = o.Open(context.Background(), &walimpls.OpenOption{Channel: channel})
+	assert.NoError(t, err)
+	s, err := w.Read(context.Background(), walimpls.ReadOption{
+		Name:          "latest",
+		DeliverPolicy: options.DeliverPolicyLatest(),
+	})
+	assert.NoError(t, err)
+	ids = appendN(t, w.(*walImpl).l, 1)
+	msg := <-s.Chan()
+	assert.True(t, msg.MessageID().EQ(fileLogID(ids[0])))
+	assert.EqualValues(t, 50, ids[0])
+	assert.NoError(t, s.Close())
+	w.Close()
+}
+
+func TestRetention(t *testing.T) {
+	cfg := newTestConfig(t)
+	cfg.retentionSize = 4096
+	cfg.syncPolicy = syncPolicyInterval
+	l, err := openFileLog(filepath.Join(cfg.rootPath, "channel"), cfg)
+	assert.NoError(t, err)
+	appendN(t, l, 100)
+
+	var totalSize int64
+	for _, seg := range l.segments {
+		totalSize += seg.size
+	}
+	assert.LessOrEqual(t, totalSize, cfg.retentionSize+cfg.segmentSize)
+	assert.Greater(t, l.firstSegment().firstID, int64(0))
+	assert.NoError(t, l.close())
+
+	_, err = l.append(context.Background(), []byte("closed"), nil)
+	assert.ErrorIs(t, err, errLogClosed)
+}
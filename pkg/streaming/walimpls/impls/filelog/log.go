@@ -0,0 +1,457 @@
+package filelog
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/pkg/v2/log"
+	"github.com/milvus-io/milvus/pkg/v2/util/syncutil"
+)
+
+const segmentFileSuffix = ".log"
+
+var errLogClosed = errors.New("file log closed")
+
+// segment is a file of the log, named by the id of its first entry.
+// Only the last segment of the log is active and appendable, the others are sealed and immutable.
+type segment struct {
+	firstID int64
+	path    string
+	// the fields below are protected by the lock of the log.
+	size    int64 // the size of the entries that are completely written.
+	lastID  int64 // the id of the last entry, firstID-1 if empty.
+	sealed  bool
+	modTime time.Time
+}
+
+// fileLog is the append only log of a physical channel, stored in segment files under the directory.
+type fileLog struct {
+	dir    string
+	cfg    *config
+	logger *log.MLogger
+
+	cond     *syncutil.ContextCond // protects the fields below and notifies the scanners on new entries.
+	segments []*segment
+	file     *os.File // the file of the active segment.
+	nextID   int64
+	dirty    bool // whether there're appended entries not synced yet.
+	closed   bool
+
+	wg      sync.WaitGroup
+	closeCh chan struct{}
+}
+
+// openFileLog opens the log under the directory, recovers the active segment if the last run crashed.
+func openFileLog(dir string, cfg *config) (*fileLog, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, err
+	}
+	l := &fileLog{
+		dir:     dir,
+		cfg:     cfg,
+		logger:  log.With(zap.String("dir", dir)),
+		cond:    syncutil.NewContextCond(&sync.Mutex{}),
+		closeCh: make(chan struct{}),
+	}
+	segments, err := listSegments(dir)
+	if err != nil {
+		return nil, err
+	}
+	for i, seg := range segments {
+		if i < len(segments)-1 {
+			seg.sealed = true
+			seg.lastID = segments[i+1].firstID - 1
+		}
+	}
+	if len(segments) == 0 {
+		if err := l.createSegment(0); err != nil {
+			return nil, err
+		}
+	} else {
+		l.segments = segments
+		if err := l.recoverActiveSegment(); err != nil {
+			return nil, err
+		}
+	}
+
+	if cfg.syncPolicy == syncPolicyInterval {
+		l.wg.Add(1)
+		go l.backgroundSync()
+	}
+	l.logger.Info("file log opened", zap.Int("segments", len(l.segments)), zap.Int64("nextID", l.nextID))
+	return l, nil
+}
+
+// listSegments lists the segment files under the directory ordered by the first id.
+func listSegments(dir string) ([]*segment, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	segments := make([]*segment, 0, len(entries))
+	for _, e := range entries {
+		if e.IsDir() || !strings.HasSuffix(e.Name(), segmentFileSuffix) {
+			continue
+		}
+		firstID, err := strconv.ParseInt(strings.TrimSuffix(e.Name(), segmentFileSuffix), 10, 64)
+		if err != nil {
+			continue
+		}
+		info, err := e.Info()
+		if err != nil {
+			return nil, err
+		}
+		segments = append(segments, &segment{
+			firstID: firstID,
+			path:    filepath.Join(dir, e.Name()),
+			size:    info.Size(),
+			lastID:  firstID - 1,
+			modTime: info.ModTime(),
+		})
+	}
+	sort.Slice(segments, func(i, j int) bool {
+		return segments[i].firstID < segments[j].firstID
+	})
+	return segments, nil
+}
+
+// recoverActiveSegment checks the entries of the last segment,
+// the torn or corrupted tail left by a crash is truncated.
+func (l *fileLog) recoverActiveSegment() error {
+	seg := l.segments[len(l.segments)-1]
+	f, err := os.OpenFile(seg.path, os.O_RDWR, 0o644)
+	if err != nil {
+		return err
+	}
+	var validSize int64
+	lastID := seg.firstID - 1
+	r := bufio.NewReader(f)
+	for {
+		e, n, err := readEntry(r)
+		if err == io.EOF {
+			break
+		}
+		if err == nil && e.id != lastID+1 {
+			err = errors.Wrapf(errCorruptedEntry, "unexpected id %d after %d", e.id, lastID)
+		}
+		if err != nil {
+			if !errors.Is(err, errCorruptedEntry) {
+				f.Close()
+				return err
+			}
+			l.logger.Warn("truncate the corrupted tail of file log segment",
+				zap.String("segment", seg.path),
+				zap.Int64("validSize", validSize),
+				zap.Int64("fileSize", seg.size),
+				zap.Error(err))
+			if err := f.Truncate(validSize); err != nil {
+				f.Close()
+				return err
+			}
+			if err := f.Sync(); err != nil {
+				f.Close()
+				return err
+			}
+			break
+		}
+		validSize += n
+		lastID = e.id
+	}
+	if _, err := f.Seek(validSize, io.SeekStart); err != nil {
+		f.Close()
+		return err
+	}
+	seg.size = validSize
+	seg.lastID = lastID
+	l.file = f
+	l.nextID = lastID + 1
+	return nil
+}
+
+// createSegment creates a new active segment starting from the id, must be called with the lock held.
+func (l *fileLog) createSegment(firstID int64) error {
+	path := filepath.Join(l.dir, fmt.Sprintf("%020d%s", firstID, segmentFileSuffix))
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_RDWR|os.O_TRUNC, 0o644)
+	if err != nil {
+		return err
+	}
+	if l.cfg.syncPolicy != syncPolicyNever {
+		// persist the directory entry of the new segment file.
+		if err := syncDir(l.dir); err != nil {
+			f.Close()
+			return err
+		}
+	}
+	l.segments = append(l.segments, &segment{
+		firstID: firstID,
+		path:    path,
+		lastID:  firstID - 1,
+		modTime: time.Now(),
+	})
+	l.file = f
+	l.nextID = firstID
+	return nil
+}
+
+// rotate seals the active segment and creates a new one, must be called with the lock held.
+func (l *fileLog) rotate() error {
+	if err := l.file.Sync(); err != nil {
+		return err
+	}
+	if err := l.file.Close(); err != nil {
+		return err
+	}
+	l.dirty = false
+	active := l.segments[len(l.segments)-1]
+	active.sealed = true
+	active.modTime = time.Now()
+	if err := l.createSegment(l.nextID); err != nil {
+		return err
+	}
+	l.applyRetention()
+	return nil
+}
+
+// applyRetention removes the sealed segments out of the retention time or size, must be called with the lock held.
+func (l *fileLog) applyRetention() {
+	var totalSize int64
+	for _, seg := range l.segments {
+		totalSize += seg.size
+	}
+	expiredBefore := time.Now().Add(-l.cfg.retentionTime)
+	for len(l.segments) > 1 {
+		oldest := l.segments[0]
+		expired := l.cfg.retentionTime > 0 && oldest.modTime.Before(expiredBefore)
+		oversize := l.cfg.retentionSize > 0 && totalSize > l.cfg.retentionSize
+		if !expired && !oversize {
+			return
+		}
+		if err := l.removeOldestSegment(); err != nil {
+			l.logger.Warn("failed to remove the file log segment out of retention", zap.String("segment", oldest.path), zap.Error(err))
+			return
+		}
+		totalSize -= oldest.size
+	}
+}
+
+// truncate removes the sealed segments whose entries are all before the id.
+func (l *fileLog) truncate(id int64) (int, error) {
+	l.cond.L.Lock()
+	defer l.cond.L.Unlock()
+	if l.closed {
+		return 0, errLogClosed
+	}
+
+	removed := 0
+	for len(l.segments) > 1 && l.segments[0].lastID < id {
+		if err := l.removeOldestSegment(); err != nil {
+			return removed, err
+		}
+		removed++
+	}
+	return removed, nil
+}
+
+// removeOldestSegment removes the oldest sealed segment, must be called with the lock held.
+// The scanners reading the segment keep reading it until the end since the file is opened.
+func (l *fileLog) removeOldestSegment() error {
+	oldest := l.segments[0]
+	if err := os.Remove(oldest.path); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	l.segments = l.segments[1:]
+	l.logger.Info("file log segment removed", zap.String("segment", oldest.path), zap.Int64("lastID", oldest.lastID))
+	return nil
+}
+
+// append appends the entry to the active segment and returns its id.
+func (l *fileLog) append(ctx context.Context, payload []byte, properties map[string]string) (int64, error) {
+	if err := ctx.Err(); err != nil {
+		return 0, err
+	}
+	l.cond.L.Lock()
+	defer l.cond.L.Unlock()
+	if l.closed {
+		return 0, errLogClosed
+	}
+
+	active := l.segments[len(l.segments)-1]
+	if active.size >= l.cfg.segmentSize && active.lastID >= active.firstID {
+		if err := l.rotate(); err != nil {
+			return 0, err
+		}
+		active = l.segments[len(l.segments)-1]
+	}
+
+	e := &entry{
+		id:         l.nextID,
+		payload:    payload,
+		properties: properties,
+	}
+	data := e.encode()
+	if _, err := l.file.Write(data); err != nil {
+		// drop the partial written entry to keep the segment appendable.
+		if truncateErr := l.file.Truncate(active.size); truncateErr != nil {
+			l.logger.Warn("failed to truncate the partial written entry", zap.Error(truncateErr))
+		}
+		if _, seekErr := l.file.Seek(active.size, io.SeekStart); seekErr != nil {
+			l.logger.Warn("failed to seek back the file log segment", zap.Error(seekErr))
+		}
+		return 0, err
+	}
+	switch l.cfg.syncPolicy {
+	case syncPolicyAlways:
+		if err := l.file.Sync(); err != nil {
+			// the entry may be lost after crash, so the append is failed and the entry stays invisible.
+			if truncateErr := l.file.Truncate(active.size); truncateErr != nil {
+				l.logger.Warn("failed to truncate the unsynced entry", zap.Error(truncateErr))
+			}
+			if _, seekErr := l.file.Seek(active.size, io.SeekStart); seekErr != nil {
+				l.logger.Warn("failed to seek back the file log segment", zap.Error(seekErr))
+			}
+			return 0, err
+		}
+	case syncPolicyInterval:
+		l.dirty = true
+	}
+
+	active.size += int64(len(data))
+	active.lastID = e.id
+	l.nextID++
+	l.cond.UnsafeBroadcast()
+	return e.id, nil
+}
+
+// backgroundSync syncs the active segment periodically for the interval sync policy.
+func (l *fileLog) backgroundSync() {
+	defer l.wg.Done()
+	ticker := time.NewTicker(l.cfg.syncInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-l.closeCh:
+			return
+		case <-ticker.C:
+			l.cond.L.Lock()
+			if !l.closed && l.dirty {
+				if err := l.file.Sync(); err != nil {
+					l.logger.Warn("failed to sync file log", zap.Error(err))
+				} else {
+					l.dirty = false
+				}
+			}
+			l.cond.L.Unlock()
+		}
+	}
+}
+
+// readView is the readable range of a segment for a scanner.
+type readView struct {
+	limit  int64    // the readable size of the segment.
+	sealed bool     // no more entry will be appended to the segment.
+	next   *segment // the segment after it, nil if not created yet.
+}
+
+// firstSegment returns the oldest segment in the log.
+func (l *fileLog) firstSegment() *segment {
+	l.cond.L.Lock()
+	defer l.cond.L.Unlock()
+	return l.segments[0]
+}
+
+// lastSegment returns the active segment, its readable size and the id of the next entry.
+func (l *fileLog) lastSegment() (*segment, int64, int64) {
+	l.cond.L.Lock()
+	defer l.cond.L.Unlock()
+	seg := l.segments[len(l.segments)-1]
+	return seg, seg.size, l.nextID
+}
+
+// segmentOf returns the segment the id belongs to, the oldest segment is returned if the id has been truncated.
+func (l *fileLog) segmentOf(id int64) *segment {
+	l.cond.L.Lock()
+	defer l.cond.L.Unlock()
+	idx := sort.Search(len(l.segments), func(i int) bool {
+		return l.segments[i].firstID > id
+	})
+	if idx == 0 {
+		return l.segments[0]
+	}
+	return l.segments[idx-1]
+}
+
+// waitForView blocks until the segment has more entries than the offset or is sealed.
+func (l *fileLog) waitForView(ctx context.Context, seg *segment, offset int64) (*readView, error) {
+	l.cond.L.Lock()
+	for {
+		if seg.size > offset || seg.sealed {
+			view := &readView{
+				limit:  seg.size,
+				sealed: seg.sealed,
+				next:   l.nextSegmentOf(seg),
+			}
+			l.cond.L.Unlock()
+			return view, nil
+		}
+		if l.closed {
+			l.cond.L.Unlock()
+			return nil, errLogClosed
+		}
+		if err := l.cond.Wait(ctx); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// nextSegmentOf returns the segment after the given one, must be called with the lock held.
+func (l *fileLog) nextSegmentOf(seg *segment) *segment {
+	for _, s := range l.segments {
+		if s.firstID > seg.firstID {
+			return s
+		}
+	}
+	return nil
+}
+
+// close syncs and closes the active segment.
+func (l *fileLog) close() error {
+	l.cond.LockAndBroadcast()
+	if l.closed {
+		l.cond.L.Unlock()
+		return nil
+	}
+	l.closed = true
+	close(l.closeCh)
+	var err error
+	if l.cfg.syncPolicy != syncPolicyNever {
+		err = l.file.Sync()
+	}
+	if closeErr := l.file.Close(); closeErr != nil && err == nil {
+		err = closeErr
+	}
+	l.cond.L.Unlock()
+	l.wg.Wait()
+	return err
+}
+
+// syncDir fsyncs the directory to persist the entries of it.
+func syncDir(dir string) error {
+	d, err := os.Open(dir)
+	if err != nil {
+		return err
+	}
+	defer d.Close()
+	return d.Sync()
+}
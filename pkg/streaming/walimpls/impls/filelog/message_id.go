@@ -0,0 +1,72 @@
+package filelog
+
+import (
+	"strconv"
+
+	"github.com/cockroachdb/errors"
+
+	"github.com/milvus-io/milvus/pkg/v2/streaming/util/message"
+)
+
+var _ message.MessageID = fileLogID(0)
+
+// NewFileLogID creates a new fileLogID.
+func NewFileLogID(id int64) message.MessageID {
+	return fileLogID(id)
+}
+
+// UnmarshalMessageID unmarshal the message id.
+func UnmarshalMessageID(data string) (message.MessageID, error) {
+	id, err := unmarshalMessageID(data)
+	if err != nil {
+		return nil, err
+	}
+	return id, nil
+}
+
+// unmarshalMessageID unmarshal the message id.
+func unmarshalMessageID(data string) (fileLogID, error) {
+	v, err := message.DecodeUint64(data)
+	if err != nil {
+		return 0, errors.Wrapf(message.ErrInvalidMessageID, "decode fileLogID fail with err: %s, id: %s", err.Error(), data)
+	}
+	return fileLogID(v), nil
+}
+
+// fileLogID is the message id for the file log,
+// it's the sequence number of the message in the log of the physical channel.
+type fileLogID int64
+
+// FileLogID returns the message id for conversion.
+func (id fileLogID) FileLogID() int64 {
+	return int64(id)
+}
+
+// WALName returns the name of message id related wal.
+func (id fileLogID) WALName() string {
+	return walName
+}
+
+// LT less than.
+func (id fileLogID) LT(other message.MessageID) bool {
+	return id < other.(fileLogID)
+}
+
+// LTE less than or equal to.
+func (id fileLogID) LTE(other message.MessageID) bool {
+	return id <= other.(fileLogID)
+}
+
+// EQ Equal to.
+func (id fileLogID) EQ(other message.MessageID) bool {
+	return id == other.(fileLogID)
+}
+
+// Marshal marshal the message id.
+func (id fileLogID) Marshal() string {
+	return message.EncodeInt64(int64(id))
+}
+
+func (id fileLogID) String() string {
+	return strconv.FormatInt(int64(id), 10)
+}
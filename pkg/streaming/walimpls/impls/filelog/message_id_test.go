@@ -0,0 +1,30 @@
+package filelog
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/pkg/v2/streaming/util/message"
+)
+
+func TestMessageID(t *testing.T) {
+	assert.Equal(t, int64(1), message.MessageID(fileLogID(1)).(interface{ FileLogID() int64 }).FileLogID())
+	assert.Equal(t, walName, fileLogID(1).WALName())
+
+	assert.True(t, fileLogID(1).LT(fileLogID(2)))
+	assert.True(t, fileLogID(1).EQ(fileLogID(1)))
+	assert.True(t, fileLogID(1).LTE(fileLogID(1)))
+	assert.True(t, fileLogID(1).LTE(fileLogID(2)))
+	assert.False(t, fileLogID(2).LT(fileLogID(1)))
+	assert.False(t, fileLogID(2).EQ(fileLogID(1)))
+	assert.False(t, fileLogID(2).LTE(fileLogID(1)))
+	assert.True(t, fileLogID(2).LTE(fileLogID(2)))
+
+	msgID, err := UnmarshalMessageID(fileLogID(1).Marshal())
+	assert.NoError(t, err)
+	assert.Equal(t, fileLogID(1), msgID)
+
+	_, err = UnmarshalMessageID(string([]byte{0x01, 0x02, 0x03, 0x04}))
+	assert.Error(t, err)
+}
@@ -0,0 +1,57 @@
+package filelog
+
+import (
+	"context"
+	"path/filepath"
+	"sync"
+
+	"github.com/cockroachdb/errors"
+
+	"github.com/milvus-io/milvus/pkg/v2/streaming/walimpls"
+	"github.com/milvus-io/milvus/pkg/v2/streaming/walimpls/helper"
+)
+
+var _ walimpls.OpenerImpls = (*openerImpl)(nil)
+
+func newOpenerImpl(cfg *config) *openerImpl {
+	return &openerImpl{
+		cfg:    cfg,
+		opened: make(map[string]struct{}),
+	}
+}
+
+// openerImpl is the implementation of walimpls.Opener interface.
+type openerImpl struct {
+	cfg *config
+
+	mu sync.Mutex
+	// opened is the set of the channels opened, a channel can only be written by one wal at the same time.
+	opened map[string]struct{}
+}
+
+// Open opens a new wal.
+func (o *openerImpl) Open(ctx context.Context, opt *walimpls.OpenOption) (walimpls.WALImpls, error) {
+	name := opt.Channel.Name
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	if _, ok := o.opened[name]; ok {
+		return nil, errors.Newf("file log of channel %s is already opened", name)
+	}
+	l, err := openFileLog(filepath.Join(o.cfg.rootPath, name), o.cfg)
+	if err != nil {
+		return nil, err
+	}
+	o.opened[name] = struct{}{}
+	return &walImpl{
+		WALHelper: helper.NewWALHelper(opt),
+		l:         l,
+		onClose: func() {
+			o.mu.Lock()
+			defer o.mu.Unlock()
+			delete(o.opened, name)
+		},
+	}, nil
+}
+
+// Close closes the opener resources.
+func (o *openerImpl) Close() {}
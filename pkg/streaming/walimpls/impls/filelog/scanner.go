@@ -0,0 +1,123 @@
+package filelog
+
+import (
+	"bufio"
+	"io"
+	"os"
+
+	"github.com/cockroachdb/errors"
+
+	"github.com/milvus-io/milvus/pkg/v2/streaming/util/message"
+	"github.com/milvus-io/milvus/pkg/v2/streaming/walimpls"
+	"github.com/milvus-io/milvus/pkg/v2/streaming/walimpls/helper"
+)
+
+var _ walimpls.ScannerImpls = (*scannerImpl)(nil)
+
+// newScanner creates a new scanner reading from the offset of the segment,
+// the messages before the startID are skipped.
+func newScanner(
+	scannerName string,
+	l *fileLog,
+	seg *segment,
+	offset int64,
+	startID int64,
+) *scannerImpl {
+	s := &scannerImpl{
+		ScannerHelper: helper.NewScannerHelper(scannerName),
+		l:             l,
+		seg:           seg,
+		offset:        offset,
+		startID:       startID,
+		msgChannel:    make(chan message.ImmutableMessage),
+	}
+	go s.executeConsume()
+	return s
+}
+
+// scannerImpl is the implementation of ScannerImpls for file log.
+type scannerImpl struct {
+	*helper.ScannerHelper
+	l          *fileLog
+	seg        *segment
+	offset     int64
+	startID    int64
+	msgChannel chan message.ImmutableMessage
+}
+
+// Chan returns the channel of message.
+func (s *scannerImpl) Chan() <-chan message.ImmutableMessage {
+	return s.msgChannel
+}
+
+// Close the scanner, release the underlying resources.
+// Return the error same with `Error`
+func (s *scannerImpl) Close() error {
+	return s.ScannerHelper.Close()
+}
+
+// executeConsume reads the segments one by one and delivers the messages.
+func (s *scannerImpl) executeConsume() (err error) {
+	var f *os.File
+	defer func() {
+		if f != nil {
+			f.Close()
+		}
+		if errors.Is(err, s.Context().Err()) {
+			err = nil
+		}
+		s.Finish(err)
+		close(s.msgChannel)
+	}()
+
+	for {
+		view, err := s.l.waitForView(s.Context(), s.seg, s.offset)
+		if err != nil {
+			return err
+		}
+		if view.limit > s.offset {
+			if f == nil {
+				if f, err = os.Open(s.seg.path); err != nil {
+					return err
+				}
+			}
+			if err := s.consumeRange(f, view.limit); err != nil {
+				return err
+			}
+			continue
+		}
+		// the segment is sealed and consumed, move to the next one.
+		if view.next == nil {
+			return errors.Newf("file log segment %s is sealed without a next one", s.seg.path)
+		}
+		if f != nil {
+			f.Close()
+			f = nil
+		}
+		s.seg = view.next
+		s.offset = 0
+	}
+}
+
+// consumeRange delivers the messages of the segment between the current offset and the limit.
+func (s *scannerImpl) consumeRange(f *os.File, limit int64) error {
+	r := bufio.NewReader(io.NewSectionReader(f, s.offset, limit-s.offset))
+	for s.offset < limit {
+		e, n, err := readEntry(r)
+		if err != nil {
+			// the entries before the limit are completely written, so io.EOF is unexpected here too.
+			return errors.Wrapf(err, "failed to read file log segment %s at offset %d", s.seg.path, s.offset)
+		}
+		s.offset += n
+		if e.id < s.startID {
+			continue
+		}
+		msg := message.NewImmutableMesasge(fileLogID(e.id), e.payload, e.properties)
+		select {
+		case <-s.Context().Done():
+			return s.Context().Err()
+		case s.msgChannel <- msg:
+		}
+	}
+	return nil
+}
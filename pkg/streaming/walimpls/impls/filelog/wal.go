@@ -0,0 +1,90 @@
+package filelog
+
+import (
+	"context"
+
+	"github.com/cockroachdb/errors"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/pkg/v2/proto/streamingpb"
+	"github.com/milvus-io/milvus/pkg/v2/streaming/util/message"
+	"github.com/milvus-io/milvus/pkg/v2/streaming/walimpls"
+	"github.com/milvus-io/milvus/pkg/v2/streaming/walimpls/helper"
+)
+
+var _ walimpls.WALImpls = (*walImpl)(nil)
+
+// walImpl is the implementation of walimpls.WAL interface.
+type walImpl struct {
+	*helper.WALHelper
+	l       *fileLog
+	onClose func()
+}
+
+func (w *walImpl) WALName() string {
+	return walName
+}
+
+// Append appends a message to the wal.
+func (w *walImpl) Append(ctx context.Context, msg message.MutableMessage) (message.MessageID, error) {
+	id, err := w.l.append(ctx, msg.Payload(), msg.Properties().ToRawMap())
+	if err != nil {
+		w.Log().RatedWarn(1, "append message to file log failed", zap.Error(err))
+		return nil, err
+	}
+	return fileLogID(id), nil
+}
+
+// Read create a scanner to read the wal.
+func (w *walImpl) Read(ctx context.Context, opt walimpls.ReadOption) (walimpls.ScannerImpls, error) {
+	var seg *segment
+	var offset int64
+	var startID int64
+	switch t := opt.DeliverPolicy.GetPolicy().(type) {
+	case *streamingpb.DeliverPolicy_All:
+		seg = w.l.firstSegment()
+		startID = seg.firstID
+	case *streamingpb.DeliverPolicy_Latest:
+		seg, offset, startID = w.l.lastSegment()
+	case *streamingpb.DeliverPolicy_StartFrom:
+		id, err := unmarshalMessageID(t.StartFrom.GetId())
+		if err != nil {
+			return nil, err
+		}
+		startID = int64(id)
+		seg = w.l.segmentOf(startID)
+	case *streamingpb.DeliverPolicy_StartAfter:
+		id, err := unmarshalMessageID(t.StartAfter.GetId())
+		if err != nil {
+			return nil, err
+		}
+		startID = int64(id) + 1
+		seg = w.l.segmentOf(startID)
+	default:
+		return nil, errors.Newf("unsupported deliver policy %T", t)
+	}
+	if seg.firstID > startID {
+		w.Log().Warn("the messages to read have been truncated, read from the oldest one",
+			zap.String("scanner", opt.Name), zap.Int64("startID", startID), zap.Int64("oldestID", seg.firstID))
+	}
+	return newScanner(opt.Name, w.l, seg, offset, startID), nil
+}
+
+// Truncate removes the sealed segment files whose messages are all before the given message id.
+// The messages after the id are kept, the messages before it may be kept too since the truncation is segment based.
+func (w *walImpl) Truncate(ctx context.Context, id message.MessageID) error {
+	removed, err := w.l.truncate(int64(id.(fileLogID)))
+	if err != nil {
+		return err
+	}
+	w.Log().Info("file log truncated", zap.Stringer("messageID", id), zap.Int("removedSegments", removed))
+	return nil
+}
+
+// Close closes the wal.
+func (w *walImpl) Close() {
+	if err := w.l.close(); err != nil {
+		w.Log().Warn("close file log failed", zap.Error(err))
+	}
+	w.onClose()
+}
@@ -9,7 +9,9 @@ import (
 type CompressType string
 
 const (
-	CompressTypeZstd CompressType = "zstd"
+	CompressTypeZstd   CompressType = "zstd"
+	CompressTypeLz4    CompressType = "lz4"
+	CompressTypeSnappy CompressType = "snappy"
 
 	DefaultCompressAlgorithm CompressType = CompressTypeZstd
 )
@@ -38,6 +38,54 @@ func TestZstdCompress(t *testing.T) {
 	assert.Equal(t, dec.GetType(), CompressTypeZstd)
 }
 
+func TestLz4Compress(t *testing.T) {
+	data := "hello lz4 algorithm!"
+	compressed := new(bytes.Buffer)
+	origin := new(bytes.Buffer)
+
+	enc, err := NewLz4Compressor(compressed)
+	assert.NoError(t, err)
+	testCompress(t, data, enc, compressed, origin)
+
+	// Reuse test
+	compressed.Reset()
+	origin.Reset()
+
+	enc.ResetWriter(compressed)
+
+	testCompress(t, data+": reuse", enc, compressed, origin)
+
+	// Test type
+	dec, err := NewLz4Decompressor(nil)
+	assert.NoError(t, err)
+	assert.Equal(t, enc.GetType(), CompressTypeLz4)
+	assert.Equal(t, dec.GetType(), CompressTypeLz4)
+}
+
+func TestSnappyCompress(t *testing.T) {
+	data := "hello snappy algorithm!"
+	compressed := new(bytes.Buffer)
+	origin := new(bytes.Buffer)
+
+	enc, err := NewSnappyCompressor(compressed)
+	assert.NoError(t, err)
+	testCompress(t, data, enc, compressed, origin)
+
+	// Reuse test
+	compressed.Reset()
+	origin.Reset()
+
+	enc.ResetWriter(compressed)
+
+	testCompress(t, data+": reuse", enc, compressed, origin)
+
+	// Test type
+	dec, err := NewSnappyDecompressor(nil)
+	assert.NoError(t, err)
+	assert.Equal(t, enc.GetType(), CompressTypeSnappy)
+	assert.Equal(t, dec.GetType(), CompressTypeSnappy)
+}
+
 func testCompress(t *testing.T, data string, enc Compressor, compressed, origin *bytes.Buffer) {
 	compressedBytes := make([]byte, 0)
 	originBytes := make([]byte, 0)
@@ -53,7 +101,7 @@ func testCompress(t *testing.T, data string, enc Compressor, compressed, origin
 	err = enc.Close()
 	assert.NoError(t, err)
 
-	dec, err := NewZstdDecompressor(compressed)
+	dec, err := NewDecompressor(enc.GetType(), compressed)
 	assert.NoError(t, err)
 	err = dec.Decompress(origin)
 	assert.NoError(t, err)
@@ -70,12 +118,12 @@ func testCompress(t *testing.T, data string, enc Compressor, compressed, origin
 	err = enc.Compress(errReader)
 	assert.ErrorIs(t, err, errReader.Err)
 
-	dec.ResetReader(bytes.NewReader(compressed.Bytes()))
+	dec.ResetReader(bytes.NewReader(compressedBytes))
 	err = dec.Decompress(errWriter)
 	assert.ErrorIs(t, err, errWriter.Err)
 
 	// Use closed decompressor
-	dec.ResetReader(bytes.NewReader(compressed.Bytes()))
+	dec.ResetReader(bytes.NewReader(compressedBytes))
 	dec.Close()
 	err = dec.Decompress(origin)
 	assert.Error(t, err)
@@ -160,6 +208,50 @@ func TestCurrencyGlobalMethods(t *testing.T) {
 	wg.Wait()
 }
 
+func TestRegistry(t *testing.T) {
+	data := []byte(strings.Repeat("hello compress algorithms!", 100))
+	for _, compressType := range []CompressType{CompressTypeZstd, CompressTypeLz4, CompressTypeSnappy} {
+		assert.True(t, IsSupported(compressType))
+
+		compressed, err := CompressBytes(compressType, data, []byte("prefix"))
+		assert.NoError(t, err)
+		assert.Equal(t, []byte("prefix"), compressed[:6])
+		detected, ok := DetectCompressType(compressed[6:])
+		assert.True(t, ok)
+		assert.Equal(t, compressType, detected)
+
+		origin, err := DecompressBytes(compressType, compressed[6:], nil)
+		assert.NoError(t, err)
+		assert.Equal(t, data, origin)
+
+		// the blocks can be decompressed as stream
+		dec, err := NewDecompressor(compressType, bytes.NewReader(compressed[6:]))
+		assert.NoError(t, err)
+		out := new(bytes.Buffer)
+		assert.NoError(t, dec.Decompress(out))
+		assert.Equal(t, data, out.Bytes())
+		dec.Close()
+
+		enc, err := NewCompressor(compressType, nil)
+		assert.NoError(t, err)
+		assert.Equal(t, compressType, enc.GetType())
+	}
+
+	_, ok := DetectCompressType([]byte(`{"fieldID": 100}`))
+	assert.False(t, ok)
+
+	unknown := CompressType("unknown")
+	assert.False(t, IsSupported(unknown))
+	_, err := NewCompressor(unknown, nil)
+	assert.Error(t, err)
+	_, err = NewDecompressor(unknown, nil)
+	assert.Error(t, err)
+	_, err = CompressBytes(unknown, data, nil)
+	assert.Error(t, err)
+	_, err = DecompressBytes(unknown, data, nil)
+	assert.Error(t, err)
+}
+
 type ErrReader struct {
 	Err error
 }
@@ -0,0 +1,138 @@
+package compressor
+
+import (
+	"bytes"
+	"io"
+	"sync"
+
+	"github.com/pierrec/lz4/v4"
+)
+
+var (
+	_ Compressor   = (*Lz4Compressor)(nil)
+	_ Decompressor = (*Lz4Decompressor)(nil)
+)
+
+// The lz4 compressor writes the frame format, so the streams and the blocks are interchangeable
+type Lz4Compressor struct {
+	writer *lz4.Writer
+	closed bool
+}
+
+// For compressing small blocks, pass nil to the `out` parameter
+func NewLz4Compressor(out io.Writer, opts ...lz4.Option) (*Lz4Compressor, error) {
+	writer := lz4.NewWriter(out)
+	if err := writer.Apply(opts...); err != nil {
+		return nil, err
+	}
+
+	return &Lz4Compressor{writer: writer}, nil
+}
+
+// Use case: compress stream
+// Call Close() to make sure the data is flushed to the underlying writer
+// after the last Compress() call
+func (c *Lz4Compressor) Compress(in io.Reader) error {
+	// hide the ReadFrom() of lz4.Writer, which takes io.ErrUnexpectedEOF as the end of input
+	_, err := io.Copy(struct{ io.Writer }{c.writer}, in)
+	return err
+}
+
+var lz4WriterPool = sync.Pool{
+	New: func() any {
+		return lz4.NewWriter(nil)
+	},
+}
+
+// Use case: compress small blocks
+// This compresses the src bytes and appends it to the dst bytes, then return the result
+// This can be called concurrently
+func (c *Lz4Compressor) CompressBytes(src []byte, dst []byte) []byte {
+	buf := bytes.NewBuffer(dst)
+	writer := lz4WriterPool.Get().(*lz4.Writer)
+	defer lz4WriterPool.Put(writer)
+
+	// writing to bytes.Buffer never fails
+	writer.Reset(buf)
+	writer.Write(src)
+	writer.Close()
+	return buf.Bytes()
+}
+
+// Reset the writer to reuse the compressor
+func (c *Lz4Compressor) ResetWriter(out io.Writer) {
+	c.writer.Reset(out)
+	c.closed = false
+}
+
+// Close writes the end of the frame, call ResetWriter() to reuse the compressor
+func (c *Lz4Compressor) Close() error {
+	if c.closed {
+		return nil
+	}
+	c.closed = true
+	return c.writer.Close()
+}
+
+func (c *Lz4Compressor) GetType() CompressType {
+	return CompressTypeLz4
+}
+
+type Lz4Decompressor struct {
+	reader *lz4.Reader
+	closed bool
+}
+
+// For decompressing small blocks, pass nil to the `in` parameter
+func NewLz4Decompressor(in io.Reader) (*Lz4Decompressor, error) {
+	return &Lz4Decompressor{reader: lz4.NewReader(in)}, nil
+}
+
+// Usa case: decompress stream
+// Write the decompressed data into `out`
+func (dec *Lz4Decompressor) Decompress(out io.Writer) error {
+	if dec.closed {
+		return errDecompressorClosed
+	}
+	_, err := io.Copy(out, dec.reader)
+	return err
+}
+
+var lz4ReaderPool = sync.Pool{
+	New: func() any {
+		return lz4.NewReader(nil)
+	},
+}
+
+// Use case: decompress small blocks
+// This decompresses the src bytes and appends it to the dst bytes, then return the result
+// This can be called concurrently
+func (dec *Lz4Decompressor) DecompressBytes(src []byte, dst []byte) ([]byte, error) {
+	if dec.closed {
+		return nil, errDecompressorClosed
+	}
+	buf := bytes.NewBuffer(dst)
+	reader := lz4ReaderPool.Get().(*lz4.Reader)
+	defer lz4ReaderPool.Put(reader)
+
+	reader.Reset(bytes.NewReader(src))
+	if _, err := io.Copy(buf, reader); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// Reset the reader to reuse the decompressor
+func (dec *Lz4Decompressor) ResetReader(in io.Reader) {
+	dec.reader.Reset(in)
+}
+
+// NOTICE: not like compressor, the decompressor is not usable after calling this
+func (dec *Lz4Decompressor) Close() {
+	dec.closed = true
+	dec.reader.Reset(nil)
+}
+
+func (dec *Lz4Decompressor) GetType() CompressType {
+	return CompressTypeLz4
+}
@@ -0,0 +1,152 @@
+package compressor
+
+import (
+	"bytes"
+	"io"
+	"sync"
+
+	"github.com/cockroachdb/errors"
+)
+
+// codec creates the compressors and decompressors of a compress type
+type codec struct {
+	// magic is the leading bytes of the compressed data, used to detect the compress type
+	magic           []byte
+	newCompressor   func(out io.Writer) (Compressor, error)
+	newDecompressor func(in io.Reader) (Decompressor, error)
+
+	// shared by the block compressing, which can be called concurrently
+	compressor   Compressor
+	decompressor Decompressor
+}
+
+var (
+	codecMu sync.RWMutex
+	codecs  = make(map[CompressType]*codec)
+)
+
+func init() {
+	builtins := []struct {
+		compressType    CompressType
+		magic           []byte
+		newCompressor   func(out io.Writer) (Compressor, error)
+		newDecompressor func(in io.Reader) (Decompressor, error)
+	}{
+		{
+			CompressTypeZstd, []byte{0x28, 0xb5, 0x2f, 0xfd},
+			func(out io.Writer) (Compressor, error) { return NewZstdCompressor(out) },
+			func(in io.Reader) (Decompressor, error) { return NewZstdDecompressor(in) },
+		},
+		{
+			CompressTypeLz4, []byte{0x04, 0x22, 0x4d, 0x18},
+			func(out io.Writer) (Compressor, error) { return NewLz4Compressor(out) },
+			func(in io.Reader) (Decompressor, error) { return NewLz4Decompressor(in) },
+		},
+		{
+			CompressTypeSnappy, []byte("\xff\x06\x00\x00sNaPpY"),
+			func(out io.Writer) (Compressor, error) { return NewSnappyCompressor(out) },
+			func(in io.Reader) (Decompressor, error) { return NewSnappyDecompressor(in) },
+		},
+	}
+	for _, b := range builtins {
+		if err := Register(b.compressType, b.magic, b.newCompressor, b.newDecompressor); err != nil {
+			panic(err)
+		}
+	}
+}
+
+// Register registers the compress type, the registered one with the same name is replaced
+// The data compressed must start with the magic bytes
+func Register(compressType CompressType, magic []byte,
+	newCompressor func(out io.Writer) (Compressor, error),
+	newDecompressor func(in io.Reader) (Decompressor, error),
+) error {
+	compressor, err := newCompressor(nil)
+	if err != nil {
+		return err
+	}
+	decompressor, err := newDecompressor(nil)
+	if err != nil {
+		return err
+	}
+
+	codecMu.Lock()
+	defer codecMu.Unlock()
+	codecs[compressType] = &codec{
+		magic:           magic,
+		newCompressor:   newCompressor,
+		newDecompressor: newDecompressor,
+		compressor:      compressor,
+		decompressor:    decompressor,
+	}
+	return nil
+}
+
+func getCodec(compressType CompressType) (*codec, error) {
+	codecMu.RLock()
+	defer codecMu.RUnlock()
+	c, ok := codecs[compressType]
+	if !ok {
+		return nil, errors.Newf("unsupported compress type %s", compressType)
+	}
+	return c, nil
+}
+
+// IsSupported returns whether the compress type is registered
+func IsSupported(compressType CompressType) bool {
+	_, err := getCodec(compressType)
+	return err == nil
+}
+
+// NewCompressor creates the compressor of the compress type
+// For compressing small blocks, pass nil to the `out` parameter
+func NewCompressor(compressType CompressType, out io.Writer) (Compressor, error) {
+	c, err := getCodec(compressType)
+	if err != nil {
+		return nil, err
+	}
+	return c.newCompressor(out)
+}
+
+// NewDecompressor creates the decompressor of the compress type
+// For decompressing small blocks, pass nil to the `in` parameter
+func NewDecompressor(compressType CompressType, in io.Reader) (Decompressor, error) {
+	c, err := getCodec(compressType)
+	if err != nil {
+		return nil, err
+	}
+	return c.newDecompressor(in)
+}
+
+// Use case: compress small blocks with the compress type
+// This can be called concurrently
+func CompressBytes(compressType CompressType, src, dst []byte) ([]byte, error) {
+	c, err := getCodec(compressType)
+	if err != nil {
+		return nil, err
+	}
+	return c.compressor.CompressBytes(src, dst), nil
+}
+
+// Use case: decompress small blocks with the compress type
+// This can be called concurrently
+func DecompressBytes(compressType CompressType, src, dst []byte) ([]byte, error) {
+	c, err := getCodec(compressType)
+	if err != nil {
+		return nil, err
+	}
+	return c.decompressor.DecompressBytes(src, dst)
+}
+
+// DetectCompressType detects the compress type by the magic bytes of the data
+// Returns false if the data is not compressed by any registered compress type
+func DetectCompressType(data []byte) (CompressType, bool) {
+	codecMu.RLock()
+	defer codecMu.RUnlock()
+	for compressType, c := range codecs {
+		if len(c.magic) > 0 && bytes.HasPrefix(data, c.magic) {
+			return compressType, true
+		}
+	}
+	return "", false
+}
@@ -0,0 +1,130 @@
+package compressor
+
+import (
+	"bytes"
+	"io"
+	"sync"
+
+	"github.com/cockroachdb/errors"
+	"github.com/golang/snappy"
+)
+
+var (
+	_ Compressor   = (*SnappyCompressor)(nil)
+	_ Decompressor = (*SnappyDecompressor)(nil)
+
+	errDecompressorClosed = errors.New("decompressor is closed")
+)
+
+// The snappy compressor writes the framing format, so the streams and the blocks are interchangeable
+type SnappyCompressor struct {
+	writer *snappy.Writer
+}
+
+// For compressing small blocks, pass nil to the `out` parameter
+func NewSnappyCompressor(out io.Writer) (*SnappyCompressor, error) {
+	return &SnappyCompressor{snappy.NewBufferedWriter(out)}, nil
+}
+
+// Use case: compress stream
+// Call Close() to make sure the data is flushed to the underlying writer
+// after the last Compress() call
+func (c *SnappyCompressor) Compress(in io.Reader) error {
+	_, err := io.Copy(c.writer, in)
+	return err
+}
+
+var snappyWriterPool = sync.Pool{
+	New: func() any {
+		return snappy.NewBufferedWriter(nil)
+	},
+}
+
+// Use case: compress small blocks
+// This compresses the src bytes and appends it to the dst bytes, then return the result
+// This can be called concurrently
+func (c *SnappyCompressor) CompressBytes(src []byte, dst []byte) []byte {
+	buf := bytes.NewBuffer(dst)
+	writer := snappyWriterPool.Get().(*snappy.Writer)
+	defer snappyWriterPool.Put(writer)
+
+	// writing to bytes.Buffer never fails
+	writer.Reset(buf)
+	writer.Write(src)
+	writer.Close()
+	return buf.Bytes()
+}
+
+// Reset the writer to reuse the compressor
+func (c *SnappyCompressor) ResetWriter(out io.Writer) {
+	c.writer.Reset(out)
+}
+
+// The snappy framing format has no trailer, flushing is enough
+// The compressor is still re-used after calling this
+func (c *SnappyCompressor) Close() error {
+	return c.writer.Flush()
+}
+
+func (c *SnappyCompressor) GetType() CompressType {
+	return CompressTypeSnappy
+}
+
+type SnappyDecompressor struct {
+	reader *snappy.Reader
+	closed bool
+}
+
+// For decompressing small blocks, pass nil to the `in` parameter
+func NewSnappyDecompressor(in io.Reader) (*SnappyDecompressor, error) {
+	return &SnappyDecompressor{reader: snappy.NewReader(in)}, nil
+}
+
+// Usa case: decompress stream
+// Write the decompressed data into `out`
+func (dec *SnappyDecompressor) Decompress(out io.Writer) error {
+	if dec.closed {
+		return errDecompressorClosed
+	}
+	_, err := io.Copy(out, dec.reader)
+	return err
+}
+
+var snappyReaderPool = sync.Pool{
+	New: func() any {
+		return snappy.NewReader(nil)
+	},
+}
+
+// Use case: decompress small blocks
+// This decompresses the src bytes and appends it to the dst bytes, then return the result
+// This can be called concurrently
+func (dec *SnappyDecompressor) DecompressBytes(src []byte, dst []byte) ([]byte, error) {
+	if dec.closed {
+		return nil, errDecompressorClosed
+	}
+	buf := bytes.NewBuffer(dst)
+	reader := snappyReaderPool.Get().(*snappy.Reader)
+	defer snappyReaderPool.Put(reader)
+
+	reader.Reset(bytes.NewReader(src))
+	if _, err := io.Copy(buf, reader); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// Reset the reader to reuse the decompressor
+func (dec *SnappyDecompressor) ResetReader(in io.Reader) {
+	dec.reader.Reset(in)
+}
+
+// NOTICE: not like compressor, the decompressor is not usable after calling this
+func (dec *SnappyDecompressor) Close() {
+	dec.closed = true
+	dec.reader.Reset(nil)
+}
+
+func (dec *SnappyDecompressor) GetType() CompressType {
+	return CompressTypeSnappy
+}
@@ -299,6 +299,32 @@ func SetDiskIndexBuildParams(indexParams map[string]string, fieldDataSize int64)
 	return nil
 }
 
+// FillBitmapIndexParams fill the roaring bitmap params of BITMAP and HYBRID index on proxy node
+// when they are not specified by user, so that the configured defaults are persisted in index meta.
+func FillBitmapIndexParams(params *paramtable.ComponentParam, indexParams map[string]string) {
+	if _, ok := indexParams[common.BitmapRunOptimizeKey]; !ok {
+		indexParams[common.BitmapRunOptimizeKey] = params.AutoIndexConfig.BitmapRunOptimize.GetValue()
+	}
+	if _, ok := indexParams[common.BitmapOffsetBitsKey]; !ok {
+		indexParams[common.BitmapOffsetBitsKey] = params.AutoIndexConfig.BitmapOffsetBits.GetValue()
+	}
+}
+
+// AppendBitmapIndexBuildParams append the roaring bitmap params for `buildIndex`,
+// indexes created before these params were introduced do not have them in meta.
+func AppendBitmapIndexBuildParams(params *paramtable.ComponentParam, indexParams []*commonpb.KeyValuePair) []*commonpb.KeyValuePair {
+	defaults := []*commonpb.KeyValuePair{
+		{Key: common.BitmapRunOptimizeKey, Value: params.AutoIndexConfig.BitmapRunOptimize.GetValue()},
+		{Key: common.BitmapOffsetBitsKey, Value: params.AutoIndexConfig.BitmapOffsetBits.GetValue()},
+	}
+	for _, kv := range defaults {
+		if len(GetIndexParams(indexParams, kv.Key)) == 0 {
+			indexParams = append(indexParams, kv)
+		}
+	}
+	return indexParams
+}
+
 func SetBitmapIndexLoadParams(params *paramtable.ComponentParam, indexParams map[string]string) {
 	_, exist := indexParams[common.IndexOffsetCacheEnabledKey]
 	if exist {
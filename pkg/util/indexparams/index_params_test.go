@@ -606,3 +606,35 @@ func TestAppendPrepareInfo_parse(t *testing.T) {
 		assert.Equal(t, resultMapString["key2"], "value2")
 	})
 }
+
+func TestBitmapIndexParams(t *testing.T) {
+	var params paramtable.ComponentParam
+	params.Init(paramtable.NewBaseTable(paramtable.SkipRemote(true)))
+
+	t.Run("fill bitmap index params", func(t *testing.T) {
+		indexParams := map[string]string{common.IndexTypeKey: "BITMAP"}
+		FillBitmapIndexParams(&params, indexParams)
+		assert.Equal(t, "true", indexParams[common.BitmapRunOptimizeKey])
+		assert.Equal(t, "32", indexParams[common.BitmapOffsetBitsKey])
+
+		indexParams = map[string]string{
+			common.IndexTypeKey:         "BITMAP",
+			common.BitmapRunOptimizeKey: "false",
+			common.BitmapOffsetBitsKey:  "64",
+		}
+		FillBitmapIndexParams(&params, indexParams)
+		assert.Equal(t, "false", indexParams[common.BitmapRunOptimizeKey])
+		assert.Equal(t, "64", indexParams[common.BitmapOffsetBitsKey])
+	})
+
+	t.Run("append bitmap index build params", func(t *testing.T) {
+		indexParams := []*commonpb.KeyValuePair{
+			{Key: common.IndexTypeKey, Value: "HYBRID"},
+			{Key: common.BitmapOffsetBitsKey, Value: "64"},
+		}
+		indexParams = AppendBitmapIndexBuildParams(&params, indexParams)
+		assert.Len(t, indexParams, 3)
+		assert.Equal(t, "true", GetIndexParams(indexParams, common.BitmapRunOptimizeKey))
+		assert.Equal(t, "64", GetIndexParams(indexParams, common.BitmapOffsetBitsKey))
+	})
+}
@@ -55,10 +55,13 @@ type AutoIndexConfig struct {
 	ScalarFloatIndexType   ParamItem `refreshable:"true"`
 
 	BitmapCardinalityLimit ParamItem `refreshable:"true"`
+	BitmapRunOptimize      ParamItem `refreshable:"true"`
+	BitmapOffsetBits       ParamItem `refreshable:"true"`
 }
 
 const (
 	DefaultBitmapCardinalityLimit = 100
+	DefaultBitmapOffsetBits       = 32
 )
 
 func (p *AutoIndexConfig) init(base *BaseTable) {
@@ -221,6 +224,24 @@ func (p *AutoIndexConfig) init(base *BaseTable) {
 	}
 	p.BitmapCardinalityLimit.Init(base.mgr)
 
+	p.BitmapRunOptimize = ParamItem{
+		Key:          "scalarAutoIndex.params.bitmapRunOptimize",
+		Version:      "2.6.0",
+		DefaultValue: "true",
+		Doc:          "whether to convert roaring bitmap containers into run containers when it saves memory, for BITMAP and HYBRID index",
+		Export:       true,
+	}
+	p.BitmapRunOptimize.Init(base.mgr)
+
+	p.BitmapOffsetBits = ParamItem{
+		Key:          "scalarAutoIndex.params.bitmapOffsetBits",
+		Version:      "2.6.0",
+		DefaultValue: strconv.Itoa(DefaultBitmapOffsetBits),
+		Doc:          "width of the row offsets stored in roaring bitmaps of BITMAP and HYBRID index, 32 or 64",
+		Export:       true,
+	}
+	p.BitmapOffsetBits.Init(base.mgr)
+
 	p.ScalarVarcharIndexType = ParamItem{
 		Version: "2.4.0",
 		Formatter: func(v string) string {
@@ -168,3 +168,18 @@ func TestScalarAutoIndexParams_build(t *testing.T) {
 		assert.Equal(t, "INVERTED", CParams.AutoIndexConfig.ScalarBoolIndexType.GetValue())
 	})
 }
+
+func TestBitmapIndexParams_build(t *testing.T) {
+	var CParams ComponentParam
+	bt := NewBaseTable(SkipRemote(true))
+	CParams.Init(bt)
+
+	assert.Equal(t, DefaultBitmapCardinalityLimit, CParams.AutoIndexConfig.BitmapCardinalityLimit.GetAsInt())
+	assert.True(t, CParams.AutoIndexConfig.BitmapRunOptimize.GetAsBool())
+	assert.Equal(t, DefaultBitmapOffsetBits, CParams.AutoIndexConfig.BitmapOffsetBits.GetAsInt())
+
+	bt.Save(CParams.AutoIndexConfig.BitmapRunOptimize.Key, "false")
+	bt.Save(CParams.AutoIndexConfig.BitmapOffsetBits.Key, "64")
+	assert.False(t, CParams.AutoIndexConfig.BitmapRunOptimize.GetAsBool())
+	assert.Equal(t, 64, CParams.AutoIndexConfig.BitmapOffsetBits.GetAsInt())
+}
@@ -31,6 +31,7 @@ import (
 
 	"github.com/milvus-io/milvus/pkg/v2/config"
 	"github.com/milvus-io/milvus/pkg/v2/log"
+	"github.com/milvus-io/milvus/pkg/v2/util/compressor"
 	"github.com/milvus-io/milvus/pkg/v2/util/hardware"
 	"github.com/milvus-io/milvus/pkg/v2/util/metricsinfo"
 	"github.com/milvus-io/milvus/pkg/v2/util/typeutil"
@@ -270,6 +271,7 @@ type commonConfig struct {
 	StorageScheme             ParamItem `refreshable:"false"`
 	EnableStorageV2           ParamItem `refreshable:"false"`
 	StoragePathPrefix         ParamItem `refreshable:"false"`
+	StatsLogCompressType      ParamItem `refreshable:"true"`
 	TTMsgEnabled              ParamItem `refreshable:"true"`
 	TraceLogMode              ParamItem `refreshable:"true"`
 	BloomFilterSize           ParamItem `refreshable:"true"`
@@ -819,6 +821,25 @@ like the old password verification when updating the credential`,
 	}
 	p.StoragePathPrefix.Init(base.mgr)
 
+	p.StatsLogCompressType = ParamItem{
+		Key:          "common.storage.statsLogCompressType",
+		Version:      "2.6.0",
+		DefaultValue: "",
+		Formatter: func(v string) string {
+			if v == "" || compressor.IsSupported(compressor.CompressType(v)) {
+				return v
+			}
+			// fall back to no compression, otherwise every flush fails
+			log.Warn("Unsupported common.storage.statsLogCompressType, stats logs are not compressed",
+				zap.String("common.storage.statsLogCompressType", v))
+			return ""
+		},
+		Doc: `The compression algorithm of the primary key stats logs, support zstd, lz4 and snappy.
+Empty means the stats logs are not compressed, the compressed and uncompressed stats logs can be read either way`,
+		Export: true,
+	}
+	p.StatsLogCompressType.Init(base.mgr)
+
 	p.TTMsgEnabled = ParamItem{
 		Key:          "common.ttMsgEnabled",
 		Version:      "2.3.2",
@@ -1415,9 +1436,13 @@ type AccessLogConfig struct {
 	RemotePath    ParamItem  `refreshable:"false"`
 	RemoteMaxTime ParamItem  `refreshable:"false"`
 	Formatter     ParamGroup `refreshable:"false"`
+	OutputFormat  ParamItem  `refreshable:"false"`
 
 	CacheSize          ParamItem `refreshable:"false"`
 	CacheFlushInterval ParamItem `refreshable:"false"`
+
+	KafkaEnable ParamItem `refreshable:"false"`
+	KafkaTopic  ParamItem `refreshable:"false"`
 }
 
 type proxyConfig struct {
@@ -1762,6 +1787,35 @@ please adjust in embedded Milvus: false`,
 	}
 	p.AccessLog.Formatter.Init(base.mgr)
 
+	p.AccessLog.OutputFormat = ParamItem{
+		Key:          "proxy.accessLog.outputFormat",
+		Version:      "2.6.0",
+		DefaultValue: "text",
+		Doc: `The output format of access logs, text or json. The text logs follow the formats of the formatters,
+while the json logs are the objects of the metrics in the formats, keyed by the metric names without "$",
+the response_size and error_code are numbers, the time_cost is a number in milliseconds and the unknown values are null.`,
+		Export: true,
+	}
+	p.AccessLog.OutputFormat.Init(base.mgr)
+
+	p.AccessLog.KafkaEnable = ParamItem{
+		Key:          "proxy.accessLog.kafka.enable",
+		Version:      "2.6.0",
+		DefaultValue: "false",
+		Doc:          "Whether to send access logs to kafka, the brokers are configured by the kafka section. Access logs are written to both the file and kafka if proxy.accessLog.filename is not empty, otherwise only to kafka.",
+		Export:       true,
+	}
+	p.AccessLog.KafkaEnable.Init(base.mgr)
+
+	p.AccessLog.KafkaTopic = ParamItem{
+		Key:          "proxy.accessLog.kafka.topic",
+		Version:      "2.6.0",
+		DefaultValue: "milvus-access-log",
+		Doc:          "The kafka topic which access logs are sent to.",
+		Export:       true,
+	}
+	p.AccessLog.KafkaTopic.Init(base.mgr)
+
 	p.ShardLeaderCacheInterval = ParamItem{
 		Key:          "proxy.shardLeaderCacheInterval",
 		Version:      "2.2.4",
@@ -2703,6 +2757,8 @@ type queryNodeConfig struct {
 	EnableSegmentPrune                      ParamItem `refreshable:"false"`
 	DefaultSegmentFilterRatio               ParamItem `refreshable:"false"`
 	UseStreamComputing                      ParamItem `refreshable:"false"`
+	EnableFilterCache                       ParamItem `refreshable:"true"`
+	FilterCacheSize                         ParamItem `refreshable:"false"`
 	QueryStreamBatchSize                    ParamItem `refreshable:"false"`
 	QueryStreamMaxBatchSize                 ParamItem `refreshable:"false"`
 
@@ -3392,7 +3448,7 @@ user-task-polling:
 		Key:          "queryNode.enableSegmentPrune",
 		Version:      "2.3.4",
 		DefaultValue: "false",
-		Doc:          "use partition stats to prune data in search/query on shard delegator",
+		Doc:          "use partition stats and zone maps to prune data in search/query on shard delegator",
 		Export:       true,
 	}
 	p.EnableSegmentPrune.Init(base.mgr)
@@ -3411,6 +3467,24 @@ user-task-polling:
 	}
 	p.UseStreamComputing.Init(base.mgr)
 
+	p.EnableFilterCache = ParamItem{
+		Key:          "queryNode.filterCache.enabled",
+		Version:      "2.6.0",
+		DefaultValue: "false",
+		Doc:          "cache the filter results of sealed segments keyed by the filter expression, which serve the count queries with the same filter",
+		Export:       true,
+	}
+	p.EnableFilterCache.Init(base.mgr)
+
+	p.FilterCacheSize = ParamItem{
+		Key:          "queryNode.filterCache.size",
+		Version:      "2.6.0",
+		DefaultValue: "268435456",
+		Doc:          "max memory used by the filter cache of sealed segments, in bytes",
+		Export:       true,
+	}
+	p.FilterCacheSize.Init(base.mgr)
+
 	p.QueryStreamBatchSize = ParamItem{
 		Key:          "queryNode.queryStreamBatchSize",
 		Version:      "2.4.1",
@@ -3486,11 +3560,13 @@ type dataCoordConfig struct {
 	BlockingL0SizeInMB             ParamItem `refreshable:"true"`
 
 	// compaction
-	EnableCompaction            ParamItem `refreshable:"false"`
-	EnableAutoCompaction        ParamItem `refreshable:"true"`
-	IndexBasedCompaction        ParamItem `refreshable:"true"`
-	CompactionTaskPrioritizer   ParamItem `refreshable:"true"`
-	CompactionTaskQueueCapacity ParamItem `refreshable:"false"`
+	EnableCompaction                          ParamItem `refreshable:"false"`
+	EnableAutoCompaction                      ParamItem `refreshable:"true"`
+	IndexBasedCompaction                      ParamItem `refreshable:"true"`
+	CompactionTaskPrioritizer                 ParamItem `refreshable:"true"`
+	CompactionTaskPriorityClasses             ParamItem `refreshable:"true"`
+	CompactionTaskQueueCapacity               ParamItem `refreshable:"false"`
+	CompactionMaxConcurrentTasksPerCollection ParamItem `refreshable:"true"`
 
 	CompactionRPCTimeout             ParamItem `refreshable:"true"`
 	CompactionMaxParallelTasks       ParamItem `refreshable:"true"`
@@ -3513,6 +3589,10 @@ type dataCoordConfig struct {
 	SingleCompactionExpiredLogMaxSize ParamItem `refreshable:"true"`
 	SingleCompactionDeltalogMaxNum    ParamItem `refreshable:"true"`
 
+	TTLCompactionEnable          ParamItem `refreshable:"true"`
+	TTLCompactionTriggerInterval ParamItem `refreshable:"false"`
+	TTLCompactionTimeWindow      ParamItem `refreshable:"true"`
+
 	ChannelCheckpointMaxLag ParamItem `refreshable:"true"`
 	SyncSegmentsInterval    ParamItem `refreshable:"false"`
 
@@ -3576,6 +3656,13 @@ type dataCoordConfig struct {
 	MaxImportJobNum          ParamItem `refreshable:"true"`
 	WaitForIndex             ParamItem `refreshable:"true"`
 
+	// export
+	ExportScheduleInterval   ParamItem `refreshable:"true"`
+	ExportJobRetention       ParamItem `refreshable:"true"`
+	MaxConcurrentExportJobs  ParamItem `refreshable:"false"`
+	MaxSizeInMBPerExportFile ParamItem `refreshable:"true"`
+	SnapshotFlushTimeout     ParamItem `refreshable:"true"`
+
 	GracefulStopTimeout ParamItem `refreshable:"true"`
 
 	ClusteringCompactionSlotUsage ParamItem `refreshable:"true"`
@@ -3799,14 +3886,27 @@ This configuration takes effect only when dataCoord.enableCompaction is set as t
 		Key:          "dataCoord.compaction.taskPrioritizer",
 		Version:      "2.5.0",
 		DefaultValue: "default",
-		Doc: `compaction task prioritizer, options: [default, level, mix]. 
+		Doc: `compaction task prioritizer, options: [default, level, mix, class]. 
 default is FIFO.
 level is prioritized by level: L0 compactions first, then mix compactions, then clustering compactions.
-mix is prioritized by level: mix compactions first, then L0 compactions, then clustering compactions.`,
+mix is prioritized by level: mix compactions first, then L0 compactions, then clustering compactions.
+class is prioritized by the order of the task classes in dataCoord.compaction.taskPriorityClasses.`,
 		Export: true,
 	}
 	p.CompactionTaskPrioritizer.Init(base.mgr)
 
+	p.CompactionTaskPriorityClasses = ParamItem{
+		Key:          "dataCoord.compaction.taskPriorityClasses",
+		Version:      "2.6.0",
+		DefaultValue: "manual,l0,mix,clustering",
+		Doc: `the task classes ordered by priority, used by the class prioritizer, options: [manual, l0, mix, clustering].
+manual is the class of the manually triggered compactions, the others are the classes of the compactions by type.
+the manual class is kept in memory, the unfinished manual compactions fall back to their type classes after datacoord restarts.
+the tasks of the unlisted classes are scheduled at last.`,
+		Export: true,
+	}
+	p.CompactionTaskPriorityClasses.Init(base.mgr)
+
 	p.CompactionTaskQueueCapacity = ParamItem{
 		Key:          "dataCoord.compaction.taskQueueCapacity",
 		Version:      "2.5.0",
@@ -3816,6 +3916,15 @@ mix is prioritized by level: mix compactions first, then L0 compactions, then cl
 	}
 	p.CompactionTaskQueueCapacity.Init(base.mgr)
 
+	p.CompactionMaxConcurrentTasksPerCollection = ParamItem{
+		Key:          "dataCoord.compaction.maxConcurrentTasksPerCollection",
+		Version:      "2.6.0",
+		DefaultValue: "0",
+		Doc:          "The max number of compaction tasks executing concurrently for one collection, 0 means unlimited",
+		Export:       true,
+	}
+	p.CompactionMaxConcurrentTasksPerCollection.Init(base.mgr)
+
 	p.CompactionRPCTimeout = ParamItem{
 		Key:          "dataCoord.compaction.rpcTimeout",
 		Version:      "2.2.12",
@@ -3954,6 +4063,33 @@ During compaction, the size of segment # of rows is able to exceed segment max #
 	}
 	p.SingleCompactionDeltalogMaxNum.Init(base.mgr)
 
+	p.TTLCompactionEnable = ParamItem{
+		Key:          "dataCoord.compaction.ttl.enable",
+		Version:      "2.6.0",
+		DefaultValue: "false",
+		Doc:          "Enable the ttl compaction, which drops the expired segments of the collections with ttl without rewriting them",
+		Export:       true,
+	}
+	p.TTLCompactionEnable.Init(base.mgr)
+
+	p.TTLCompactionTriggerInterval = ParamItem{
+		Key:          "dataCoord.compaction.ttl.triggerInterval",
+		Version:      "2.6.0",
+		DefaultValue: "600",
+		Doc:          "The time interval in seconds to trigger ttl compaction",
+		Export:       true,
+	}
+	p.TTLCompactionTriggerInterval.Init(base.mgr)
+
+	p.TTLCompactionTimeWindow = ParamItem{
+		Key:          "dataCoord.compaction.ttl.timeWindow",
+		Version:      "2.6.0",
+		DefaultValue: "3600",
+		Doc:          "The insert time window in seconds to group the segments by ttl compaction, the partially expired segments are only compacted with the ones in the same window",
+		Export:       true,
+	}
+	p.TTLCompactionTimeWindow.Init(base.mgr)
+
 	p.GlobalCompactionInterval = ParamItem{
 		Key:          "dataCoord.compaction.global.interval",
 		Version:      "2.0.0",
@@ -4432,6 +4568,56 @@ During compaction, the size of segment # of rows is able to exceed segment max #
 	}
 	p.WaitForIndex.Init(base.mgr)
 
+	p.ExportScheduleInterval = ParamItem{
+		Key:          "dataCoord.export.scheduleInterval",
+		Version:      "2.6.0",
+		Doc:          "The interval for scheduling export, measured in seconds.",
+		DefaultValue: "2",
+		PanicIfEmpty: false,
+		Export:       true,
+	}
+	p.ExportScheduleInterval.Init(base.mgr)
+
+	p.ExportJobRetention = ParamItem{
+		Key:          "dataCoord.export.jobRetention",
+		Version:      "2.6.0",
+		Doc:          "The retention period in seconds for export jobs in the Completed or Failed state.",
+		DefaultValue: "10800",
+		PanicIfEmpty: false,
+		Export:       true,
+	}
+	p.ExportJobRetention.Init(base.mgr)
+
+	p.MaxConcurrentExportJobs = ParamItem{
+		Key:          "dataCoord.export.maxConcurrentJobs",
+		Version:      "2.6.0",
+		Doc:          "The maximum number of export jobs allowed to run concurrently, the tasks of each job are dispatched to the dataNodes.",
+		DefaultValue: "2",
+		PanicIfEmpty: false,
+		Export:       true,
+	}
+	p.MaxConcurrentExportJobs.Init(base.mgr)
+
+	p.MaxSizeInMBPerExportFile = ParamItem{
+		Key:          "dataCoord.export.maxSizeInMBPerFile",
+		Version:      "2.6.0",
+		Doc:          "The maximum size (in MB) of each exported Parquet file, a new file is started once the limit is reached.",
+		DefaultValue: "512",
+		PanicIfEmpty: false,
+		Export:       true,
+	}
+	p.MaxSizeInMBPerExportFile.Init(base.mgr)
+
+	p.SnapshotFlushTimeout = ParamItem{
+		Key:          "dataCoord.snapshot.flushTimeout",
+		Version:      "2.6.0",
+		Doc:          "The timeout in seconds to wait for the collection to be flushed before creating a snapshot.",
+		DefaultValue: "600",
+		PanicIfEmpty: false,
+		Export:       true,
+	}
+	p.SnapshotFlushTimeout.Init(base.mgr)
+
 	p.GracefulStopTimeout = ParamItem{
 		Key:          "dataCoord.gracefulStopTimeout",
 		Version:      "2.3.7",
@@ -4530,6 +4716,7 @@ type dataNodeConfig struct {
 	FlushDeleteBufferBytes ParamItem `refreshable:"true"`
 	BinLogMaxSize          ParamItem `refreshable:"true"`
 	SyncPeriod             ParamItem `refreshable:"true"`
+	ZoneMapEnabled         ParamItem `refreshable:"true"`
 
 	// watchEvent
 	WatchEventTicklerInterval ParamItem `refreshable:"false"`
@@ -4565,8 +4752,13 @@ type dataNodeConfig struct {
 	MaxConcurrentImportTaskNum ParamItem `refreshable:"true"`
 	MaxImportFileSizeInGB      ParamItem `refreshable:"true"`
 	ReadBufferSizeInMB         ParamItem `refreshable:"true"`
+	MaxBadRowsInReport         ParamItem `refreshable:"true"`
+	MaxPrimaryKeysInDryRun     ParamItem `refreshable:"true"`
 	MaxTaskSlotNum             ParamItem `refreshable:"true"`
 
+	// export
+	MaxConcurrentExportTaskNum ParamItem `refreshable:"false"`
+
 	// Compaction
 	L0BatchMemoryRatio       ParamItem `refreshable:"true"`
 	L0CompactionMaxBatchSize ParamItem `refreshable:"true"`
@@ -4747,6 +4939,15 @@ Setting this parameter too small causes the system to store a small amount of da
 	}
 	p.SyncPeriod.Init(base.mgr)
 
+	p.ZoneMapEnabled = ParamItem{
+		Key:          "dataNode.segment.zoneMap.enabled",
+		Version:      "2.6.0",
+		DefaultValue: "true",
+		Doc:          "Whether to write the min/max and null count stats of the scalar fields when syncing segments, which are used by query nodes to prune segments.",
+		Export:       true,
+	}
+	p.ZoneMapEnabled.Init(base.mgr)
+
 	p.WatchEventTicklerInterval = ParamItem{
 		Key:          "dataNode.segment.watchEventTicklerInterval",
 		Version:      "2.2.3",
@@ -4875,6 +5076,26 @@ if this parameter <= 0, will set it as 10`,
 	}
 	p.ReadBufferSizeInMB.Init(base.mgr)
 
+	p.MaxBadRowsInReport = ParamItem{
+		Key:          "dataNode.import.maxBadRowsInReport",
+		Version:      "2.6.0",
+		Doc:          "The maximum number of bad rows kept in the bad rows report of each import file.",
+		DefaultValue: "1000",
+		PanicIfEmpty: false,
+		Export:       true,
+	}
+	p.MaxBadRowsInReport.Init(base.mgr)
+
+	p.MaxPrimaryKeysInDryRun = ParamItem{
+		Key:          "dataNode.import.maxPrimaryKeysInDryRun",
+		Version:      "2.6.0",
+		Doc:          "The maximum number of primary keys of each import file kept in memory to detect the duplicated primary keys in dry-run mode, the keys beyond it are not tracked.",
+		DefaultValue: "1000000",
+		PanicIfEmpty: false,
+		Export:       true,
+	}
+	p.MaxPrimaryKeysInDryRun.Init(base.mgr)
+
 	p.MaxTaskSlotNum = ParamItem{
 		Key:          "dataNode.import.maxTaskSlotNum",
 		Version:      "2.4.13",
@@ -4885,6 +5106,16 @@ if this parameter <= 0, will set it as 10`,
 	}
 	p.MaxTaskSlotNum.Init(base.mgr)
 
+	p.MaxConcurrentExportTaskNum = ParamItem{
+		Key:          "dataNode.export.maxConcurrentTaskNum",
+		Version:      "2.6.0",
+		Doc:          "The maximum number of export tasks allowed to run concurrently on a datanode.",
+		DefaultValue: "2",
+		PanicIfEmpty: false,
+		Export:       true,
+	}
+	p.MaxConcurrentExportTaskNum.Init(base.mgr)
+
 	p.L0BatchMemoryRatio = ParamItem{
 		Key:          "dataNode.compaction.levelZeroBatchMemoryRatio",
 		Version:      "2.4.0",
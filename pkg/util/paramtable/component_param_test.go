@@ -142,6 +142,12 @@ func TestComponentParam(t *testing.T) {
 		assert.Equal(t, 60*time.Second, params.CommonCfg.SyncTaskPoolReleaseTimeoutSeconds.GetAsDuration(time.Second))
 		params.Save("common.sync.taskPoolReleaseTimeoutSeconds", "100")
 		assert.Equal(t, 100*time.Second, params.CommonCfg.SyncTaskPoolReleaseTimeoutSeconds.GetAsDuration(time.Second))
+
+		assert.Equal(t, "", params.CommonCfg.StatsLogCompressType.GetValue())
+		params.Save("common.storage.statsLogCompressType", "lz4")
+		assert.Equal(t, "lz4", params.CommonCfg.StatsLogCompressType.GetValue())
+		params.Save("common.storage.statsLogCompressType", "unknown")
+		assert.Equal(t, "", params.CommonCfg.StatsLogCompressType.GetValue())
 	})
 
 	t.Run("test rootCoordConfig", func(t *testing.T) {
@@ -487,6 +493,9 @@ func TestComponentParam(t *testing.T) {
 
 		assert.Equal(t, true, Params.MmapChunkCache.GetAsBool())
 		assert.Equal(t, 60*time.Second, Params.DiskSizeFetchInterval.GetAsDuration(time.Second))
+
+		assert.False(t, Params.EnableFilterCache.GetAsBool())
+		assert.Equal(t, int64(256*1024*1024), Params.FilterCacheSize.GetAsInt64())
 	})
 
 	t.Run("test dataCoordConfig", func(t *testing.T) {
@@ -509,6 +518,11 @@ func TestComponentParam(t *testing.T) {
 		assert.Equal(t, 1024, Params.MaxFilesPerImportReq.GetAsInt())
 		assert.Equal(t, 1024, Params.MaxImportJobNum.GetAsInt())
 		assert.Equal(t, true, Params.WaitForIndex.GetAsBool())
+		assert.Equal(t, 2*time.Second, Params.ExportScheduleInterval.GetAsDuration(time.Second))
+		assert.Equal(t, 10800*time.Second, Params.ExportJobRetention.GetAsDuration(time.Second))
+		assert.Equal(t, 2, Params.MaxConcurrentExportJobs.GetAsInt())
+		assert.Equal(t, 512, Params.MaxSizeInMBPerExportFile.GetAsInt())
+		assert.Equal(t, 600*time.Second, Params.SnapshotFlushTimeout.GetAsDuration(time.Second))
 
 		params.Save("datacoord.gracefulStopTimeout", "100")
 		assert.Equal(t, 100*time.Second, Params.GracefulStopTimeout.GetAsDuration(time.Second))
@@ -538,6 +552,13 @@ func TestComponentParam(t *testing.T) {
 		assert.Equal(t, 5, Params.MixCompactionSlotUsage.GetAsInt())
 		params.Save("dataCoord.slot.l0DeleteCompactionUsage", "4")
 		assert.Equal(t, 4, Params.L0DeleteCompactionSlotUsage.GetAsInt())
+		assert.Equal(t, []string{"manual", "l0", "mix", "clustering"}, Params.CompactionTaskPriorityClasses.GetAsStrings())
+		assert.Equal(t, 0, Params.CompactionMaxConcurrentTasksPerCollection.GetAsInt())
+		params.Save("dataCoord.compaction.maxConcurrentTasksPerCollection", "2")
+		assert.Equal(t, 2, Params.CompactionMaxConcurrentTasksPerCollection.GetAsInt())
+		assert.False(t, Params.TTLCompactionEnable.GetAsBool())
+		assert.Equal(t, 600*time.Second, Params.TTLCompactionTriggerInterval.GetAsDuration(time.Second))
+		assert.Equal(t, time.Hour, Params.TTLCompactionTimeWindow.GetAsDuration(time.Second))
 		params.Save("datacoord.scheduler.taskSlowThreshold", "1000")
 		assert.Equal(t, 1000*time.Second, Params.TaskSlowThreshold.GetAsDuration(time.Second))
 
@@ -581,6 +602,7 @@ func TestComponentParam(t *testing.T) {
 		period := &Params.SyncPeriod
 		t.Logf("SyncPeriod: %v", period)
 		assert.Equal(t, 10*time.Minute, Params.SyncPeriod.GetAsDuration(time.Second))
+		assert.True(t, Params.ZoneMapEnabled.GetAsBool())
 
 		channelWorkPoolSize := Params.ChannelWorkPoolSize.GetAsInt()
 		t.Logf("channelWorkPoolSize: %d", channelWorkPoolSize)
@@ -597,7 +619,10 @@ func TestComponentParam(t *testing.T) {
 		assert.Equal(t, 16, maxConcurrentImportTaskNum)
 		assert.Equal(t, int64(16), Params.MaxImportFileSizeInGB.GetAsInt64())
 		assert.Equal(t, 16, Params.ReadBufferSizeInMB.GetAsInt())
+		assert.Equal(t, 1000, Params.MaxBadRowsInReport.GetAsInt())
+		assert.Equal(t, 1000000, Params.MaxPrimaryKeysInDryRun.GetAsInt())
 		assert.Equal(t, 16, Params.MaxTaskSlotNum.GetAsInt())
+		assert.Equal(t, 2, Params.MaxConcurrentExportTaskNum.GetAsInt())
 		params.Save("datanode.gracefulStopTimeout", "100")
 		assert.Equal(t, 100*time.Second, Params.GracefulStopTimeout.GetAsDuration(time.Second))
 		assert.Equal(t, 16, Params.SlotCap.GetAsInt())
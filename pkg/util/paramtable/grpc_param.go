@@ -56,6 +56,7 @@ const (
 	DefaultInitialBackoff     float64 = 0.2
 	DefaultMaxBackoff         float64 = 10
 	DefaultCompressionEnabled bool    = false
+	DefaultCompressionType            = "zstd"
 
 	ProxyInternalPort = 19529
 	ProxyExternalPort = 19530
@@ -215,6 +216,7 @@ type GrpcClientConfig struct {
 	grpcConfig
 
 	CompressionEnabled ParamItem `refreshable:"false"`
+	CompressionType    ParamItem `refreshable:"false"`
 
 	ClientMaxSendSize ParamItem `refreshable:"false"`
 	ClientMaxRecvSize ParamItem `refreshable:"false"`
@@ -436,6 +438,28 @@ func (p *GrpcClientConfig) Init(domain string, base *BaseTable) {
 	}
 	p.CompressionEnabled.Init(base.mgr)
 
+	p.CompressionType = ParamItem{
+		Key:          "grpc.client.compressionType",
+		Version:      "2.6.0",
+		DefaultValue: DefaultCompressionType,
+		Formatter: func(v string) string {
+			switch v {
+			case "zstd", "lz4", "snappy":
+				return v
+			case "":
+				return DefaultCompressionType
+			default:
+				log.Warn("Unsupported grpc.client.compressionType, set to default",
+					zap.String("role", p.Domain),
+					zap.String("grpc.client.compressionType", v))
+				return DefaultCompressionType
+			}
+		},
+		Doc:    "the compression algorithm of the grpc payloads when compressionEnabled is true, support zstd, lz4 and snappy",
+		Export: true,
+	}
+	p.CompressionType.Init(base.mgr)
+
 	p.MinResetInterval = ParamItem{
 		Key:          "grpc.client.minResetInterval",
 		DefaultValue: "1000",
@@ -501,7 +525,7 @@ func (p *GrpcClientConfig) Init(domain string, base *BaseTable) {
 func (p *GrpcClientConfig) GetDialOptionsFromConfig() []grpc.DialOption {
 	compress := ""
 	if p.CompressionEnabled.GetAsBool() {
-		compress = "zstd"
+		compress = p.CompressionType.GetValue()
 	}
 	return []grpc.DialOption{
 		grpc.WithDefaultCallOptions(
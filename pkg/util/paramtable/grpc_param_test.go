@@ -150,6 +150,12 @@ func TestGrpcClientParams(t *testing.T) {
 	base.Save(clientConfig.CompressionEnabled.Key, "true")
 	assert.Equal(t, true, clientConfig.CompressionEnabled.GetAsBool())
 
+	assert.Equal(t, DefaultCompressionType, clientConfig.CompressionType.GetValue())
+	base.Save(clientConfig.CompressionType.Key, "unknown")
+	assert.Equal(t, DefaultCompressionType, clientConfig.CompressionType.GetValue())
+	base.Save(clientConfig.CompressionType.Key, "lz4")
+	assert.Equal(t, "lz4", clientConfig.CompressionType.GetValue())
+
 	assert.Equal(t, clientConfig.MinResetInterval.GetValue(), "1000")
 	base.Save("grpc.client.minResetInterval", "abc")
 	assert.Equal(t, clientConfig.MinResetInterval.GetValue(), "1000")
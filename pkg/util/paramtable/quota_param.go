@@ -164,6 +164,11 @@ type quotaConfig struct {
 
 	// limit reading
 	ForceDenyReading ParamItem `refreshable:"true"`
+
+	// limit users and roles
+	UserLimitEnabled ParamItem `refreshable:"true"`
+	UserRateLimits   ParamItem `refreshable:"true"`
+	RoleRateLimits   ParamItem `refreshable:"true"`
 }
 
 func (p *quotaConfig) init(base *BaseTable) {
@@ -1987,6 +1992,36 @@ specific conditions, such as collection has been dropped), ` + "true" + ` means
 	}
 	p.ForceDenyReading.Init(base.mgr)
 
+	p.UserLimitEnabled = ParamItem{
+		Key:          "quotaAndLimits.user.enabled",
+		Version:      "2.6.0",
+		DefaultValue: "false",
+		Doc:          "Whether the rate limits of users and roles are enabled.",
+		Export:       true,
+	}
+	p.UserLimitEnabled.Init(base.mgr)
+
+	p.UserRateLimits = ParamItem{
+		Key:          "quotaAndLimits.user.rates",
+		Version:      "2.6.0",
+		DefaultValue: "{}",
+		Doc: `rate limits of each user in json, keyed by the user name and then the rate type, e.g. {"alice": {"DMLInsert": 4, "DQLSearch": 10}},
+the user "*" applies to the users without their own limits, every user has a separate token bucket.
+The rates of DMLInsert, DMLUpsert, DMLDelete and DMLBulkLoad are in MB/s, DQLSearch is in vectors per second, the others are in requests per second.`,
+		Export: true,
+	}
+	p.UserRateLimits.Init(base.mgr)
+
+	p.RoleRateLimits = ParamItem{
+		Key:          "quotaAndLimits.role.rates",
+		Version:      "2.6.0",
+		DefaultValue: "{}",
+		Doc: `rate limits of each role in json, keyed by the role name and then the rate type, e.g. {"public": {"DQLQuery": 100}},
+the users of the role share the token bucket, the units of the rates are the same as quotaAndLimits.user.rates.`,
+		Export: true,
+	}
+	p.RoleRateLimits.Init(base.mgr)
+
 	p.AllocRetryTimes = ParamItem{
 		Key:          "quotaAndLimits.limits.allocRetryTimes",
 		Version:      "2.4.0",
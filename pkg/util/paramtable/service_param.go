@@ -50,6 +50,7 @@ type ServiceParam struct {
 	KafkaCfg        KafkaConfig
 	RocksmqCfg      RocksmqConfig
 	NatsmqCfg       NatsmqConfig
+	FileLogCfg      FileLogConfig
 	MinioCfg        MinioConfig
 	ProfileCfg      ProfileConfig
 }
@@ -64,6 +65,7 @@ func (p *ServiceParam) init(bt *BaseTable) {
 	p.KafkaCfg.Init(bt)
 	p.RocksmqCfg.Init(bt)
 	p.NatsmqCfg.Init(bt)
+	p.FileLogCfg.Init(bt)
 	p.MinioCfg.Init(bt)
 	p.ProfileCfg.Init(bt)
 }
@@ -542,7 +544,7 @@ func (p *MQConfig) Init(base *BaseTable) {
 		Version:      "2.3.0",
 		DefaultValue: "default",
 		Doc: `Default value: "default"
-Valid values: [default, pulsar, kafka, rocksmq, natsmq]`,
+Valid values: [default, pulsar, kafka, rocksmq, natsmq, filelog]`,
 		Export: true,
 	}
 	p.Type.Init(base.mgr)
@@ -1151,6 +1153,78 @@ func (r *NatsmqConfig) Init(base *BaseTable) {
 	r.ServerRetentionMaxMsgs.Init(base.mgr)
 }
 
+// /////////////////////////////////////////////////////////////////////////////
+// --- filelog ---
+// FileLogConfig describes the configuration options for the local file wal, only available with streaming service.
+type FileLogConfig struct {
+	Path         ParamItem `refreshable:"false"`
+	SegmentSize  ParamItem `refreshable:"false"`
+	SyncPolicy   ParamItem `refreshable:"false"`
+	SyncInterval ParamItem `refreshable:"false"`
+	// RetentionTimeInMinutes is the time of retention
+	RetentionTimeInMinutes ParamItem `refreshable:"false"`
+	// RetentionSizeInMB is the size of retention
+	RetentionSizeInMB ParamItem `refreshable:"false"`
+}
+
+func (r *FileLogConfig) Init(base *BaseTable) {
+	r.Path = ParamItem{
+		Key:          "filelog.path",
+		Version:      "2.6.0",
+		DefaultValue: "/var/lib/milvus/filelog",
+		Doc: `Local directory where the file wal stores its segment files, one sub directory per physical channel.
+Caution: Changing this parameter after using Milvus for a period of time will affect your access to old data.`,
+		Export: true,
+	}
+	r.Path.Init(base.mgr)
+
+	r.SegmentSize = ParamItem{
+		Key:          "filelog.segmentSize",
+		Version:      "2.6.0",
+		DefaultValue: "64m",
+		Doc:          "The maximum size of each segment file of the file wal, a new segment file is created once the current one exceeds it.",
+		Export:       true,
+	}
+	r.SegmentSize.Init(base.mgr)
+
+	r.SyncPolicy = ParamItem{
+		Key:          "filelog.syncPolicy",
+		Version:      "2.6.0",
+		DefaultValue: "always",
+		Doc: `The fsync policy of the file wal, valid values: [always, interval, never].
+always: fsync before every append returns, interval: fsync every syncInterval at background, never: leave it to the os.`,
+		Export: true,
+	}
+	r.SyncPolicy.Init(base.mgr)
+
+	r.SyncInterval = ParamItem{
+		Key:          "filelog.syncInterval",
+		Version:      "2.6.0",
+		DefaultValue: "100ms",
+		Doc:          "The fsync interval of the file wal if the sync policy is interval.",
+		Export:       true,
+	}
+	r.SyncInterval.Init(base.mgr)
+
+	r.RetentionTimeInMinutes = ParamItem{
+		Key:          "filelog.retentionTimeInMinutes",
+		Version:      "2.6.0",
+		DefaultValue: "4320",
+		Doc:          "The maximum retention time of the sealed segment files of the file wal. Unit: Minute.",
+		Export:       true,
+	}
+	r.RetentionTimeInMinutes.Init(base.mgr)
+
+	r.RetentionSizeInMB = ParamItem{
+		Key:          "filelog.retentionSizeInMB",
+		Version:      "2.6.0",
+		DefaultValue: "8192",
+		Doc:          "The maximum retention size of the segment files of each physical channel of the file wal. Unit: MB.",
+		Export:       true,
+	}
+	r.RetentionSizeInMB.Init(base.mgr)
+}
+
 // /////////////////////////////////////////////////////////////////////////////
 // --- minio ---
 type MinioConfig struct {
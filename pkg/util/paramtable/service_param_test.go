@@ -175,6 +175,17 @@ func TestServiceParam(t *testing.T) {
 		t.Logf("rocksmq path = %s", Params.Path.GetValue())
 	})
 
+	t.Run("test fileLogConfig", func(t *testing.T) {
+		Params := &SParams.FileLogCfg
+
+		assert.NotEqual(t, "", Params.Path.GetValue())
+		assert.Equal(t, int64(64*1024*1024), Params.SegmentSize.GetAsSize())
+		assert.Equal(t, "always", Params.SyncPolicy.GetValue())
+		assert.Equal(t, 100*time.Millisecond, Params.SyncInterval.GetAsDurationByParse())
+		assert.Equal(t, int64(4320), Params.RetentionTimeInMinutes.GetAsInt64())
+		assert.Equal(t, int64(8192), Params.RetentionSizeInMB.GetAsInt64())
+	})
+
 	t.Run("test kafkaConfig", func(t *testing.T) {
 		// test default value
 		{